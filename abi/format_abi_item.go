@@ -128,3 +128,117 @@ func (a *ABI) GetErrorSignature(name string) (string, error) {
 	}
 	return FormatErrorSignature(err.Name, err.Inputs), nil
 }
+
+// FormatAbi formats every item of the ABI as a human-readable signature,
+// the inverse of ParseAbi.
+//
+// This mirrors viem's formatAbi.
+//
+// Example:
+//
+//	sigs, _ := FormatAbi(erc20ABI)
+//	// ["function balanceOf(address owner) view returns (uint256)", ...]
+func FormatAbi(a *ABI) ([]string, error) {
+	items, err := ParseItems(a.raw)
+	if err != nil {
+		return nil, err
+	}
+	return FormatAbiItems(items), nil
+}
+
+// FormatAbiItems formats JSON ABI items as human-readable signatures.
+func FormatAbiItems(items []ABIItem) []string {
+	sigs := make([]string, len(items))
+	for i, item := range items {
+		sigs[i] = FormatHumanReadableAbiItem(item)
+	}
+	return sigs
+}
+
+// FormatHumanReadableAbiItem formats a JSON ABI item as a full human-readable
+// signature including parameter names, modifiers and return types.
+//
+// Example:
+//
+//	sig := FormatHumanReadableAbiItem(item) // "function transfer(address to, uint256 amount) returns (bool)"
+func FormatHumanReadableAbiItem(item ABIItem) string {
+	inputs := formatAbiInputs(item.Inputs)
+
+	switch item.Type {
+	case "event":
+		sig := fmt.Sprintf("event %s(%s)", item.Name, inputs)
+		if item.Anonymous {
+			sig += " anonymous"
+		}
+		return sig
+	case "error":
+		return fmt.Sprintf("error %s(%s)", item.Name, inputs)
+	case "constructor":
+		sig := fmt.Sprintf("constructor(%s)", inputs)
+		if item.StateMutability == "payable" {
+			sig += " payable"
+		}
+		return sig
+	case "fallback":
+		if item.StateMutability == "payable" {
+			return "fallback() external payable"
+		}
+		return "fallback() external"
+	case "receive":
+		return "receive() external payable"
+	default:
+		sig := fmt.Sprintf("function %s(%s)", item.Name, inputs)
+		if item.StateMutability != "" && item.StateMutability != "nonpayable" {
+			sig += " " + item.StateMutability
+		}
+		if len(item.Outputs) > 0 {
+			sig += fmt.Sprintf(" returns (%s)", formatAbiInputs(item.Outputs))
+		}
+		return sig
+	}
+}
+
+// FormatAbiParameters formats parameters as a human-readable list,
+// the inverse of ParseAbiParameters.
+//
+// Example:
+//
+//	params := FormatAbiParameters(params) // "address to, (uint256 a, bool b)[] items"
+func FormatAbiParameters(params []AbiParam) string {
+	parts := make([]string, len(params))
+	for i, param := range params {
+		parts[i] = formatAbiParam(param)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatAbiParam formats a single AbiParam with its name.
+func formatAbiParam(param AbiParam) string {
+	typ := param.Type
+	if strings.HasPrefix(typ, "tuple") {
+		typ = "(" + FormatAbiParameters(param.Components) + ")" + strings.TrimPrefix(typ, "tuple")
+	}
+	if param.Name != "" {
+		return typ + " " + param.Name
+	}
+	return typ
+}
+
+// formatAbiInputs formats JSON ABI inputs as a human-readable list.
+func formatAbiInputs(inputs []ABIInput) string {
+	parts := make([]string, len(inputs))
+	for i, input := range inputs {
+		typ := input.Type
+		if strings.HasPrefix(typ, "tuple") {
+			typ = "(" + formatAbiInputs(input.Components) + ")" + strings.TrimPrefix(typ, "tuple")
+		}
+		if input.Indexed {
+			typ += " indexed"
+		}
+		if input.Name != "" {
+			typ += " " + input.Name
+		}
+		parts[i] = typ
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,592 @@
+package abi
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	json "github.com/goccy/go-json"
+)
+
+var (
+	// structSignatureRegex matches "struct Name { ... }".
+	structSignatureRegex = regexp.MustCompile(`^struct\s+([A-Za-z$_][A-Za-z0-9$_]*)\s*\{(.*)\}$`)
+	// itemSignatureRegex matches the keyword and optional name of a signature.
+	itemSignatureRegex = regexp.MustCompile(`^(function|event|error)\s+([A-Za-z$_][A-Za-z0-9$_]*)\s*\(`)
+	// specialSignatureRegex matches constructor, fallback and receive signatures.
+	specialSignatureRegex = regexp.MustCompile(`^(constructor|fallback|receive)\s*\(`)
+	// identifierRegex matches a valid Solidity identifier.
+	identifierRegex = regexp.MustCompile(`^[A-Za-z$_][A-Za-z0-9$_]*$`)
+	// elementaryTypeRegex matches elementary Solidity types without array suffixes.
+	elementaryTypeRegex = regexp.MustCompile(`^(address|bool|string|bytes([1-9]|[12][0-9]|3[0-2])?|u?int([1-9][0-9]{0,2})?|function)$`)
+	// arraySuffixRegex matches the array dimensions of a type, e.g. "[][3]".
+	arraySuffixRegex = regexp.MustCompile(`^(\[[0-9]*\])*$`)
+)
+
+// dataLocations are modifiers accepted (and ignored) on function parameters.
+var dataLocations = map[string]bool{"memory": true, "calldata": true, "storage": true}
+
+// ParseAbi parses human-readable ABI signatures into an ABI.
+// Struct definitions may appear anywhere in the list and be referenced by name.
+//
+// This mirrors viem's parseAbi.
+//
+// Example:
+//
+//	parsed, err := abi.ParseAbi([]string{
+//	    "struct Order { address maker; uint256 amount; }",
+//	    "function fill(Order order) payable returns (bool)",
+//	    "function balanceOf(address owner) view returns (uint256)",
+//	    "event Transfer(address indexed from, address indexed to, uint256 amount)",
+//	    "error InsufficientBalance(uint256 available, uint256 required)",
+//	})
+func ParseAbi(signatures []string) (*ABI, error) {
+	items, err := ParseAbiItems(signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonABI, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode ABI: %w", err)
+	}
+	return Parse(jsonABI)
+}
+
+// MustParseAbi parses human-readable ABI signatures and panics on error.
+func MustParseAbi(signatures []string) *ABI {
+	a, err := ParseAbi(signatures)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// ParseAbiItems parses human-readable ABI signatures into JSON ABI items.
+// Struct definitions are resolved and omitted from the result.
+func ParseAbiItems(signatures []string) ([]ABIItem, error) {
+	structs, rest, err := parseStructs(signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ABIItem, 0, len(rest))
+	for _, sig := range rest {
+		item, err := parseSignature(sig, structs)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// ParseAbiItem parses a single human-readable signature into a JSON ABI item.
+// Optional struct definitions can be passed for struct-typed parameters.
+//
+// This mirrors viem's parseAbiItem.
+//
+// Example:
+//
+//	item, err := abi.ParseAbiItem("function transfer(address to, uint256 amount) returns (bool)")
+func ParseAbiItem(signature string, structDefs ...string) (*ABIItem, error) {
+	items, err := ParseAbiItems(append(slices.Clip(structDefs), signature))
+	if err != nil {
+		return nil, err
+	}
+	if len(items) != 1 {
+		return nil, fmt.Errorf("expected a single ABI item signature, got %d", len(items))
+	}
+	return &items[0], nil
+}
+
+// ParseAbiParameters parses a comma-separated list of human-readable parameters.
+// Optional struct definitions can be passed for struct-typed parameters.
+//
+// This mirrors viem's parseAbiParameters.
+//
+// Example:
+//
+//	params, err := abi.ParseAbiParameters("address to, uint256 amount, (bool ok, bytes data)[] results")
+func ParseAbiParameters(params string, structDefs ...string) ([]AbiParam, error) {
+	structs, rest, err := parseStructs(structDefs)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("invalid struct signature %q", rest[0])
+	}
+
+	inputs, err := parseParamList(params, structs, paramContextAny)
+	if err != nil {
+		return nil, err
+	}
+	return inputsToAbiParams(inputs), nil
+}
+
+// ParseAbiParameter parses a single human-readable parameter, e.g. "address indexed from".
+//
+// This mirrors viem's parseAbiParameter.
+func ParseAbiParameter(param string, structDefs ...string) (AbiParam, error) {
+	params, err := ParseAbiParameters(param, structDefs...)
+	if err != nil {
+		return AbiParam{}, err
+	}
+	if len(params) != 1 {
+		return AbiParam{}, fmt.Errorf("expected a single parameter, got %d", len(params))
+	}
+	return params[0], nil
+}
+
+// paramContext restricts which modifiers a parameter may carry.
+type paramContext int
+
+const (
+	paramContextAny paramContext = iota
+	paramContextFunction
+	paramContextEvent
+	paramContextStruct
+)
+
+// structResolver lazily resolves struct definitions into tuple components.
+type structResolver struct {
+	bodies   map[string]string
+	resolved map[string][]ABIInput
+	visiting map[string]bool
+}
+
+// parseStructs separates struct definitions from other signatures.
+func parseStructs(signatures []string) (*structResolver, []string, error) {
+	r := &structResolver{
+		bodies:   make(map[string]string),
+		resolved: make(map[string][]ABIInput),
+		visiting: make(map[string]bool),
+	}
+
+	var rest []string
+	for _, raw := range signatures {
+		sig := normalizeSignature(raw)
+		if sig == "" {
+			continue
+		}
+		if !strings.HasPrefix(sig, "struct ") {
+			rest = append(rest, sig)
+			continue
+		}
+		m := structSignatureRegex.FindStringSubmatch(sig)
+		if m == nil {
+			return nil, nil, fmt.Errorf("invalid struct signature %q", raw)
+		}
+		if _, dup := r.bodies[m[1]]; dup {
+			return nil, nil, fmt.Errorf("duplicate struct %q", m[1])
+		}
+		r.bodies[m[1]] = m[2]
+	}
+
+	// Resolve eagerly so errors surface even for unreferenced structs.
+	for name := range r.bodies {
+		if _, _, err := r.resolve(name); err != nil {
+			return nil, nil, err
+		}
+	}
+	return r, rest, nil
+}
+
+// resolve returns the tuple components of a struct, detecting cycles.
+func (r *structResolver) resolve(name string) ([]ABIInput, bool, error) {
+	if components, ok := r.resolved[name]; ok {
+		return components, true, nil
+	}
+	body, ok := r.bodies[name]
+	if !ok {
+		return nil, false, nil
+	}
+	if r.visiting[name] {
+		return nil, true, fmt.Errorf("circular reference in struct %q", name)
+	}
+	r.visiting[name] = true
+	defer delete(r.visiting, name)
+
+	var members []ABIInput
+	for _, member := range strings.Split(body, ";") {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			continue
+		}
+		input, err := parseParam(member, r, paramContextStruct)
+		if err != nil {
+			return nil, true, fmt.Errorf("struct %s: %w", name, err)
+		}
+		members = append(members, input)
+	}
+	if len(members) == 0 {
+		return nil, true, fmt.Errorf("struct %q has no members", name)
+	}
+
+	r.resolved[name] = members
+	return members, true, nil
+}
+
+// parseSignature parses a single non-struct signature.
+func parseSignature(sig string, structs *structResolver) (ABIItem, error) {
+	if m := itemSignatureRegex.FindStringSubmatch(sig); m != nil {
+		kind, name := m[1], m[2]
+		open := len(m[0]) - 1
+		inner, rest, err := splitParens(sig, open)
+		if err != nil {
+			return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+		}
+
+		switch kind {
+		case "function":
+			return parseFunctionSignature(sig, name, inner, rest, structs)
+		case "event":
+			inputs, err := parseParamList(inner, structs, paramContextEvent)
+			if err != nil {
+				return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+			}
+			item := ABIItem{Type: "event", Name: name, Inputs: inputs}
+			switch strings.TrimSpace(rest) {
+			case "":
+			case "anonymous":
+				item.Anonymous = true
+			default:
+				return ABIItem{}, fmt.Errorf("invalid signature %q: unexpected %q", sig, rest)
+			}
+			return item, nil
+		default: // error
+			inputs, err := parseParamList(inner, structs, paramContextAny)
+			if err != nil {
+				return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+			}
+			if strings.TrimSpace(rest) != "" {
+				return ABIItem{}, fmt.Errorf("invalid signature %q: unexpected %q", sig, rest)
+			}
+			return ABIItem{Type: "error", Name: name, Inputs: inputs}, nil
+		}
+	}
+
+	if m := specialSignatureRegex.FindStringSubmatch(sig); m != nil {
+		kind := m[1]
+		inner, rest, err := splitParens(sig, len(m[0])-1)
+		if err != nil {
+			return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+		}
+		modifiers := strings.Fields(rest)
+
+		switch kind {
+		case "constructor":
+			inputs, err := parseParamList(inner, structs, paramContextFunction)
+			if err != nil {
+				return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+			}
+			mutability, err := parseMutability(modifiers, false)
+			if err != nil {
+				return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+			}
+			return ABIItem{Type: "constructor", Inputs: inputs, StateMutability: mutability}, nil
+		case "fallback":
+			if strings.TrimSpace(inner) != "" {
+				return ABIItem{}, fmt.Errorf("invalid signature %q: fallback takes no parameters", sig)
+			}
+			mutability, err := parseMutability(modifiers, true)
+			if err != nil {
+				return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+			}
+			return ABIItem{Type: "fallback", StateMutability: mutability}, nil
+		default: // receive
+			if strings.TrimSpace(inner) != "" {
+				return ABIItem{}, fmt.Errorf("invalid signature %q: receive takes no parameters", sig)
+			}
+			return ABIItem{Type: "receive", StateMutability: "payable"}, nil
+		}
+	}
+
+	return ABIItem{}, fmt.Errorf("unknown signature %q", sig)
+}
+
+// parseFunctionSignature parses the remainder of a function signature.
+func parseFunctionSignature(sig, name, inner, rest string, structs *structResolver) (ABIItem, error) {
+	inputs, err := parseParamList(inner, structs, paramContextFunction)
+	if err != nil {
+		return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+	}
+
+	var outputs []ABIInput
+	modifierPart := rest
+	if idx := strings.Index(rest, "returns"); idx >= 0 {
+		modifierPart = rest[:idx]
+		returns := strings.TrimSpace(rest[idx+len("returns"):])
+		if !strings.HasPrefix(returns, "(") {
+			return ABIItem{}, fmt.Errorf("invalid signature %q: expected \"(\" after returns", sig)
+		}
+		outInner, trailing, err := splitParens(returns, 0)
+		if err != nil {
+			return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+		}
+		if strings.TrimSpace(trailing) != "" {
+			return ABIItem{}, fmt.Errorf("invalid signature %q: unexpected %q", sig, trailing)
+		}
+		outputs, err = parseParamList(outInner, structs, paramContextFunction)
+		if err != nil {
+			return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+		}
+	}
+
+	mutability, err := parseMutability(strings.Fields(modifierPart), true)
+	if err != nil {
+		return ABIItem{}, fmt.Errorf("invalid signature %q: %w", sig, err)
+	}
+
+	return ABIItem{
+		Type:            "function",
+		Name:            name,
+		Inputs:          inputs,
+		Outputs:         outputs,
+		StateMutability: mutability,
+	}, nil
+}
+
+// parseMutability reads visibility and state mutability modifiers.
+func parseMutability(modifiers []string, allowVisibility bool) (string, error) {
+	mutability := "nonpayable"
+	seen := false
+	for _, mod := range modifiers {
+		switch mod {
+		case "external", "public":
+			if !allowVisibility {
+				return "", fmt.Errorf("unexpected modifier %q", mod)
+			}
+		case "pure", "view", "payable", "nonpayable":
+			if seen {
+				return "", fmt.Errorf("multiple state mutability modifiers")
+			}
+			seen = true
+			mutability = mod
+		default:
+			return "", fmt.Errorf("unexpected modifier %q", mod)
+		}
+	}
+	return mutability, nil
+}
+
+// parseParamList parses a comma-separated parameter list.
+func parseParamList(list string, structs *structResolver, ctx paramContext) ([]ABIInput, error) {
+	parts, err := splitTopLevel(list, ',')
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]ABIInput, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			if len(parts) == 1 {
+				break
+			}
+			return nil, fmt.Errorf("empty parameter in %q", list)
+		}
+		input, err := parseParam(part, structs, ctx)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+// parseParam parses a single parameter: "<type> [modifiers] [name]".
+func parseParam(param string, structs *structResolver, ctx paramContext) (ABIInput, error) {
+	typ, rest, err := splitType(param)
+	if err != nil {
+		return ABIInput{}, err
+	}
+
+	input, err := resolveType(typ, structs)
+	if err != nil {
+		return ABIInput{}, err
+	}
+
+	for _, word := range strings.Fields(rest) {
+		switch {
+		case word == "indexed":
+			if ctx != paramContextEvent && ctx != paramContextAny {
+				return ABIInput{}, fmt.Errorf("invalid modifier \"indexed\" in %q", param)
+			}
+			input.Indexed = true
+		case dataLocations[word]:
+			if ctx != paramContextFunction && ctx != paramContextAny {
+				return ABIInput{}, fmt.Errorf("invalid modifier %q in %q", word, param)
+			}
+		case input.Name == "" && identifierRegex.MatchString(word):
+			input.Name = word
+		default:
+			return ABIInput{}, fmt.Errorf("invalid parameter %q", param)
+		}
+	}
+	return input, nil
+}
+
+// splitType separates the type of a parameter from its trailing words.
+func splitType(param string) (string, string, error) {
+	param = strings.TrimSpace(param)
+	end := 0
+	if strings.HasPrefix(param, "(") || strings.HasPrefix(param, "tuple(") {
+		open := strings.Index(param, "(")
+		_, rest, err := splitParens(param, open)
+		if err != nil {
+			return "", "", err
+		}
+		end = len(param) - len(rest)
+	}
+	// Consume the remaining type characters, including array suffixes.
+	for end < len(param) && param[end] != ' ' && param[end] != '\t' {
+		end++
+	}
+	return param[:end], param[end:], nil
+}
+
+// resolveType resolves a type string (elementary, tuple or struct reference).
+func resolveType(typ string, structs *structResolver) (ABIInput, error) {
+	base, suffix := typ, ""
+	if strings.HasPrefix(typ, "(") || strings.HasPrefix(typ, "tuple(") {
+		open := strings.Index(typ, "(")
+		inner, rest, err := splitParens(typ, open)
+		if err != nil {
+			return ABIInput{}, err
+		}
+		if !arraySuffixRegex.MatchString(rest) {
+			return ABIInput{}, fmt.Errorf("invalid type %q", typ)
+		}
+		components, err := parseParamList(inner, structs, paramContextStruct)
+		if err != nil {
+			return ABIInput{}, err
+		}
+		if len(components) == 0 {
+			return ABIInput{}, fmt.Errorf("empty tuple in %q", typ)
+		}
+		return ABIInput{Type: "tuple" + rest, Components: components}, nil
+	}
+
+	if idx := strings.Index(typ, "["); idx >= 0 {
+		base, suffix = typ[:idx], typ[idx:]
+		if !arraySuffixRegex.MatchString(suffix) {
+			return ABIInput{}, fmt.Errorf("invalid type %q", typ)
+		}
+	}
+
+	if elementaryTypeRegex.MatchString(base) {
+		normalized, err := normalizeElementaryType(base)
+		if err != nil {
+			return ABIInput{}, err
+		}
+		return ABIInput{Type: normalized + suffix}, nil
+	}
+
+	if structs != nil {
+		components, ok, err := structs.resolve(base)
+		if err != nil {
+			return ABIInput{}, err
+		}
+		if ok {
+			return ABIInput{Type: "tuple" + suffix, Components: components}, nil
+		}
+	}
+	return ABIInput{}, fmt.Errorf("unknown type %q", typ)
+}
+
+// normalizeElementaryType expands aliases and validates integer sizes.
+func normalizeElementaryType(typ string) (string, error) {
+	switch typ {
+	case "uint":
+		return "uint256", nil
+	case "int":
+		return "int256", nil
+	}
+	for _, prefix := range []string{"uint", "int"} {
+		if !strings.HasPrefix(typ, prefix) {
+			continue
+		}
+		size, err := strconv.Atoi(strings.TrimPrefix(typ, prefix))
+		if err != nil || size < 8 || size > 256 || size%8 != 0 {
+			return "", fmt.Errorf("invalid integer type %q", typ)
+		}
+		return typ, nil
+	}
+	return typ, nil
+}
+
+// splitParens returns the content of the parenthesized group starting at
+// open, and whatever follows its closing parenthesis.
+func splitParens(s string, open int) (string, string, error) {
+	if open >= len(s) || s[open] != '(' {
+		return "", "", fmt.Errorf("expected \"(\"")
+	}
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s[open+1 : i], s[i+1:], nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("unbalanced parentheses")
+}
+
+// splitTopLevel splits s on sep, ignoring separators nested in parentheses.
+func splitTopLevel(s string, sep byte) ([]string, error) {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses in %q", s)
+			}
+		case sep:
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses in %q", s)
+	}
+	return append(parts, s[start:]), nil
+}
+
+// normalizeSignature collapses whitespace and trims trailing semicolons.
+func normalizeSignature(sig string) string {
+	sig = strings.Join(strings.Fields(sig), " ")
+	sig = strings.TrimSuffix(sig, ";")
+	sig = strings.ReplaceAll(sig, "( ", "(")
+	sig = strings.ReplaceAll(sig, " )", ")")
+	sig = strings.ReplaceAll(sig, " ,", ",")
+	return strings.TrimSpace(sig)
+}
+
+// inputsToAbiParams converts JSON ABI inputs to AbiParams.
+func inputsToAbiParams(inputs []ABIInput) []AbiParam {
+	params := make([]AbiParam, len(inputs))
+	for i, input := range inputs {
+		params[i] = AbiParam{
+			Name:       input.Name,
+			Type:       input.Type,
+			Components: inputsToAbiParams(input.Components),
+		}
+		if len(input.Components) == 0 {
+			params[i].Components = nil
+		}
+	}
+	return params
+}
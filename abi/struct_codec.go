@@ -0,0 +1,789 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	bigIntPtrType = reflect.TypeOf((*big.Int)(nil))
+	addressType   = reflect.TypeOf(common.Address{})
+)
+
+// DecodeInto decodes ABI-encoded data into a value of type T.
+//
+// With a single parameter, T receives that value directly. With several
+// parameters, T must be a struct (fields matched to parameter names), a slice
+// or array, or any. Tuples map onto structs the same way, recursively.
+//
+// Struct fields are matched to ABI names case-insensitively, or exactly via an
+// `abi:"name"` tag. Fields tagged `abi:"-"` are ignored. Every ABI value must
+// have a field and every field must match an ABI value, so shape mismatches
+// are reported instead of silently dropped. Unnamed tuple components are
+// matched by field position.
+//
+// Integers decode into *big.Int or any Go integer kind (with overflow checks),
+// addresses into common.Address, fixed bytes into byte arrays such as
+// common.Hash, and arrays into slices or fixed-size arrays.
+//
+// Example:
+//
+//	type Reserves struct {
+//	    Reserve0  *big.Int
+//	    Reserve1  *big.Int
+//	    Timestamp uint32 `abi:"blockTimestampLast"`
+//	}
+//	reserves, err := abi.DecodeInto[Reserves](outputs, data)
+func DecodeInto[T any](params []AbiParam, data []byte) (T, error) {
+	var out T
+
+	args, err := paramsToArguments(params)
+	if err != nil {
+		return out, fmt.Errorf("failed to parse parameters: %w", err)
+	}
+	if err := decodeArgumentsInto(reflect.ValueOf(&out).Elem(), args, data); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+// DecodeFunctionResultAs decodes the return data of a function into a value of
+// type T, following the same rules as DecodeInto.
+//
+// Example:
+//
+//	reserves, err := abi.DecodeFunctionResultAs[Reserves](pairABI, "getReserves", data)
+func DecodeFunctionResultAs[T any](a *ABI, functionName string, data []byte) (T, error) {
+	var out T
+
+	m, ok := a.gethABI.Methods[functionName]
+	if !ok {
+		return out, fmt.Errorf("function %q not found on ABI", functionName)
+	}
+	if err := decodeArgumentsInto(reflect.ValueOf(&out).Elem(), m.Outputs, data); err != nil {
+		return out, fmt.Errorf("failed to decode function result for %q: %w", functionName, err)
+	}
+	return out, nil
+}
+
+// DecodeEventLogInto decodes an event log (indexed topics and data) into a
+// value of type T, following the same rules as DecodeInto with the event
+// inputs as parameters. Indexed dynamic values (strings, bytes, arrays and
+// tuples) are only available as their keccak256 hash and decode as bytes32.
+//
+// Example:
+//
+//	type Transfer struct {
+//	    From  common.Address
+//	    To    common.Address
+//	    Value *big.Int
+//	}
+//	ev, err := abi.DecodeEventLogInto[Transfer](erc20ABI, "Transfer", topics, data)
+func DecodeEventLogInto[T any](a *ABI, eventName string, topics []common.Hash, data []byte) (T, error) {
+	var out T
+
+	e, ok := a.gethABI.Events[eventName]
+	if !ok {
+		return out, fmt.Errorf("event %q not found on ABI", eventName)
+	}
+	decoded, err := a.DecodeEventLogByName(eventName, topics, data)
+	if err != nil {
+		return out, err
+	}
+
+	bytes32, _ := abi.NewType("bytes32", "", nil)
+	names := make([]string, len(e.Inputs))
+	types := make([]*abi.Type, len(e.Inputs))
+	srcs := make([]reflect.Value, len(e.Inputs))
+	for i, input := range e.Inputs {
+		v, ok := decoded.Args[input.Name]
+		if !ok {
+			return out, fmt.Errorf("failed to decode event %q: missing value for %q", eventName, input.Name)
+		}
+		typ := input.Type
+		if input.Indexed {
+			switch typ.T {
+			case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+				typ = bytes32
+			case abi.FixedBytesTy:
+				// Topics are left-aligned 32-byte words.
+				topic := v.(common.Hash)
+				v = topic[:typ.Size]
+			}
+		}
+		names[i] = input.Name
+		types[i] = &typ
+		srcs[i] = reflect.ValueOf(v)
+	}
+
+	dst := reflect.ValueOf(&out).Elem()
+	if len(types) == 1 && !isParamsStruct(dst.Type(), *types[0]) {
+		err = decodeValue(dst, srcs[0], *types[0], names[0])
+	} else {
+		err = decodeTuple(dst, names, types, srcs, "")
+	}
+	if err != nil {
+		return out, fmt.Errorf("failed to decode event %q: %w", eventName, err)
+	}
+	return out, nil
+}
+
+// EncodeFrom ABI-encodes a Go value according to the parameter definitions.
+//
+// With a single parameter, value is that parameter. With several parameters,
+// value must be a struct (fields matched to parameter names as in DecodeInto),
+// a map[string]any, or a slice or array with one element per parameter.
+//
+// Example:
+//
+//	type Order struct {
+//	    Maker  common.Address
+//	    Amount *big.Int
+//	}
+//	params, _ := abi.ParseAbiParameters("(address maker, uint256 amount) order")
+//	encoded, err := abi.EncodeFrom(params, Order{Maker: maker, Amount: big.NewInt(1)})
+func EncodeFrom(params []AbiParam, value any) ([]byte, error) {
+	args, err := paramsToArguments(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse parameters: %w", err)
+	}
+
+	values, err := encodeArguments(args, reflect.ValueOf(value))
+	if err != nil {
+		return nil, err
+	}
+
+	packed, err := args.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode parameters: %w", err)
+	}
+	return packed, nil
+}
+
+// EncodeFunctionDataFrom encodes a function call whose arguments are taken
+// from a Go value, following the same rules as EncodeFrom.
+func (a *ABI) EncodeFunctionDataFrom(functionName string, value any) ([]byte, error) {
+	m, ok := a.gethABI.Methods[functionName]
+	if !ok {
+		return nil, fmt.Errorf("function %q not found on ABI", functionName)
+	}
+
+	values, err := encodeArguments(m.Inputs, reflect.ValueOf(value))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode arguments for %q: %w", functionName, err)
+	}
+
+	packed, err := m.Inputs.Pack(values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode arguments for %q: %w", functionName, err)
+	}
+	return append(m.ID[:4:4], packed...), nil
+}
+
+// =============================================================================
+// Decoding
+// =============================================================================
+
+// decodeArgumentsInto unpacks data and assigns the values to dst.
+func decodeArgumentsInto(dst reflect.Value, args abi.Arguments, data []byte) error {
+	if len(args) == 0 {
+		return nil
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("cannot decode zero data with non-empty params")
+	}
+
+	unpacked, err := args.Unpack(data)
+	if err != nil {
+		return fmt.Errorf("failed to decode parameters: %w", err)
+	}
+
+	if len(args) == 1 && !isParamsStruct(dst.Type(), args[0].Type) {
+		return decodeValue(dst, reflect.ValueOf(unpacked[0]), args[0].Type, args[0].Name)
+	}
+
+	names := make([]string, len(args))
+	types := make([]*abi.Type, len(args))
+	srcs := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		names[i] = arg.Name
+		types[i] = &args[i].Type
+		srcs[i] = reflect.ValueOf(unpacked[i])
+	}
+	return decodeTuple(dst, names, types, srcs, "")
+}
+
+// decodeValue assigns a decoded go-ethereum value to dst.
+func decodeValue(dst, src reflect.Value, typ abi.Type, path string) error {
+	for dst.Kind() == reflect.Ptr && dst.Type() != bigIntPtrType {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		dst = dst.Elem()
+	}
+	if dst.Kind() == reflect.Interface && dst.NumMethod() == 0 {
+		dst.Set(src)
+		return nil
+	}
+
+	switch typ.T {
+	case abi.IntTy, abi.UintTy:
+		bi, ok := toBigInt(src)
+		if !ok {
+			return mismatch(path, typ, dst.Type())
+		}
+		return setInteger(dst, bi, typ, path)
+
+	case abi.BoolTy:
+		if dst.Kind() != reflect.Bool {
+			return mismatch(path, typ, dst.Type())
+		}
+		dst.SetBool(src.Bool())
+		return nil
+
+	case abi.StringTy:
+		if dst.Kind() != reflect.String {
+			return mismatch(path, typ, dst.Type())
+		}
+		dst.SetString(src.String())
+		return nil
+
+	case abi.AddressTy:
+		addr := src.Interface().(common.Address)
+		switch {
+		case addressType.ConvertibleTo(dst.Type()):
+			dst.Set(reflect.ValueOf(addr).Convert(dst.Type()))
+		case dst.Kind() == reflect.String:
+			dst.SetString(addr.Hex())
+		default:
+			return mismatch(path, typ, dst.Type())
+		}
+		return nil
+
+	case abi.BytesTy, abi.FixedBytesTy, abi.FunctionTy:
+		return setBytes(dst, bytesOf(src), typ, path)
+
+	case abi.SliceTy, abi.ArrayTy:
+		n := src.Len()
+		switch dst.Kind() {
+		case reflect.Slice:
+			dst.Set(reflect.MakeSlice(dst.Type(), n, n))
+		case reflect.Array:
+			if dst.Len() != n {
+				return fmt.Errorf("%s: cannot decode %s of length %d into %s", pathOrValue(path), typ.String(), n, dst.Type())
+			}
+		default:
+			return mismatch(path, typ, dst.Type())
+		}
+		for i := 0; i < n; i++ {
+			if err := decodeValue(dst.Index(i), src.Index(i), *typ.Elem, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case abi.TupleTy:
+		srcs := make([]reflect.Value, len(typ.TupleElems))
+		for i := range srcs {
+			srcs[i] = src.Field(i)
+		}
+		return decodeTuple(dst, typ.TupleRawNames, typ.TupleElems, srcs, path)
+	}
+
+	return mismatch(path, typ, dst.Type())
+}
+
+// decodeTuple assigns a list of named values to a struct, slice, array or map.
+func decodeTuple(dst reflect.Value, names []string, types []*abi.Type, srcs []reflect.Value, path string) error {
+	for dst.Kind() == reflect.Ptr {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		dst = dst.Elem()
+	}
+
+	switch dst.Kind() {
+	case reflect.Struct:
+		indexes, err := matchFields(dst.Type(), names, path)
+		if err != nil {
+			return err
+		}
+		for i, idx := range indexes {
+			if err := decodeValue(dst.Field(idx), srcs[i], *types[i], joinPath(path, names[i], i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		if dst.Kind() == reflect.Slice {
+			dst.Set(reflect.MakeSlice(dst.Type(), len(srcs), len(srcs)))
+		} else if dst.Len() != len(srcs) {
+			return fmt.Errorf("%s: cannot decode %d values into %s", pathOrValue(path), len(srcs), dst.Type())
+		}
+		for i := range srcs {
+			if err := decodeValue(dst.Index(i), srcs[i], *types[i], joinPath(path, names[i], i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Map:
+		if dst.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMapWithSize(dst.Type(), len(srcs)))
+		}
+		for i := range srcs {
+			elem := reflect.New(dst.Type().Elem()).Elem()
+			if err := decodeValue(elem, srcs[i], *types[i], joinPath(path, names[i], i)); err != nil {
+				return err
+			}
+			dst.SetMapIndex(reflect.ValueOf(names[i]).Convert(dst.Type().Key()), elem)
+		}
+		return nil
+
+	case reflect.Interface:
+		if dst.NumMethod() != 0 {
+			break
+		}
+		values := make([]any, len(srcs))
+		for i, src := range srcs {
+			values[i] = src.Interface()
+		}
+		dst.Set(reflect.ValueOf(values))
+		return nil
+	}
+
+	return fmt.Errorf("%s: cannot decode %d values into %s", pathOrValue(path), len(srcs), dst.Type())
+}
+
+// setInteger assigns a big integer to an integer or *big.Int destination.
+func setInteger(dst reflect.Value, bi *big.Int, typ abi.Type, path string) error {
+	switch {
+	case dst.Type() == bigIntPtrType:
+		dst.Set(reflect.ValueOf(new(big.Int).Set(bi)))
+	case dst.Kind() >= reflect.Int && dst.Kind() <= reflect.Int64:
+		if !bi.IsInt64() || dst.OverflowInt(bi.Int64()) {
+			return fmt.Errorf("%s: value %s overflows %s", pathOrValue(path), bi, dst.Type())
+		}
+		dst.SetInt(bi.Int64())
+	case dst.Kind() >= reflect.Uint && dst.Kind() <= reflect.Uintptr:
+		if bi.Sign() < 0 || !bi.IsUint64() || dst.OverflowUint(bi.Uint64()) {
+			return fmt.Errorf("%s: value %s overflows %s", pathOrValue(path), bi, dst.Type())
+		}
+		dst.SetUint(bi.Uint64())
+	default:
+		return mismatch(path, typ, dst.Type())
+	}
+	return nil
+}
+
+// setBytes assigns a byte string to a []byte, byte array or string destination.
+func setBytes(dst reflect.Value, b []byte, typ abi.Type, path string) error {
+	switch {
+	case dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8:
+		dst.SetBytes(append([]byte(nil), b...))
+	case dst.Kind() == reflect.Array && dst.Type().Elem().Kind() == reflect.Uint8:
+		if dst.Len() != len(b) {
+			return fmt.Errorf("%s: cannot decode %s into %s", pathOrValue(path), typ.String(), dst.Type())
+		}
+		reflect.Copy(dst, reflect.ValueOf(b))
+	case dst.Kind() == reflect.String:
+		dst.SetString(hexutil.Encode(b))
+	default:
+		return mismatch(path, typ, dst.Type())
+	}
+	return nil
+}
+
+// =============================================================================
+// Encoding
+// =============================================================================
+
+// encodeArguments converts a Go value into the values expected by args.Pack.
+func encodeArguments(args abi.Arguments, value reflect.Value) ([]any, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	if len(args) == 1 && (!value.IsValid() || !isParamsStruct(value.Type(), args[0].Type)) {
+		v, err := encodeValue(value, args[0].Type, args[0].Name)
+		if err != nil {
+			return nil, err
+		}
+		return []any{v.Interface()}, nil
+	}
+
+	names := make([]string, len(args))
+	types := make([]*abi.Type, len(args))
+	for i, arg := range args {
+		names[i] = arg.Name
+		types[i] = &args[i].Type
+	}
+	encoded, err := encodeTuple(value, names, types, "")
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, len(encoded))
+	for i, v := range encoded {
+		values[i] = v.Interface()
+	}
+	return values, nil
+}
+
+// encodeValue converts src into a value of the Go type go-ethereum expects for typ.
+func encodeValue(src reflect.Value, typ abi.Type, path string) (reflect.Value, error) {
+	src = indirect(src)
+	if !src.IsValid() {
+		return reflect.Value{}, fmt.Errorf("%s: missing value for %s", pathOrValue(path), typ.String())
+	}
+	target := typ.GetType()
+
+	switch typ.T {
+	case abi.IntTy, abi.UintTy:
+		bi, ok := toBigInt(src)
+		if !ok {
+			return reflect.Value{}, mismatchEncode(path, typ, src.Type())
+		}
+		if !fitsInteger(bi, typ) {
+			return reflect.Value{}, fmt.Errorf("%s: value %s out of range for %s", pathOrValue(path), bi, typ.String())
+		}
+		if target == bigIntPtrType {
+			return reflect.ValueOf(new(big.Int).Set(bi)), nil
+		}
+		out := reflect.New(target).Elem()
+		if typ.T == abi.IntTy {
+			out.SetInt(bi.Int64())
+		} else {
+			out.SetUint(bi.Uint64())
+		}
+		return out, nil
+
+	case abi.BoolTy:
+		if src.Kind() != reflect.Bool {
+			return reflect.Value{}, mismatchEncode(path, typ, src.Type())
+		}
+		return reflect.ValueOf(src.Bool()), nil
+
+	case abi.StringTy:
+		if src.Kind() != reflect.String {
+			return reflect.Value{}, mismatchEncode(path, typ, src.Type())
+		}
+		return reflect.ValueOf(src.String()), nil
+
+	case abi.AddressTy:
+		switch {
+		case src.Type().ConvertibleTo(addressType) && src.Kind() == reflect.Array:
+			return src.Convert(addressType), nil
+		case src.Kind() == reflect.String:
+			if !common.IsHexAddress(src.String()) {
+				return reflect.Value{}, fmt.Errorf("%s: invalid address %q", pathOrValue(path), src.String())
+			}
+			return reflect.ValueOf(common.HexToAddress(src.String())), nil
+		}
+		return reflect.Value{}, mismatchEncode(path, typ, src.Type())
+
+	case abi.BytesTy:
+		b, err := encodeBytes(src, typ, path)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b), nil
+
+	case abi.FixedBytesTy, abi.FunctionTy:
+		b, err := encodeBytes(src, typ, path)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(b) != target.Len() {
+			return reflect.Value{}, fmt.Errorf("%s: expected %d bytes for %s, got %d", pathOrValue(path), target.Len(), typ.String(), len(b))
+		}
+		out := reflect.New(target).Elem()
+		reflect.Copy(out, reflect.ValueOf(b))
+		return out, nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		if src.Kind() != reflect.Slice && src.Kind() != reflect.Array {
+			return reflect.Value{}, mismatchEncode(path, typ, src.Type())
+		}
+		n := src.Len()
+		var out reflect.Value
+		if typ.T == abi.SliceTy {
+			out = reflect.MakeSlice(target, n, n)
+		} else {
+			if n != typ.Size {
+				return reflect.Value{}, fmt.Errorf("%s: expected %d elements for %s, got %d", pathOrValue(path), typ.Size, typ.String(), n)
+			}
+			out = reflect.New(target).Elem()
+		}
+		for i := 0; i < n; i++ {
+			elem, err := encodeValue(src.Index(i), *typ.Elem, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out.Index(i).Set(elem)
+		}
+		return out, nil
+
+	case abi.TupleTy:
+		fields, err := encodeTuple(src, typ.TupleRawNames, typ.TupleElems, path)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		out := reflect.New(target).Elem()
+		for i, field := range fields {
+			out.Field(i).Set(field)
+		}
+		return out, nil
+	}
+
+	return reflect.Value{}, mismatchEncode(path, typ, src.Type())
+}
+
+// encodeTuple converts a struct, map, slice or array into a list of values.
+func encodeTuple(src reflect.Value, names []string, types []*abi.Type, path string) ([]reflect.Value, error) {
+	src = indirect(src)
+	if !src.IsValid() {
+		return nil, fmt.Errorf("%s: missing value for tuple", pathOrValue(path))
+	}
+
+	out := make([]reflect.Value, len(names))
+	switch src.Kind() {
+	case reflect.Struct:
+		indexes, err := matchFields(src.Type(), names, path)
+		if err != nil {
+			return nil, err
+		}
+		for i, idx := range indexes {
+			v, err := encodeValue(src.Field(idx), *types[i], joinPath(path, names[i], i))
+			if err != nil {
+				return nil, err
+			}
+			out[i] = v
+		}
+		return out, nil
+
+	case reflect.Map:
+		if src.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if src.Len() != len(names) {
+			return nil, fmt.Errorf("%s: expected %d map entries, got %d", pathOrValue(path), len(names), src.Len())
+		}
+		for i, name := range names {
+			elem := src.MapIndex(reflect.ValueOf(name).Convert(src.Type().Key()))
+			if !elem.IsValid() {
+				return nil, fmt.Errorf("%s: missing map entry %q", pathOrValue(path), name)
+			}
+			v, err := encodeValue(elem, *types[i], joinPath(path, name, i))
+			if err != nil {
+				return nil, err
+			}
+			out[i] = v
+		}
+		return out, nil
+
+	case reflect.Slice, reflect.Array:
+		if src.Len() != len(names) {
+			return nil, fmt.Errorf("%s: expected %d values, got %d", pathOrValue(path), len(names), src.Len())
+		}
+		for i := range names {
+			v, err := encodeValue(src.Index(i), *types[i], joinPath(path, names[i], i))
+			if err != nil {
+				return nil, err
+			}
+			out[i] = v
+		}
+		return out, nil
+	}
+
+	return nil, fmt.Errorf("%s: cannot encode %s as a tuple of %d values", pathOrValue(path), src.Type(), len(names))
+}
+
+// encodeBytes extracts a byte string from a []byte, byte array or hex string.
+func encodeBytes(src reflect.Value, typ abi.Type, path string) ([]byte, error) {
+	switch {
+	case src.Kind() == reflect.Slice && src.Type().Elem().Kind() == reflect.Uint8:
+		return src.Bytes(), nil
+	case src.Kind() == reflect.Array && src.Type().Elem().Kind() == reflect.Uint8:
+		return bytesOf(src), nil
+	case src.Kind() == reflect.String:
+		b, err := hexutil.Decode(src.String())
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid hex for %s: %w", pathOrValue(path), typ.String(), err)
+		}
+		return b, nil
+	}
+	return nil, mismatchEncode(path, typ, src.Type())
+}
+
+// fitsInteger reports whether bi is representable by the integer type typ.
+func fitsInteger(bi *big.Int, typ abi.Type) bool {
+	if typ.T == abi.UintTy {
+		return bi.Sign() >= 0 && bi.BitLen() <= typ.Size
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(typ.Size-1))
+	if bi.Sign() >= 0 {
+		return bi.Cmp(limit) < 0
+	}
+	return new(big.Int).Neg(bi).Cmp(limit) <= 0
+}
+
+// =============================================================================
+// Helpers
+// =============================================================================
+
+// isParamsStruct reports whether t should be treated as a struct holding a
+// parameter list rather than the value of a single parameter of type typ.
+func isParamsStruct(t reflect.Type, typ abi.Type) bool {
+	for t.Kind() == reflect.Ptr && t != bigIntPtrType {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && typ.T != abi.TupleTy
+}
+
+// matchFields maps each ABI name to a struct field index.
+func matchFields(t reflect.Type, names []string, path string) ([]int, error) {
+	type field struct {
+		index  int
+		name   string
+		tagged bool
+	}
+
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("abi")
+		if tag == "-" {
+			continue
+		}
+		if tag != "" {
+			fields = append(fields, field{index: i, name: tag, tagged: true})
+		} else {
+			fields = append(fields, field{index: i, name: f.Name})
+		}
+	}
+
+	indexes := make([]int, len(names))
+	used := make([]bool, len(fields))
+	for i, name := range names {
+		found := -1
+		if name == "" {
+			if i < len(fields) && !used[i] {
+				found = i
+			}
+		} else {
+			for j, f := range fields {
+				if used[j] {
+					continue
+				}
+				if f.tagged && f.name == name || !f.tagged && strings.EqualFold(f.name, strings.TrimLeft(name, "_")) {
+					found = j
+					break
+				}
+			}
+		}
+		if found < 0 {
+			return nil, fmt.Errorf("%s: %s has no field for %q", pathOrValue(path), t, joinPath("", name, i))
+		}
+		used[found] = true
+		indexes[i] = fields[found].index
+	}
+
+	for j, f := range fields {
+		if !used[j] {
+			return nil, fmt.Errorf("%s: field %s.%s does not match any ABI parameter (tag it `abi:\"-\"` to ignore)", pathOrValue(path), t, t.Field(f.index).Name)
+		}
+	}
+	return indexes, nil
+}
+
+// toBigInt converts an integer-like reflect value to *big.Int.
+func toBigInt(v reflect.Value) (*big.Int, bool) {
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil, false
+	}
+	if v.CanAddr() && v.Addr().Type() == bigIntPtrType {
+		return v.Addr().Interface().(*big.Int), true
+	}
+	if v.Type() == bigIntPtrType.Elem() {
+		bi := v.Interface().(big.Int)
+		return &bi, true
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(v.Uint()), true
+	}
+	return nil, false
+}
+
+// indirect dereferences pointers (except *big.Int) and interfaces.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr && v.Type() != bigIntPtrType) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if v.IsValid() && v.Type() == bigIntPtrType && v.IsNil() {
+		return reflect.Value{}
+	}
+	if v.IsValid() && v.Type() == bigIntPtrType {
+		return v.Elem()
+	}
+	return v
+}
+
+// bytesOf returns the contents of a byte slice or byte array value.
+func bytesOf(v reflect.Value) []byte {
+	if v.Kind() == reflect.Slice {
+		return v.Bytes()
+	}
+	b := make([]byte, v.Len())
+	reflect.Copy(reflect.ValueOf(b), v)
+	return b
+}
+
+// joinPath appends a parameter name (or position) to a value path.
+func joinPath(path, name string, index int) string {
+	if name == "" {
+		name = fmt.Sprintf("#%d", index)
+	}
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// pathOrValue returns path, or "value" for the top-level value.
+func pathOrValue(path string) string {
+	if path == "" {
+		return "value"
+	}
+	return path
+}
+
+// mismatch reports an ABI value that cannot be decoded into a Go type.
+func mismatch(path string, typ abi.Type, goType reflect.Type) error {
+	return fmt.Errorf("%s: cannot decode %s into %s", pathOrValue(path), typ.String(), goType)
+}
+
+// mismatchEncode reports a Go value that cannot be encoded as an ABI type.
+func mismatchEncode(path string, typ abi.Type, goType reflect.Type) error {
+	return fmt.Errorf("%s: cannot encode %s as %s", pathOrValue(path), goType, typ.String())
+}
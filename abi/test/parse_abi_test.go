@@ -0,0 +1,164 @@
+package abi_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseAbi", func() {
+	signatures := []string{
+		"struct Order { address maker; uint amount; }",
+		"constructor(address owner) payable",
+		"function balanceOf(address owner) view returns (uint256)",
+		"function transfer(address to, uint256 amount) returns (bool)",
+		"function fill(Order order, bytes calldata data) payable returns (bool ok, Order[] fills)",
+		"event Transfer(address indexed from, address indexed to, uint256 amount)",
+		"error InsufficientBalance(uint256 available, uint256 required)",
+		"fallback() external",
+		"receive() external payable",
+	}
+
+	Context("when parsing human-readable signatures", func() {
+		It("should build functions, events and errors", func() {
+			parsed, err := abi.ParseAbi(signatures)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(parsed.HasFunction("balanceOf")).To(BeTrue())
+			Expect(parsed.HasFunction("transfer")).To(BeTrue())
+			Expect(parsed.HasEvent("Transfer")).To(BeTrue())
+			Expect(parsed.Errors).To(HaveKey("InsufficientBalance"))
+
+			fn := parsed.Functions["balanceOf"]
+			Expect(fn.IsReadOnly()).To(BeTrue())
+			Expect(fn.Inputs[0].Name).To(Equal("owner"))
+			Expect(fn.Outputs[0].Type).To(Equal("uint256"))
+
+			ev := parsed.Events["Transfer"]
+			Expect(ev.Inputs[0].Indexed).To(BeTrue())
+			Expect(ev.Inputs[2].Indexed).To(BeFalse())
+		})
+
+		It("should resolve struct references as tuples", func() {
+			parsed, err := abi.ParseAbi(signatures)
+			Expect(err).ToNot(HaveOccurred())
+
+			sig, err := parsed.GetFunctionSignature("fill")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sig).To(Equal("fill((address,uint256),bytes)"))
+		})
+
+		It("should match the selector of the JSON ABI", func() {
+			parsed, err := abi.ParseAbi(signatures)
+			Expect(err).ToNot(HaveOccurred())
+
+			data, err := parsed.EncodeFunctionData("transfer", common.HexToAddress("0x01"), big.NewInt(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(common.Bytes2Hex(data[:4])).To(Equal("a9059cbb"))
+		})
+
+		It("should reject invalid signatures", func() {
+			_, err := abi.ParseAbi([]string{"function foo(uint7 a)"})
+			Expect(err).To(HaveOccurred())
+
+			_, err = abi.ParseAbi([]string{"function foo(Missing a)"})
+			Expect(err).To(HaveOccurred())
+
+			_, err = abi.ParseAbi([]string{"struct A { B b; }", "struct B { A a; }"})
+			Expect(err).To(HaveOccurred())
+
+			_, err = abi.ParseAbi([]string{"function foo(uint256 a"})
+			Expect(err).To(HaveOccurred())
+
+			_, err = abi.ParseAbi([]string{"function foo() view pure"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ParseAbiItem", func() {
+		It("should parse a single event", func() {
+			item, err := abi.ParseAbiItem("event Approval(address indexed owner, address indexed spender, uint256 value)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(item.Type).To(Equal("event"))
+			Expect(item.Name).To(Equal("Approval"))
+			Expect(item.Inputs).To(HaveLen(3))
+		})
+
+		It("should accept struct definitions", func() {
+			item, err := abi.ParseAbiItem("function get() view returns (Foo)", "struct Foo { uint a; bool b; }")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(item.Outputs[0].Type).To(Equal("tuple"))
+			Expect(item.Outputs[0].Components).To(HaveLen(2))
+			Expect(item.Outputs[0].Components[0].Type).To(Equal("uint256"))
+		})
+
+		It("should not write into the caller's struct definitions", func() {
+			defs := make([]string, 1, 2)
+			defs[0] = "struct Foo { uint a; }"
+			backing := defs[:2]
+			backing[1] = "struct Bar { bool b; }"
+
+			_, err := abi.ParseAbiItem("function get() view returns (Foo)", defs...)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(backing[1]).To(Equal("struct Bar { bool b; }"))
+		})
+	})
+
+	Context("ParseAbiParameters", func() {
+		It("should parse parameter lists with tuples and arrays", func() {
+			params, err := abi.ParseAbiParameters("address to, uint amount, (bool ok, bytes data)[] results")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params).To(HaveLen(3))
+			Expect(params[1]).To(Equal(abi.AbiParam{Name: "amount", Type: "uint256"}))
+			Expect(params[2].Type).To(Equal("tuple[]"))
+			Expect(params[2].Components).To(Equal([]abi.AbiParam{
+				{Name: "ok", Type: "bool"},
+				{Name: "data", Type: "bytes"},
+			}))
+		})
+
+		It("should be usable for encoding", func() {
+			params, err := abi.ParseAbiParameters("address, uint256")
+			Expect(err).ToNot(HaveOccurred())
+
+			encoded, err := abi.EncodeAbiParameters(params, []any{common.HexToAddress("0x01"), big.NewInt(2)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(encoded).To(HaveLen(64))
+		})
+	})
+
+	Context("FormatAbi", func() {
+		It("should round-trip human-readable signatures", func() {
+			parsed, err := abi.ParseAbi(signatures)
+			Expect(err).ToNot(HaveOccurred())
+
+			formatted, err := abi.FormatAbi(parsed)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(formatted).To(Equal([]string{
+				"constructor(address owner) payable",
+				"function balanceOf(address owner) view returns (uint256)",
+				"function transfer(address to, uint256 amount) returns (bool)",
+				"function fill((address maker, uint256 amount) order, bytes data) payable returns (bool ok, (address maker, uint256 amount)[] fills)",
+				"event Transfer(address indexed from, address indexed to, uint256 amount)",
+				"error InsufficientBalance(uint256 available, uint256 required)",
+				"fallback() external",
+				"receive() external payable",
+			}))
+
+			reparsed, err := abi.ParseAbi(formatted)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reparsed.Functions["fill"].Selector).To(Equal(parsed.Functions["fill"].Selector))
+		})
+
+		It("should format parameters", func() {
+			params, err := abi.ParseAbiParameters("address to, (uint256 a, bool b)[2] items")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(abi.FormatAbiParameters(params)).To(Equal("address to, (uint256 a, bool b)[2] items"))
+		})
+	})
+})
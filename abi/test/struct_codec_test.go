@@ -0,0 +1,207 @@
+package abi_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type codecOrder struct {
+	Maker  common.Address
+	Amount *big.Int
+}
+
+type codecBatch struct {
+	ID     uint64 `abi:"batchId"`
+	Orders []codecOrder
+	Hashes [2]common.Hash
+	Note   string `abi:"-"`
+}
+
+type codecReserves struct {
+	Reserve0           *big.Int
+	Reserve1           *big.Int
+	BlockTimestampLast uint32
+}
+
+var _ = Describe("Struct codec", func() {
+	maker := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	Context("EncodeFrom and DecodeInto", func() {
+		It("should round-trip nested tuples, slices and fixed arrays", func() {
+			params, err := abi.ParseAbiParameters("(uint64 batchId, (address maker, uint256 amount)[] orders, bytes32[2] hashes) batch")
+			Expect(err).ToNot(HaveOccurred())
+
+			batch := codecBatch{
+				ID: 7,
+				Orders: []codecOrder{
+					{Maker: maker, Amount: big.NewInt(100)},
+					{Maker: maker, Amount: big.NewInt(200)},
+				},
+				Hashes: [2]common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")},
+				Note:   "ignored",
+			}
+
+			encoded, err := abi.EncodeFrom(params, batch)
+			Expect(err).ToNot(HaveOccurred())
+
+			decoded, err := abi.DecodeInto[codecBatch](params, encoded)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decoded.ID).To(Equal(uint64(7)))
+			Expect(decoded.Orders).To(HaveLen(2))
+			Expect(decoded.Orders[1].Maker).To(Equal(maker))
+			Expect(decoded.Orders[1].Amount.Int64()).To(Equal(int64(200)))
+			Expect(decoded.Hashes).To(Equal(batch.Hashes))
+			Expect(decoded.Note).To(BeEmpty())
+		})
+
+		It("should map multiple parameters onto struct fields", func() {
+			params, err := abi.ParseAbiParameters("uint112 reserve0, uint112 reserve1, uint32 blockTimestampLast")
+			Expect(err).ToNot(HaveOccurred())
+
+			encoded, err := abi.EncodeAbiParameters(params, []any{big.NewInt(1), big.NewInt(2), uint32(3)})
+			Expect(err).ToNot(HaveOccurred())
+
+			reserves, err := abi.DecodeInto[codecReserves](params, encoded)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reserves.Reserve0.Int64()).To(Equal(int64(1)))
+			Expect(reserves.Reserve1.Int64()).To(Equal(int64(2)))
+			Expect(reserves.BlockTimestampLast).To(Equal(uint32(3)))
+
+			reencoded, err := abi.EncodeFrom(params, reserves)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reencoded).To(Equal(encoded))
+		})
+
+		It("should decode scalars and slices directly", func() {
+			params := []abi.AbiParam{{Type: "uint256[]"}}
+			encoded, err := abi.EncodeFrom(params, []int{1, 2, 3})
+			Expect(err).ToNot(HaveOccurred())
+
+			values, err := abi.DecodeInto[[]uint16](params, encoded)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]uint16{1, 2, 3}))
+		})
+
+		It("should accept maps and positional slices for tuples", func() {
+			params, err := abi.ParseAbiParameters("address maker, uint256 amount")
+			Expect(err).ToNot(HaveOccurred())
+
+			fromMap, err := abi.EncodeFrom(params, map[string]any{"maker": maker, "amount": big.NewInt(5)})
+			Expect(err).ToNot(HaveOccurred())
+			fromSlice, err := abi.EncodeFrom(params, []any{maker, 5})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fromMap).To(Equal(fromSlice))
+		})
+	})
+
+	Context("shape mismatches", func() {
+		params, _ := abi.ParseAbiParameters("(address maker, uint256 amount)[] orders")
+
+		It("should report fields that match no parameter", func() {
+			type extra struct {
+				Maker  common.Address
+				Amount *big.Int
+				Extra  bool
+			}
+			_, err := abi.EncodeFrom(params, []extra{{Maker: maker, Amount: big.NewInt(1)}})
+			Expect(err).To(MatchError(ContainSubstring("Extra does not match any ABI parameter")))
+		})
+
+		It("should report missing fields with their path", func() {
+			type missing struct {
+				Maker common.Address
+			}
+			_, err := abi.EncodeFrom(params, []missing{{Maker: maker}})
+			Expect(err).To(MatchError(ContainSubstring(`orders[0]`)))
+			Expect(err).To(MatchError(ContainSubstring(`"amount"`)))
+		})
+
+		It("should report incompatible types and overflows", func() {
+			encoded, err := abi.EncodeFrom(params, []codecOrder{{Maker: maker, Amount: big.NewInt(300)}})
+			Expect(err).ToNot(HaveOccurred())
+
+			type narrow struct {
+				Maker  common.Address
+				Amount uint8
+			}
+			_, err = abi.DecodeInto[[]narrow](params, encoded)
+			Expect(err).To(MatchError(ContainSubstring("orders[0].amount: value 300 overflows uint8")))
+
+			type wrong struct {
+				Maker  bool
+				Amount *big.Int
+			}
+			_, err = abi.DecodeInto[[]wrong](params, encoded)
+			Expect(err).To(MatchError(ContainSubstring("cannot decode address into bool")))
+
+			_, err = abi.EncodeFrom([]abi.AbiParam{{Type: "uint8"}}, 256)
+			Expect(err).To(MatchError(ContainSubstring("out of range")))
+
+			_, err = abi.EncodeFrom([]abi.AbiParam{{Type: "uint256[2]"}}, []int{1})
+			Expect(err).To(MatchError(ContainSubstring("expected 2 elements")))
+		})
+	})
+
+	Context("DecodeFunctionResultAs", func() {
+		It("should decode function outputs into a struct", func() {
+			parsed, err := abi.ParseAbi([]string{
+				"function getReserves() view returns (uint112 reserve0, uint112 reserve1, uint32 blockTimestampLast)",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			data, err := abi.EncodeFrom([]abi.AbiParam{{Type: "uint112"}, {Type: "uint112"}, {Type: "uint32"}}, []any{10, 20, 30})
+			Expect(err).ToNot(HaveOccurred())
+
+			reserves, err := abi.DecodeFunctionResultAs[codecReserves](parsed, "getReserves", data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reserves.Reserve1.Int64()).To(Equal(int64(20)))
+			Expect(reserves.BlockTimestampLast).To(Equal(uint32(30)))
+		})
+	})
+
+	Context("DecodeEventLogInto", func() {
+		It("should decode indexed topics and data into a struct", func() {
+			parsed := abi.MustParseAbi([]string{
+				"event Swap(address indexed sender, bytes4 indexed selector, string indexed tag, uint256 amountIn, int24 tick)",
+			})
+			event, err := parsed.GetEvent("Swap")
+			Expect(err).ToNot(HaveOccurred())
+
+			dataParams, err := abi.ParseAbiParameters("uint256 amountIn, int24 tick")
+			Expect(err).ToNot(HaveOccurred())
+			data, err := abi.EncodeFrom(dataParams, []any{big.NewInt(500), int64(-60)})
+			Expect(err).ToNot(HaveOccurred())
+			tagHash := common.HexToHash("0x1234")
+			topics := []common.Hash{
+				event.Topic,
+				common.BytesToHash(maker.Bytes()),
+				common.HexToHash("0xa9059cbb00000000000000000000000000000000000000000000000000000000"),
+				tagHash,
+			}
+
+			type swap struct {
+				Sender   common.Address
+				Selector [4]byte
+				Tag      common.Hash
+				AmountIn *big.Int
+				Tick     int32
+			}
+			got, err := abi.DecodeEventLogInto[swap](parsed, "Swap", topics, data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(got.Sender).To(Equal(maker))
+			Expect(got.Selector).To(Equal([4]byte{0xa9, 0x05, 0x9c, 0xbb}))
+			Expect(got.Tag).To(Equal(tagHash))
+			Expect(got.AmountIn.Int64()).To(Equal(int64(500)))
+			Expect(got.Tick).To(Equal(int32(-60)))
+
+			_, err = abi.DecodeEventLogInto[swap](parsed, "Mint", topics, data)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -68,6 +68,14 @@ func PrivateKeyToAccount(privateKey string, opts ...PrivateKeyToAccountOptions)
 		})
 	}
 
+	signTransactionWithSerializerFunc := func(tx *transaction.Transaction, serializer func(tx *transaction.Transaction, sig *transaction.Signature) (string, error)) (string, error) {
+		return accountUtils.SignTransaction(accountUtils.SignTransactionParameters{
+			PrivateKey:  privateKey,
+			Transaction: tx,
+			Serializer:  serializer,
+		})
+	}
+
 	signTypedDataFunc := func(data signature.TypedDataDefinition) (string, error) {
 		return accountUtils.SignTypedData(accountUtils.SignTypedDataParameters{
 			Domain:      data.Domain,
@@ -110,6 +118,7 @@ func PrivateKeyToAccount(privateKey string, opts ...PrivateKeyToAccountOptions)
 		signTypedDataFunc,
 		signAuthorizationFunc,
 	)
+	localAccount.signTransactionWithSerializer = signTransactionWithSerializerFunc
 
 	return &PrivateKeyAccount{
 		LocalAccount: localAccount,
@@ -118,6 +118,38 @@ var _ = Describe("Accounts", func() {
 			Expect(signedTx).To(HavePrefix("0x02"))
 		})
 
+		It("should sign transactions with a custom serializer", func() {
+			account, err := accounts.PrivateKeyToAccount(testPrivateKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			tx := &transaction.Transaction{
+				Type:                 transaction.TransactionTypeEIP1559,
+				ChainId:              10,
+				MaxPriorityFeePerGas: big.NewInt(1000000000),
+				MaxFeePerGas:         big.NewInt(2000000000),
+				Gas:                  big.NewInt(21000),
+				To:                   "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+				ChainFields:          "l2",
+			}
+
+			var calls []*transaction.Signature
+			serializer := func(tx *transaction.Transaction, sig *transaction.Signature) (string, error) {
+				Expect(tx.ChainFields).To(Equal("l2"))
+				calls = append(calls, sig)
+				return transaction.SerializeTransaction(tx, sig)
+			}
+
+			signedTx, err := account.SignTransactionWithSerializer(tx, serializer)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(calls).To(HaveLen(2))
+			Expect(calls[0]).To(BeNil())
+			Expect(calls[1]).NotTo(BeNil())
+
+			defaultTx, err := account.SignTransaction(tx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signedTx).To(Equal(defaultTx))
+		})
+
 		It("should sign typed data", func() {
 			account, err := accounts.PrivateKeyToAccount(testPrivateKey)
 			Expect(err).NotTo(HaveOccurred())
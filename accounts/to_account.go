@@ -20,6 +20,10 @@ type CustomSource struct {
 	SignMessage SignMessageFunc
 	// SignTransaction signs a transaction.
 	SignTransaction SignTransactionFunc
+	// SignTransactionWithSerializer signs a transaction with a custom
+	// serializer, as used by chains with their own transaction types
+	// (optional).
+	SignTransactionWithSerializer SignTransactionWithSerializerFunc
 	// SignTypedData signs EIP-712 typed data.
 	SignTypedData SignTypedDataFunc
 	// SignAuthorization signs an EIP-7702 authorization (optional).
@@ -75,6 +79,8 @@ func ToAccount(source CustomSource) (*LocalAccount, error) {
 		signTransaction:   source.SignTransaction,
 		signTypedData:     source.SignTypedData,
 		signAuthorization: source.SignAuthorization,
+
+		signTransactionWithSerializer: source.SignTransactionWithSerializer,
 	}, nil
 }
 
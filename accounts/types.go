@@ -40,6 +40,10 @@ type SignMessageFunc func(message signature.SignableMessage) (string, error)
 // SignTransactionFunc is the function signature for signing transactions.
 type SignTransactionFunc func(tx *transaction.Transaction) (string, error)
 
+// SignTransactionWithSerializerFunc is the function signature for signing
+// transactions with a custom serializer.
+type SignTransactionWithSerializerFunc func(tx *transaction.Transaction, serializer func(tx *transaction.Transaction, sig *transaction.Signature) (string, error)) (string, error)
+
 // SignTypedDataFunc is the function signature for signing typed data.
 type SignTypedDataFunc func(data signature.TypedDataDefinition) (string, error)
 
@@ -64,6 +68,8 @@ type LocalAccount struct {
 	signTransaction   SignTransactionFunc
 	signTypedData     SignTypedDataFunc
 	signAuthorization SignAuthorizationFunc
+
+	signTransactionWithSerializer SignTransactionWithSerializerFunc
 }
 
 // GetAddress returns the account's address as a string.
@@ -110,6 +116,16 @@ func (a *LocalAccount) SignTransaction(tx *transaction.Transaction) (string, err
 	return a.signTransaction(tx)
 }
 
+// SignTransactionWithSerializer signs a transaction, serializing it with
+// serializer instead of transaction.SerializeTransaction, e.g. for
+// chain-specific transaction types.
+func (a *LocalAccount) SignTransactionWithSerializer(tx *transaction.Transaction, serializer func(tx *transaction.Transaction, sig *transaction.Signature) (string, error)) (string, error) {
+	if a.signTransactionWithSerializer == nil {
+		return "", ErrSigningNotSupported
+	}
+	return a.signTransactionWithSerializer(tx, serializer)
+}
+
 // SignTypedData signs EIP-712 typed data and returns the signature as hex.
 func (a *LocalAccount) SignTypedData(data signature.TypedDataDefinition) (string, error) {
 	if a.signTypedData == nil {
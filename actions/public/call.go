@@ -14,6 +14,7 @@ import (
 
 	"github.com/ChefBingbong/viem-go/abi"
 	"github.com/ChefBingbong/viem-go/constants"
+	"github.com/ChefBingbong/viem-go/tracing"
 	"github.com/ChefBingbong/viem-go/types"
 	blockoverride "github.com/ChefBingbong/viem-go/utils/block_override"
 	"github.com/ChefBingbong/viem-go/utils/ccip"
@@ -143,7 +144,11 @@ type callRequest struct {
 //	    Code: contractBytecode,
 //	    Data: calldata,
 //	})
-func Call(ctx context.Context, client Client, params CallParameters) (*CallReturnType, error) {
+func Call(ctx context.Context, client Client, params CallParameters) (_ *CallReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.call", client.Chain().GetID(),
+		tracing.String(tracing.AttrBlockTag, resolveBlockTag(client, params.BlockNumber, params.BlockTag)))
+	defer func() { tracing.End(span, err) }()
+
 	// Validate mutually exclusive parameters
 	if len(params.Code) > 0 && (params.Factory != nil || len(params.FactoryData) > 0) {
 		return nil, &InvalidCallParamsError{
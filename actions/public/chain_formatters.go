@@ -0,0 +1,55 @@
+package public
+
+import (
+	"fmt"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// chainFormatters returns the formatters of the client's chain, or nil.
+func chainFormatters(client Client) *chain.ChainFormatters {
+	ch := client.Chain()
+	if ch == nil {
+		return nil
+	}
+	return ch.Formatters
+}
+
+// formatChainFields runs a chain formatter on a raw RPC object. It returns
+// nil fields when no formatter is set.
+func formatChainFields(format func(raw json.RawMessage) (any, error), raw json.RawMessage, kind string) (any, error) {
+	if format == nil {
+		return nil, nil
+	}
+	fields, err := format(raw)
+	if err != nil {
+		return nil, fmt.Errorf("chain %s formatter failed: %w", kind, err)
+	}
+	return fields, nil
+}
+
+// FormatChainTransactionRequest applies the TransactionRequest formatter of
+// ch to an RPC transaction request, passing it the chain-specific fields of
+// the request. The request is converted to a JSON object first so the
+// formatter can add or replace fields. It is returned unchanged when ch has
+// no TransactionRequest formatter.
+func FormatChainTransactionRequest(ch *chain.Chain, request any, fields any) (any, error) {
+	if ch == nil || ch.Formatters == nil || ch.Formatters.TransactionRequest == nil {
+		return request, nil
+	}
+
+	encoded, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transaction request: %w", err)
+	}
+	out := map[string]any{}
+	if err := json.Unmarshal(encoded, &out); err != nil {
+		return nil, fmt.Errorf("failed to encode transaction request: %w", err)
+	}
+	if err := ch.Formatters.TransactionRequest(out, fields); err != nil {
+		return nil, fmt.Errorf("chain transactionRequest formatter failed: %w", err)
+	}
+	return out, nil
+}
@@ -7,11 +7,14 @@ package public
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/metrics"
 	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
 )
 
 // Client is the interface that actions require from a client.
@@ -43,6 +46,24 @@ type Client interface {
 	UID() string
 }
 
+// metricsCollector returns the client's metrics collector if the client
+// exposes one (as client.BaseClient does), or nil.
+func metricsCollector(client Client) metrics.Collector {
+	if c, ok := client.(interface{ Metrics() metrics.Collector }); ok {
+		return c.Metrics()
+	}
+	return nil
+}
+
+// clientLogger returns the client's logger if the client exposes one (as
+// client.BaseClient does), or a logger that discards everything.
+func clientLogger(client Client) *slog.Logger {
+	if c, ok := client.(interface{ Logger() *slog.Logger }); ok {
+		return rpc.LoggerOrDiscard(c.Logger())
+	}
+	return rpc.LoggerOrDiscard(nil)
+}
+
 // BlockTag is an alias for types.BlockTag for convenience.
 type BlockTag = types.BlockTag
 
@@ -2,9 +2,14 @@ package public
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/chain"
 )
 
 // FeeValuesType represents the type of fee values to return.
@@ -17,6 +22,10 @@ const (
 	FeeValuesTypeEIP1559 FeeValuesType = "eip1559"
 )
 
+// ErrIncompleteChainFees is returned when a chain's EstimateFeesPerGas hook
+// returns values missing a field required by the requested fee type.
+var ErrIncompleteChainFees = errors.New("chain fee estimation returned incomplete fee values")
+
 // EstimateFeesPerGasParameters contains the parameters for the
 // EstimateFeesPerGas action.
 //
@@ -24,6 +33,7 @@ const (
 //   - For EIP-1559 chains, returns maxFeePerGas & maxPriorityFeePerGas.
 //   - For legacy chains, returns gasPrice.
 //   - Applies a base fee multiplier (default 1.2) to provide a safety buffer.
+//   - Honors the chain's fee configuration (chain.ChainFees).
 type EstimateFeesPerGasParameters struct {
 	// Type is the type of fee values to return.
 	// Defaults to FeeValuesTypeEIP1559.
@@ -31,8 +41,12 @@ type EstimateFeesPerGasParameters struct {
 
 	// BaseFeeMultiplier is the multiplier applied to the base fee per gas
 	// (or gas price for legacy chains) when computing fees.
-	// Defaults to 1.2 (20% buffer).
+	// Defaults to the chain's BaseFeeMultiplier, or 1.2 (20% buffer).
 	BaseFeeMultiplier *float64
+
+	// Chain optionally overrides the client's chain, whose Fees configure
+	// the estimation.
+	Chain *chain.Chain
 }
 
 // EstimateFeesPerGasReturnType represents the estimated fees per gas.
@@ -63,10 +77,14 @@ func EstimateFeesPerGas(
 		feeType = FeeValuesTypeEIP1559
 	}
 
-	// Resolve multiplier (default 1.2).
+	fees := resolveChainFees(client, params.Chain)
+
+	// Resolve multiplier: param > chain > default (1.2).
 	baseFeeMultiplier := 1.2
 	if params.BaseFeeMultiplier != nil {
 		baseFeeMultiplier = *params.BaseFeeMultiplier
+	} else if fees.BaseFeeMultiplier != nil {
+		baseFeeMultiplier = *fees.BaseFeeMultiplier
 	}
 	if baseFeeMultiplier < 1 {
 		return nil, &BaseFeeScalarError{Multiplier: baseFeeMultiplier}
@@ -80,6 +98,37 @@ func EstimateFeesPerGas(
 		return nil, fmt.Errorf("failed to fetch latest block: %w", err)
 	}
 
+	if fees.EstimateFeesPerGas != nil {
+		values, err := fees.EstimateFeesPerGas(ctx, chain.EstimateFeesPerGasParameters{
+			Block: block,
+			Type:  string(feeType),
+			Multiply: func(value *big.Int) *big.Int {
+				return applyBaseFeeMultiplier(value, baseFeeMultiplier)
+			},
+			Request: func(ctx context.Context, method string, params ...any) (json.RawMessage, error) {
+				resp, err := client.Request(ctx, method, params...)
+				if err != nil {
+					return nil, err
+				}
+				return resp.Result, nil
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("chain fee estimation failed: %w", err)
+		}
+		if values != nil {
+			if err := checkChainFeeValues(feeType, values); err != nil {
+				return nil, err
+			}
+			return &EstimateFeesPerGasReturnType{
+				Type:                 feeType,
+				MaxFeePerGas:         values.MaxFeePerGas,
+				MaxPriorityFeePerGas: values.MaxPriorityFeePerGas,
+				GasPrice:             values.GasPrice,
+			}, nil
+		}
+	}
+
 	switch feeType {
 	case FeeValuesTypeEIP1559:
 		if block.BaseFeePerGas == nil {
@@ -88,6 +137,7 @@ func EstimateFeesPerGas(
 
 		maxPriorityFeePerGas, err := EstimateMaxPriorityFeePerGas(ctx, client, EstimateMaxPriorityFeePerGasParameters{
 			Block: block,
+			Chain: params.Chain,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to estimate maxPriorityFeePerGas: %w", err)
@@ -109,6 +159,16 @@ func EstimateFeesPerGas(
 		}
 
 		adjustedGasPrice := applyBaseFeeMultiplier(gasPrice, baseFeeMultiplier)
+		if fees.MinPriorityFee != nil {
+			// The gas price pays the base fee and the tip.
+			minGasPrice := new(big.Int).Set(fees.MinPriorityFee)
+			if block.BaseFeePerGas != nil {
+				minGasPrice.Add(minGasPrice, block.BaseFeePerGas)
+			}
+			if adjustedGasPrice.Cmp(minGasPrice) < 0 {
+				adjustedGasPrice = minGasPrice
+			}
+		}
 		return &EstimateFeesPerGasReturnType{
 			Type:     FeeValuesTypeLegacy,
 			GasPrice: adjustedGasPrice,
@@ -119,6 +179,34 @@ func EstimateFeesPerGas(
 	}
 }
 
+// checkChainFeeValues reports whether values, returned by a chain's
+// EstimateFeesPerGas hook, hold every field required by feeType.
+func checkChainFeeValues(feeType FeeValuesType, values *chain.FeeValues) error {
+	switch feeType {
+	case FeeValuesTypeEIP1559:
+		if values.MaxFeePerGas == nil || values.MaxPriorityFeePerGas == nil {
+			return fmt.Errorf("%w: %s requires MaxFeePerGas and MaxPriorityFeePerGas", ErrIncompleteChainFees, feeType)
+		}
+	case FeeValuesTypeLegacy:
+		if values.GasPrice == nil {
+			return fmt.Errorf("%w: %s requires GasPrice", ErrIncompleteChainFees, feeType)
+		}
+	}
+	return nil
+}
+
+// resolveChainFees returns the fee configuration of ch, or of the client's
+// chain when ch is nil.
+func resolveChainFees(client Client, ch *chain.Chain) chain.ChainFees {
+	if ch == nil {
+		ch = client.Chain()
+	}
+	if ch == nil || ch.Fees == nil {
+		return chain.ChainFees{}
+	}
+	return *ch.Fees
+}
+
 // applyBaseFeeMultiplier applies the base fee multiplier using integer math
 // to avoid floating point precision issues.
 func applyBaseFeeMultiplier(base *big.Int, multiplier float64) *big.Int {
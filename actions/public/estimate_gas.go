@@ -11,6 +11,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/tracing"
 	"github.com/ChefBingbong/viem-go/types"
 	stateoverride "github.com/ChefBingbong/viem-go/utils/state_override"
 	"github.com/ChefBingbong/viem-go/utils/transaction"
@@ -70,6 +72,14 @@ type EstimateGasParameters struct {
 	// BlockTag is the block tag to estimate at (e.g., "latest", "pending").
 	// Mutually exclusive with BlockNumber.
 	BlockTag BlockTag
+
+	// Chain optionally overrides the client's chain, whose
+	// TransactionRequest formatter formats the request.
+	Chain *chain.Chain
+
+	// ChainFields holds chain-specific request fields, passed to the chain's
+	// TransactionRequest formatter.
+	ChainFields any
 }
 
 // EstimateGasReturnType is the return type for the EstimateGas action.
@@ -103,7 +113,11 @@ func EstimateGas(
 	ctx context.Context,
 	client Client,
 	params EstimateGasParameters,
-) (EstimateGasReturnType, error) {
+) (_ EstimateGasReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.estimateGas", client.Chain().GetID(),
+		tracing.String(tracing.AttrBlockTag, resolveBlockTag(client, params.BlockNumber, params.BlockTag)))
+	defer func() { tracing.End(span, err) }()
+
 	// Validate request.
 	accountAddr := ""
 	if params.Account != nil {
@@ -181,8 +195,17 @@ func EstimateGas(
 		req.Blobs = blobs
 	}
 
+	ch := params.Chain
+	if ch == nil {
+		ch = client.Chain()
+	}
+	formatted, err := FormatChainTransactionRequest(ch, req, params.ChainFields)
+	if err != nil {
+		return 0, err
+	}
+
 	// Build RPC params.
-	rpcParams := []any{req, blockTag}
+	rpcParams := []any{formatted, blockTag}
 	if rpcStateOverride != nil {
 		rpcParams = append(rpcParams, rpcStateOverride)
 	}
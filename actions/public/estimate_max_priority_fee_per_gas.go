@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/types"
 )
 
@@ -20,16 +21,21 @@ type EstimateMaxPriorityFeePerGasReturnType = *big.Int
 // EstimateMaxPriorityFeePerGasParameters contains the parameters for the
 // EstimateMaxPriorityFeePerGas action.
 //
-// This mirrors viem's EstimateMaxPriorityFeePerGas parameters shape, but is
-// simplified for viem-go since chain fee configuration is not yet exposed on
-// the Chain type. The action will:
-//   - Prefer the `eth_maxPriorityFeePerGas` RPC method when available.
+// This mirrors viem's EstimateMaxPriorityFeePerGas parameters shape. The
+// action will:
+//   - Use the chain's DefaultPriorityFee when configured.
+//   - Otherwise prefer the `eth_maxPriorityFeePerGas` RPC method when available.
 //   - Fallback to `gasPrice - baseFeePerGas` using `eth_getBlockByNumber`
 //     and `eth_gasPrice` when the RPC method is not supported.
+//   - Raise the result to the chain's MinPriorityFee when configured.
 type EstimateMaxPriorityFeePerGasParameters struct {
 	// Block is an optional pre-fetched block to use for fallback
 	// calculations. If nil, the latest block will be fetched when needed.
 	Block *types.Block
+
+	// Chain optionally overrides the client's chain, whose Fees configure
+	// the estimation.
+	Chain *chain.Chain
 }
 
 // EstimateMaxPriorityFeePerGas returns an estimate for the max priority fee
@@ -37,7 +43,7 @@ type EstimateMaxPriorityFeePerGasParameters struct {
 //
 // This is equivalent to viem's `estimateMaxPriorityFeePerGas` action.
 //
-// JSON-RPC Methods:
+// JSON-RPC Methods (unless the chain sets a DefaultPriorityFee):
 //   - eth_maxPriorityFeePerGas (preferred)
 //   - eth_getBlockByNumber + eth_gasPrice (fallback)
 func EstimateMaxPriorityFeePerGas(
@@ -45,6 +51,24 @@ func EstimateMaxPriorityFeePerGas(
 	client Client,
 	params EstimateMaxPriorityFeePerGasParameters,
 ) (EstimateMaxPriorityFeePerGasReturnType, error) {
+	fees := resolveChainFees(client, params.Chain)
+
+	priorityFee := fees.DefaultPriorityFee
+	if priorityFee == nil {
+		estimated, err := estimateMaxPriorityFeePerGas(ctx, client, params.Block)
+		if err != nil {
+			return nil, err
+		}
+		priorityFee = estimated
+	}
+	if fees.MinPriorityFee != nil && priorityFee.Cmp(fees.MinPriorityFee) < 0 {
+		priorityFee = fees.MinPriorityFee
+	}
+	return new(big.Int).Set(priorityFee), nil
+}
+
+// estimateMaxPriorityFeePerGas estimates the priority fee from the node.
+func estimateMaxPriorityFeePerGas(ctx context.Context, client Client, block *types.Block) (*big.Int, error) {
 	// First, try the direct RPC method.
 	feeHex, err := estimateMaxPriorityFeePerGasViaRpc(ctx, client)
 	if err == nil {
@@ -56,7 +80,6 @@ func EstimateMaxPriorityFeePerGas(
 	}
 
 	// Fallback: compute maxPriorityFeePerGas as gasPrice - baseFeePerGas.
-	block := params.Block
 	if block == nil {
 		blockResult, blockErr := GetBlock(ctx, client, GetBlockParameters{
 			BlockTag: BlockTagLatest,
@@ -94,6 +94,11 @@ func GetBlock(ctx context.Context, client Client, params GetBlockParameters) (Ge
 	if err = json.Unmarshal(result, &block); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal block: %w", err)
 	}
+	if f := chainFormatters(client); f != nil {
+		if block.ChainFields, err = formatChainFields(f.Block, result, "block"); err != nil {
+			return nil, err
+		}
+	}
 
 	return &block, nil
 }
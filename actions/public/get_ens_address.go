@@ -0,0 +1,140 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/utils/ens"
+)
+
+// universalResolverABI is the subset of the ENS Universal Resolver used to
+// resolve names and primary names.
+var universalResolverABI = abi.MustParseAbi([]string{
+	"function resolve(bytes name, bytes data) view returns (bytes, address)",
+	"function reverse(bytes lookupAddress, uint256 coinType) view returns (string, address, address)",
+})
+
+// ensAddrSelector is the selector of addr(bytes32) on ENS resolvers.
+var ensAddrSelector = []byte{0x3b, 0x3b, 0x57, 0xde}
+
+// GetEnsAddressParameters contains the parameters for the GetEnsAddress action.
+// This mirrors viem's GetEnsAddressParameters type.
+type GetEnsAddressParameters struct {
+	// Name is the ENS name to resolve. It is normalized before resolution.
+	Name string
+
+	// UniversalResolverAddress overrides the chain's ENS Universal Resolver.
+	UniversalResolverAddress *common.Address
+
+	// BlockNumber is the block number to resolve at.
+	// Mutually exclusive with BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the block tag to resolve at (e.g., "latest", "pending").
+	// Mutually exclusive with BlockNumber.
+	// Default: "latest"
+	BlockTag BlockTag
+}
+
+// GetEnsAddressReturnType is the return type for the GetEnsAddress action.
+// Nil means the name has no ETH address record.
+type GetEnsAddressReturnType = *common.Address
+
+// GetEnsAddress resolves the ETH address record of an ENS name through the
+// ENS Universal Resolver.
+//
+// This is equivalent to viem's `getEnsAddress` action.
+//
+// Example:
+//
+//	addr, err := public.GetEnsAddress(ctx, client, public.GetEnsAddressParameters{
+//	    Name: "vitalik.eth",
+//	})
+//	if addr == nil {
+//	    // The name has no address record
+//	}
+func GetEnsAddress(ctx context.Context, client Client, params GetEnsAddressParameters) (GetEnsAddressReturnType, error) {
+	normalized, err := ens.Normalize(params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ENS name %q: %w", params.Name, err)
+	}
+
+	addrCall := append(append([]byte{}, ensAddrSelector...), ens.NamehashBytes(normalized)...)
+	data, err := universalResolverABI.EncodeFunctionData("resolve", ens.PacketToBytes(normalized), addrCall)
+	if err != nil {
+		return nil, err
+	}
+	result, err := callUniversalResolver(ctx, client, params.UniversalResolverAddress, params.BlockNumber, params.BlockTag, data)
+	if err != nil {
+		return nil, err
+	}
+	values, err := universalResolverABI.DecodeFunctionResult("resolve", result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode ENS resolver response: %w", err)
+	}
+
+	record, ok := values[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected ENS resolver record type %T", values[0])
+	}
+	if len(record) < 32 {
+		return nil, nil
+	}
+	addr := common.BytesToAddress(record[12:32])
+	if addr == (common.Address{}) {
+		return nil, nil
+	}
+	return &addr, nil
+}
+
+// callUniversalResolver calls the ENS Universal Resolver, defaulting to the
+// one configured on the client's chain.
+func callUniversalResolver(ctx context.Context, client Client, resolver *common.Address, blockNumber *uint64, blockTag BlockTag, data []byte) ([]byte, error) {
+	if resolver == nil {
+		var err error
+		if resolver, err = universalResolverAddress(client, blockNumber); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := Call(ctx, client, CallParameters{
+		To:          resolver,
+		Data:        data,
+		BlockNumber: blockNumber,
+		BlockTag:    blockTag,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ENS resolution failed: %w", err)
+	}
+	if len(result.Data) == 0 {
+		return nil, fmt.Errorf("ENS resolution failed: empty response from %s", resolver.Hex())
+	}
+	return result.Data, nil
+}
+
+// universalResolverAddress returns the ENS Universal Resolver of the client's
+// chain.
+func universalResolverAddress(client Client, blockNumber *uint64) (*common.Address, error) {
+	chain := client.Chain()
+	if chain == nil {
+		return nil, &ChainNotConfiguredError{}
+	}
+	if chain.Contracts == nil || chain.Contracts.EnsUniversalResolver == nil {
+		return nil, &ChainDoesNotSupportContractError{
+			ChainID:      chain.ID,
+			ContractName: "ensUniversalResolver",
+		}
+	}
+	contract := chain.Contracts.EnsUniversalResolver
+	if blockNumber != nil && contract.BlockCreated != nil && *blockNumber < *contract.BlockCreated {
+		return nil, &ChainDoesNotSupportContractError{
+			ChainID:      chain.ID,
+			ContractName: "ensUniversalResolver",
+			BlockNumber:  blockNumber,
+		}
+	}
+	return &contract.Address, nil
+}
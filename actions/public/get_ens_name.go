@@ -0,0 +1,67 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ethCoinType is the ENSIP-9 coin type of Ethereum mainnet addresses.
+const ethCoinType = 60
+
+// GetEnsNameParameters contains the parameters for the GetEnsName action.
+// This mirrors viem's GetEnsNameParameters type.
+type GetEnsNameParameters struct {
+	// Address is the address to look up the primary name of.
+	Address common.Address
+
+	// UniversalResolverAddress overrides the chain's ENS Universal Resolver.
+	UniversalResolverAddress *common.Address
+
+	// BlockNumber is the block number to look up at.
+	// Mutually exclusive with BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the block tag to look up at (e.g., "latest", "pending").
+	// Mutually exclusive with BlockNumber.
+	// Default: "latest"
+	BlockTag BlockTag
+}
+
+// GetEnsNameReturnType is the return type for the GetEnsName action.
+// An empty string means the address has no primary name.
+type GetEnsNameReturnType = string
+
+// GetEnsName looks up the primary ENS name of an address through the ENS
+// Universal Resolver, which verifies that the name resolves back to the
+// address.
+//
+// This is equivalent to viem's `getEnsName` action.
+//
+// Example:
+//
+//	name, err := public.GetEnsName(ctx, client, public.GetEnsNameParameters{
+//	    Address: common.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"),
+//	})
+func GetEnsName(ctx context.Context, client Client, params GetEnsNameParameters) (GetEnsNameReturnType, error) {
+	data, err := universalResolverABI.EncodeFunctionData("reverse", params.Address.Bytes(), big.NewInt(ethCoinType))
+	if err != nil {
+		return "", err
+	}
+	result, err := callUniversalResolver(ctx, client, params.UniversalResolverAddress, params.BlockNumber, params.BlockTag, data)
+	if err != nil {
+		return "", err
+	}
+	values, err := universalResolverABI.DecodeFunctionResult("reverse", result)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode ENS resolver response: %w", err)
+	}
+
+	name, ok := values[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected ENS primary name type %T", values[0])
+	}
+	return name, nil
+}
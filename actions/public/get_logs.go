@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/ChefBingbong/viem-go/tracing"
 	"github.com/ChefBingbong/viem-go/utils/formatters"
 )
 
@@ -79,7 +80,10 @@ type rpcGetLogsParams struct {
 //	logs, err := public.GetLogs(ctx, client, public.GetLogsParameters{
 //	    BlockHash: &blockHash,
 //	})
-func GetLogs(ctx context.Context, client Client, params GetLogsParameters) (GetLogsReturnType, error) {
+func GetLogs(ctx context.Context, client Client, params GetLogsParameters) (_ GetLogsReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.getLogs", client.Chain().GetID())
+	defer func() { tracing.End(span, err) }()
+
 	// Build filter params
 	filterParams := rpcGetLogsParams{}
 
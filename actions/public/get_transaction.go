@@ -107,6 +107,10 @@ type TransactionResponse struct {
 
 	// BlobVersionedHashes are the blob versioned hashes (EIP-4844).
 	BlobVersionedHashes []common.Hash `json:"blobVersionedHashes,omitempty"`
+
+	// ChainFields holds the chain-specific fields returned by the chain's
+	// transaction formatter, if any.
+	ChainFields any `json:"-"`
 }
 
 // AccessTuple represents an access list entry.
@@ -266,6 +270,11 @@ func GetTransaction(ctx context.Context, client Client, params GetTransactionPar
 	if err = json.Unmarshal(result, &tx); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal transaction: %w", err)
 	}
+	if f := chainFormatters(client); f != nil {
+		if tx.ChainFields, err = formatChainFields(f.Transaction, result, "transaction"); err != nil {
+			return nil, err
+		}
+	}
 
 	return &tx, nil
 }
@@ -61,6 +61,11 @@ func GetTransactionReceipt(ctx context.Context, client Client, params GetTransac
 	if unmarshalErr := json.Unmarshal(resp.Result, &receipt); unmarshalErr != nil {
 		return nil, fmt.Errorf("failed to unmarshal transaction receipt: %w", unmarshalErr)
 	}
+	if f := chainFormatters(client); f != nil {
+		if receipt.ChainFields, err = formatChainFields(f.TransactionReceipt, resp.Result, "transactionReceipt"); err != nil {
+			return nil, err
+		}
+	}
 
 	return &receipt, nil
 }
@@ -0,0 +1,223 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	utilblock "github.com/ChefBingbong/viem-go/utils/block"
+	"github.com/ChefBingbong/viem-go/utils/proof"
+)
+
+// GetVerifiedAccountParameters contains the parameters for the
+// GetVerifiedAccount action and the verified read actions built on it.
+//
+// Proofs are only as trustworthy as the state root they are checked
+// against, so a trusted anchor obtained outside the client is required:
+// either StateRoot with its BlockNumber, or BlockHash.
+type GetVerifiedAccountParameters struct {
+	// Address is the account address.
+	Address common.Address
+
+	// StorageKeys are the storage slots to prove.
+	StorageKeys []common.Hash
+
+	// StateRoot is a trusted state root, e.g. from a light client or a
+	// second provider. Requires BlockNumber. Mutually exclusive with
+	// BlockHash.
+	StateRoot *common.Hash
+
+	// BlockNumber is the number of the block StateRoot belongs to. With
+	// BlockHash it is optional and must match the number in the header.
+	BlockNumber *uint64
+
+	// BlockHash is a trusted block hash. The block header is fetched from
+	// the client and must hash to BlockHash; its state root is then used.
+	// Mutually exclusive with StateRoot.
+	BlockHash *common.Hash
+}
+
+// VerifiedAccount is the return type of the GetVerifiedAccount action.
+type VerifiedAccount struct {
+	proof.Account
+
+	// BlockNumber is the block the account was proven at.
+	BlockNumber uint64
+
+	// StateRoot is the state root the account was proven against.
+	StateRoot common.Hash
+}
+
+var (
+	// ErrTrustedAnchorRequired is returned when a verified read is given
+	// neither a trusted state root nor a trusted block hash.
+	ErrTrustedAnchorRequired = errors.New("verified read: a trusted StateRoot or BlockHash is required")
+
+	// ErrConflictingTrustedAnchors is returned when both StateRoot and
+	// BlockHash are given.
+	ErrConflictingTrustedAnchors = errors.New("verified read: StateRoot and BlockHash are mutually exclusive")
+
+	// ErrStateRootRequiresBlockNumber is returned when a trusted state root is
+	// given without the block number it belongs to.
+	ErrStateRootRequiresBlockNumber = errors.New("verified read: StateRoot requires BlockNumber")
+
+	// ErrBlockHashMismatch is returned when the header returned for a
+	// trusted block hash does not hash to it.
+	ErrBlockHashMismatch = errors.New("verified read: block header does not match the trusted block hash")
+
+	// ErrBlockNumberMismatch is returned when BlockNumber is given with
+	// BlockHash and differs from the number of that block.
+	ErrBlockNumberMismatch = errors.New("verified read: BlockNumber does not match the block of BlockHash")
+)
+
+// GetVerifiedAccount fetches an account with eth_getProof and verifies the
+// account and storage proofs against a trusted state root. It returns an
+// error instead of unproven data, so that untrusted RPC providers can be
+// used for sensitive reads.
+//
+// The state root is either given directly (StateRoot) or taken from the
+// block header of a trusted block hash (BlockHash), after checking that the
+// header fetched from the client hashes to it.
+//
+// JSON-RPC Methods: eth_getBlockByHash (with BlockHash), eth_getProof
+//
+// Example:
+//
+//	// finalized is a block hash from a light client or a trusted provider.
+//	account, err := public.GetVerifiedAccount(ctx, client, public.GetVerifiedAccountParameters{
+//	    Address:     common.HexToAddress("0x..."),
+//	    StorageKeys: []common.Hash{common.HexToHash("0x0")},
+//	    BlockHash:   &finalized,
+//	})
+func GetVerifiedAccount(ctx context.Context, client Client, params GetVerifiedAccountParameters) (*VerifiedAccount, error) {
+	var (
+		blockNumber uint64
+		stateRoot   common.Hash
+	)
+	switch {
+	case params.StateRoot != nil && params.BlockHash != nil:
+		return nil, ErrConflictingTrustedAnchors
+	case params.StateRoot != nil:
+		if params.BlockNumber == nil {
+			return nil, ErrStateRootRequiresBlockNumber
+		}
+		blockNumber, stateRoot = *params.BlockNumber, *params.StateRoot
+	case params.BlockHash != nil:
+		block, err := GetBlock(ctx, client, GetBlockParameters{BlockHash: params.BlockHash})
+		if err != nil {
+			return nil, err
+		}
+		hash, err := utilblock.HashHeader(block)
+		if err != nil {
+			return nil, err
+		}
+		if hash != *params.BlockHash {
+			return nil, fmt.Errorf("%w: header hashes to %s, want %s", ErrBlockHashMismatch, hash.Hex(), params.BlockHash.Hex())
+		}
+		if params.BlockNumber != nil && *params.BlockNumber != block.Number {
+			return nil, fmt.Errorf("%w: block %s is number %d, want %d", ErrBlockNumberMismatch, params.BlockHash.Hex(), block.Number, *params.BlockNumber)
+		}
+		blockNumber, stateRoot = block.Number, block.StateRoot
+	default:
+		return nil, ErrTrustedAnchorRequired
+	}
+
+	p, err := GetProof(ctx, client, GetProofParameters{
+		Address:     params.Address,
+		StorageKeys: params.StorageKeys,
+		BlockNumber: &blockNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(p.Address) || common.HexToAddress(p.Address) != params.Address {
+		return nil, fmt.Errorf("%w: proof is for address %q, want %s", proof.ErrInvalidAccountProof, p.Address, params.Address.Hex())
+	}
+
+	account, err := proof.VerifyProof(stateRoot, p)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range params.StorageKeys {
+		if _, ok := account.Storage[key]; !ok {
+			return nil, fmt.Errorf("%w: missing proof for slot %s", proof.ErrInvalidStorageProof, key.Hex())
+		}
+	}
+
+	return &VerifiedAccount{
+		Account:     *account,
+		BlockNumber: blockNumber,
+		StateRoot:   stateRoot,
+	}, nil
+}
+
+// GetVerifiedBalance returns the balance of an address in wei, proven
+// against a trusted state root (see GetVerifiedAccount).
+//
+// Example:
+//
+//	balance, err := public.GetVerifiedBalance(ctx, client, public.GetVerifiedAccountParameters{
+//	    Address:   common.HexToAddress("0x..."),
+//	    BlockHash: &finalized,
+//	})
+func GetVerifiedBalance(ctx context.Context, client Client, params GetVerifiedAccountParameters) (*big.Int, error) {
+	params.StorageKeys = nil
+	account, err := GetVerifiedAccount(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+	return account.Balance, nil
+}
+
+// GetVerifiedTransactionCount returns the nonce of an address, proven
+// against a trusted state root (see GetVerifiedAccount).
+func GetVerifiedTransactionCount(ctx context.Context, client Client, params GetVerifiedAccountParameters) (uint64, error) {
+	params.StorageKeys = nil
+	account, err := GetVerifiedAccount(ctx, client, params)
+	if err != nil {
+		return 0, err
+	}
+	return account.Nonce, nil
+}
+
+// GetVerifiedCode returns the bytecode at an address. The code is fetched
+// with eth_getCode at the proven block and must hash to the proven code
+// hash (see GetVerifiedAccount).
+func GetVerifiedCode(ctx context.Context, client Client, params GetVerifiedAccountParameters) (GetCodeReturnType, error) {
+	params.StorageKeys = nil
+	account, err := GetVerifiedAccount(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+	if account.CodeHash == proof.EmptyCodeHash {
+		return nil, nil
+	}
+
+	code, err := GetCode(ctx, client, GetCodeParameters{
+		Address:     params.Address,
+		BlockNumber: &account.BlockNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := proof.VerifyCode(account.CodeHash, code); err != nil {
+		return nil, err
+	}
+	return code, nil
+}
+
+// GetVerifiedStorageAt returns the 32-byte value of a storage slot, proven
+// against the account's storage root and a trusted state root (see
+// GetVerifiedAccount).
+func GetVerifiedStorageAt(ctx context.Context, client Client, params GetVerifiedAccountParameters, slot common.Hash) (GetStorageAtReturnType, error) {
+	params.StorageKeys = []common.Hash{slot}
+	account, err := GetVerifiedAccount(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+	value := account.Storage[slot]
+	return value.Bytes(), nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/ChefBingbong/viem-go/abi"
 	"github.com/ChefBingbong/viem-go/constants"
+	"github.com/ChefBingbong/viem-go/tracing"
 	"github.com/ChefBingbong/viem-go/utils/deployless"
 )
 
@@ -192,7 +193,12 @@ func getNumWorkers(numJobs int) int {
 //	        },
 //	    },
 //	})
-func Multicall(ctx context.Context, client Client, params MulticallParameters) (MulticallReturnType, error) {
+func Multicall(ctx context.Context, client Client, params MulticallParameters) (_ MulticallReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.multicall", client.Chain().GetID(),
+		tracing.Int(tracing.AttrCalls, len(params.Contracts)),
+		tracing.String(tracing.AttrBlockTag, resolveBlockTag(client, params.BlockNumber, params.BlockTag)))
+	defer func() { tracing.End(span, err) }()
+
 	// Check if client has multicall batch aggregation enabled
 	if batch := client.Batch(); batch != nil && batch.Multicall != nil {
 		batcher := getMulticallBatcher(client, batch.Multicall)
@@ -211,7 +217,12 @@ func Multicall(ctx context.Context, client Client, params MulticallParameters) (
 //
 // Use this instead of Multicall when you know multiple goroutines will call it
 // concurrently (e.g., resolving N tokens in parallel).
-func MulticallConcurrent(ctx context.Context, client Client, params MulticallParameters) (MulticallReturnType, error) {
+func MulticallConcurrent(ctx context.Context, client Client, params MulticallParameters) (_ MulticallReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.multicall", client.Chain().GetID(),
+		tracing.Int(tracing.AttrCalls, len(params.Contracts)),
+		tracing.String(tracing.AttrBlockTag, resolveBlockTag(client, params.BlockNumber, params.BlockTag)))
+	defer func() { tracing.End(span, err) }()
+
 	if batch := client.Batch(); batch != nil && batch.Multicall != nil {
 		batcher := getMulticallBatcher(client, batch.Multicall)
 		if batcher != nil {
@@ -329,6 +340,10 @@ func multicallDirect(ctx context.Context, client Client, params MulticallParamet
 	chunkedCalls := chunkCalls(encodedCalls, batchSize)
 	numChunks := len(chunkedCalls)
 	chunkResults := make([]*chunkResult, numChunks)
+	if collector := metricsCollector(client); collector != nil {
+		collector.ObserveMulticall(numContracts, numChunks)
+	}
+	tracing.SpanFromContext(ctx).SetAttributes(tracing.Int(tracing.AttrChunks, numChunks))
 
 	if numChunks == 1 {
 		// Single chunk - no need for workers
@@ -73,7 +73,9 @@ type SimulateCallsParameters struct {
 	// StateOverrides contains state overrides for the simulation.
 	StateOverrides types.StateOverride
 
-	// TraceAssetChanges enables tracing of asset (ETH/ERC20) balance changes.
+	// TraceAssetChanges enables tracing of asset changes: ETH and ERC20
+	// balance changes, ERC-721/ERC-1155 transfers and approvals granted by the
+	// account.
 	TraceAssetChanges bool
 
 	// TraceTransfers enables transfer tracing.
@@ -137,11 +139,87 @@ type SimulateCallResult struct {
 	Error error
 }
 
+// Token standards reported in NFTChange and Approval.
+const (
+	TokenStandardERC20   = "erc20"
+	TokenStandardERC721  = "erc721"
+	TokenStandardERC1155 = "erc1155"
+)
+
+// Directions of an NFTChange relative to the account.
+const (
+	NFTChangeDirectionIn  = "in"
+	NFTChangeDirectionOut = "out"
+)
+
+// NFTChange represents an ERC-721 or ERC-1155 token moving in or out of the
+// account during the simulation.
+type NFTChange struct {
+	// Token is the token contract address.
+	Token common.Address
+
+	// Standard is TokenStandardERC721 or TokenStandardERC1155.
+	Standard string
+
+	// TokenID is the id of the transferred token.
+	TokenID *big.Int
+
+	// Amount is the number of tokens transferred (always 1 for ERC-721).
+	Amount *big.Int
+
+	// Direction is NFTChangeDirectionIn or NFTChangeDirectionOut.
+	Direction string
+
+	// From and To are the sender and recipient of the transfer.
+	From common.Address
+	To   common.Address
+}
+
+// Approval represents an approval granted (or revoked) by the account during
+// the simulation.
+type Approval struct {
+	// Token is the token contract address.
+	Token common.Address
+
+	// Standard is TokenStandardERC20 or TokenStandardERC721 for Approval
+	// events. It is empty for ApprovalForAll, which ERC-721 and ERC-1155
+	// share.
+	Standard string
+
+	// Spender is the approved address (operator for ApprovalForAll).
+	Spender common.Address
+
+	// Amount is the approved allowance of an ERC20 approval.
+	Amount *big.Int
+
+	// TokenID is the approved token of an ERC-721 approval.
+	TokenID *big.Int
+
+	// ForAll is true for ApprovalForAll, which covers all of the account's
+	// tokens of the contract.
+	ForAll bool
+
+	// Approved is false when the approval is revoked: an ERC20 allowance of
+	// zero, an ERC-721 approval of the zero address or an ApprovalForAll
+	// revoking the operator.
+	Approved bool
+}
+
 // SimulateCallsReturnType is the return type for the SimulateCalls action.
 type SimulateCallsReturnType struct {
 	// AssetChanges contains the asset balance changes (if TraceAssetChanges was enabled).
+	// ERC-721 contracts with transfers in NFTChanges are left out: their
+	// balanceOf is a token count, and NFTChanges lists the tokens moved.
 	AssetChanges []AssetChange
 
+	// NFTChanges contains the ERC-721/ERC-1155 transfers in and out of the
+	// account, in log order (if TraceAssetChanges was enabled).
+	NFTChanges []NFTChange
+
+	// Approvals contains the approvals granted by the account, in log order
+	// (if TraceAssetChanges was enabled).
+	Approvals []Approval
+
 	// Block is the simulated block data.
 	Block formatters.Block
 
@@ -165,7 +243,8 @@ const getBalanceCode = "0x6080604052348015600e575f80fd5b5061016d8061001c5f395ff3
 // It internally uses eth_simulateV1 to execute the calls.
 //
 // When TraceAssetChanges is enabled, it also tracks ETH and ERC20 balance changes
-// by making additional simulation calls.
+// by making additional simulation calls, and reports the account's ERC-721 and
+// ERC-1155 transfers and approvals decoded from the simulated logs.
 //
 // Example:
 //
@@ -265,7 +344,8 @@ func simulateCallsSimple(ctx context.Context, client Client, params SimulateCall
 	}, nil
 }
 
-// simulateCallsWithAssetTracing performs simulation with ETH/ERC20 balance tracking.
+// simulateCallsWithAssetTracing performs simulation with ETH/ERC20 balance
+// tracking, and decodes NFT transfers and approvals from the main block logs.
 func simulateCallsWithAssetTracing(ctx context.Context, client Client, params SimulateCallsParameters) (*SimulateCallsReturnType, error) {
 	account := params.Account
 
@@ -537,6 +617,18 @@ func simulateCallsWithAssetTracing(ctx context.Context, client Client, params Si
 		results = append(results, SimulateCallResult(callResult))
 	}
 
+	// NFT transfers and approvals of the account
+	nftChanges, approvals := traceLogs(mainBlock.Calls, *account)
+
+	// ERC-721 balanceOf returns a token count, which the NFT changes already
+	// describe, so those contracts are left out of the balance changes.
+	erc721Tokens := make(map[common.Address]struct{})
+	for _, change := range nftChanges {
+		if change.Standard == TokenStandardERC721 {
+			erc721Tokens[change.Token] = struct{}{}
+		}
+	}
+
 	// Build asset changes
 	assetChanges := []AssetChange{}
 
@@ -570,6 +662,10 @@ func simulateCallsWithAssetTracing(ctx context.Context, client Client, params Si
 				continue
 			}
 
+			if _, ok := erc721Tokens[addr]; ok {
+				continue
+			}
+
 			pre := extractBalance(assetPreBlock.Calls[i])
 			post := extractBalance(assetPostBlock.Calls[i])
 
@@ -629,11 +725,192 @@ func simulateCallsWithAssetTracing(ctx context.Context, client Client, params Si
 
 	return &SimulateCallsReturnType{
 		AssetChanges: assetChanges,
+		NFTChanges:   nftChanges,
+		Approvals:    approvals,
 		Block:        mainBlock.Block,
 		Results:      results,
 	}, nil
 }
 
+// Event topics decoded by traceLogs. Transfer and Approval are shared by
+// ERC20 and ERC-721, which index the token id as a fourth topic.
+var (
+	transferTopic       = abi.StandardEventTopics["Transfer"]
+	approvalTopic       = abi.StandardEventTopics["Approval"]
+	approvalForAllTopic = abi.StandardEventTopics["ApprovalForAll"]
+	transferSingleTopic = abi.ComputeEventTopic("TransferSingle(address,address,address,uint256,uint256)")
+	transferBatchTopic  = abi.ComputeEventTopic("TransferBatch(address,address,address,uint256[],uint256[])")
+)
+
+// traceLogs decodes the NFT transfers in and out of account and the
+// approvals granted by account from the logs of the calls. Logs that do not
+// decode as a standard event are skipped.
+func traceLogs(calls []CallResult, account common.Address) ([]NFTChange, []Approval) {
+	nftChanges := []NFTChange{}
+	approvals := []Approval{}
+
+	for _, call := range calls {
+		for _, log := range call.Logs {
+			if len(log.Topics) == 0 {
+				continue
+			}
+			token := common.HexToAddress(log.Address)
+			topics := make([]common.Hash, len(log.Topics))
+			for i, topic := range log.Topics {
+				topics[i] = common.HexToHash(topic)
+			}
+			data := common.FromHex(log.Data)
+
+			switch topics[0] {
+			case transferTopic:
+				// ERC20 transfers (3 topics) are covered by the balance changes.
+				if len(topics) != 4 {
+					continue
+				}
+				from, to := topicAddress(topics[1]), topicAddress(topics[2])
+				nftChanges = appendNFTChange(nftChanges, account, NFTChange{
+					Token:    token,
+					Standard: TokenStandardERC721,
+					TokenID:  topics[3].Big(),
+					Amount:   big.NewInt(1),
+					From:     from,
+					To:       to,
+				})
+
+			case transferSingleTopic:
+				if len(topics) != 4 || len(data) != 64 {
+					continue
+				}
+				nftChanges = appendNFTChange(nftChanges, account, NFTChange{
+					Token:    token,
+					Standard: TokenStandardERC1155,
+					TokenID:  new(big.Int).SetBytes(data[:32]),
+					Amount:   new(big.Int).SetBytes(data[32:]),
+					From:     topicAddress(topics[2]),
+					To:       topicAddress(topics[3]),
+				})
+
+			case transferBatchTopic:
+				if len(topics) != 4 {
+					continue
+				}
+				ids, amounts, err := decodeTransferBatch(data)
+				if err != nil {
+					continue
+				}
+				for i := range ids {
+					nftChanges = appendNFTChange(nftChanges, account, NFTChange{
+						Token:    token,
+						Standard: TokenStandardERC1155,
+						TokenID:  ids[i],
+						Amount:   amounts[i],
+						From:     topicAddress(topics[2]),
+						To:       topicAddress(topics[3]),
+					})
+				}
+
+			case approvalTopic:
+				if len(topics) < 3 || topicAddress(topics[1]) != account {
+					continue
+				}
+				approval := Approval{Token: token, Spender: topicAddress(topics[2])}
+				switch {
+				case len(topics) == 4:
+					// Approving the zero address clears the token approval.
+					approval.Standard = TokenStandardERC721
+					approval.TokenID = topics[3].Big()
+					approval.Approved = approval.Spender != (common.Address{})
+				case len(data) == 32:
+					approval.Standard = TokenStandardERC20
+					approval.Amount = new(big.Int).SetBytes(data)
+					approval.Approved = approval.Amount.Sign() != 0
+				default:
+					continue
+				}
+				approvals = append(approvals, approval)
+
+			case approvalForAllTopic:
+				if len(topics) != 3 || len(data) != 32 || topicAddress(topics[1]) != account {
+					continue
+				}
+				approvals = append(approvals, Approval{
+					Token:    token,
+					Spender:  topicAddress(topics[2]),
+					ForAll:   true,
+					Approved: new(big.Int).SetBytes(data).Sign() != 0,
+				})
+			}
+		}
+	}
+
+	return nftChanges, approvals
+}
+
+// appendNFTChange appends change if it moves a token in or out of account,
+// setting its direction. Transfers from the account to itself are skipped.
+func appendNFTChange(changes []NFTChange, account common.Address, change NFTChange) []NFTChange {
+	switch {
+	case change.From == account && change.To == account:
+		return changes
+	case change.To == account:
+		change.Direction = NFTChangeDirectionIn
+	case change.From == account:
+		change.Direction = NFTChangeDirectionOut
+	default:
+		return changes
+	}
+	return append(changes, change)
+}
+
+// decodeTransferBatch decodes the ids and amounts of an ERC-1155
+// TransferBatch log.
+func decodeTransferBatch(data []byte) ([]*big.Int, []*big.Int, error) {
+	values, err := abi.DecodeAbiParameters([]abi.AbiParam{
+		{Name: "ids", Type: "uint256[]"},
+		{Name: "values", Type: "uint256[]"},
+	}, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids, err := bigInts(values[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	amounts, err := bigInts(values[1])
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ids) != len(amounts) {
+		return nil, nil, fmt.Errorf("TransferBatch has %d ids and %d values", len(ids), len(amounts))
+	}
+	return ids, amounts, nil
+}
+
+// bigInts converts a decoded uint256[] to []*big.Int.
+func bigInts(value any) ([]*big.Int, error) {
+	switch v := value.(type) {
+	case []*big.Int:
+		return v, nil
+	case []any:
+		out := make([]*big.Int, len(v))
+		for i, item := range v {
+			n, ok := item.(*big.Int)
+			if !ok {
+				return nil, fmt.Errorf("unexpected uint256 value %T", item)
+			}
+			out[i] = n
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unexpected uint256[] value %T", value)
+	}
+}
+
+// topicAddress returns the address stored in an indexed event topic.
+func topicAddress(topic common.Hash) common.Address {
+	return common.BytesToAddress(topic.Bytes())
+}
+
 // extractBalance extracts a balance value from a call result.
 func extractBalance(result CallResult) *big.Int {
 	if result.Status != "success" {
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/tracing"
 	"github.com/ChefBingbong/viem-go/types"
 )
 
@@ -157,7 +158,11 @@ type SimulateContractReturnType struct {
 //	    // Transfer would succeed, can proceed with actual transaction
 //	    fmt.Println("Simulation successful!")
 //	}
-func SimulateContract(ctx context.Context, client Client, params SimulateContractParameters) (*SimulateContractReturnType, error) {
+func SimulateContract(ctx context.Context, client Client, params SimulateContractParameters) (_ *SimulateContractReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.simulateContract", client.Chain().GetID(),
+		tracing.String(tracing.AttrFunctionName, params.FunctionName))
+	defer func() { tracing.End(span, err) }()
+
 	if params.ABI == nil {
 		return nil, fmt.Errorf("ABI is required")
 	}
@@ -12,6 +12,9 @@ import (
 	json "github.com/goccy/go-json"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -20,6 +23,8 @@ import (
 	"github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/client/transport"
 	"github.com/ChefBingbong/viem-go/types"
+	utilblock "github.com/ChefBingbong/viem-go/utils/block"
+	"github.com/ChefBingbong/viem-go/utils/proof"
 )
 
 // mockClient implements the public.Client interface for testing.
@@ -1698,6 +1703,792 @@ func TestGetProof_Basic(t *testing.T) {
 	assert.Equal(t, big.NewInt(0x2a).String(), proof.StorageProof[0].Value.String())
 }
 
+// ============================================================================
+// Verified State Tests
+// ============================================================================
+
+// singleLeafTrie returns the root and proof of a trie holding one value.
+func singleLeafTrie(t *testing.T, key, value []byte) (common.Hash, []string) {
+	path := append([]byte{0x20}, crypto.Keccak256(key)...)
+	node, err := rlp.EncodeToBytes([]any{path, value})
+	require.NoError(t, err)
+	return crypto.Keccak256Hash(node), []string{hexutil.Encode(node)}
+}
+
+// verifiedState serves a block, an account proof with one storage slot and
+// code for the verified read actions. tamper modifies the eth_getProof
+// response.
+type verifiedState struct {
+	server     *httptest.Server
+	methods    []string
+	servedCode []byte
+	block      map[string]any
+	blockHash  common.Hash
+	stateRoot  common.Hash
+}
+
+// params returns read parameters anchored to the served block's hash.
+func (s *verifiedState) params(addr common.Address) public.GetVerifiedAccountParameters {
+	return public.GetVerifiedAccountParameters{Address: addr, BlockHash: &s.blockHash}
+}
+
+func newVerifiedState(t *testing.T, addr common.Address, slot common.Hash, code []byte, tamper func(map[string]any)) *verifiedState {
+	value, err := rlp.EncodeToBytes(big.NewInt(0x2a).Bytes())
+	require.NoError(t, err)
+	storageRoot, storageProof := singleLeafTrie(t, slot.Bytes(), value)
+
+	account, err := rlp.EncodeToBytes([]any{uint64(5), big.NewInt(1e18), storageRoot, crypto.Keccak256(code)})
+	require.NoError(t, err)
+	stateRoot, accountProof := singleLeafTrie(t, addr.Bytes(), account)
+
+	header := &types.Block{
+		Number:           100,
+		ParentHash:       common.HexToHash("0x63"),
+		Sha3Uncles:       utilblock.EmptyUncleHash,
+		StateRoot:        stateRoot,
+		TransactionsRoot: proof.EmptyRootHash,
+		ReceiptsRoot:     proof.EmptyRootHash,
+		Difficulty:       new(big.Int),
+		GasLimit:         30000000,
+		Timestamp:        1700000000,
+	}
+	blockHash, err := utilblock.HashHeader(header)
+	require.NoError(t, err)
+
+	state := &verifiedState{servedCode: code, blockHash: blockHash, stateRoot: stateRoot}
+	state.block = map[string]any{
+		"number":           "0x64",
+		"hash":             blockHash.Hex(),
+		"parentHash":       header.ParentHash.Hex(),
+		"nonce":            "0x0000000000000000",
+		"sha3Uncles":       header.Sha3Uncles.Hex(),
+		"logsBloom":        hexutil.Encode(make([]byte, utilblock.BloomLength)),
+		"transactionsRoot": header.TransactionsRoot.Hex(),
+		"stateRoot":        stateRoot.Hex(),
+		"receiptsRoot":     header.ReceiptsRoot.Hex(),
+		"miner":            common.Address{}.Hex(),
+		"difficulty":       "0x0",
+		"extraData":        "0x",
+		"gasLimit":         "0x1c9c380",
+		"gasUsed":          "0x0",
+		"timestamp":        "0x6553f100",
+		"transactions":     []string{},
+		"uncles":           []string{},
+		"mixHash":          common.Hash{}.Hex(),
+	}
+	state.server = createTestServer(t, func(method string, params []any) any {
+		state.methods = append(state.methods, method)
+		switch method {
+		case "eth_getBlockByHash":
+			assert.Equal(t, blockHash.Hex(), params[0])
+			return state.block
+		case "eth_getProof":
+			assert.Equal(t, "0x64", params[2])
+			resp := map[string]any{
+				"address":      addr.Hex(),
+				"accountProof": accountProof,
+				"balance":      "0xde0b6b3a7640000",
+				"codeHash":     crypto.Keccak256Hash(code).Hex(),
+				"nonce":        "0x5",
+				"storageHash":  storageRoot.Hex(),
+				"storageProof": []any{},
+			}
+			if keys, _ := params[1].([]any); len(keys) > 0 {
+				resp["storageProof"] = []any{map[string]any{"key": slot.Hex(), "value": "0x2a", "proof": storageProof}}
+			}
+			if tamper != nil {
+				tamper(resp)
+			}
+			return resp
+		case "eth_getCode":
+			return hexutil.Encode(state.servedCode)
+		}
+		return nil
+	})
+	return state
+}
+
+func TestGetVerifiedState_Basic(t *testing.T) {
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	slot := common.HexToHash("0x0")
+	code := []byte{0x60, 0x00, 0xf3}
+	state := newVerifiedState(t, addr, slot, code, nil)
+	defer state.server.Close()
+
+	client := createMockClient(t, state.server.URL)
+	ctx := context.Background()
+	params := state.params(addr)
+
+	balance, err := public.GetVerifiedBalance(ctx, client, params)
+	require.NoError(t, err)
+	assert.Equal(t, "1000000000000000000", balance.String())
+
+	nonce, err := public.GetVerifiedTransactionCount(ctx, client, params)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), nonce)
+
+	gotCode, err := public.GetVerifiedCode(ctx, client, params)
+	require.NoError(t, err)
+	assert.Equal(t, code, gotCode)
+
+	value, err := public.GetVerifiedStorageAt(ctx, client, params, slot)
+	require.NoError(t, err)
+	assert.Equal(t, common.BigToHash(big.NewInt(0x2a)).Bytes(), value)
+
+	params.StorageKeys = []common.Hash{slot}
+	account, err := public.GetVerifiedAccount(ctx, client, params)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), account.BlockNumber)
+	assert.Equal(t, state.stateRoot, account.StateRoot)
+	assert.True(t, account.Exists)
+	assert.Contains(t, state.methods, "eth_getCode")
+}
+
+func TestGetVerifiedState_TrustedStateRoot(t *testing.T) {
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	state := newVerifiedState(t, addr, common.Hash{}, nil, nil)
+	defer state.server.Close()
+
+	client := createMockClient(t, state.server.URL)
+	ctx := context.Background()
+
+	blockNumber := uint64(100)
+	balance, err := public.GetVerifiedBalance(ctx, client, public.GetVerifiedAccountParameters{
+		Address:     addr,
+		BlockNumber: &blockNumber,
+		StateRoot:   &state.stateRoot,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1000000000000000000", balance.String())
+
+	wrongRoot := common.HexToHash("0xbad")
+	_, err = public.GetVerifiedBalance(ctx, client, public.GetVerifiedAccountParameters{
+		Address:     addr,
+		BlockNumber: &blockNumber,
+		StateRoot:   &wrongRoot,
+	})
+	assert.ErrorIs(t, err, proof.ErrInvalidAccountProof)
+	assert.NotContains(t, state.methods, "eth_getBlockByHash")
+	assert.NotContains(t, state.methods, "eth_getBlockByNumber")
+
+	_, err = public.GetVerifiedBalance(ctx, client, public.GetVerifiedAccountParameters{
+		Address:   addr,
+		StateRoot: &wrongRoot,
+	})
+	assert.ErrorIs(t, err, public.ErrStateRootRequiresBlockNumber)
+}
+
+func TestGetVerifiedState_RequiresTrustedAnchor(t *testing.T) {
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	state := newVerifiedState(t, addr, common.Hash{}, nil, nil)
+	defer state.server.Close()
+
+	client := createMockClient(t, state.server.URL)
+	ctx := context.Background()
+
+	_, err := public.GetVerifiedBalance(ctx, client, public.GetVerifiedAccountParameters{Address: addr})
+	assert.ErrorIs(t, err, public.ErrTrustedAnchorRequired)
+
+	blockNumber := uint64(100)
+	_, err = public.GetVerifiedBalance(ctx, client, public.GetVerifiedAccountParameters{
+		Address:     addr,
+		BlockNumber: &blockNumber,
+		StateRoot:   &state.stateRoot,
+		BlockHash:   &state.blockHash,
+	})
+	assert.ErrorIs(t, err, public.ErrConflictingTrustedAnchors)
+	assert.Empty(t, state.methods)
+}
+
+func TestGetVerifiedState_RejectsForgedHeader(t *testing.T) {
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	state := newVerifiedState(t, addr, common.Hash{}, nil, nil)
+	defer state.server.Close()
+
+	// A lying provider answers with a header for another state root, keeping
+	// the requested hash in the "hash" field.
+	forged, _ := singleLeafTrie(t, addr.Bytes(), []byte{0x01})
+	state.block["stateRoot"] = forged.Hex()
+
+	_, err := public.GetVerifiedBalance(context.Background(), createMockClient(t, state.server.URL), state.params(addr))
+	assert.ErrorIs(t, err, public.ErrBlockHashMismatch)
+	assert.NotContains(t, state.methods, "eth_getProof")
+}
+
+func TestGetVerifiedState_BlockNumberMustMatchBlockHash(t *testing.T) {
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	state := newVerifiedState(t, addr, common.Hash{}, nil, nil)
+	defer state.server.Close()
+
+	client := createMockClient(t, state.server.URL)
+	ctx := context.Background()
+
+	params := state.params(addr)
+	blockNumber := uint64(101)
+	params.BlockNumber = &blockNumber
+	_, err := public.GetVerifiedBalance(ctx, client, params)
+	assert.ErrorIs(t, err, public.ErrBlockNumberMismatch)
+	assert.NotContains(t, state.methods, "eth_getProof")
+
+	blockNumber = 100
+	balance, err := public.GetVerifiedBalance(ctx, client, params)
+	require.NoError(t, err)
+	assert.Equal(t, "1000000000000000000", balance.String())
+}
+
+func TestGetVerifiedState_RejectsUnprovenData(t *testing.T) {
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	slot := common.HexToHash("0x0")
+	ctx := context.Background()
+
+	for name, tc := range map[string]struct {
+		tamper func(map[string]any)
+		read   func(public.Client, public.GetVerifiedAccountParameters) error
+		want   error
+	}{
+		"balance": {
+			tamper: func(resp map[string]any) { resp["balance"] = "0x1" },
+			read: func(c public.Client, params public.GetVerifiedAccountParameters) error {
+				_, err := public.GetVerifiedBalance(ctx, c, params)
+				return err
+			},
+			want: proof.ErrInvalidAccountProof,
+		},
+		"storage value": {
+			tamper: func(resp map[string]any) {
+				sp := resp["storageProof"].([]any)[0].(map[string]any)
+				sp["value"] = "0x2b"
+			},
+			read: func(c public.Client, params public.GetVerifiedAccountParameters) error {
+				_, err := public.GetVerifiedStorageAt(ctx, c, params, slot)
+				return err
+			},
+			want: proof.ErrInvalidStorageProof,
+		},
+		"missing storage proof": {
+			tamper: func(resp map[string]any) { resp["storageProof"] = []any{} },
+			read: func(c public.Client, params public.GetVerifiedAccountParameters) error {
+				_, err := public.GetVerifiedStorageAt(ctx, c, params, slot)
+				return err
+			},
+			want: proof.ErrInvalidStorageProof,
+		},
+		"other address": {
+			tamper: func(resp map[string]any) { resp["address"] = common.HexToAddress("0x1").Hex() },
+			read: func(c public.Client, params public.GetVerifiedAccountParameters) error {
+				_, err := public.GetVerifiedTransactionCount(ctx, c, params)
+				return err
+			},
+			want: proof.ErrInvalidAccountProof,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			state := newVerifiedState(t, addr, slot, nil, tc.tamper)
+			defer state.server.Close()
+			assert.ErrorIs(t, tc.read(createMockClient(t, state.server.URL), state.params(addr)), tc.want)
+		})
+	}
+}
+
+func TestGetVerifiedCode_RejectsWrongCode(t *testing.T) {
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	state := newVerifiedState(t, addr, common.Hash{}, []byte{0x60, 0x00, 0xf3}, nil)
+	defer state.server.Close()
+
+	// Serve different code than the one the proof commits to.
+	state.servedCode = []byte{0x60, 0x01}
+	_, err := public.GetVerifiedCode(context.Background(), createMockClient(t, state.server.URL), state.params(addr))
+	assert.ErrorIs(t, err, proof.ErrCodeMismatch)
+}
+
+// ============================================================================
+// Verify Block Tests
+// ============================================================================
+
+// verifiedBlock serves a consistent block with two transactions and their
+// receipts. tamper modifies the served block, raw transactions and receipts.
+type verifiedBlock struct {
+	server   *httptest.Server
+	block    map[string]any
+	raw      []string
+	receipts []map[string]any
+}
+
+func newVerifiedBlock(t *testing.T) *verifiedBlock {
+	legacy, err := rlp.EncodeToBytes([]any{uint64(0), big.NewInt(1), uint64(21000), common.HexToAddress("0x1"), big.NewInt(1), []byte{}, uint64(27), big.NewInt(1), big.NewInt(2)})
+	require.NoError(t, err)
+	dynamic, err := rlp.EncodeToBytes([]any{uint64(1), uint64(1), big.NewInt(1), big.NewInt(2), uint64(50000), common.HexToAddress("0x2"), big.NewInt(0), []byte{0xa9}, []any{}, uint64(1), big.NewInt(3), big.NewInt(4)})
+	require.NoError(t, err)
+	raw := []string{hexutil.Encode(legacy), hexutil.Encode(append([]byte{0x02}, dynamic...))}
+
+	bloom := make([]byte, utilblock.BloomLength)
+	receipts := []types.Receipt{
+		{Type: 0, Status: 1, CumulativeGasUsed: 21000, LogsBloom: bloom},
+		{Type: 2, Status: 1, CumulativeGasUsed: 60000, LogsBloom: bloom, Logs: []types.Log{{
+			Address: common.HexToAddress("0x2"),
+			Topics:  []common.Hash{common.HexToHash("0x01")},
+			Data:    []byte{0x2a},
+		}}},
+	}
+	txRoot, err := utilblock.TransactionsRoot(raw)
+	require.NoError(t, err)
+	receiptsRoot, err := utilblock.ReceiptsRoot(receipts)
+	require.NoError(t, err)
+
+	header := &types.Block{
+		Number:           100,
+		ParentHash:       common.HexToHash("0x99"),
+		Sha3Uncles:       utilblock.EmptyUncleHash,
+		StateRoot:        common.HexToHash("0x01"),
+		TransactionsRoot: txRoot,
+		ReceiptsRoot:     receiptsRoot,
+		Difficulty:       new(big.Int),
+		GasLimit:         30000000,
+		GasUsed:          60000,
+		Timestamp:        1700000000,
+		BaseFeePerGas:    big.NewInt(1),
+		WithdrawalsRoot:  &proof.EmptyRootHash,
+	}
+	hash, err := utilblock.HashHeader(header)
+	require.NoError(t, err)
+
+	var txHashes []string
+	for _, tx := range raw {
+		txHash, err := utilblock.TransactionHash(tx)
+		require.NoError(t, err)
+		txHashes = append(txHashes, txHash.Hex())
+	}
+
+	b := &verifiedBlock{
+		block: map[string]any{
+			"number":           "0x64",
+			"hash":             hash.Hex(),
+			"parentHash":       header.ParentHash.Hex(),
+			"nonce":            "0x0000000000000000",
+			"sha3Uncles":       header.Sha3Uncles.Hex(),
+			"logsBloom":        hexutil.Encode(bloom),
+			"transactionsRoot": txRoot.Hex(),
+			"stateRoot":        header.StateRoot.Hex(),
+			"receiptsRoot":     receiptsRoot.Hex(),
+			"miner":            common.Address{}.Hex(),
+			"difficulty":       "0x0",
+			"extraData":        "0x",
+			"gasLimit":         "0x1c9c380",
+			"gasUsed":          "0xea60",
+			"timestamp":        "0x6553f100",
+			"transactions":     txHashes,
+			"uncles":           []string{},
+			"baseFeePerGas":    "0x1",
+			"mixHash":          common.Hash{}.Hex(),
+			"withdrawalsRoot":  proof.EmptyRootHash.Hex(),
+		},
+		raw: raw,
+		receipts: []map[string]any{
+			{"type": "0x0", "status": "0x1", "cumulativeGasUsed": "0x5208", "logsBloom": hexutil.Encode(bloom), "logs": []any{}},
+			{"type": "0x2", "status": "0x1", "cumulativeGasUsed": "0xea60", "logsBloom": hexutil.Encode(bloom), "logs": []any{
+				map[string]any{"address": common.HexToAddress("0x2").Hex(), "topics": []string{common.HexToHash("0x01").Hex()}, "data": "0x2a"},
+			}},
+		},
+	}
+	b.server = createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_getBlockByNumber", "eth_getBlockByHash":
+			return b.block
+		case "eth_getRawTransactionByBlockHashAndIndex":
+			assert.Equal(t, b.block["hash"], params[0])
+			index, err := hexutil.DecodeUint64(params[1].(string))
+			require.NoError(t, err)
+			return b.raw[index]
+		case "eth_getBlockReceipts":
+			return b.receipts
+		}
+		return nil
+	})
+	return b
+}
+
+func TestVerifyBlock_Valid(t *testing.T) {
+	b := newVerifiedBlock(t)
+	defer b.server.Close()
+
+	result, err := public.VerifyBlock(context.Background(), createMockClient(t, b.server.URL), public.VerifyBlockParameters{})
+	require.NoError(t, err)
+	assert.True(t, result.Valid(), "mismatches: %v", result.Mismatches)
+	assert.Equal(t, b.block["hash"], result.Hash.Hex())
+	require.NotNil(t, result.TransactionsRoot)
+	assert.Equal(t, b.block["transactionsRoot"], result.TransactionsRoot.Hex())
+	require.NotNil(t, result.ReceiptsRoot)
+	assert.Equal(t, b.block["receiptsRoot"], result.ReceiptsRoot.Hex())
+}
+
+func TestVerifyBlock_Mismatches(t *testing.T) {
+	fields := func(result *public.VerifyBlockReturnType) []string {
+		var out []string
+		for _, m := range result.Mismatches {
+			out = append(out, m.Field)
+		}
+		return out
+	}
+
+	for name, tc := range map[string]struct {
+		tamper func(b *verifiedBlock)
+		params public.VerifyBlockParameters
+		want   []string
+	}{
+		"header field": {
+			tamper: func(b *verifiedBlock) { b.block["gasUsed"] = "0x1" },
+			want:   []string{"hash"},
+		},
+		"transactions root": {
+			tamper: func(b *verifiedBlock) { b.block["transactionsRoot"] = common.HexToHash("0x1").Hex() },
+			want:   []string{"hash", "transactionsRoot"},
+		},
+		"raw transaction": {
+			tamper: func(b *verifiedBlock) { b.raw[1] = b.raw[0] },
+			want:   []string{"transactions[1]", "transactionsRoot"},
+		},
+		"receipt": {
+			tamper: func(b *verifiedBlock) { b.receipts[0]["status"] = "0x0" },
+			want:   []string{"receiptsRoot"},
+		},
+		"missing receipt": {
+			tamper: func(b *verifiedBlock) { b.receipts = b.receipts[:1] },
+			want:   []string{"receiptsCount", "receiptsRoot"},
+		},
+		"receipt skipped": {
+			tamper: func(b *verifiedBlock) { b.receipts[0]["status"] = "0x0" },
+			params: public.VerifyBlockParameters{SkipReceipts: true},
+		},
+		"requested hash": {
+			params: public.VerifyBlockParameters{BlockHash: &common.Hash{0x01}},
+			want:   []string{"requestedHash"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			b := newVerifiedBlock(t)
+			defer b.server.Close()
+			if tc.tamper != nil {
+				tc.tamper(b)
+			}
+
+			result, err := public.VerifyBlock(context.Background(), createMockClient(t, b.server.URL), tc.params)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, fields(result))
+			assert.Equal(t, len(tc.want) == 0, result.Valid())
+		})
+	}
+}
+
+// ============================================================================
+// Chain Fees Tests
+// ============================================================================
+
+// newFeeServer serves a block with a 1 gwei base fee, a 1 gwei suggested
+// priority fee and a 2 gwei gas price, and records the called methods.
+func newFeeServer(t *testing.T) (*httptest.Server, *[]string) {
+	var methods []string
+	server := createTestServer(t, func(method string, params []any) any {
+		methods = append(methods, method)
+		switch method {
+		case "eth_getBlockByNumber":
+			return map[string]any{"number": "0x10", "baseFeePerGas": "0x3b9aca00"}
+		case "eth_maxPriorityFeePerGas":
+			return "0x3b9aca00"
+		case "eth_gasPrice":
+			return "0x77359400"
+		}
+		return nil
+	})
+	return server, &methods
+}
+
+func TestEstimateFeesPerGas_ChainFees(t *testing.T) {
+	gwei := func(n int64) *big.Int { return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e9)) }
+	multiplier := 2.0
+
+	for name, tc := range map[string]struct {
+		fees            *chain.ChainFees
+		feeType         public.FeeValuesType
+		wantMaxFee      *big.Int
+		wantPriorityFee *big.Int
+		wantGasPrice    *big.Int
+		noPriorityRPC   bool
+	}{
+		"defaults": {
+			wantMaxFee:      new(big.Int).Add(big.NewInt(1.2e9), gwei(1)),
+			wantPriorityFee: gwei(1),
+		},
+		"base fee multiplier": {
+			fees:            &chain.ChainFees{BaseFeeMultiplier: &multiplier},
+			wantMaxFee:      gwei(3),
+			wantPriorityFee: gwei(1),
+		},
+		"min priority fee": {
+			fees:            &chain.ChainFees{MinPriorityFee: gwei(30)},
+			wantMaxFee:      new(big.Int).Add(big.NewInt(1.2e9), gwei(30)),
+			wantPriorityFee: gwei(30),
+		},
+		"default priority fee": {
+			fees:            &chain.ChainFees{DefaultPriorityFee: big.NewInt(0)},
+			wantMaxFee:      big.NewInt(1.2e9),
+			wantPriorityFee: big.NewInt(0),
+			noPriorityRPC:   true,
+		},
+		"legacy min priority fee": {
+			fees:         &chain.ChainFees{MinPriorityFee: gwei(5)},
+			feeType:      public.FeeValuesTypeLegacy,
+			wantGasPrice: gwei(6),
+		},
+		"legacy multiplier": {
+			fees:         &chain.ChainFees{BaseFeeMultiplier: &multiplier},
+			feeType:      public.FeeValuesTypeLegacy,
+			wantGasPrice: gwei(4),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server, methods := newFeeServer(t)
+			defer server.Close()
+
+			client := createMockClient(t, server.URL)
+			client.chain = &chain.Chain{ID: 1, Fees: tc.fees}
+
+			fees, err := public.EstimateFeesPerGas(context.Background(), client, public.EstimateFeesPerGasParameters{Type: tc.feeType})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantMaxFee, fees.MaxFeePerGas)
+			assert.Equal(t, tc.wantPriorityFee, fees.MaxPriorityFeePerGas)
+			assert.Equal(t, tc.wantGasPrice, fees.GasPrice)
+			if tc.noPriorityRPC {
+				assert.NotContains(t, *methods, "eth_maxPriorityFeePerGas")
+			}
+		})
+	}
+}
+
+func TestEstimateFeesPerGas_ParamOverridesChain(t *testing.T) {
+	server, _ := newFeeServer(t)
+	defer server.Close()
+
+	chainMultiplier, paramMultiplier := 3.0, 1.0
+	client := createMockClient(t, server.URL)
+	client.chain = &chain.Chain{ID: 1, Fees: &chain.ChainFees{BaseFeeMultiplier: &chainMultiplier}}
+
+	fees, err := public.EstimateFeesPerGas(context.Background(), client, public.EstimateFeesPerGasParameters{
+		BaseFeeMultiplier: &paramMultiplier,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2e9), fees.MaxFeePerGas)
+
+	// A chain passed in the parameters replaces the client's chain.
+	fees, err = public.EstimateFeesPerGas(context.Background(), client, public.EstimateFeesPerGasParameters{
+		Chain: &chain.Chain{ID: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2.2e9), fees.MaxFeePerGas)
+}
+
+func TestEstimateFeesPerGas_CustomEstimator(t *testing.T) {
+	server, _ := newFeeServer(t)
+	defer server.Close()
+
+	var calls []string
+	fees := &chain.ChainFees{
+		EstimateFeesPerGas: func(ctx context.Context, params chain.EstimateFeesPerGasParameters) (*chain.FeeValues, error) {
+			calls = append(calls, params.Type)
+			if params.Type == "legacy" {
+				// Fall back to the default estimation.
+				return nil, nil
+			}
+			raw, err := params.Request(ctx, "eth_gasPrice")
+			if err != nil {
+				return nil, err
+			}
+			var gasPrice hexutil.Big
+			if err := json.Unmarshal(raw, &gasPrice); err != nil {
+				return nil, err
+			}
+			return &chain.FeeValues{
+				MaxFeePerGas:         params.Multiply(gasPrice.ToInt()),
+				MaxPriorityFeePerGas: params.Block.BaseFeePerGas,
+			}, nil
+		},
+	}
+	client := createMockClient(t, server.URL)
+	client.chain = &chain.Chain{ID: 1, Fees: fees}
+	ctx := context.Background()
+
+	result, err := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{})
+	require.NoError(t, err)
+	assert.Equal(t, public.FeeValuesTypeEIP1559, result.Type)
+	assert.Equal(t, big.NewInt(2.4e9), result.MaxFeePerGas)
+	assert.Equal(t, big.NewInt(1e9), result.MaxPriorityFeePerGas)
+
+	result, err = public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{Type: public.FeeValuesTypeLegacy})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2.4e9), result.GasPrice)
+	assert.Equal(t, []string{"eip1559", "legacy"}, calls)
+}
+
+func TestEstimateFeesPerGas_IncompleteCustomEstimate(t *testing.T) {
+	server, _ := newFeeServer(t)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = &chain.Chain{ID: 1, Fees: &chain.ChainFees{
+		EstimateFeesPerGas: func(ctx context.Context, params chain.EstimateFeesPerGasParameters) (*chain.FeeValues, error) {
+			// Only a gas price: enough for legacy, not for EIP-1559.
+			return &chain.FeeValues{GasPrice: big.NewInt(1e9)}, nil
+		},
+	}}
+	ctx := context.Background()
+
+	_, err := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{})
+	assert.ErrorIs(t, err, public.ErrIncompleteChainFees)
+
+	result, err := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{Type: public.FeeValuesTypeLegacy})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1e9), result.GasPrice)
+}
+
+func TestEstimateMaxPriorityFeePerGas_ChainFees(t *testing.T) {
+	server, methods := newFeeServer(t)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = &chain.Chain{ID: 1, Fees: &chain.ChainFees{MinPriorityFee: big.NewInt(5e9)}}
+
+	fee, err := public.EstimateMaxPriorityFeePerGas(context.Background(), client, public.EstimateMaxPriorityFeePerGasParameters{})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(5e9), fee)
+	assert.Contains(t, *methods, "eth_maxPriorityFeePerGas")
+}
+
+// ============================================================================
+// Chain Formatters Tests
+// ============================================================================
+
+type testChainFields struct {
+	SourceHash    common.Hash     `json:"sourceHash"`
+	L1BlockNumber *hexutil.Uint64 `json:"l1BlockNumber"`
+	L1Fee         *hexutil.Big    `json:"l1Fee"`
+}
+
+func decodeTestChainFields(raw json.RawMessage) (any, error) {
+	var fields testChainFields
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return &fields, nil
+}
+
+func TestChainFormatters_PopulateChainFields(t *testing.T) {
+	txHash := common.HexToHash("0xaa")
+	sourceHash := common.HexToHash("0x01")
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_getBlockByNumber":
+			return map[string]any{"number": "0x10", "l1BlockNumber": "0x64"}
+		case "eth_getTransactionByHash":
+			return map[string]any{"hash": txHash.Hex(), "type": "0x7e", "sourceHash": sourceHash.Hex()}
+		case "eth_getTransactionReceipt":
+			return map[string]any{"transactionHash": txHash.Hex(), "status": "0x1", "type": "0x7e", "l1Fee": "0x2a"}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	// Without formatters, chain fields are not decoded.
+	block, err := public.GetBlock(ctx, client, public.GetBlockParameters{})
+	require.NoError(t, err)
+	assert.Nil(t, block.ChainFields)
+
+	client.chain = &chain.Chain{ID: 10, Formatters: &chain.ChainFormatters{
+		Block:              decodeTestChainFields,
+		Transaction:        decodeTestChainFields,
+		TransactionReceipt: decodeTestChainFields,
+	}}
+
+	block, err = public.GetBlock(ctx, client, public.GetBlockParameters{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(16), block.Number)
+	require.IsType(t, &testChainFields{}, block.ChainFields)
+	assert.Equal(t, hexutil.Uint64(100), *block.ChainFields.(*testChainFields).L1BlockNumber)
+
+	tx, err := public.GetTransaction(ctx, client, public.GetTransactionParameters{Hash: &txHash})
+	require.NoError(t, err)
+	assert.Equal(t, uint8(0x7e), tx.Type)
+	require.IsType(t, &testChainFields{}, tx.ChainFields)
+	assert.Equal(t, sourceHash, tx.ChainFields.(*testChainFields).SourceHash)
+
+	receipt, err := public.GetTransactionReceipt(ctx, client, public.GetTransactionReceiptParameters{Hash: txHash})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x7e), receipt.Type)
+	require.IsType(t, &testChainFields{}, receipt.ChainFields)
+	assert.Equal(t, big.NewInt(42), receipt.ChainFields.(*testChainFields).L1Fee.ToInt())
+}
+
+func TestChainFormatters_Error(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return map[string]any{"number": "0x10"}
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = &chain.Chain{ID: 10, Formatters: &chain.ChainFormatters{
+		Block: func(raw json.RawMessage) (any, error) { return nil, assert.AnError },
+	}}
+
+	_, err := public.GetBlock(context.Background(), client, public.GetBlockParameters{})
+	require.ErrorIs(t, err, assert.AnError)
+}
+
+func TestEstimateGas_ChainTransactionRequestFormatter(t *testing.T) {
+	var request map[string]any
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_estimateGas" {
+			request = params[0].(map[string]any)
+			return "0x5208"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = &chain.Chain{ID: 324, Formatters: &chain.ChainFormatters{
+		TransactionRequest: func(request map[string]any, fields any) error {
+			if fields != nil {
+				request["feeToken"] = fields.(string)
+			}
+			return nil
+		},
+	}}
+
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	gas, err := public.EstimateGas(context.Background(), client, public.EstimateGasParameters{
+		Account:     &from,
+		To:          &to,
+		Value:       big.NewInt(1),
+		ChainFields: "0x3333333333333333333333333333333333333333",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(21000), gas)
+	assert.Equal(t, from.Hex(), request["from"])
+	assert.Equal(t, "0x1", request["value"])
+	assert.Equal(t, "0x3333333333333333333333333333333333333333", request["feeToken"])
+
+	// A chain passed in the parameters replaces the client's chain.
+	_, err = public.EstimateGas(context.Background(), client, public.EstimateGasParameters{
+		Account:     &from,
+		Chain:       &chain.Chain{ID: 324},
+		ChainFields: "0x3333333333333333333333333333333333333333",
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, request, "feeToken")
+}
+
 // ============================================================================
 // GetChainID & GetGasPrice Tests
 // ============================================================================
@@ -2184,6 +2975,135 @@ func TestSimulateCalls_RequiresAccountForAssetTracing(t *testing.T) {
 	assert.Contains(t, err.Error(), "account")
 }
 
+func TestSimulateCalls_TraceAssetChangesNFTsAndApprovals(t *testing.T) {
+	account := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	other := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	spender := common.HexToAddress("0xcccccccccccccccccccccccccccccccccccccccc")
+	token := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	nft := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	multiToken := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	topic := func(v any) string {
+		switch v := v.(type) {
+		case common.Address:
+			return common.BytesToHash(v.Bytes()).Hex()
+		case int64:
+			return common.BigToHash(big.NewInt(v)).Hex()
+		default:
+			return abi.ComputeEventTopicHex(v.(string))
+		}
+	}
+	word := func(n int64) string {
+		return common.Bytes2Hex(common.BigToHash(big.NewInt(n)).Bytes())
+	}
+	rpcLog := func(address common.Address, data string, topics ...string) map[string]any {
+		return map[string]any{"address": address.Hex(), "topics": topics, "data": "0x" + data}
+	}
+	const (
+		transfer       = "Transfer(address,address,uint256)"
+		approval       = "Approval(address,address,uint256)"
+		approvalForAll = "ApprovalForAll(address,address,bool)"
+		transferSingle = "TransferSingle(address,address,address,uint256,uint256)"
+		transferBatch  = "TransferBatch(address,address,address,uint256[],uint256[])"
+	)
+	logs := []map[string]any{
+		// ERC20 transfer out, covered by the balance changes
+		rpcLog(token, word(10), topic(transfer), topic(account), topic(other)),
+		// ERC-721 token 7 out
+		rpcLog(nft, "", topic(transfer), topic(account), topic(other), topic(int64(7))),
+		// ERC-1155 10 of token 5 in
+		rpcLog(multiToken, word(5)+word(10), topic(transferSingle), topic(other), topic(other), topic(account)),
+		// ERC-1155 batch in: 3 of token 1, 4 of token 2
+		rpcLog(multiToken, word(64)+word(160)+word(2)+word(1)+word(2)+word(2)+word(3)+word(4),
+			topic(transferBatch), topic(other), topic(other), topic(account)),
+		// ERC-721 transfer between other accounts
+		rpcLog(nft, "", topic(transfer), topic(other), topic(spender), topic(int64(8))),
+		// Approvals granted by the account
+		rpcLog(token, word(100), topic(approval), topic(account), topic(spender)),
+		rpcLog(nft, "", topic(approval), topic(account), topic(spender), topic(int64(9))),
+		rpcLog(multiToken, word(1), topic(approvalForAll), topic(account), topic(spender)),
+		// Revocations: a zero ERC20 allowance and an ERC-721 approval of the
+		// zero address
+		rpcLog(token, word(0), topic(approval), topic(account), topic(spender)),
+		rpcLog(nft, "", topic(approval), topic(account), topic(common.Address{}), topic(int64(9))),
+		// Approval granted by another owner
+		rpcLog(token, word(1), topic(approval), topic(other), topic(spender)),
+	}
+
+	success := func(returnData string) map[string]any {
+		return map[string]any{"status": "0x1", "returnData": returnData, "gasUsed": "0x0"}
+	}
+	failure := map[string]any{"status": "0x0", "returnData": "0x", "gasUsed": "0x0", "error": map[string]any{"code": 3, "message": "execution reverted"}}
+	block := func(calls ...map[string]any) map[string]any {
+		return map[string]any{"number": "0x1", "calls": calls}
+	}
+	mainCall := success("0x")
+	mainCall["logs"] = logs
+
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_createAccessList":
+			return map[string]any{
+				"accessList": []map[string]any{
+					{"address": token.Hex(), "storageKeys": []string{topic(int64(1))}},
+					{"address": nft.Hex(), "storageKeys": []string{topic(int64(2))}},
+				},
+				"gasUsed": "0x5208",
+			}
+		case "eth_simulateV1":
+			symbol := "0x" + word(32) + word(3) + common.Bytes2Hex(common.RightPadBytes([]byte("TKN"), 32))
+			return []map[string]any{
+				block(success("0x" + word(1000))),                    // ETH pre balance
+				block(success("0x"+word(50)), success("0x"+word(2))), // asset pre balances
+				block(mainCall, success("0x")),                       // main calls
+				block(success("0x" + word(900))),                     // ETH post balance
+				block(success("0x"+word(40)), success("0x"+word(1))), // asset post balances
+				block(success("0x"+word(18)), failure),               // decimals
+				block(success(symbol), failure),                      // symbols
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	result, err := public.SimulateCalls(ctx, client, public.SimulateCallsParameters{
+		Account:           &account,
+		Calls:             []public.SimulateCall{{To: &nft, Data: []byte{0x01}}},
+		TraceAssetChanges: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+
+	// The ERC-721 contract is reported as NFT changes, not as a balance change.
+	require.Len(t, result.AssetChanges, 2)
+	assert.Equal(t, public.ETHAddress, result.AssetChanges[0].Token.Address)
+	assert.Equal(t, big.NewInt(-100), result.AssetChanges[0].Value.Diff)
+	assert.Equal(t, token, result.AssetChanges[1].Token.Address)
+	assert.Equal(t, "TKN", result.AssetChanges[1].Token.Symbol)
+	assert.Equal(t, big.NewInt(-10), result.AssetChanges[1].Value.Diff)
+	for _, change := range result.AssetChanges {
+		assert.NotEqual(t, nft, change.Token.Address, "ERC-721 balance counts are not asset changes")
+	}
+
+	assert.Equal(t, []public.NFTChange{
+		{Token: nft, Standard: public.TokenStandardERC721, TokenID: big.NewInt(7), Amount: big.NewInt(1), Direction: public.NFTChangeDirectionOut, From: account, To: other},
+		{Token: multiToken, Standard: public.TokenStandardERC1155, TokenID: big.NewInt(5), Amount: big.NewInt(10), Direction: public.NFTChangeDirectionIn, From: other, To: account},
+		{Token: multiToken, Standard: public.TokenStandardERC1155, TokenID: big.NewInt(1), Amount: big.NewInt(3), Direction: public.NFTChangeDirectionIn, From: other, To: account},
+		{Token: multiToken, Standard: public.TokenStandardERC1155, TokenID: big.NewInt(2), Amount: big.NewInt(4), Direction: public.NFTChangeDirectionIn, From: other, To: account},
+	}, result.NFTChanges)
+
+	assert.Equal(t, []public.Approval{
+		{Token: token, Standard: public.TokenStandardERC20, Spender: spender, Amount: big.NewInt(100), Approved: true},
+		{Token: nft, Standard: public.TokenStandardERC721, Spender: spender, TokenID: big.NewInt(9), Approved: true},
+		{Token: multiToken, Spender: spender, ForAll: true, Approved: true},
+		{Token: token, Standard: public.TokenStandardERC20, Spender: spender, Amount: new(big.Int).SetBytes(make([]byte, 32)), Approved: false},
+		{Token: nft, Standard: public.TokenStandardERC721, Spender: common.Address{}, TokenID: big.NewInt(9), Approved: false},
+	}, result.Approvals)
+}
+
 // ============================================================================
 // SimulateContract Tests
 // ============================================================================
@@ -2327,6 +3247,124 @@ func TestSimulateContract_ReturnsRequest(t *testing.T) {
 	assert.Equal(t, &account, result.Request.Account)
 }
 
+// ============================================================================
+// ENS Tests
+// ============================================================================
+
+var ensResolver = common.HexToAddress("0xeEeEEEeE14D718C2B47D9923Deab1335E144EeEe")
+
+// newEnsServer answers Universal Resolver eth_calls with result, ABI-encoded
+// with the given parameter types, and records the decoded calldata.
+func newEnsServer(t *testing.T, types string, result ...any) (*httptest.Server, *[]any) {
+	resolverABI := abi.MustParseAbi([]string{
+		"function resolve(bytes name, bytes data) view returns (bytes, address)",
+		"function reverse(bytes lookupAddress, uint256 coinType) view returns (string, address, address)",
+	})
+	params, err := abi.ParseAbiParameters(types)
+	require.NoError(t, err)
+	encoded, err := abi.EncodeAbiParameters(params, result)
+	require.NoError(t, err)
+
+	var args []any
+	server := createTestServer(t, func(method string, params []any) any {
+		require.Equal(t, "eth_call", method)
+		call := params[0].(map[string]any)
+		assert.Equal(t, ensResolver, common.HexToAddress(call["to"].(string)))
+		decoded, err := resolverABI.DecodeFunctionData(common.FromHex(call["data"].(string)))
+		require.NoError(t, err)
+		args = append([]any{decoded.FunctionName}, decoded.Args...)
+		return hexutil.Encode(encoded)
+	})
+	return server, &args
+}
+
+func ensChain() *chain.Chain {
+	return &chain.Chain{ID: 1, Contracts: &chain.ChainContracts{
+		EnsUniversalResolver: &chain.ChainContract{Address: ensResolver},
+	}}
+}
+
+func TestGetEnsAddress_Basic(t *testing.T) {
+	owner := common.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	server, args := newEnsServer(t, "bytes, address", common.LeftPadBytes(owner.Bytes(), 32), ensResolver)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = ensChain()
+
+	addr, err := public.GetEnsAddress(context.Background(), client, public.GetEnsAddressParameters{Name: "Vitalik.eth"})
+	require.NoError(t, err)
+	require.NotNil(t, addr)
+	assert.Equal(t, owner, *addr)
+
+	namehash := common.FromHex("0xee6c4522aab0003e8d14cd40a6af439055fd2577951148c14b6cea9a53475835")
+	require.Len(t, *args, 3)
+	assert.Equal(t, "resolve", (*args)[0])
+	assert.Equal(t, append([]byte{7}, append([]byte("vitalik"), append([]byte{3}, append([]byte("eth"), 0)...)...)...), (*args)[1])
+	assert.Equal(t, append([]byte{0x3b, 0x3b, 0x57, 0xde}, namehash...), (*args)[2])
+}
+
+func TestGetEnsAddress_NoRecord(t *testing.T) {
+	server, _ := newEnsServer(t, "bytes, address", []byte{}, ensResolver)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = ensChain()
+
+	addr, err := public.GetEnsAddress(context.Background(), client, public.GetEnsAddressParameters{Name: "unknown.eth"})
+	require.NoError(t, err)
+	assert.Nil(t, addr)
+}
+
+func TestGetEnsAddress_ResolverOverride(t *testing.T) {
+	owner := common.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	server, _ := newEnsServer(t, "bytes, address", common.LeftPadBytes(owner.Bytes(), 32), ensResolver)
+	defer server.Close()
+
+	// The client has no chain; the resolver is passed explicitly.
+	client := createMockClient(t, server.URL)
+	addr, err := public.GetEnsAddress(context.Background(), client, public.GetEnsAddressParameters{
+		Name:                     "vitalik.eth",
+		UniversalResolverAddress: &ensResolver,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, owner, *addr)
+}
+
+func TestGetEnsAddress_RequiresResolver(t *testing.T) {
+	client := &mockClient{}
+	_, err := public.GetEnsAddress(context.Background(), client, public.GetEnsAddressParameters{Name: "vitalik.eth"})
+	var notConfigured *public.ChainNotConfiguredError
+	assert.ErrorAs(t, err, &notConfigured)
+
+	client.chain = &chain.Chain{ID: 10}
+	_, err = public.GetEnsAddress(context.Background(), client, public.GetEnsAddressParameters{Name: "vitalik.eth"})
+	var unsupported *public.ChainDoesNotSupportContractError
+	require.ErrorAs(t, err, &unsupported)
+	assert.Equal(t, "ensUniversalResolver", unsupported.ContractName)
+
+	_, err = public.GetEnsAddress(context.Background(), client, public.GetEnsAddressParameters{Name: "not a name.eth"})
+	assert.ErrorContains(t, err, "invalid ENS name")
+}
+
+func TestGetEnsName_Basic(t *testing.T) {
+	owner := common.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	server, args := newEnsServer(t, "string, address, address", "vitalik.eth", ensResolver, ensResolver)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = ensChain()
+
+	name, err := public.GetEnsName(context.Background(), client, public.GetEnsNameParameters{Address: owner})
+	require.NoError(t, err)
+	assert.Equal(t, "vitalik.eth", name)
+
+	require.Len(t, *args, 3)
+	assert.Equal(t, "reverse", (*args)[0])
+	assert.Equal(t, owner.Bytes(), (*args)[1])
+	assert.Equal(t, big.NewInt(60), (*args)[2])
+}
+
 // Helper to parse ABI for tests
 func parseTestABI(jsonABI string) (*abi.ABI, error) {
 	return abi.ParseFromString(jsonABI)
@@ -0,0 +1,241 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/types"
+	utilblock "github.com/ChefBingbong/viem-go/utils/block"
+)
+
+// VerifyBlockParameters contains the parameters for the VerifyBlock action.
+type VerifyBlockParameters struct {
+	// BlockHash is the hash of the block to verify. The recomputed block hash
+	// must match it.
+	// Mutually exclusive with BlockNumber and BlockTag.
+	BlockHash *common.Hash
+
+	// BlockNumber is the number of the block to verify.
+	// Mutually exclusive with BlockHash and BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the block tag of the block to verify (e.g., "latest", "finalized").
+	// Mutually exclusive with BlockHash and BlockNumber.
+	// Default: "latest"
+	BlockTag BlockTag
+
+	// SkipTransactions skips fetching the raw transactions and checking the
+	// transactions root.
+	SkipTransactions bool
+
+	// SkipReceipts skips fetching the receipts and checking the receipts root.
+	SkipReceipts bool
+
+	// MaxConcurrency is the maximum number of concurrent raw transaction
+	// requests.
+	// Default: 16
+	MaxConcurrency int
+}
+
+// BlockMismatch describes a block field whose value reported by the RPC
+// provider differs from the value recomputed from the underlying data.
+type BlockMismatch struct {
+	// Field is the name of the field, e.g. "hash", "transactionsRoot" or
+	// "transactions[3]". For "receiptsCount", Reported and Computed hold the
+	// number of transactions and receipts as big-endian integers.
+	Field string
+	// Reported is the value reported by the provider.
+	Reported common.Hash
+	// Computed is the recomputed value.
+	Computed common.Hash
+}
+
+func (m BlockMismatch) String() string {
+	return fmt.Sprintf("%s: reported %s, computed %s", m.Field, m.Reported.Hex(), m.Computed.Hex())
+}
+
+// VerifyBlockReturnType is the return type for the VerifyBlock action.
+type VerifyBlockReturnType struct {
+	// Block is the block as returned by the provider.
+	Block *types.Block
+
+	// Hash is the block hash recomputed from the header fields.
+	Hash common.Hash
+
+	// TransactionsRoot is the transactions root recomputed from the raw
+	// transactions. Nil when SkipTransactions is set.
+	TransactionsRoot *common.Hash
+
+	// ReceiptsRoot is the receipts root recomputed from the block receipts.
+	// Nil when SkipReceipts is set.
+	ReceiptsRoot *common.Hash
+
+	// Mismatches lists every reported value that differs from its
+	// recomputed value. Empty when the block is consistent.
+	Mismatches []BlockMismatch
+}
+
+// Valid reports whether the block passed every check.
+func (r *VerifyBlockReturnType) Valid() bool {
+	return len(r.Mismatches) == 0
+}
+
+// VerifyBlock fetches a block and cross-checks it against its own
+// commitments: the block hash is recomputed from the header fields, the
+// transactions root from the raw transactions and the receipts root from
+// the block receipts. Mismatches are reported in the result rather than as
+// an error, so callers can decide how to act on inconsistent provider data;
+// errors are only returned for failed requests or undecodable data.
+//
+// Receipts of pre-Byzantium blocks, which carry a state root instead of a
+// status, cannot be verified; use SkipReceipts for such blocks.
+//
+// JSON-RPC Methods:
+//   - eth_getBlockByHash or eth_getBlockByNumber
+//   - eth_getRawTransactionByBlockHashAndIndex (per transaction)
+//   - eth_getBlockReceipts
+//
+// Example:
+//
+//	result, err := public.VerifyBlock(ctx, client, public.VerifyBlockParameters{
+//	    BlockTag: public.BlockTagFinalized,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	if !result.Valid() {
+//	    log.Printf("inconsistent block: %v", result.Mismatches)
+//	}
+func VerifyBlock(ctx context.Context, client Client, params VerifyBlockParameters) (*VerifyBlockReturnType, error) {
+	block, err := GetBlock(ctx, client, GetBlockParameters{
+		BlockHash:   params.BlockHash,
+		BlockNumber: params.BlockNumber,
+		BlockTag:    params.BlockTag,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := utilblock.HashHeader(block)
+	if err != nil {
+		return nil, err
+	}
+	result := &VerifyBlockReturnType{Block: block, Hash: hash}
+	result.check("hash", block.Hash, hash)
+	if params.BlockHash != nil && *params.BlockHash != block.Hash {
+		result.check("requestedHash", *params.BlockHash, hash)
+	}
+
+	if len(block.Uncles) == 0 {
+		result.check("sha3Uncles", block.Sha3Uncles, utilblock.EmptyUncleHash)
+	}
+
+	if !params.SkipTransactions {
+		raw, err := getRawBlockTransactions(ctx, client, block, params.MaxConcurrency)
+		if err != nil {
+			return nil, err
+		}
+		for i, tx := range raw {
+			txHash, err := utilblock.TransactionHash(tx)
+			if err != nil {
+				return nil, fmt.Errorf("verify block: transaction %d: %w", i, err)
+			}
+			result.check(fmt.Sprintf("transactions[%d]", i), block.Transactions[i], txHash)
+		}
+		root, err := utilblock.TransactionsRoot(raw)
+		if err != nil {
+			return nil, fmt.Errorf("verify block: %w", err)
+		}
+		result.TransactionsRoot = &root
+		result.check("transactionsRoot", block.TransactionsRoot, root)
+	}
+
+	if !params.SkipReceipts {
+		resp, err := client.Request(ctx, "eth_getBlockReceipts", block.Hash.Hex())
+		if err != nil {
+			return nil, fmt.Errorf("eth_getBlockReceipts failed: %w", err)
+		}
+		var receipts []types.Receipt
+		if err := json.Unmarshal(resp.Result, &receipts); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal receipts: %w", err)
+		}
+		result.check("receiptsCount", countHash(len(block.Transactions)), countHash(len(receipts)))
+		root, err := utilblock.ReceiptsRoot(receipts)
+		if err != nil {
+			return nil, fmt.Errorf("verify block: %w", err)
+		}
+		result.ReceiptsRoot = &root
+		result.check("receiptsRoot", block.ReceiptsRoot, root)
+	}
+
+	return result, nil
+}
+
+// check records a mismatch when reported differs from computed.
+func (r *VerifyBlockReturnType) check(field string, reported, computed common.Hash) {
+	if reported != computed {
+		r.Mismatches = append(r.Mismatches, BlockMismatch{Field: field, Reported: reported, Computed: computed})
+	}
+}
+
+// countHash encodes n as a big-endian hash for count mismatches.
+func countHash(n int) common.Hash {
+	return common.BigToHash(big.NewInt(int64(n)))
+}
+
+// getRawBlockTransactions fetches the serialized transactions of a block,
+// in block order.
+func getRawBlockTransactions(ctx context.Context, client Client, block *types.Block, maxConcurrency int) ([]string, error) {
+	n := len(block.Transactions)
+	raw := make([]string, n)
+	if n == 0 {
+		return raw, nil
+	}
+	if maxConcurrency <= 0 {
+		maxConcurrency = 16
+	}
+	if maxConcurrency > n {
+		maxConcurrency = n
+	}
+
+	jobs := make(chan int, n)
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	wg.Add(maxConcurrency)
+	for w := 0; w < maxConcurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				resp, err := client.Request(ctx, "eth_getRawTransactionByBlockHashAndIndex",
+					block.Hash.Hex(), hexutil.EncodeUint64(uint64(i)))
+				if err != nil {
+					errs[i] = fmt.Errorf("eth_getRawTransactionByBlockHashAndIndex failed: %w", err)
+					continue
+				}
+				if err := json.Unmarshal(resp.Result, &raw[i]); err != nil || raw[i] == "" {
+					errs[i] = fmt.Errorf("verify block: missing raw transaction %d", i)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return raw, nil
+}
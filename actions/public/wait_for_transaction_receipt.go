@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/ChefBingbong/viem-go/tracing"
 	"github.com/ChefBingbong/viem-go/types"
 )
 
@@ -105,7 +106,12 @@ func (e *WaitForTransactionReceiptTimeoutError) Error() string {
 //	    log.Fatal(err)
 //	}
 //	fmt.Printf("Transaction mined in block %d\n", receipt.BlockNumber)
-func WaitForTransactionReceipt(ctx context.Context, client Client, params WaitForTransactionReceiptParameters) (WaitForTransactionReceiptReturnType, error) {
+func WaitForTransactionReceipt(ctx context.Context, client Client, params WaitForTransactionReceiptParameters) (_ WaitForTransactionReceiptReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.waitForTransactionReceipt", client.Chain().GetID(),
+		tracing.String(tracing.AttrTxHash, params.Hash.Hex()),
+		tracing.Int64(tracing.AttrConfirmations, int64(params.Confirmations)))
+	defer func() { tracing.End(span, err) }()
+
 	// Set defaults
 	checkReplacement := true
 	if params.CheckReplacement != nil {
@@ -128,6 +128,8 @@ func pollBlockNumber(
 	eventCh := blockNumberObserver.Subscribe(observerID, func() (<-chan WatchBlockNumberEvent, func()) {
 		sourceCh := make(chan WatchBlockNumberEvent, 10)
 
+		logger := clientLogger(client)
+
 		// Start polling
 		pollResults := poll.Poll(ctx, func(ctx context.Context) (uint64, error) {
 			// Get block number with no caching
@@ -146,6 +148,7 @@ func pollBlockNumber(
 
 			for result := range pollResults {
 				if result.Error != nil {
+					logger.Warn("poll failed", "action", "watchBlockNumber", "error", result.Error)
 					select {
 					case sourceCh <- WatchBlockNumberEvent{Error: result.Error}:
 					case <-ctx.Done():
@@ -152,6 +152,8 @@ func pollBlocks(
 	eventCh := blocksObserver.Subscribe(observerID, func() (<-chan WatchBlocksEvent, func()) {
 		sourceCh := make(chan WatchBlocksEvent, 10)
 
+		logger := clientLogger(client)
+
 		// Start polling
 		pollResults := poll.Poll(ctx, func(ctx context.Context) (*types.Block, error) {
 			return GetBlock(ctx, client, GetBlockParameters{
@@ -169,6 +171,7 @@ func pollBlocks(
 
 			for result := range pollResults {
 				if result.Error != nil {
+					logger.Warn("poll failed", "action", "watchBlocks", "error", result.Error)
 					select {
 					case sourceCh <- WatchBlocksEvent{Error: result.Error}:
 					case <-ctx.Done():
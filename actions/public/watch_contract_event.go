@@ -202,6 +202,8 @@ func pollContractEvent(
 			previousBlockNumber = *params.FromBlock - 1
 		}
 
+		logger := clientLogger(client)
+
 		// Start polling
 		pollResults := poll.Poll(ctx, func(ctx context.Context) ([]formatters.Log, error) {
 			// First iteration: create filter
@@ -270,6 +272,7 @@ func pollContractEvent(
 
 			for result := range pollResults {
 				if result.Error != nil {
+					logger.Warn("poll failed", "action", "watchContractEvent", "error", result.Error)
 					if filterID != "" && isInvalidInputError(result.Error) {
 						initialized = false
 						filterID = ""
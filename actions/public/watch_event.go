@@ -191,6 +191,8 @@ func pollEvent(
 			previousBlockNumber = *params.FromBlock - 1
 		}
 
+		logger := clientLogger(client)
+
 		// Start polling
 		pollResults := poll.Poll(ctx, func(ctx context.Context) ([]formatters.Log, error) {
 			// First iteration: create filter
@@ -263,6 +265,7 @@ func pollEvent(
 
 			for result := range pollResults {
 				if result.Error != nil {
+					logger.Warn("poll failed", "action", "watchEvent", "error", result.Error)
 					// Check if filter was invalidated
 					if filterID != "" && isInvalidInputError(result.Error) {
 						// Reinitialize filter
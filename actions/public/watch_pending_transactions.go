@@ -133,6 +133,8 @@ func pollPendingTransactions(
 		// Create filter
 		var filterID FilterID
 
+		logger := clientLogger(client)
+
 		// Start polling
 		pollResults := poll.Poll(ctx, func(ctx context.Context) ([]common.Hash, error) {
 			// Create filter on first poll
@@ -164,6 +166,7 @@ func pollPendingTransactions(
 
 			for result := range pollResults {
 				if result.Error != nil {
+					logger.Warn("poll failed", "action", "watchPendingTransactions", "error", result.Error)
 					select {
 					case sourceCh <- WatchPendingTransactionsEvent{Error: result.Error}:
 					case <-ctx.Done():
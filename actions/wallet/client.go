@@ -97,6 +97,16 @@ type TransactionSignableAccount interface {
 	SignTransaction(tx *utiltx.Transaction) (string, error)
 }
 
+// SerializerSignableAccount represents an account that can sign transactions locally
+// with a custom serializer. It is required to sign for chains with a transaction serializer.
+// This mirrors viem's account.signTransaction(transaction, { serializer }).
+type SerializerSignableAccount interface {
+	Account
+	// SignTransactionWithSerializer signs a transaction, serializing it with serializer,
+	// and returns the serialized signed transaction hex.
+	SignTransactionWithSerializer(tx *utiltx.Transaction, serializer func(tx *utiltx.Transaction, sig *utiltx.Signature) (string, error)) (string, error)
+}
+
 // AuthorizationSignableAccount represents an account that can sign EIP-7702 authorizations locally.
 // This mirrors viem's account.signAuthorization capability.
 type AuthorizationSignableAccount interface {
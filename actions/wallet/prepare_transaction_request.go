@@ -56,6 +56,11 @@ type PrepareTransactionRequestParameters struct {
 	To                   string                            `json:"to,omitempty"`
 	Type                 formatters.TransactionType        `json:"type,omitempty"`
 	Value                *big.Int                          `json:"value,omitempty"`
+
+	// ChainFields holds chain-specific request fields. It is passed to the
+	// chain's TransactionRequest formatter when estimating gas and kept on
+	// the prepared request for the chain's serializer.
+	ChainFields any `json:"-"`
 }
 
 // PrepareTransactionRequestReturnType is the return type for PrepareTransactionRequest.
@@ -170,7 +175,8 @@ func PrepareTransactionRequest(ctx context.Context, client Client, params Prepar
 			// EIP-1559 fees
 			if params.MaxFeePerGas == nil || params.MaxPriorityFeePerGas == nil {
 				fees, feeErr := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{
-					Type: public.FeeValuesTypeEIP1559,
+					Type:  public.FeeValuesTypeEIP1559,
+					Chain: ch,
 				})
 				if feeErr != nil {
 					return nil, fmt.Errorf("failed to estimate fees: %w", feeErr)
@@ -200,7 +206,8 @@ func PrepareTransactionRequest(ctx context.Context, client Client, params Prepar
 
 			if params.GasPrice == nil {
 				fees, feeErr := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{
-					Type: public.FeeValuesTypeLegacy,
+					Type:  public.FeeValuesTypeLegacy,
+					Chain: ch,
 				})
 				if feeErr != nil {
 					return nil, fmt.Errorf("failed to estimate gas price: %w", feeErr)
@@ -220,6 +227,8 @@ func PrepareTransactionRequest(ctx context.Context, client Client, params Prepar
 			MaxFeePerGas:         params.MaxFeePerGas,
 			MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
 			MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
+			Chain:                ch,
+			ChainFields:          params.ChainFields,
 		}
 		if account != nil {
 			addr := common.HexToAddress(account.Address().Hex())
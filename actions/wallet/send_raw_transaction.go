@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/tracing"
 )
 
 // SendRawTransactionParameters contains the parameters for the SendRawTransaction action.
@@ -29,13 +31,20 @@ type SendRawTransactionReturnType = string
 //	hash, err := wallet.SendRawTransaction(ctx, client, wallet.SendRawTransactionParameters{
 //	    SerializedTransaction: "0x02f850018203118080825208808080c080a04012522854168b27e5dc3d5839bab5e6b39e1a0ffd343901ce1622e3d64b48f1a04e00902ae0502c4728cbf12156290df99c3ed7de85b1dbfe20b5c36931733a33",
 //	})
-func SendRawTransaction(ctx context.Context, client Client, params SendRawTransactionParameters) (SendRawTransactionReturnType, error) {
+func SendRawTransaction(ctx context.Context, client Client, params SendRawTransactionParameters) (hash SendRawTransactionReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.sendRawTransaction", client.Chain().GetID())
+	defer func() {
+		if err == nil {
+			span.SetAttributes(tracing.String(tracing.AttrTxHash, hash))
+		}
+		tracing.End(span, err)
+	}()
+
 	resp, err := client.Request(ctx, "eth_sendRawTransaction", params.SerializedTransaction)
 	if err != nil {
 		return "", fmt.Errorf("eth_sendRawTransaction failed: %w", err)
 	}
 
-	var hash string
 	if unmarshalErr := json.Unmarshal(resp.Result, &hash); unmarshalErr != nil {
 		return "", fmt.Errorf("failed to unmarshal transaction hash: %w", unmarshalErr)
 	}
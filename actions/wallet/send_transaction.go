@@ -10,6 +10,7 @@ import (
 
 	"github.com/ChefBingbong/viem-go/actions/public"
 	viemchain "github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/tracing"
 	"github.com/ChefBingbong/viem-go/utils"
 	"github.com/ChefBingbong/viem-go/utils/authorization"
 	"github.com/ChefBingbong/viem-go/utils/data"
@@ -55,6 +56,10 @@ type SendTransactionParameters struct {
 	To                   string                            `json:"to,omitempty"`
 	Type                 formatters.TransactionType        `json:"type,omitempty"`
 	Value                *big.Int                          `json:"value,omitempty"`
+
+	// ChainFields holds chain-specific transaction fields, passed to the
+	// chain's TransactionRequest formatter and transaction serializer.
+	ChainFields any `json:"-"`
 }
 
 // SendTransactionReturnType is the return type for the SendTransaction action.
@@ -87,7 +92,15 @@ type SendTransactionReturnType = string
 //	    To:      "0x70997970c51812dc3a010c7d01b50e0d17dc79c8",
 //	    Value:   big.NewInt(1000000000000000000),
 //	})
-func SendTransaction(ctx context.Context, client Client, params SendTransactionParameters) (SendTransactionReturnType, error) {
+func SendTransaction(ctx context.Context, client Client, params SendTransactionParameters) (hash SendTransactionReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.sendTransaction", client.Chain().GetID())
+	defer func() {
+		if err == nil {
+			span.SetAttributes(tracing.String(tracing.AttrTxHash, hash))
+		}
+		tracing.End(span, err)
+	}()
+
 	// Resolve account: param > client
 	account := params.Account
 	if account == nil {
@@ -234,11 +247,15 @@ func sendTransactionViaRPC(
 	if chainID != nil {
 		rpcReq.ChainID = encoding.NumberToHex(new(big.Int).SetUint64(*chainID))
 	}
+	formatted, err := public.FormatChainTransactionRequest(ch, rpcReq, params.ChainFields)
+	if err != nil {
+		return "", err
+	}
 
 	// Send with wallet_sendTransaction namespace fallback.
 	// Mirrors viem's: try eth_sendTransaction first, on certain RPC errors
 	// retry with wallet_sendTransaction, cache the result per client.UID().
-	return sendWithNamespaceFallback(ctx, client, rpcReq)
+	return sendWithNamespaceFallback(ctx, client, formatted)
 }
 
 // sendTransactionViaLocalSign handles the local account path: prepare + sign + sendRawTransaction.
@@ -278,6 +295,7 @@ func sendTransactionViaLocalSign(
 		To:                   to,
 		Type:                 params.Type,
 		Value:                params.Value,
+		ChainFields:          params.ChainFields,
 	}
 
 	prepared, err := PrepareTransactionRequest(ctx, client, prepareParams)
@@ -290,7 +308,7 @@ func sendTransactionViaLocalSign(
 
 	// Sign the transaction locally
 	// This mirrors viem's: account.signTransaction(request, { serializer })
-	serializedTx, signErr := signable.SignTransaction(tx)
+	serializedTx, signErr := signTransactionWithChain(signable, ch, tx)
 	if signErr != nil {
 		return "", fmt.Errorf("failed to sign transaction: %w", signErr)
 	}
@@ -426,6 +444,7 @@ func preparedParamsToTransaction(params *PrepareTransactionRequestParameters) *t
 		MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
 		BlobVersionedHashes:  params.BlobVersionedHashes,
 		Blobs:                params.Blobs,
+		ChainFields:          params.ChainFields,
 	}
 
 	if params.ChainID != nil {
@@ -61,6 +61,10 @@ type SendTransactionSyncParameters struct {
 	To                   string                            `json:"to,omitempty"`
 	Type                 formatters.TransactionType        `json:"type,omitempty"`
 	Value                *big.Int                          `json:"value,omitempty"`
+
+	// ChainFields holds chain-specific transaction fields, passed to the
+	// chain's TransactionRequest formatter and transaction serializer.
+	ChainFields any `json:"-"`
 }
 
 // SendTransactionSyncReturnType is the return type for SendTransactionSync.
@@ -240,10 +244,14 @@ func sendTransactionSyncViaRPC(
 	if chainID != nil {
 		rpcReq.ChainID = encoding.NumberToHex(new(big.Int).SetUint64(*chainID))
 	}
+	formatted, err := public.FormatChainTransactionRequest(ch, rpcReq, params.ChainFields)
+	if err != nil {
+		return nil, err
+	}
 
 	// Send with wallet_sendTransaction namespace fallback.
 	// Uses the same LRU cache as sendTransaction (shared supportsWalletNamespace).
-	hash, err := sendWithNamespaceFallback(ctx, client, rpcReq)
+	hash, err := sendWithNamespaceFallback(ctx, client, formatted)
 	if err != nil {
 		return nil, err
 	}
@@ -318,6 +326,7 @@ func sendTransactionSyncViaLocalSign(
 		To:                   to,
 		Type:                 params.Type,
 		Value:                params.Value,
+		ChainFields:          params.ChainFields,
 	}
 
 	prepared, err := PrepareTransactionRequest(ctx, client, prepareParams)
@@ -327,7 +336,7 @@ func sendTransactionSyncViaLocalSign(
 
 	// Convert and sign
 	tx := preparedParamsToTransaction(prepared)
-	serializedTx, signErr := signable.SignTransaction(tx)
+	serializedTx, signErr := signTransactionWithChain(signable, ch, tx)
 	if signErr != nil {
 		return nil, fmt.Errorf("failed to sign transaction: %w", signErr)
 	}
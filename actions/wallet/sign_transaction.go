@@ -2,11 +2,13 @@ package wallet
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	json "github.com/goccy/go-json"
 
+	"github.com/ChefBingbong/viem-go/accounts"
 	"github.com/ChefBingbong/viem-go/actions/public"
 	viemchain "github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/utils/encoding"
@@ -43,6 +45,10 @@ type SignTransactionParameters struct {
 	To                   string                            `json:"to,omitempty"`
 	Type                 formatters.TransactionType        `json:"type,omitempty"`
 	Value                *big.Int                          `json:"value,omitempty"`
+
+	// ChainFields holds chain-specific transaction fields, passed to the
+	// chain's TransactionRequest formatter and transaction serializer.
+	ChainFields any `json:"-"`
 }
 
 // SignTransactionReturnType is the return type for the SignTransaction action (hex string).
@@ -111,7 +117,7 @@ func SignTransaction(ctx context.Context, client Client, params SignTransactionP
 	// If the account can sign transactions locally, use it directly
 	if signable, ok := account.(TransactionSignableAccount); ok {
 		tx := paramsToTransaction(params, int(chainID))
-		return signable.SignTransaction(tx)
+		return signTransactionWithChain(signable, resolvedChain, tx)
 	}
 
 	// Otherwise, format the transaction request and send via eth_signTransaction RPC
@@ -130,8 +136,12 @@ func SignTransaction(ctx context.Context, client Client, params SignTransactionP
 		ChainID:               encoding.NumberToHex(new(big.Int).SetUint64(chainID)),
 		From:                  account.Address().Hex(),
 	}
+	formatted, err := public.FormatChainTransactionRequest(resolvedChain, rpcReq, params.ChainFields)
+	if err != nil {
+		return "", err
+	}
 
-	resp, err := client.Request(ctx, "eth_signTransaction", rpcReq)
+	resp, err := client.Request(ctx, "eth_signTransaction", formatted)
 	if err != nil {
 		return "", fmt.Errorf("eth_signTransaction failed: %w", err)
 	}
@@ -163,6 +173,7 @@ func PreparedToSignParams(p *PrepareTransactionRequestParameters) SignTransactio
 		Blobs:                p.Blobs,
 		Nonce:                p.Nonce,
 		Type:                 p.Type,
+		ChainFields:          p.ChainFields,
 	}
 
 	if len(p.AccessList) > 0 {
@@ -183,6 +194,30 @@ func PreparedToSignParams(p *PrepareTransactionRequestParameters) SignTransactio
 	return params
 }
 
+// signTransactionWithChain signs a transaction locally, serializing it with the
+// chain's transaction serializer when the chain has one.
+// This mirrors viem's account.signTransaction(transaction, { serializer: chain?.serializers?.transaction }).
+//
+// Accounts without serializer support still sign transactions that carry no
+// ChainFields: chain serializers encode those like transaction.SerializeTransaction.
+func signTransactionWithChain(signable TransactionSignableAccount, ch *viemchain.Chain, tx *transaction.Transaction) (string, error) {
+	if ch == nil || ch.Serializers == nil || ch.Serializers.Transaction == nil {
+		return signable.SignTransaction(tx)
+	}
+	if withSerializer, ok := signable.(SerializerSignableAccount); ok {
+		signed, err := withSerializer.SignTransactionWithSerializer(tx, ch.Serializers.Transaction)
+		if !errors.Is(err, accounts.ErrSigningNotSupported) {
+			return signed, err
+		}
+	}
+	if tx.ChainFields == nil {
+		return signable.SignTransaction(tx)
+	}
+	return "", &AccountTypeNotSupportedError{
+		MetaMessages: []string{fmt.Sprintf("Chain %q has a custom transaction serializer, which the account does not support.", ch.Name)},
+	}
+}
+
 // paramsToTransaction converts SignTransactionParameters to a transaction.Transaction for local signing.
 func paramsToTransaction(params SignTransactionParameters, chainID int) *transaction.Transaction {
 	tx := &transaction.Transaction{
@@ -198,6 +233,7 @@ func paramsToTransaction(params SignTransactionParameters, chainID int) *transac
 		BlobVersionedHashes:  params.BlobVersionedHashes,
 		Blobs:                params.Blobs,
 		AuthorizationList:    params.AuthorizationList,
+		ChainFields:          params.ChainFields,
 	}
 
 	if params.Nonce != nil {
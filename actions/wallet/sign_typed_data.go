@@ -27,6 +27,11 @@ type SignTypedDataParameters struct {
 
 	// Message is the structured message to sign.
 	Message map[string]any
+
+	// Data is an optional Go struct annotated with `eip712:"name,type"` tags.
+	// When set, Types, PrimaryType and Message are derived from it
+	// (see signature.TypedDataFromStruct) and must be left empty.
+	Data any
 }
 
 // SignTypedDataReturnType is the return type for the SignTypedData action (hex string).
@@ -69,6 +74,13 @@ type SignTypedDataReturnType = string
 //	        "contents": "Hello, Bob!",
 //	    },
 //	})
+//
+// Or from a Go struct with eip712 tags:
+//
+//	sig, err := wallet.SignTypedData(ctx, client, wallet.SignTypedDataParameters{
+//	    Domain: domain,
+//	    Data:   Mail{From: alice, To: bob, Contents: "Hello, Bob!"},
+//	})
 func SignTypedData(ctx context.Context, client Client, params SignTypedDataParameters) (SignTypedDataReturnType, error) {
 	// Resolve account: param > client
 	account := params.Account
@@ -79,6 +91,20 @@ func SignTypedData(ctx context.Context, client Client, params SignTypedDataParam
 		return "", &AccountNotFoundError{DocsPath: "/docs/actions/wallet/signTypedData"}
 	}
 
+	// Derive types and message from a typed Go value
+	if params.Data != nil {
+		if params.Types != nil || params.PrimaryType != "" || params.Message != nil {
+			return "", fmt.Errorf("typed data: Data cannot be combined with Types, PrimaryType or Message")
+		}
+		derived, err := signature.TypedDataFromStruct(params.Domain, params.Data)
+		if err != nil {
+			return "", fmt.Errorf("typed data: %w", err)
+		}
+		params.Types = derived.Types
+		params.PrimaryType = derived.PrimaryType
+		params.Message = derived.Message
+	}
+
 	// Build the complete types map with EIP712Domain (mirrors viem's getTypesForEIP712Domain)
 	types := make(map[string][]signature.TypedDataField)
 	for k, v := range params.Types {
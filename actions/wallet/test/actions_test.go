@@ -14,8 +14,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ChefBingbong/viem-go/accounts"
 	"github.com/ChefBingbong/viem-go/actions/wallet"
 	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
 	"github.com/ChefBingbong/viem-go/client/transport"
 	"github.com/ChefBingbong/viem-go/types"
 	"github.com/ChefBingbong/viem-go/utils/formatters"
@@ -79,6 +81,16 @@ func (a *mockTransactionSignableAccount) SignTransaction(tx *utiltx.Transaction)
 	return a.signFn(tx)
 }
 
+// mockSerializerSignableAccount implements wallet.SerializerSignableAccount.
+type mockSerializerSignableAccount struct {
+	mockTransactionSignableAccount
+	signWithSerializerFn func(tx *utiltx.Transaction, serializer func(*utiltx.Transaction, *utiltx.Signature) (string, error)) (string, error)
+}
+
+func (a *mockSerializerSignableAccount) SignTransactionWithSerializer(tx *utiltx.Transaction, serializer func(tx *utiltx.Transaction, sig *utiltx.Signature) (string, error)) (string, error) {
+	return a.signWithSerializerFn(tx, serializer)
+}
+
 // mockAuthorizationSignableAccount implements wallet.AuthorizationSignableAccount.
 type mockAuthorizationSignableAccount struct {
 	address common.Address
@@ -512,6 +524,151 @@ func TestSendTransaction_LocalAccount(t *testing.T) {
 	assert.Equal(t, "eth_sendRawTransaction", capturedMethod)
 }
 
+func TestSendTransaction_LocalAccountHonorsChainFees(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getTransactionCount":
+			return "0x0"
+		case "eth_getBlockByNumber":
+			return map[string]any{"number": "0x10", "baseFeePerGas": "0x3b9aca00"}
+		case "eth_maxPriorityFeePerGas":
+			return "0x3b9aca00" // 1 gwei
+		case "eth_estimateGas":
+			return "0x5208"
+		case "eth_sendRawTransaction":
+			return "0xlocalhash123456789012345678901234567890123456789012345678901234"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	multiplier := 2.0
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	client.chain.Fees = &chain.ChainFees{
+		BaseFeeMultiplier: &multiplier,
+		MinPriorityFee:    big.NewInt(30_000_000_000),
+	}
+
+	var signed *utiltx.Transaction
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			signed = tx
+			return "0x02f850018203118080825208808080c080a04012522854168b27e5dc3d5839bab5e6b39e1a0ffd343901ce1622e3d64b48f1a04e00902ae0502c4728cbf12156290df99c3ed7de85b1dbfe20b5c36931733a33", nil
+		},
+	}
+
+	_, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account: localAccount,
+		To:      targetAddr.Hex(),
+		Value:   big.NewInt(1),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, signed)
+	assert.Equal(t, big.NewInt(30_000_000_000), signed.MaxPriorityFeePerGas)
+	assert.Equal(t, big.NewInt(32_000_000_000), signed.MaxFeePerGas)
+}
+
+func TestSendTransaction_LocalAccountUsesChainFormattersAndSerializer(t *testing.T) {
+	var estimateRequest map[string]any
+	var rawTx string
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getTransactionCount":
+			return "0x0"
+		case "eth_getBlockByNumber":
+			return map[string]any{"number": "0x10", "baseFeePerGas": "0x3b9aca00"}
+		case "eth_maxPriorityFeePerGas":
+			return "0x3b9aca00"
+		case "eth_estimateGas":
+			estimateRequest = params[0].(map[string]any)
+			return "0x5208"
+		case "eth_sendRawTransaction":
+			rawTx = params[0].(string)
+			return "0xlocalhash123456789012345678901234567890123456789012345678901234"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	client.chain.Formatters = &chain.ChainFormatters{
+		TransactionRequest: func(request map[string]any, fields any) error {
+			request["feeToken"] = fields
+			return nil
+		},
+	}
+	chainSerializer := func(tx *utiltx.Transaction, sig *utiltx.Signature) (string, error) {
+		return "0x71" + tx.ChainFields.(string)[2:], nil
+	}
+	client.chain.Serializers = &chain.ChainSerializers{Transaction: chainSerializer}
+
+	localAccount := &mockSerializerSignableAccount{
+		mockTransactionSignableAccount: mockTransactionSignableAccount{
+			address: sourceAddr,
+			signFn: func(tx *utiltx.Transaction) (string, error) {
+				t.Fatal("expected the chain serializer to be used")
+				return "", nil
+			},
+		},
+		signWithSerializerFn: func(tx *utiltx.Transaction, serializer func(*utiltx.Transaction, *utiltx.Signature) (string, error)) (string, error) {
+			assert.Equal(t, 1, tx.ChainId)
+			assert.Equal(t, big.NewInt(21000), tx.Gas)
+			return serializer(tx, nil)
+		},
+	}
+
+	_, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account:     localAccount,
+		To:          targetAddr.Hex(),
+		Value:       big.NewInt(1),
+		ChainFields: "0xabcdef",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0xabcdef", estimateRequest["feeToken"])
+	assert.Equal(t, "0x71abcdef", rawTx)
+}
+
+func TestSendTransaction_JSONRPCUsesChainFormatter(t *testing.T) {
+	var sent map[string]any
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			sent = params[0].(map[string]any)
+			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	client.chain.Formatters = &chain.ChainFormatters{
+		TransactionRequest: func(request map[string]any, fields any) error {
+			request["type"] = "0x71"
+			return nil
+		},
+	}
+
+	_, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account: &mockAccount{address: sourceAddr},
+		To:      targetAddr.Hex(),
+		Value:   big.NewInt(1),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0x71", sent["type"])
+	assert.Equal(t, "0x1", sent["chainId"])
+	assert.Equal(t, sourceAddr.Hex(), sent["from"])
+}
+
 func TestSendTransaction_DataSuffix(t *testing.T) {
 	var capturedParams []any
 	server := createTestServer(t, func(method string, params []any) any {
@@ -869,6 +1026,89 @@ func TestSignTransaction_WithData(t *testing.T) {
 	assert.Equal(t, expectedSig, sig)
 }
 
+func TestSignTransaction_ChainSerializerRequiresSupport(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_chainId" {
+			return "0x1"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	client.chain.Serializers = &chain.ChainSerializers{
+		Transaction: func(tx *utiltx.Transaction, sig *utiltx.Signature) (string, error) {
+			return "0x71", nil
+		},
+	}
+
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			return "0x02", nil
+		},
+	}
+
+	// Without ChainFields the chain serializer is not needed.
+	signed, err := wallet.SignTransaction(context.Background(), client, wallet.SignTransactionParameters{
+		Account: localAccount,
+		To:      targetAddr.Hex(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0x02", signed)
+
+	_, err = wallet.SignTransaction(context.Background(), client, wallet.SignTransactionParameters{
+		Account:     localAccount,
+		To:          targetAddr.Hex(),
+		ChainFields: "0xabcdef",
+	})
+	var notSupported *wallet.AccountTypeNotSupportedError
+	require.ErrorAs(t, err, &notSupported)
+
+	signed, err = wallet.SignTransaction(context.Background(), client, wallet.SignTransactionParameters{
+		Account: &mockSerializerSignableAccount{
+			mockTransactionSignableAccount: *localAccount,
+			signWithSerializerFn: func(tx *utiltx.Transaction, serializer func(*utiltx.Transaction, *utiltx.Signature) (string, error)) (string, error) {
+				return serializer(tx, nil)
+			},
+		},
+		To: targetAddr.Hex(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0x71", signed)
+}
+
+func TestSignTransaction_ToAccountOnOptimism(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_chainId" {
+			return "0xa"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = &definitions.Optimism
+
+	// ToAccount without SignTransactionWithSerializer has no serializer support.
+	account, err := accounts.ToAccount(accounts.CustomSource{
+		Address: sourceAddr.Hex(),
+		SignTransaction: func(tx *utiltx.Transaction) (string, error) {
+			assert.Equal(t, 10, tx.ChainId)
+			return "0x02f8", nil
+		},
+	})
+	require.NoError(t, err)
+
+	signed, err := wallet.SignTransaction(context.Background(), client, wallet.SignTransactionParameters{
+		Account: account,
+		To:      targetAddr.Hex(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0x02f8", signed)
+}
+
 func TestSignTransaction_ChainMismatch(t *testing.T) {
 	server := createTestServer(t, func(method string, params []any) any {
 		if method == "eth_chainId" {
@@ -2106,3 +2346,63 @@ func TestTransactionReceiptRevertedError_Message(t *testing.T) {
 	assert.Contains(t, err.Error(), "transaction reverted")
 	assert.Contains(t, err.Error(), "0xdeadbeef")
 }
+
+func TestSignTypedData_FromStruct(t *testing.T) {
+	type Person struct {
+		Name   string         `eip712:"name"`
+		Wallet common.Address `eip712:"wallet"`
+	}
+	type Mail struct {
+		From     Person `eip712:"from"`
+		To       Person `eip712:"to"`
+		Contents string `eip712:"contents"`
+	}
+
+	localAccount := &mockTypedDataSignableAccount{
+		address: sourceAddr,
+		signFn: func(data signature.TypedDataDefinition) (string, error) {
+			assert.Equal(t, "Mail", data.PrimaryType)
+			assert.Equal(t, []signature.TypedDataField{
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			}, data.Types["Person"])
+			assert.Contains(t, data.Types, "EIP712Domain")
+			return signature.HashTypedData(data)
+		},
+	}
+
+	server := createTestServer(t, func(method string, params []any) any {
+		t.Fatal("RPC should not be called for local typed data signing")
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	domain := signature.TypedDataDomain{
+		Name:              "Ether Mail",
+		Version:           "1",
+		ChainId:           big.NewInt(1),
+		VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+	}
+
+	hash, err := wallet.SignTypedData(context.Background(), client, wallet.SignTypedDataParameters{
+		Account: localAccount,
+		Domain:  domain,
+		Data: Mail{
+			From:     Person{Name: "Cow", Wallet: common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")},
+			To:       Person{Name: "Bob", Wallet: common.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB")},
+			Contents: "Hello, Bob!",
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hash)
+
+	_, err = wallet.SignTypedData(context.Background(), client, wallet.SignTypedDataParameters{
+		Account:     localAccount,
+		Domain:      domain,
+		PrimaryType: "Mail",
+		Data:        Mail{},
+	})
+	require.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 
 	viemabi "github.com/ChefBingbong/viem-go/abi"
 	viemchain "github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/tracing"
 	"github.com/ChefBingbong/viem-go/utils/formatters"
 	"github.com/ChefBingbong/viem-go/utils/transaction"
 )
@@ -94,7 +95,16 @@ type WriteContractReturnType = SendTransactionReturnType
 //	    FunctionName: "mint",
 //	    Args:         []any{uint32(69420)},
 //	})
-func WriteContract(ctx context.Context, client Client, params WriteContractParameters) (WriteContractReturnType, error) {
+func WriteContract(ctx context.Context, client Client, params WriteContractParameters) (hash WriteContractReturnType, err error) {
+	ctx, span := tracing.StartAction(ctx, "viem.writeContract", client.Chain().GetID(),
+		tracing.String(tracing.AttrFunctionName, params.FunctionName))
+	defer func() {
+		if err == nil {
+			span.SetAttributes(tracing.String(tracing.AttrTxHash, hash))
+		}
+		tracing.End(span, err)
+	}()
+
 	// Resolve account: param > client
 	account := params.Account
 	if account == nil {
@@ -0,0 +1,77 @@
+// Package chainconfig holds the chain formatters of Arbitrum chains. It
+// depends only on the chain package, so chain definitions can use it without
+// importing the arbitrum actions.
+package chainconfig
+
+import (
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// BlockFields are the Arbitrum fields of a block, set as the ChainFields of
+// public.GetBlock results by Formatters.
+type BlockFields struct {
+	// SendCount is the number of L2 -> L1 messages sent up to this block.
+	SendCount uint64
+	// SendRoot is the root of the L2 -> L1 message Merkle tree at this block.
+	SendRoot common.Hash
+	// L1BlockNumber is the L1 block number seen by this block.
+	L1BlockNumber uint64
+}
+
+// ReceiptFields are the Arbitrum fields of a transaction receipt, set as the
+// ChainFields of public.GetTransactionReceipt results by Formatters.
+type ReceiptFields struct {
+	// GasUsedForL1 is the part of GasUsed paying for L1 data.
+	GasUsedForL1 uint64
+	// L1BlockNumber is the L1 block number seen by the transaction.
+	L1BlockNumber uint64
+}
+
+// Formatters are the chain formatters of Arbitrum chains. They decode
+// BlockFields for blocks and ReceiptFields for receipts.
+var Formatters = &chain.ChainFormatters{
+	Block:              formatBlock,
+	TransactionReceipt: formatTransactionReceipt,
+}
+
+func formatBlock(raw json.RawMessage) (any, error) {
+	var dec struct {
+		SendCount     *hexutil.Uint64 `json:"sendCount"`
+		SendRoot      common.Hash     `json:"sendRoot"`
+		L1BlockNumber *hexutil.Uint64 `json:"l1BlockNumber"`
+	}
+	if err := json.Unmarshal(raw, &dec); err != nil {
+		return nil, err
+	}
+	fields := &BlockFields{SendRoot: dec.SendRoot}
+	if dec.SendCount != nil {
+		fields.SendCount = uint64(*dec.SendCount)
+	}
+	if dec.L1BlockNumber != nil {
+		fields.L1BlockNumber = uint64(*dec.L1BlockNumber)
+	}
+	return fields, nil
+}
+
+func formatTransactionReceipt(raw json.RawMessage) (any, error) {
+	var dec struct {
+		GasUsedForL1  *hexutil.Uint64 `json:"gasUsedForL1"`
+		L1BlockNumber *hexutil.Uint64 `json:"l1BlockNumber"`
+	}
+	if err := json.Unmarshal(raw, &dec); err != nil {
+		return nil, err
+	}
+	fields := &ReceiptFields{}
+	if dec.GasUsedForL1 != nil {
+		fields.GasUsedForL1 = uint64(*dec.GasUsedForL1)
+	}
+	if dec.L1BlockNumber != nil {
+		fields.L1BlockNumber = uint64(*dec.L1BlockNumber)
+	}
+	return fields, nil
+}
@@ -0,0 +1,146 @@
+// Package arbitrum adds support for Arbitrum chains (Arbitrum One and
+// Arbitrum Sepolia), built on the precompiles every Arbitrum node exposes
+// and on the rollup contracts deployed on L1:
+//
+//   - gas estimation split into its L1 and L2 portions through the
+//     NodeInterface (EstimateGasComponents, EstimateL1GasComponent)
+//   - ArbGasInfo price queries (GetPricesInWei, GetL1BaseFeeEstimate, ...)
+//   - L1 -> L2 retryable tickets (CreateRetryableTicket,
+//     GetRetryableTickets, GetRetryableStatus, RedeemRetryable)
+//   - L2 -> L1 messages (GetL2ToL1Messages, BuildOutboxProof,
+//     ExecuteL2ToL1Message)
+//   - chain formatters for the Arbitrum block and receipt fields
+//
+// Actions take the client of the network they run on. PublicActionsL2,
+// PublicActionsL1, WalletActionsL2 and WalletActionsL1 bind them to a client
+// for use with client.Extend, like the decorators package.
+//
+// Example:
+//
+//	components, err := arbitrum.EstimateGasComponents(ctx, arbClient, arbitrum.EstimateGasComponentsParameters{
+//		Account: &from,
+//		To:      &to,
+//		Data:    calldata,
+//	})
+//	fmt.Println(components.GasEstimateForL1, components.L2Gas())
+package arbitrum
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Precompiles available on every Arbitrum chain.
+var (
+	// ArbSysAddress is the ArbSys precompile, which sends L2 -> L1 messages.
+	ArbSysAddress = common.HexToAddress("0x0000000000000000000000000000000000000064")
+	// ArbGasInfoAddress is the ArbGasInfo precompile, which reports gas prices.
+	ArbGasInfoAddress = common.HexToAddress("0x000000000000000000000000000000000000006C")
+	// ArbRetryableTxAddress is the ArbRetryableTx precompile, which manages
+	// retryable tickets.
+	ArbRetryableTxAddress = common.HexToAddress("0x000000000000000000000000000000000000006E")
+	// NodeInterfaceAddress is the NodeInterface, a virtual contract served
+	// by nodes through eth_call only.
+	NodeInterfaceAddress = common.HexToAddress("0x00000000000000000000000000000000000000C8")
+)
+
+// ErrContractNotFound is returned when the L1 contracts of a chain are
+// unknown and no Contracts override is given.
+var ErrContractNotFound = errors.New("arbitrum: L1 contract address not found")
+
+// L1Contracts are the contracts of an Arbitrum chain deployed on its parent
+// chain.
+type L1Contracts struct {
+	// Inbox receives L1 -> L2 messages, including retryable tickets.
+	Inbox common.Address
+	// Outbox executes confirmed L2 -> L1 messages.
+	Outbox common.Address
+	// Bridge records the delivered messages.
+	Bridge common.Address
+	// Rollup confirms L2 state assertions.
+	Rollup common.Address
+}
+
+// l1Contracts holds the L1 contracts of known Arbitrum chains, by L2 chain ID.
+var l1Contracts = map[int64]L1Contracts{
+	// Arbitrum One
+	42_161: {
+		Inbox:  common.HexToAddress("0x4Dbd4fc535Ac27206064B68FfCf827b0A60BAB3f"),
+		Outbox: common.HexToAddress("0x0B9857ae2D4A3DBe74ffE1d7DF045bb7F96E4840"),
+		Bridge: common.HexToAddress("0x8315177aB297bA92A06054cE80a67Ed4DBd7ed3a"),
+		Rollup: common.HexToAddress("0x5eF0D09d1E6204141B4d37530808eD19f60FBa35"),
+	},
+	// Arbitrum Sepolia
+	421_614: {
+		Inbox:  common.HexToAddress("0xaAe29B0366299461418F5324a79Afc425BE5ae21"),
+		Outbox: common.HexToAddress("0x65f07C7D521164a4d5DaC6eB8Fac8DA067A3B78F"),
+		Bridge: common.HexToAddress("0x38f918D0E9F1b721EDaA41302E399fa1B79333a9"),
+		Rollup: common.HexToAddress("0xd80810638dbDF9081b72C1B33c65375e807281C8"),
+	},
+}
+
+// GetL1Contracts returns the L1 contracts of a known Arbitrum chain, by L2
+// chain ID.
+func GetL1Contracts(chainID int64) (L1Contracts, bool) {
+	c, ok := l1Contracts[chainID]
+	return c, ok
+}
+
+// resolveL1Contracts returns the override if set, or the known L1 contracts
+// of the target chain.
+func resolveL1Contracts(override *L1Contracts, targetChain *chain.Chain) (L1Contracts, error) {
+	if override != nil {
+		return *override, nil
+	}
+	if targetChain == nil {
+		return L1Contracts{}, fmt.Errorf("%w: TargetChain or Contracts is required", ErrContractNotFound)
+	}
+	c, ok := l1Contracts[targetChain.ID]
+	if !ok {
+		return L1Contracts{}, fmt.Errorf("%w: unknown chain %d", ErrContractNotFound, targetChain.ID)
+	}
+	return c, nil
+}
+
+var nodeInterfaceABI = abi.MustParseAbi([]string{
+	"function gasEstimateComponents(address to, bool contractCreation, bytes data) payable returns (uint64 gasEstimate, uint64 gasEstimateForL1, uint256 baseFee, uint256 l1BaseFeeEstimate)",
+	"function gasEstimateL1Component(address to, bool contractCreation, bytes data) payable returns (uint64 gasEstimateForL1, uint256 baseFee, uint256 l1BaseFeeEstimate)",
+	"function constructOutboxProof(uint64 size, uint64 leaf) view returns (bytes32 send, bytes32 root, bytes32[] proof)",
+})
+
+var arbGasInfoABI = abi.MustParseAbi([]string{
+	"function getPricesInWei() view returns (uint256 perL2Tx, uint256 perL1CalldataByte, uint256 perStorageAllocation, uint256 perArbGasBase, uint256 perArbGasCongestion, uint256 perArbGasTotal)",
+	"function getL1BaseFeeEstimate() view returns (uint256)",
+	"function getMinimumGasPrice() view returns (uint256)",
+	"function getGasAccountingParams() view returns (uint256 speedLimitPerSecond, uint256 gasPoolMax, uint256 maxTxGasLimit)",
+})
+
+var arbSysABI = abi.MustParseAbi([]string{
+	"event L2ToL1Tx(address caller, address indexed destination, uint256 indexed hash, uint256 indexed position, uint256 arbBlockNum, uint256 ethBlockNum, uint256 timestamp, uint256 callvalue, bytes data)",
+})
+
+var arbRetryableTxABI = abi.MustParseAbi([]string{
+	"function redeem(bytes32 ticketId) returns (bytes32)",
+	"function getTimeout(bytes32 ticketId) view returns (uint256)",
+	"event RedeemScheduled(bytes32 indexed ticketId, bytes32 indexed retryTxHash, uint64 indexed sequenceNum, uint64 donatedGas, address gasDonor, uint256 maxRefund, uint256 submissionFeeRefund)",
+})
+
+var inboxABI = abi.MustParseAbi([]string{
+	"function createRetryableTicket(address to, uint256 l2CallValue, uint256 maxSubmissionCost, address excessFeeRefundAddress, address callValueRefundAddress, uint256 gasLimit, uint256 maxFeePerGas, bytes data) payable returns (uint256)",
+	"function calculateRetryableSubmissionFee(uint256 dataLength, uint256 baseFee) view returns (uint256)",
+	"event InboxMessageDelivered(uint256 indexed messageNum, bytes data)",
+})
+
+var bridgeABI = abi.MustParseAbi([]string{
+	"event MessageDelivered(uint256 indexed messageIndex, bytes32 indexed beforeInboxAcc, address inbox, uint8 kind, address sender, bytes32 messageDataHash, uint256 baseFeeL1, uint64 timestamp)",
+})
+
+var outboxABI = abi.MustParseAbi([]string{
+	"function executeTransaction(bytes32[] proof, uint256 index, address l2Sender, address to, uint256 l2Block, uint256 l1Block, uint256 l2Timestamp, uint256 value, bytes data)",
+	"function isSpent(uint256 index) view returns (bool)",
+})
@@ -0,0 +1,110 @@
+package arbitrum
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// PublicActionsL2 returns the Arbitrum actions run against an Arbitrum chain,
+// bound to c, as a map for client.Extend.
+//
+// Example:
+//
+//	arbClient := client.CreatePublicClient(config)
+//	arbClient.Extend("arbitrum", arbitrum.PublicActionsL2(arbClient))
+func PublicActionsL2(c *client.PublicClient) map[string]any {
+	return map[string]any{
+		// Gas
+		"estimateGasComponents": func(ctx context.Context, params EstimateGasComponentsParameters) (*GasEstimateComponents, error) {
+			return EstimateGasComponents(ctx, c, params)
+		},
+		"estimateL1GasComponent": func(ctx context.Context, params EstimateGasComponentsParameters) (*L1GasComponent, error) {
+			return EstimateL1GasComponent(ctx, c, params)
+		},
+		"getPricesInWei": func(ctx context.Context) (*GasPrices, error) {
+			return GetPricesInWei(ctx, c)
+		},
+		"getL1BaseFeeEstimate": func(ctx context.Context) (*big.Int, error) {
+			return GetL1BaseFeeEstimate(ctx, c)
+		},
+		"getMinimumGasPrice": func(ctx context.Context) (*big.Int, error) {
+			return GetMinimumGasPrice(ctx, c)
+		},
+		"getGasAccountingParams": func(ctx context.Context) (*GasAccountingParams, error) {
+			return GetGasAccountingParams(ctx, c)
+		},
+
+		// Retryables
+		"getRetryableStatus": func(ctx context.Context, params GetRetryableStatusParameters) (RetryableStatus, error) {
+			return GetRetryableStatus(ctx, c, params)
+		},
+
+		// L2 -> L1 messages
+		"getL2ToL1Messages": func(receipt *types.Receipt) ([]L2ToL1Message, error) {
+			return GetL2ToL1Messages(receipt)
+		},
+		"buildOutboxProof": func(ctx context.Context, params BuildOutboxProofParameters) (*OutboxProof, error) {
+			return BuildOutboxProof(ctx, c, params)
+		},
+	}
+}
+
+// PublicActionsL1 returns the Arbitrum actions run against the parent chain,
+// bound to c, as a map for client.Extend.
+//
+// Example:
+//
+//	l1Client := client.CreatePublicClient(config)
+//	l1Client.Extend("arbitrum", arbitrum.PublicActionsL1(l1Client))
+func PublicActionsL1(c *client.PublicClient) map[string]any {
+	return map[string]any{
+		// Retryables
+		"getRetryableSubmissionFee": func(ctx context.Context, params GetRetryableSubmissionFeeParameters) (*big.Int, error) {
+			return GetRetryableSubmissionFee(ctx, c, params)
+		},
+		"getRetryableTickets": func(receipt *types.Receipt, l2ChainID int64) ([]RetryableTicket, error) {
+			return GetRetryableTickets(receipt, l2ChainID)
+		},
+
+		// L2 -> L1 messages
+		"isL2ToL1MessageExecuted": func(ctx context.Context, params IsL2ToL1MessageExecutedParameters) (bool, error) {
+			return IsL2ToL1MessageExecuted(ctx, c, params)
+		},
+	}
+}
+
+// WalletActionsL2 returns the Arbitrum wallet actions run against an
+// Arbitrum chain, bound to c, as a map for client.Extend.
+//
+// Example:
+//
+//	arbWallet := client.CreateWalletClient(config)
+//	arbWallet.Extend("arbitrum", arbitrum.WalletActionsL2(arbWallet))
+func WalletActionsL2(c *client.WalletClient) map[string]any {
+	return map[string]any{
+		"redeemRetryable": func(ctx context.Context, params RedeemRetryableParameters) (string, error) {
+			return RedeemRetryable(ctx, c, params)
+		},
+	}
+}
+
+// WalletActionsL1 returns the Arbitrum wallet actions run against the parent
+// chain, bound to c, as a map for client.Extend.
+//
+// Example:
+//
+//	l1Wallet := client.CreateWalletClient(config)
+//	l1Wallet.Extend("arbitrum", arbitrum.WalletActionsL1(l1Wallet))
+func WalletActionsL1(c *client.WalletClient) map[string]any {
+	return map[string]any{
+		"createRetryableTicket": func(ctx context.Context, params CreateRetryableTicketParameters) (string, error) {
+			return CreateRetryableTicket(ctx, c, params)
+		},
+		"executeL2ToL1Message": func(ctx context.Context, params ExecuteL2ToL1MessageParameters) (string, error) {
+			return ExecuteL2ToL1Message(ctx, c, params)
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package arbitrum
+
+import "github.com/ChefBingbong/viem-go/arbitrum/chainconfig"
+
+// BlockFields are the Arbitrum fields of a block. See
+// chainconfig.BlockFields.
+type BlockFields = chainconfig.BlockFields
+
+// ReceiptFields are the Arbitrum fields of a transaction receipt. See
+// chainconfig.ReceiptFields.
+type ReceiptFields = chainconfig.ReceiptFields
+
+// Formatters are the chain formatters of Arbitrum chains, as set on the
+// chain definitions.
+var Formatters = chainconfig.Formatters
@@ -0,0 +1,183 @@
+package arbitrum
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/internal/contractread"
+)
+
+// EstimateGasComponentsParameters contains the parameters for the
+// EstimateGasComponents and EstimateL1GasComponent actions.
+type EstimateGasComponentsParameters struct {
+	// Account is the sender of the transaction.
+	Account *common.Address
+
+	// To is the recipient address. Nil for contract deployment.
+	To *common.Address
+
+	// Data is the calldata.
+	Data []byte
+
+	// Value is the amount of wei to send.
+	Value *big.Int
+
+	// BlockNumber is the block number to estimate at.
+	// Mutually exclusive with BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the block tag to estimate at (e.g., "latest", "pending").
+	// Mutually exclusive with BlockNumber.
+	BlockTag public.BlockTag
+}
+
+// GasEstimateComponents is a gas estimate split into its L1 and L2 portions.
+type GasEstimateComponents struct {
+	// GasEstimate is the total gas limit, as returned by eth_estimateGas.
+	GasEstimate uint64
+	// GasEstimateForL1 is the part of GasEstimate paying for L1 data.
+	GasEstimateForL1 uint64
+	// BaseFee is the L2 base fee.
+	BaseFee *big.Int
+	// L1BaseFeeEstimate is the estimated L1 base fee.
+	L1BaseFeeEstimate *big.Int
+}
+
+// L2Gas returns the part of the estimate paying for L2 execution.
+func (c *GasEstimateComponents) L2Gas() uint64 {
+	if c.GasEstimateForL1 > c.GasEstimate {
+		return 0
+	}
+	return c.GasEstimate - c.GasEstimateForL1
+}
+
+// L1GasComponent is the L1 portion of a gas estimate.
+type L1GasComponent struct {
+	// GasEstimateForL1 is the gas paying for L1 data.
+	GasEstimateForL1 uint64
+	// BaseFee is the L2 base fee.
+	BaseFee *big.Int
+	// L1BaseFeeEstimate is the estimated L1 base fee.
+	L1BaseFeeEstimate *big.Int
+}
+
+// EstimateGasComponents estimates the gas of a transaction through
+// NodeInterface.gasEstimateComponents, reporting the L1 portion of the
+// estimate next to the total.
+//
+// Example:
+//
+//	components, err := arbitrum.EstimateGasComponents(ctx, arbClient, arbitrum.EstimateGasComponentsParameters{
+//	    Account: &from,
+//	    To:      &to,
+//	    Value:   big.NewInt(1e18),
+//	})
+func EstimateGasComponents(ctx context.Context, client public.Client, params EstimateGasComponentsParameters) (*GasEstimateComponents, error) {
+	var components GasEstimateComponents
+	if err := readNodeInterface(ctx, client, "gasEstimateComponents", params, &components); err != nil {
+		return nil, err
+	}
+	return &components, nil
+}
+
+// EstimateL1GasComponent estimates only the L1 portion of the gas of a
+// transaction through NodeInterface.gasEstimateL1Component.
+func EstimateL1GasComponent(ctx context.Context, client public.Client, params EstimateGasComponentsParameters) (*L1GasComponent, error) {
+	var component L1GasComponent
+	if err := readNodeInterface(ctx, client, "gasEstimateL1Component", params, &component); err != nil {
+		return nil, err
+	}
+	return &component, nil
+}
+
+func readNodeInterface(ctx context.Context, client public.Client, functionName string, params EstimateGasComponentsParameters, out any) error {
+	var to common.Address
+	if params.To != nil {
+		to = *params.To
+	}
+	data := params.Data
+	if data == nil {
+		data = []byte{}
+	}
+	return contractread.Read(ctx, client, contractread.Parameters{
+		Address:      NodeInterfaceAddress,
+		ABI:          nodeInterfaceABI,
+		FunctionName: functionName,
+		Args:         []any{to, params.To == nil, data},
+		Account:      params.Account,
+		Value:        params.Value,
+		BlockNumber:  params.BlockNumber,
+		BlockTag:     params.BlockTag,
+	}, out)
+}
+
+// GasPrices are the gas prices reported by ArbGasInfo.getPricesInWei, in wei.
+type GasPrices struct {
+	// PerL2Tx is the fixed cost per L2 transaction.
+	PerL2Tx *big.Int
+	// PerL1CalldataByte is the cost per byte of L1 calldata.
+	PerL1CalldataByte *big.Int
+	// PerStorageAllocation is the cost per storage slot allocation.
+	PerStorageAllocation *big.Int
+	// PerArbGasBase is the L2 base price per gas.
+	PerArbGasBase *big.Int
+	// PerArbGasCongestion is the congestion premium per gas.
+	PerArbGasCongestion *big.Int
+	// PerArbGasTotal is the total price per gas.
+	PerArbGasTotal *big.Int
+}
+
+// GasAccountingParams are the gas pool parameters reported by
+// ArbGasInfo.getGasAccountingParams.
+type GasAccountingParams struct {
+	SpeedLimitPerSecond *big.Int
+	GasPoolMax          *big.Int
+	MaxTxGasLimit       *big.Int
+}
+
+// GetPricesInWei returns the current gas prices from ArbGasInfo.
+func GetPricesInWei(ctx context.Context, client public.Client) (*GasPrices, error) {
+	var prices GasPrices
+	if err := readGasInfo(ctx, client, "getPricesInWei", &prices); err != nil {
+		return nil, err
+	}
+	return &prices, nil
+}
+
+// GetL1BaseFeeEstimate returns the L1 base fee estimate from ArbGasInfo.
+func GetL1BaseFeeEstimate(ctx context.Context, client public.Client) (*big.Int, error) {
+	var fee *big.Int
+	if err := readGasInfo(ctx, client, "getL1BaseFeeEstimate", &fee); err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+// GetMinimumGasPrice returns the minimum L2 gas price from ArbGasInfo.
+func GetMinimumGasPrice(ctx context.Context, client public.Client) (*big.Int, error) {
+	var price *big.Int
+	if err := readGasInfo(ctx, client, "getMinimumGasPrice", &price); err != nil {
+		return nil, err
+	}
+	return price, nil
+}
+
+// GetGasAccountingParams returns the gas pool parameters from ArbGasInfo.
+func GetGasAccountingParams(ctx context.Context, client public.Client) (*GasAccountingParams, error) {
+	var params GasAccountingParams
+	if err := readGasInfo(ctx, client, "getGasAccountingParams", &params); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+func readGasInfo(ctx context.Context, client public.Client, functionName string, out any) error {
+	return contractread.Read(ctx, client, contractread.Parameters{
+		Address:      ArbGasInfoAddress,
+		ABI:          arbGasInfoABI,
+		FunctionName: functionName,
+	}, out)
+}
@@ -0,0 +1,309 @@
+package arbitrum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/internal/contractread"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// ErrMessageNotInSendTree is returned when an L2 -> L1 message is not part
+// of the send Merkle tree it is proven against yet.
+var ErrMessageNotInSendTree = errors.New("arbitrum: L2 to L1 message not in send tree")
+
+// ErrInvalidL2ToL1Message is returned when the L2ToL1Tx events of a receipt
+// cannot be decoded.
+var ErrInvalidL2ToL1Message = errors.New("arbitrum: invalid L2 to L1 message")
+
+// L2ToL1Message is an L2 -> L1 message, as recorded by the ArbSys L2ToL1Tx
+// event.
+type L2ToL1Message struct {
+	// Caller is the L2 sender.
+	Caller common.Address
+	// Destination is the L1 target.
+	Destination common.Address
+	// Hash is the message hash, a leaf of the send Merkle tree.
+	Hash *big.Int
+	// Position is the leaf index of the message in the send Merkle tree.
+	Position *big.Int
+	// ArbBlockNum is the L2 block the message was sent in.
+	ArbBlockNum *big.Int
+	// EthBlockNum is the L1 block number seen by that L2 block.
+	EthBlockNum *big.Int
+	// Timestamp is the L2 timestamp of the message.
+	Timestamp *big.Int
+	// CallValue is the ETH sent to Destination.
+	CallValue *big.Int
+	// Data is the L1 calldata.
+	Data []byte
+}
+
+// GetL2ToL1Messages returns the L2 -> L1 messages sent in an L2 transaction
+// receipt, e.g. of an ArbSys.withdrawEth or sendTxToL1 call.
+func GetL2ToL1Messages(receipt *types.Receipt) ([]L2ToL1Message, error) {
+	if receipt == nil {
+		return nil, fmt.Errorf("%w: nil receipt", ErrInvalidL2ToL1Message)
+	}
+	event, err := arbSysABI.GetEvent("L2ToL1Tx")
+	if err != nil {
+		return nil, err
+	}
+	var messages []L2ToL1Message
+	for _, log := range receipt.Logs {
+		if len(log.Topics) != 4 || log.Topics[0] != event.Topic || log.Address != ArbSysAddress {
+			continue
+		}
+		decoded, err := arbSysABI.DecodeEventLogByName("L2ToL1Tx", log.Topics, log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidL2ToL1Message, err)
+		}
+		m := L2ToL1Message{
+			Destination: common.BytesToAddress(log.Topics[1].Bytes()),
+			Hash:        new(big.Int).SetBytes(log.Topics[2].Bytes()),
+			Position:    new(big.Int).SetBytes(log.Topics[3].Bytes()),
+		}
+		args := decoded.Args
+		if m.Caller, err = eventArg[common.Address](args, "caller"); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidL2ToL1Message, err)
+		}
+		if m.ArbBlockNum, err = eventArg[*big.Int](args, "arbBlockNum"); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidL2ToL1Message, err)
+		}
+		if m.EthBlockNum, err = eventArg[*big.Int](args, "ethBlockNum"); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidL2ToL1Message, err)
+		}
+		if m.Timestamp, err = eventArg[*big.Int](args, "timestamp"); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidL2ToL1Message, err)
+		}
+		if m.CallValue, err = eventArg[*big.Int](args, "callvalue"); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidL2ToL1Message, err)
+		}
+		if m.Data, err = eventArg[[]byte](args, "data"); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidL2ToL1Message, err)
+		}
+		messages = append(messages, m)
+	}
+	return messages, nil
+}
+
+// eventArg returns the decoded event argument name, which must be a T.
+func eventArg[T any](args map[string]any, name string) (T, error) {
+	v, ok := args[name].(T)
+	if !ok {
+		return v, fmt.Errorf("unexpected type %T for %s", args[name], name)
+	}
+	return v, nil
+}
+
+// OutboxProof is the Merkle proof of an L2 -> L1 message against a send root.
+type OutboxProof struct {
+	// Send is the proven leaf (the message hash).
+	Send common.Hash
+	// Root is the send root the proof verifies against.
+	Root common.Hash
+	// Proof is the list of sibling hashes from the leaf to the root.
+	Proof []common.Hash
+}
+
+// BuildOutboxProofParameters contains the parameters for the
+// BuildOutboxProof action.
+type BuildOutboxProofParameters struct {
+	// Message is the message to prove, from GetL2ToL1Messages.
+	Message L2ToL1Message
+
+	// SendCount is the size of the send Merkle tree to prove against: the
+	// SendCount (see BlockFields) of the L2 block whose send root is
+	// confirmed on L1. When 0, the SendCount of BlockNumber is used.
+	SendCount uint64
+
+	// BlockNumber is the L2 block whose SendCount is used when SendCount is 0.
+	// Defaults to the latest block.
+	BlockNumber *uint64
+}
+
+// BuildOutboxProof builds the proof needed to execute an L2 -> L1 message on
+// L1, through NodeInterface.constructOutboxProof. It is run against the L2.
+// The message can only be executed once a send root including it is
+// confirmed on L1, about a week after it was sent.
+//
+// Example:
+//
+//	messages, err := arbitrum.GetL2ToL1Messages(receipt)
+//	proof, err := arbitrum.BuildOutboxProof(ctx, arbClient, arbitrum.BuildOutboxProofParameters{
+//	    Message:   messages[0],
+//	    SendCount: confirmedBlock.SendCount,
+//	})
+func BuildOutboxProof(ctx context.Context, client public.Client, params BuildOutboxProofParameters) (*OutboxProof, error) {
+	size := params.SendCount
+	if size == 0 {
+		var err error
+		if size, err = getSendCount(ctx, client, params.BlockNumber); err != nil {
+			return nil, err
+		}
+	}
+	position := params.Message.Position
+	if position == nil || !position.IsUint64() || position.Uint64() >= size {
+		return nil, fmt.Errorf("%w: position %v, send count %d", ErrMessageNotInSendTree, position, size)
+	}
+
+	var result struct {
+		Send  [32]byte
+		Root  [32]byte
+		Proof [][32]byte
+	}
+	err := contractread.Read(ctx, client, contractread.Parameters{
+		Address:      NodeInterfaceAddress,
+		ABI:          nodeInterfaceABI,
+		FunctionName: "constructOutboxProof",
+		Args:         []any{size, position.Uint64()},
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &OutboxProof{
+		Send:  result.Send,
+		Root:  result.Root,
+		Proof: make([]common.Hash, len(result.Proof)),
+	}
+	for i, node := range result.Proof {
+		proof.Proof[i] = node
+	}
+	return proof, nil
+}
+
+// getSendCount returns the SendCount of an L2 block. The raw block is
+// formatted directly so that it does not depend on the client's chain
+// formatters.
+func getSendCount(ctx context.Context, client public.Client, blockNumber *uint64) (uint64, error) {
+	tag := string(public.BlockTagLatest)
+	if blockNumber != nil {
+		tag = hexutil.EncodeUint64(*blockNumber)
+	}
+	resp, err := client.Request(ctx, "eth_getBlockByNumber", tag, false)
+	if err != nil {
+		return 0, fmt.Errorf("eth_getBlockByNumber failed: %w", err)
+	}
+	if resp.Result == nil || string(resp.Result) == "null" {
+		return 0, &public.BlockNotFoundError{BlockNumber: blockNumber}
+	}
+	fields, err := Formatters.Block(resp.Result)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode block: %w", err)
+	}
+	return fields.(*BlockFields).SendCount, nil
+}
+
+// ExecuteL2ToL1MessageParameters contains the parameters for the
+// ExecuteL2ToL1Message action.
+type ExecuteL2ToL1MessageParameters struct {
+	// Account is the L1 account to send from. If nil, uses the client's account.
+	Account wallet.Account
+
+	// Message is the message to execute.
+	Message L2ToL1Message
+
+	// Proof is the message proof, from BuildOutboxProof.
+	Proof OutboxProof
+
+	// TargetChain is the L2 chain, whose L1 contracts are used.
+	TargetChain *chain.Chain
+
+	// Contracts overrides the L1 contracts of TargetChain.
+	Contracts *L1Contracts
+
+	// Chain optionally overrides the client's (L1) chain for chain ID validation.
+	Chain *chain.Chain
+
+	// L1 transaction fields
+	Gas                  *big.Int
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Nonce                *int
+}
+
+// ExecuteL2ToL1Message executes a confirmed L2 -> L1 message through the
+// Outbox, returning the L1 transaction hash.
+func ExecuteL2ToL1Message(ctx context.Context, client wallet.Client, params ExecuteL2ToL1MessageParameters) (string, error) {
+	contracts, err := resolveL1Contracts(params.Contracts, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+
+	m := params.Message
+	proof := make([][32]byte, len(params.Proof.Proof))
+	for i, node := range params.Proof.Proof {
+		proof[i] = node
+	}
+	data := m.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	return wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:      params.Account,
+		Address:      contracts.Outbox.Hex(),
+		ABI:          outboxABI,
+		FunctionName: "executeTransaction",
+		Args: []any{
+			proof,
+			bigOrZero(m.Position),
+			m.Caller,
+			m.Destination,
+			bigOrZero(m.ArbBlockNum),
+			bigOrZero(m.EthBlockNum),
+			bigOrZero(m.Timestamp),
+			bigOrZero(m.CallValue),
+			data,
+		},
+		Chain:                params.Chain,
+		Gas:                  params.Gas,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		Nonce:                params.Nonce,
+	})
+}
+
+// IsL2ToL1MessageExecutedParameters contains the parameters for the
+// IsL2ToL1MessageExecuted action.
+type IsL2ToL1MessageExecutedParameters struct {
+	// Message is the message to check.
+	Message L2ToL1Message
+
+	// TargetChain is the L2 chain, whose L1 contracts are used.
+	TargetChain *chain.Chain
+
+	// Contracts overrides the L1 contracts of TargetChain.
+	Contracts *L1Contracts
+}
+
+// IsL2ToL1MessageExecuted reports whether an L2 -> L1 message has been
+// executed in the Outbox. It is run against the L1.
+func IsL2ToL1MessageExecuted(ctx context.Context, client public.Client, params IsL2ToL1MessageExecutedParameters) (bool, error) {
+	contracts, err := resolveL1Contracts(params.Contracts, params.TargetChain)
+	if err != nil {
+		return false, err
+	}
+	var spent bool
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.Outbox,
+		ABI:          outboxABI,
+		FunctionName: "isSpent",
+		Args:         []any{bigOrZero(params.Message.Position)},
+	}, &spent)
+	if err != nil {
+		return false, err
+	}
+	return spent, nil
+}
@@ -0,0 +1,563 @@
+package arbitrum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/internal/contractread"
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
+)
+
+// SubmitRetryableTxType is the transaction type of the L2 transactions
+// creating retryable tickets.
+const SubmitRetryableTxType = 0x69
+
+// submitRetryableMessageKind is the Bridge message kind of retryable tickets.
+const submitRetryableMessageKind = 9
+
+// ErrInvalidRetryableMessage is returned when an Inbox message of a
+// retryable ticket cannot be decoded.
+var ErrInvalidRetryableMessage = errors.New("arbitrum: invalid retryable ticket message")
+
+// RetryableStatus is the stage of an L1 -> L2 retryable ticket.
+type RetryableStatus string
+
+const (
+	// RetryableStatusNotYetCreated means the ticket has not been created on
+	// L2 yet.
+	RetryableStatusNotYetCreated RetryableStatus = "not-yet-created"
+	// RetryableStatusCreationFailed means the ticket creation reverted on L2,
+	// e.g. because the deposit did not cover the submission cost.
+	RetryableStatusCreationFailed RetryableStatus = "creation-failed"
+	// RetryableStatusFundsDeposited means the ticket exists but has not been
+	// redeemed yet; it can be redeemed with RedeemRetryable until it expires.
+	RetryableStatusFundsDeposited RetryableStatus = "funds-deposited-on-child"
+	// RetryableStatusRedeemed means the ticket's L2 call succeeded.
+	RetryableStatusRedeemed RetryableStatus = "redeemed"
+	// RetryableStatusExpired means the ticket expired without being redeemed.
+	RetryableStatusExpired RetryableStatus = "expired"
+)
+
+// aliasOffset is added to L1 contract addresses sending messages to L2.
+var aliasOffset = new(big.Int).SetBytes(common.FromHex("0x1111000000000000000000000000000000001111"))
+
+var addressSpace = new(big.Int).Lsh(big.NewInt(1), 160)
+
+// ApplyL1ToL2Alias returns the L2 alias of an L1 address, the sender seen on
+// L2 for messages sent by it.
+func ApplyL1ToL2Alias(address common.Address) common.Address {
+	aliased := new(big.Int).Add(new(big.Int).SetBytes(address.Bytes()), aliasOffset)
+	return common.BigToAddress(aliased.Mod(aliased, addressSpace))
+}
+
+// RetryableTicketRequest is an L1 -> L2 retryable ticket: an L2 call paid
+// for on L1, which is retried until it succeeds or expires.
+type RetryableTicketRequest struct {
+	// To is the L2 destination of the call.
+	To common.Address
+	// L2CallValue is the ETH sent with the L2 call.
+	L2CallValue *big.Int
+	// MaxSubmissionCost is the maximum fee for storing the ticket, see
+	// GetRetryableSubmissionFee.
+	MaxSubmissionCost *big.Int
+	// ExcessFeeRefundAddress receives unused L2 gas and submission fees.
+	// Defaults to the sender.
+	ExcessFeeRefundAddress common.Address
+	// CallValueRefundAddress receives L2CallValue if the ticket expires or
+	// is cancelled. Defaults to the sender.
+	CallValueRefundAddress common.Address
+	// GasLimit is the L2 gas limit of the automatic redemption.
+	GasLimit *big.Int
+	// MaxFeePerGas is the L2 gas price bid of the automatic redemption.
+	MaxFeePerGas *big.Int
+	// Data is the L2 calldata.
+	Data []byte
+}
+
+// Deposit returns the ETH to send with the ticket on L1:
+// maxSubmissionCost + l2CallValue + gasLimit * maxFeePerGas.
+func (r *RetryableTicketRequest) Deposit() *big.Int {
+	deposit := new(big.Int).Mul(bigOrZero(r.GasLimit), bigOrZero(r.MaxFeePerGas))
+	deposit.Add(deposit, bigOrZero(r.MaxSubmissionCost))
+	return deposit.Add(deposit, bigOrZero(r.L2CallValue))
+}
+
+// GetRetryableSubmissionFeeParameters contains the parameters for the
+// GetRetryableSubmissionFee action.
+type GetRetryableSubmissionFeeParameters struct {
+	// DataLength is the length of the ticket's L2 calldata.
+	DataLength uint64
+
+	// BaseFee is the L1 base fee to price the submission at. Defaults to the
+	// base fee of the latest L1 block.
+	BaseFee *big.Int
+
+	// TargetChain is the L2 chain, whose L1 contracts are used.
+	TargetChain *chain.Chain
+
+	// Contracts overrides the L1 contracts of TargetChain.
+	Contracts *L1Contracts
+}
+
+// GetRetryableSubmissionFee returns the fee for submitting a retryable
+// ticket, to use as its MaxSubmissionCost. It is run against the L1.
+func GetRetryableSubmissionFee(ctx context.Context, client public.Client, params GetRetryableSubmissionFeeParameters) (*big.Int, error) {
+	contracts, err := resolveL1Contracts(params.Contracts, params.TargetChain)
+	if err != nil {
+		return nil, err
+	}
+	baseFee := params.BaseFee
+	if baseFee == nil {
+		block, err := public.GetBlock(ctx, client, public.GetBlockParameters{})
+		if err != nil {
+			return nil, err
+		}
+		baseFee = bigOrZero(block.BaseFeePerGas)
+	}
+	var fee *big.Int
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.Inbox,
+		ABI:          inboxABI,
+		FunctionName: "calculateRetryableSubmissionFee",
+		Args:         []any{new(big.Int).SetUint64(params.DataLength), baseFee},
+	}, &fee)
+	if err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+// CreateRetryableTicketParameters contains the parameters for the
+// CreateRetryableTicket action.
+type CreateRetryableTicketParameters struct {
+	// Account is the L1 account to send from. If nil, uses the client's account.
+	Account wallet.Account
+
+	// Request is the ticket to create.
+	Request RetryableTicketRequest
+
+	// Value overrides the ETH sent with the ticket. Defaults to Request.Deposit().
+	Value *big.Int
+
+	// TargetChain is the L2 chain, whose L1 contracts are used.
+	TargetChain *chain.Chain
+
+	// Contracts overrides the L1 contracts of TargetChain.
+	Contracts *L1Contracts
+
+	// Chain optionally overrides the client's (L1) chain for chain ID validation.
+	Chain *chain.Chain
+
+	// L1 transaction fields
+	Gas                  *big.Int
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Nonce                *int
+}
+
+// CreateRetryableTicket creates a retryable ticket through the Inbox,
+// returning the L1 transaction hash. Once the L1 transaction is included,
+// GetRetryableTickets returns the ticket IDs to follow with
+// GetRetryableStatus.
+//
+// Example:
+//
+//	hash, err := arbitrum.CreateRetryableTicket(ctx, mainnetWallet, arbitrum.CreateRetryableTicketParameters{
+//	    Request: arbitrum.RetryableTicketRequest{
+//	        To:                target,
+//	        MaxSubmissionCost: submissionFee,
+//	        GasLimit:          big.NewInt(100_000),
+//	        MaxFeePerGas:      big.NewInt(100_000_000),
+//	        Data:              calldata,
+//	    },
+//	    TargetChain: &definitions.Arbitrum,
+//	})
+func CreateRetryableTicket(ctx context.Context, client wallet.Client, params CreateRetryableTicketParameters) (string, error) {
+	contracts, err := resolveL1Contracts(params.Contracts, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+
+	request := params.Request
+	sender := params.Account
+	if sender == nil {
+		sender = client.Account()
+	}
+	if sender != nil {
+		if request.ExcessFeeRefundAddress == (common.Address{}) {
+			request.ExcessFeeRefundAddress = sender.Address()
+		}
+		if request.CallValueRefundAddress == (common.Address{}) {
+			request.CallValueRefundAddress = sender.Address()
+		}
+	}
+	value := params.Value
+	if value == nil {
+		value = request.Deposit()
+	}
+	data := request.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	return wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:      params.Account,
+		Address:      contracts.Inbox.Hex(),
+		ABI:          inboxABI,
+		FunctionName: "createRetryableTicket",
+		Args: []any{
+			request.To,
+			bigOrZero(request.L2CallValue),
+			bigOrZero(request.MaxSubmissionCost),
+			request.ExcessFeeRefundAddress,
+			request.CallValueRefundAddress,
+			bigOrZero(request.GasLimit),
+			bigOrZero(request.MaxFeePerGas),
+			data,
+		},
+		Value:                value,
+		Chain:                params.Chain,
+		Gas:                  params.Gas,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		Nonce:                params.Nonce,
+	})
+}
+
+// RetryableTicket is a retryable ticket created on L1.
+type RetryableTicket struct {
+	// ID is the L2 transaction hash of the ticket creation, which also
+	// identifies the ticket in ArbRetryableTx.
+	ID common.Hash
+	// MessageNumber is the Bridge delayed message index.
+	MessageNumber *big.Int
+	// From is the (aliased) L2 sender.
+	From common.Address
+	// L1BaseFee is the L1 base fee when the ticket was submitted.
+	L1BaseFee *big.Int
+	// Deposit is the ETH sent with the ticket on L1.
+	Deposit *big.Int
+	// Request is the ticket.
+	Request RetryableTicketRequest
+}
+
+// submitRetryableRLP is the consensus encoding of the transaction creating a
+// retryable ticket.
+type submitRetryableRLP struct {
+	ChainID          *big.Int
+	RequestID        common.Hash
+	From             common.Address
+	L1BaseFee        *big.Int
+	DepositValue     *big.Int
+	GasFeeCap        *big.Int
+	Gas              uint64
+	RetryTo          *common.Address `rlp:"nil"`
+	RetryValue       *big.Int
+	Beneficiary      common.Address
+	MaxSubmissionFee *big.Int
+	FeeRefundAddr    common.Address
+	RetryData        []byte
+}
+
+// GetRetryableTickets returns the retryable tickets created in an L1
+// transaction receipt, e.g. of a CreateRetryableTicket call. l2ChainID is the
+// chain ID of the Arbitrum chain, which ticket IDs depend on.
+func GetRetryableTickets(receipt *types.Receipt, l2ChainID int64) ([]RetryableTicket, error) {
+	if receipt == nil {
+		return nil, fmt.Errorf("%w: nil receipt", ErrInvalidRetryableMessage)
+	}
+	delivered, err := bridgeABI.GetEvent("MessageDelivered")
+	if err != nil {
+		return nil, err
+	}
+	inboxDelivered, err := inboxABI.GetEvent("InboxMessageDelivered")
+	if err != nil {
+		return nil, err
+	}
+
+	type bridgeMessage struct {
+		kind      uint8
+		sender    common.Address
+		l1BaseFee *big.Int
+	}
+	messages := map[common.Hash]bridgeMessage{}
+	for _, log := range receipt.Logs {
+		if len(log.Topics) != 3 || log.Topics[0] != delivered.Topic {
+			continue
+		}
+		decoded, err := bridgeABI.DecodeEventLogByName("MessageDelivered", log.Topics, log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRetryableMessage, err)
+		}
+		msg := bridgeMessage{}
+		if msg.kind, err = eventArg[uint8](decoded.Args, "kind"); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRetryableMessage, err)
+		}
+		if msg.sender, err = eventArg[common.Address](decoded.Args, "sender"); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRetryableMessage, err)
+		}
+		if msg.l1BaseFee, err = eventArg[*big.Int](decoded.Args, "baseFeeL1"); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRetryableMessage, err)
+		}
+		messages[log.Topics[1]] = msg
+	}
+
+	var tickets []RetryableTicket
+	for _, log := range receipt.Logs {
+		if len(log.Topics) != 2 || log.Topics[0] != inboxDelivered.Topic {
+			continue
+		}
+		msg, ok := messages[log.Topics[1]]
+		if !ok || msg.kind != submitRetryableMessageKind {
+			continue
+		}
+		decoded, err := inboxABI.DecodeEventLogByName("InboxMessageDelivered", log.Topics, log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRetryableMessage, err)
+		}
+		data, err := eventArg[[]byte](decoded.Args, "data")
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRetryableMessage, err)
+		}
+		ticket, err := parseRetryableMessage(data)
+		if err != nil {
+			return nil, err
+		}
+		ticket.MessageNumber = new(big.Int).SetBytes(log.Topics[1].Bytes())
+		ticket.From = msg.sender
+		ticket.L1BaseFee = bigOrZero(msg.l1BaseFee)
+		if ticket.ID, err = retryableTicketID(ticket, l2ChainID); err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, *ticket)
+	}
+	return tickets, nil
+}
+
+// parseRetryableMessage decodes the Inbox message of a retryable ticket:
+// abi.encodePacked(to, l2CallValue, deposit, maxSubmissionCost,
+// excessFeeRefundAddress, callValueRefundAddress, gasLimit, maxFeePerGas,
+// data.length, data), every field but data padded to 32 bytes.
+func parseRetryableMessage(data []byte) (*RetryableTicket, error) {
+	const words = 9
+	if len(data) < words*32 {
+		return nil, fmt.Errorf("%w: message is %d bytes", ErrInvalidRetryableMessage, len(data))
+	}
+	word := func(i int) []byte { return data[i*32 : (i+1)*32] }
+	dataLength := new(big.Int).SetBytes(word(8))
+	if !dataLength.IsUint64() || uint64(len(data)-words*32) != dataLength.Uint64() {
+		return nil, fmt.Errorf("%w: calldata length %s does not match message", ErrInvalidRetryableMessage, dataLength)
+	}
+	return &RetryableTicket{
+		Deposit: new(big.Int).SetBytes(word(2)),
+		Request: RetryableTicketRequest{
+			To:                     common.BytesToAddress(word(0)),
+			L2CallValue:            new(big.Int).SetBytes(word(1)),
+			MaxSubmissionCost:      new(big.Int).SetBytes(word(3)),
+			ExcessFeeRefundAddress: common.BytesToAddress(word(4)),
+			CallValueRefundAddress: common.BytesToAddress(word(5)),
+			GasLimit:               new(big.Int).SetBytes(word(6)),
+			MaxFeePerGas:           new(big.Int).SetBytes(word(7)),
+			Data:                   data[words*32:],
+		},
+	}, nil
+}
+
+// retryableTicketID returns the hash of the L2 transaction creating a ticket:
+// keccak256(0x69 || rlp(submitRetryableTx)).
+func retryableTicketID(ticket *RetryableTicket, l2ChainID int64) (common.Hash, error) {
+	request := ticket.Request
+	if !request.GasLimit.IsUint64() {
+		return common.Hash{}, fmt.Errorf("%w: gas limit %s overflows uint64", ErrInvalidRetryableMessage, request.GasLimit)
+	}
+	tx := submitRetryableRLP{
+		ChainID:          big.NewInt(l2ChainID),
+		RequestID:        common.BigToHash(ticket.MessageNumber),
+		From:             ticket.From,
+		L1BaseFee:        ticket.L1BaseFee,
+		DepositValue:     ticket.Deposit,
+		GasFeeCap:        request.MaxFeePerGas,
+		Gas:              request.GasLimit.Uint64(),
+		RetryValue:       request.L2CallValue,
+		Beneficiary:      request.CallValueRefundAddress,
+		MaxSubmissionFee: request.MaxSubmissionCost,
+		FeeRefundAddr:    request.ExcessFeeRefundAddress,
+		RetryData:        request.Data,
+	}
+	if request.To != (common.Address{}) {
+		to := request.To
+		tx.RetryTo = &to
+	}
+	enc, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("%w: %v", ErrInvalidRetryableMessage, err)
+	}
+	return crypto.Keccak256Hash([]byte{SubmitRetryableTxType}, enc), nil
+}
+
+// GetRetryableStatusParameters contains the parameters for the
+// GetRetryableStatus action.
+type GetRetryableStatusParameters struct {
+	// TicketID is the ticket, from GetRetryableTickets.
+	TicketID common.Hash
+}
+
+// GetRetryableStatus returns the stage of a retryable ticket. It is run
+// against the L2.
+//
+// Example:
+//
+//	tickets, err := arbitrum.GetRetryableTickets(l1Receipt, definitions.Arbitrum.ID)
+//	status, err := arbitrum.GetRetryableStatus(ctx, arbClient, arbitrum.GetRetryableStatusParameters{
+//	    TicketID: tickets[0].ID,
+//	})
+func GetRetryableStatus(ctx context.Context, client public.Client, params GetRetryableStatusParameters) (RetryableStatus, error) {
+	creation, err := public.GetTransactionReceipt(ctx, client, public.GetTransactionReceiptParameters{Hash: params.TicketID})
+	if err != nil {
+		var notFound *public.TransactionReceiptNotFoundError
+		if errors.As(err, &notFound) {
+			return RetryableStatusNotYetCreated, nil
+		}
+		return "", err
+	}
+	if creation.Status != 1 {
+		return RetryableStatusCreationFailed, nil
+	}
+
+	event, err := arbRetryableTxABI.GetEvent("RedeemScheduled")
+	if err != nil {
+		return "", err
+	}
+
+	// The automatic redemption is scheduled in the creation transaction.
+	var retries []common.Hash
+	for _, log := range creation.Logs {
+		if log.Address == ArbRetryableTxAddress && len(log.Topics) == 4 && log.Topics[0] == event.Topic && log.Topics[1] == params.TicketID {
+			retries = append(retries, log.Topics[2])
+		}
+	}
+	redeemed, err := anyRedeemSucceeded(ctx, client, retries)
+	if err != nil {
+		return "", err
+	}
+	if redeemed {
+		return RetryableStatusRedeemed, nil
+	}
+
+	var timeout *big.Int
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      ArbRetryableTxAddress,
+		ABI:          arbRetryableTxABI,
+		FunctionName: "getTimeout",
+		Args:         []any{params.TicketID},
+	}, &timeout)
+	if err == nil {
+		return RetryableStatusFundsDeposited, nil
+	}
+	var rpcErr *rpc.RPCError
+	if !errors.As(err, &rpcErr) {
+		return "", err
+	}
+
+	// The ticket no longer exists: it was either redeemed manually or expired.
+	fromBlock := creation.BlockNumber
+	logs, err := public.GetLogs(ctx, client, public.GetLogsParameters{
+		Address:   ArbRetryableTxAddress,
+		Topics:    []any{event.Topic, params.TicketID},
+		FromBlock: &fromBlock,
+	})
+	if err != nil {
+		return "", err
+	}
+	retries = retries[:0]
+	for _, log := range logs {
+		if len(log.Topics) == 4 {
+			retries = append(retries, common.HexToHash(log.Topics[2]))
+		}
+	}
+	redeemed, err = anyRedeemSucceeded(ctx, client, retries)
+	if err != nil {
+		return "", err
+	}
+	if redeemed {
+		return RetryableStatusRedeemed, nil
+	}
+	return RetryableStatusExpired, nil
+}
+
+// anyRedeemSucceeded reports whether any of the redemption (retry)
+// transactions of a ticket succeeded.
+func anyRedeemSucceeded(ctx context.Context, client public.Client, retries []common.Hash) (bool, error) {
+	for _, hash := range retries {
+		receipt, err := public.GetTransactionReceipt(ctx, client, public.GetTransactionReceiptParameters{Hash: hash})
+		if err != nil {
+			var notFound *public.TransactionReceiptNotFoundError
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return false, err
+		}
+		if receipt.Status == 1 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RedeemRetryableParameters contains the parameters for the RedeemRetryable
+// action.
+type RedeemRetryableParameters struct {
+	// Account is the L2 account to send from. If nil, uses the client's account.
+	Account wallet.Account
+
+	// TicketID is the ticket to redeem.
+	TicketID common.Hash
+
+	// Chain optionally overrides the client's (L2) chain for chain ID validation.
+	Chain *chain.Chain
+
+	// L2 transaction fields
+	Gas                  *big.Int
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Nonce                *int
+}
+
+// RedeemRetryable manually redeems a retryable ticket whose automatic
+// redemption failed, retrying its L2 call through ArbRetryableTx. It returns
+// the L2 transaction hash.
+func RedeemRetryable(ctx context.Context, client wallet.Client, params RedeemRetryableParameters) (string, error) {
+	return wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:              params.Account,
+		Address:              ArbRetryableTxAddress.Hex(),
+		ABI:                  arbRetryableTxABI,
+		FunctionName:         "redeem",
+		Args:                 []any{params.TicketID},
+		Chain:                params.Chain,
+		Gas:                  params.Gas,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		Nonce:                params.Nonce,
+	})
+}
+
+func bigOrZero(n *big.Int) *big.Int {
+	if n == nil {
+		return new(big.Int)
+	}
+	return n
+}
@@ -0,0 +1,13 @@
+package arbitrum_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestArbitrum(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Arbitrum Suite")
+}
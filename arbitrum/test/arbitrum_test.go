@@ -0,0 +1,715 @@
+package arbitrum_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/accounts"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/arbitrum"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/internal/rpctest"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+const testPrivateKey = "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcab78f4c6f2c5ff80"
+
+var (
+	senderAddr = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	targetAddr = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	refundAddr = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+var (
+	nodeInterfaceABI = abi.MustParseAbi([]string{
+		"function gasEstimateComponents(address to, bool contractCreation, bytes data) payable returns (uint64 gasEstimate, uint64 gasEstimateForL1, uint256 baseFee, uint256 l1BaseFeeEstimate)",
+		"function gasEstimateL1Component(address to, bool contractCreation, bytes data) payable returns (uint64 gasEstimateForL1, uint256 baseFee, uint256 l1BaseFeeEstimate)",
+		"function constructOutboxProof(uint64 size, uint64 leaf) view returns (bytes32 send, bytes32 root, bytes32[] proof)",
+	})
+	arbGasInfoABI = abi.MustParseAbi([]string{
+		"function getPricesInWei() view returns (uint256, uint256, uint256, uint256, uint256, uint256)",
+		"function getL1BaseFeeEstimate() view returns (uint256)",
+		"function getMinimumGasPrice() view returns (uint256)",
+		"function getGasAccountingParams() view returns (uint256, uint256, uint256)",
+	})
+	arbSysABI = abi.MustParseAbi([]string{
+		"event L2ToL1Tx(address caller, address indexed destination, uint256 indexed hash, uint256 indexed position, uint256 arbBlockNum, uint256 ethBlockNum, uint256 timestamp, uint256 callvalue, bytes data)",
+	})
+	arbRetryableTxABI = abi.MustParseAbi([]string{
+		"function redeem(bytes32 ticketId) returns (bytes32)",
+		"function getTimeout(bytes32 ticketId) view returns (uint256)",
+		"event RedeemScheduled(bytes32 indexed ticketId, bytes32 indexed retryTxHash, uint64 indexed sequenceNum, uint64 donatedGas, address gasDonor, uint256 maxRefund, uint256 submissionFeeRefund)",
+	})
+	inboxABI = abi.MustParseAbi([]string{
+		"function createRetryableTicket(address to, uint256 l2CallValue, uint256 maxSubmissionCost, address excessFeeRefundAddress, address callValueRefundAddress, uint256 gasLimit, uint256 maxFeePerGas, bytes data) payable returns (uint256)",
+		"function calculateRetryableSubmissionFee(uint256 dataLength, uint256 baseFee) view returns (uint256)",
+		"event InboxMessageDelivered(uint256 indexed messageNum, bytes data)",
+	})
+	bridgeABI = abi.MustParseAbi([]string{
+		"event MessageDelivered(uint256 indexed messageIndex, bytes32 indexed beforeInboxAcc, address inbox, uint8 kind, address sender, bytes32 messageDataHash, uint256 baseFeeL1, uint64 timestamp)",
+	})
+	outboxABI = abi.MustParseAbi([]string{
+		"function executeTransaction(bytes32[] proof, uint256 index, address l2Sender, address to, uint256 l2Block, uint256 l1Block, uint256 l2Timestamp, uint256 value, bytes data)",
+		"function isSpent(uint256 index) view returns (bool)",
+	})
+)
+
+// fakeNode is a JSON-RPC node serving stubbed contract calls, a block,
+// receipts and logs, and recording raw transactions.
+type fakeNode struct {
+	*rpctest.Node
+	mu       sync.Mutex
+	block    map[string]any
+	receipts map[common.Hash]map[string]any
+	logs     []map[string]any
+}
+
+func newFakeNode(chainID uint64) *fakeNode {
+	n := &fakeNode{
+		Node:     rpctest.NewNode(chainID),
+		receipts: map[common.Hash]map[string]any{},
+		block: map[string]any{
+			"number":        "0x64",
+			"hash":          common.HexToHash("0xb1").Hex(),
+			"parentHash":    common.Hash{}.Hex(),
+			"timestamp":     "0x3e8",
+			"baseFeePerGas": "0x5f5e100",
+			"gasLimit":      "0x4000000000000",
+			"gasUsed":       "0x0",
+			"transactions":  []any{},
+			"sendCount":     "0x10",
+			"sendRoot":      common.HexToHash("0x5e").Hex(),
+			"l1BlockNumber": "0x1234",
+		},
+	}
+	n.Result("eth_getBlockByNumber", n.block)
+	n.Handle("eth_getTransactionReceipt", n.getTransactionReceipt)
+	n.Handle("eth_getLogs", n.getLogs)
+	return n
+}
+
+func (n *fakeNode) addReceipt(hash common.Hash, status uint64, logs ...types.Log) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	rpcLogs := make([]map[string]any, len(logs))
+	for i, log := range logs {
+		rpcLogs[i] = logJSON(log, hash)
+	}
+	n.receipts[hash] = map[string]any{
+		"transactionHash":   hash.Hex(),
+		"transactionIndex":  "0x0",
+		"blockHash":         common.HexToHash("0xb1").Hex(),
+		"blockNumber":       "0x64",
+		"from":              senderAddr.Hex(),
+		"cumulativeGasUsed": "0x5208",
+		"gasUsed":           "0x5208",
+		"gasUsedForL1":      "0x1000",
+		"l1BlockNumber":     "0x1234",
+		"status":            hexutil.EncodeUint64(status),
+		"logs":              rpcLogs,
+		"effectiveGasPrice": "0x5f5e100",
+		"type":              "0x2",
+	}
+}
+
+func (n *fakeNode) addLog(log types.Log, txHash common.Hash) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.logs = append(n.logs, logJSON(log, txHash))
+}
+
+func logJSON(log types.Log, txHash common.Hash) map[string]any {
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.Hex()
+	}
+	return map[string]any{
+		"address":          log.Address.Hex(),
+		"topics":           topics,
+		"data":             hexutil.Encode(log.Data),
+		"blockNumber":      "0x64",
+		"blockHash":        common.HexToHash("0xb1").Hex(),
+		"transactionHash":  txHash.Hex(),
+		"transactionIndex": "0x0",
+		"logIndex":         "0x0",
+		"removed":          false,
+	}
+}
+
+func (n *fakeNode) getTransactionReceipt(params []json.RawMessage) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var hash common.Hash
+	_ = json.Unmarshal(params[0], &hash)
+	receipt, ok := n.receipts[hash]
+	if !ok {
+		return nil, nil
+	}
+	return receipt, nil
+}
+
+func (n *fakeNode) getLogs([]json.RawMessage) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	logs := n.logs
+	if logs == nil {
+		logs = []map[string]any{}
+	}
+	return logs, nil
+}
+
+func encodeParams(types string, values ...any) []byte {
+	params, err := abi.ParseAbiParameters(types)
+	Expect(err).NotTo(HaveOccurred())
+	data, err := abi.EncodeAbiParameters(params, values)
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
+
+func eventTopic(a *abi.ABI, name string) common.Hash {
+	event, err := a.GetEvent(name)
+	Expect(err).NotTo(HaveOccurred())
+	return event.Topic
+}
+
+// retryableLogs builds the Bridge MessageDelivered and Inbox
+// InboxMessageDelivered logs of a retryable ticket.
+func retryableLogs(messageNum int64, sender common.Address, l1BaseFee, deposit *big.Int, request arbitrum.RetryableTicketRequest) []types.Log {
+	word := func(b []byte) []byte { return common.LeftPadBytes(b, 32) }
+	var message []byte
+	message = append(message, word(request.To.Bytes())...)
+	message = append(message, word(request.L2CallValue.Bytes())...)
+	message = append(message, word(deposit.Bytes())...)
+	message = append(message, word(request.MaxSubmissionCost.Bytes())...)
+	message = append(message, word(request.ExcessFeeRefundAddress.Bytes())...)
+	message = append(message, word(request.CallValueRefundAddress.Bytes())...)
+	message = append(message, word(request.GasLimit.Bytes())...)
+	message = append(message, word(request.MaxFeePerGas.Bytes())...)
+	message = append(message, word(big.NewInt(int64(len(request.Data))).Bytes())...)
+	message = append(message, request.Data...)
+
+	num := common.BigToHash(big.NewInt(messageNum))
+	contracts, _ := arbitrum.GetL1Contracts(42_161)
+	return []types.Log{
+		{
+			Address: contracts.Bridge,
+			Topics:  []common.Hash{eventTopic(bridgeABI, "MessageDelivered"), num, common.HexToHash("0xacc")},
+			Data: encodeParams("address, uint8, address, bytes32, uint256, uint64",
+				contracts.Inbox, uint8(9), sender, [32]byte(crypto.Keccak256Hash(message)), l1BaseFee, uint64(1_000)),
+		},
+		{
+			Address: contracts.Inbox,
+			Topics:  []common.Hash{eventTopic(inboxABI, "InboxMessageDelivered"), num},
+			Data:    encodeParams("bytes", message),
+		},
+	}
+}
+
+// redeemScheduledLog builds the RedeemScheduled log of a ticket redemption.
+func redeemScheduledLog(ticketID, retryHash common.Hash) types.Log {
+	return types.Log{
+		Address: arbitrum.ArbRetryableTxAddress,
+		Topics:  []common.Hash{eventTopic(arbRetryableTxABI, "RedeemScheduled"), ticketID, retryHash, common.BigToHash(big.NewInt(0))},
+		Data:    encodeParams("uint64, address, uint256, uint256", uint64(0), senderAddr, big.NewInt(0), big.NewInt(0)),
+	}
+}
+
+// l2ToL1Log builds the ArbSys L2ToL1Tx log of a message.
+func l2ToL1Log(m arbitrum.L2ToL1Message) types.Log {
+	return types.Log{
+		Address: arbitrum.ArbSysAddress,
+		Topics: []common.Hash{
+			eventTopic(arbSysABI, "L2ToL1Tx"),
+			common.BytesToHash(m.Destination.Bytes()),
+			common.BigToHash(m.Hash),
+			common.BigToHash(m.Position),
+		},
+		Data: encodeParams("address, uint256, uint256, uint256, uint256, bytes",
+			m.Caller, m.ArbBlockNum, m.EthBlockNum, m.Timestamp, m.CallValue, m.Data),
+	}
+}
+
+var _ = Describe("Arbitrum", func() {
+	var (
+		ctx  context.Context
+		node *fakeNode
+		pc   *client.PublicClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		node = newFakeNode(42_161)
+		var err error
+		pc, err = client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(node.URL)})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		node.Close()
+	})
+
+	Describe("gas estimation", func() {
+		It("splits the estimate into its L1 and L2 portions", func() {
+			var args []any
+			node.Stub(arbitrum.NodeInterfaceAddress, nodeInterfaceABI, map[string]func([]any) []any{
+				"gasEstimateComponents": func(a []any) []any {
+					args = a
+					return []any{uint64(600_000), uint64(400_000), big.NewInt(100_000_000), big.NewInt(30_000_000_000)}
+				},
+			})
+
+			components, err := arbitrum.EstimateGasComponents(ctx, pc, arbitrum.EstimateGasComponentsParameters{
+				Account: &senderAddr,
+				To:      &targetAddr,
+				Data:    []byte{0xde, 0xad},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(components.GasEstimate).To(Equal(uint64(600_000)))
+			Expect(components.GasEstimateForL1).To(Equal(uint64(400_000)))
+			Expect(components.L2Gas()).To(Equal(uint64(200_000)))
+			Expect(components.BaseFee).To(Equal(big.NewInt(100_000_000)))
+			Expect(components.L1BaseFeeEstimate).To(Equal(big.NewInt(30_000_000_000)))
+
+			Expect(args[0]).To(Equal(targetAddr))
+			Expect(args[1]).To(BeFalse())
+			Expect(args[2]).To(Equal([]byte{0xde, 0xad}))
+		})
+
+		It("flags contract creation when To is nil", func() {
+			var args []any
+			node.Stub(arbitrum.NodeInterfaceAddress, nodeInterfaceABI, map[string]func([]any) []any{
+				"gasEstimateL1Component": func(a []any) []any {
+					args = a
+					return []any{uint64(123), big.NewInt(1), big.NewInt(2)}
+				},
+			})
+
+			component, err := arbitrum.EstimateL1GasComponent(ctx, pc, arbitrum.EstimateGasComponentsParameters{Data: []byte{0x60}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(component.GasEstimateForL1).To(Equal(uint64(123)))
+			Expect(args[0]).To(Equal(common.Address{}))
+			Expect(args[1]).To(BeTrue())
+		})
+	})
+
+	Describe("ArbGasInfo", func() {
+		BeforeEach(func() {
+			node.Stub(arbitrum.ArbGasInfoAddress, arbGasInfoABI, map[string]func([]any) []any{
+				"getPricesInWei":         rpctest.Returns(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4), big.NewInt(5), big.NewInt(9)),
+				"getL1BaseFeeEstimate":   rpctest.Returns(big.NewInt(30_000_000_000)),
+				"getMinimumGasPrice":     rpctest.Returns(big.NewInt(10_000_000)),
+				"getGasAccountingParams": rpctest.Returns(big.NewInt(7_000_000), big.NewInt(32_000_000), big.NewInt(32_000_000)),
+			})
+		})
+
+		It("returns the gas prices", func() {
+			prices, err := arbitrum.GetPricesInWei(ctx, pc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(prices.PerL2Tx).To(Equal(big.NewInt(1)))
+			Expect(prices.PerL1CalldataByte).To(Equal(big.NewInt(2)))
+			Expect(prices.PerArbGasTotal).To(Equal(big.NewInt(9)))
+		})
+
+		It("returns the L1 base fee estimate and minimum gas price", func() {
+			fee, err := arbitrum.GetL1BaseFeeEstimate(ctx, pc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fee).To(Equal(big.NewInt(30_000_000_000)))
+
+			price, err := arbitrum.GetMinimumGasPrice(ctx, pc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(price).To(Equal(big.NewInt(10_000_000)))
+		})
+
+		It("returns the gas accounting parameters", func() {
+			params, err := arbitrum.GetGasAccountingParams(ctx, pc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(params.SpeedLimitPerSecond).To(Equal(big.NewInt(7_000_000)))
+			Expect(params.MaxTxGasLimit).To(Equal(big.NewInt(32_000_000)))
+		})
+	})
+
+	Describe("formatters", func() {
+		It("decodes the Arbitrum block and receipt fields", func() {
+			arbClient, err := client.CreatePublicClient(client.PublicClientConfig{
+				Chain:     &definitions.Arbitrum,
+				Transport: transport.HTTP(node.URL),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			block, err := arbClient.GetBlock(ctx, client.BlockTagLatest, false)
+			Expect(err).NotTo(HaveOccurred())
+			fields, ok := block.ChainFields.(*arbitrum.BlockFields)
+			Expect(ok).To(BeTrue())
+			Expect(fields.SendCount).To(Equal(uint64(16)))
+			Expect(fields.SendRoot).To(Equal(common.HexToHash("0x5e")))
+			Expect(fields.L1BlockNumber).To(Equal(uint64(0x1234)))
+
+			hash := common.HexToHash("0xaa")
+			node.addReceipt(hash, 1)
+			receipt, err := public.GetTransactionReceipt(ctx, arbClient, public.GetTransactionReceiptParameters{Hash: hash})
+			Expect(err).NotTo(HaveOccurred())
+			receiptFields, ok := receipt.ChainFields.(*arbitrum.ReceiptFields)
+			Expect(ok).To(BeTrue())
+			Expect(receiptFields.GasUsedForL1).To(Equal(uint64(0x1000)))
+		})
+	})
+
+	Describe("retryable tickets", func() {
+		var request arbitrum.RetryableTicketRequest
+
+		BeforeEach(func() {
+			request = arbitrum.RetryableTicketRequest{
+				To:                     targetAddr,
+				L2CallValue:            big.NewInt(1_000),
+				MaxSubmissionCost:      big.NewInt(50_000),
+				ExcessFeeRefundAddress: refundAddr,
+				CallValueRefundAddress: refundAddr,
+				GasLimit:               big.NewInt(100_000),
+				MaxFeePerGas:           big.NewInt(100_000_000),
+				Data:                   []byte{0xca, 0xfe},
+			}
+		})
+
+		It("computes the deposit", func() {
+			Expect(request.Deposit()).To(Equal(big.NewInt(100_000*100_000_000 + 50_000 + 1_000)))
+		})
+
+		It("aliases L1 contract addresses", func() {
+			Expect(arbitrum.ApplyL1ToL2Alias(common.HexToAddress("0x0000000000000000000000000000000000000001"))).
+				To(Equal(common.HexToAddress("0x1111000000000000000000000000000000001112")))
+			Expect(arbitrum.ApplyL1ToL2Alias(common.HexToAddress("0xffffffffffffffffffffffffffffffffffffffff"))).
+				To(Equal(common.HexToAddress("0x1111000000000000000000000000000000001110")))
+		})
+
+		It("parses tickets from the L1 receipt and derives their IDs", func() {
+			sender := arbitrum.ApplyL1ToL2Alias(senderAddr)
+			deposit := request.Deposit()
+			receipt := &types.Receipt{Logs: retryableLogs(42, sender, big.NewInt(20_000_000_000), deposit, request)}
+
+			tickets, err := arbitrum.GetRetryableTickets(receipt, 42_161)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tickets).To(HaveLen(1))
+			ticket := tickets[0]
+			Expect(ticket.MessageNumber).To(Equal(big.NewInt(42)))
+			Expect(ticket.From).To(Equal(sender))
+			Expect(ticket.Deposit).To(Equal(deposit))
+			Expect(ticket.Request.To).To(Equal(targetAddr))
+			Expect(ticket.Request.Data).To(Equal([]byte{0xca, 0xfe}))
+			Expect(ticket.Request.GasLimit).To(Equal(big.NewInt(100_000)))
+
+			enc, err := rlp.EncodeToBytes([]any{
+				big.NewInt(42_161),
+				common.BigToHash(big.NewInt(42)),
+				sender,
+				big.NewInt(20_000_000_000),
+				deposit,
+				big.NewInt(100_000_000),
+				uint64(100_000),
+				targetAddr,
+				big.NewInt(1_000),
+				refundAddr,
+				big.NewInt(50_000),
+				refundAddr,
+				[]byte{0xca, 0xfe},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ticket.ID).To(Equal(crypto.Keccak256Hash([]byte{arbitrum.SubmitRetryableTxType}, enc)))
+		})
+
+		It("ignores logs that are not retryable tickets", func() {
+			logs := retryableLogs(1, senderAddr, big.NewInt(1), request.Deposit(), request)
+			logs[0].Data = encodeParams("address, uint8, address, bytes32, uint256, uint64",
+				senderAddr, uint8(3), senderAddr, [32]byte{}, big.NewInt(1), uint64(1))
+			tickets, err := arbitrum.GetRetryableTickets(&types.Receipt{Logs: logs}, 42_161)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tickets).To(BeEmpty())
+		})
+
+		It("rejects nil receipts", func() {
+			_, err := arbitrum.GetRetryableTickets(nil, 42_161)
+			Expect(errors.Is(err, arbitrum.ErrInvalidRetryableMessage)).To(BeTrue())
+		})
+
+		It("returns the submission fee from the Inbox", func() {
+			contracts, _ := arbitrum.GetL1Contracts(42_161)
+			var args []any
+			node.Stub(contracts.Inbox, inboxABI, map[string]func([]any) []any{
+				"calculateRetryableSubmissionFee": func(a []any) []any {
+					args = a
+					return []any{big.NewInt(77)}
+				},
+			})
+
+			fee, err := arbitrum.GetRetryableSubmissionFee(ctx, pc, arbitrum.GetRetryableSubmissionFeeParameters{
+				DataLength:  2,
+				TargetChain: &definitions.Arbitrum,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fee).To(Equal(big.NewInt(77)))
+			Expect(args[0]).To(Equal(big.NewInt(2)))
+			Expect(args[1]).To(Equal(big.NewInt(100_000_000)))
+
+			_, err = arbitrum.GetRetryableSubmissionFee(ctx, pc, arbitrum.GetRetryableSubmissionFeeParameters{
+				TargetChain: &chain.Chain{ID: 12345},
+			})
+			Expect(errors.Is(err, arbitrum.ErrContractNotFound)).To(BeTrue())
+		})
+
+		Describe("status", func() {
+			ticketID := common.HexToHash("0x71c7")
+			retryHash := common.HexToHash("0x7e7")
+
+			status := func() arbitrum.RetryableStatus {
+				s, err := arbitrum.GetRetryableStatus(ctx, pc, arbitrum.GetRetryableStatusParameters{TicketID: ticketID})
+				Expect(err).NotTo(HaveOccurred())
+				return s
+			}
+
+			It("is not yet created without a creation receipt", func() {
+				Expect(status()).To(Equal(arbitrum.RetryableStatusNotYetCreated))
+			})
+
+			It("reports a failed creation", func() {
+				node.addReceipt(ticketID, 0)
+				Expect(status()).To(Equal(arbitrum.RetryableStatusCreationFailed))
+			})
+
+			It("is redeemed when the automatic redemption succeeded", func() {
+				node.addReceipt(ticketID, 1, redeemScheduledLog(ticketID, retryHash))
+				node.addReceipt(retryHash, 1)
+				Expect(status()).To(Equal(arbitrum.RetryableStatusRedeemed))
+			})
+
+			It("has funds deposited while the ticket is alive", func() {
+				node.addReceipt(ticketID, 1, redeemScheduledLog(ticketID, retryHash))
+				node.addReceipt(retryHash, 0)
+				node.Stub(arbitrum.ArbRetryableTxAddress, arbRetryableTxABI, map[string]func([]any) []any{
+					"getTimeout": rpctest.Returns(big.NewInt(1_000_000)),
+				})
+				Expect(status()).To(Equal(arbitrum.RetryableStatusFundsDeposited))
+			})
+
+			It("is redeemed when a manual redemption succeeded", func() {
+				node.addReceipt(ticketID, 1)
+				node.addLog(redeemScheduledLog(ticketID, retryHash), common.HexToHash("0x4ed"))
+				node.addReceipt(retryHash, 1)
+				Expect(status()).To(Equal(arbitrum.RetryableStatusRedeemed))
+			})
+
+			It("is expired when the ticket is gone and was never redeemed", func() {
+				node.addReceipt(ticketID, 1)
+				Expect(status()).To(Equal(arbitrum.RetryableStatusExpired))
+			})
+		})
+	})
+
+	Describe("wallet actions", func() {
+		var (
+			l1Node   *fakeNode
+			l1Wallet *client.WalletClient
+			l2Wallet *client.WalletClient
+			address  common.Address
+		)
+
+		BeforeEach(func() {
+			l1Node = newFakeNode(1)
+			account, err := accounts.PrivateKeyToAccount(testPrivateKey)
+			Expect(err).NotTo(HaveOccurred())
+			address = account.Address()
+			l1Wallet, err = client.CreateWalletClient(client.WalletClientConfig{
+				Account:   account,
+				Chain:     &chain.Chain{ID: 1, Name: "L1"},
+				Transport: transport.HTTP(l1Node.URL),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			l2Wallet, err = client.CreateWalletClient(client.WalletClientConfig{
+				Account:   account,
+				Chain:     &definitions.Arbitrum,
+				Transport: transport.HTTP(node.URL),
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			l1Node.Close()
+		})
+
+		decodeCall := func(a *abi.ABI, tx *gethtypes.Transaction) *abi.DecodedFunctionData {
+			decoded, err := a.DecodeFunctionData(tx.Data())
+			Expect(err).NotTo(HaveOccurred())
+			return decoded
+		}
+
+		It("creates retryable tickets through the Inbox", func() {
+			request := arbitrum.RetryableTicketRequest{
+				To:                targetAddr,
+				L2CallValue:       big.NewInt(1_000),
+				MaxSubmissionCost: big.NewInt(50_000),
+				GasLimit:          big.NewInt(100_000),
+				MaxFeePerGas:      big.NewInt(100_000_000),
+			}
+			_, err := arbitrum.CreateRetryableTicket(ctx, l1Wallet, arbitrum.CreateRetryableTicketParameters{
+				Request:     request,
+				TargetChain: &definitions.Arbitrum,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			contracts, _ := arbitrum.GetL1Contracts(42_161)
+			sent := l1Node.SentTxs()
+			Expect(sent).To(HaveLen(1))
+			Expect(*sent[0].To()).To(Equal(contracts.Inbox))
+			Expect(sent[0].Value()).To(Equal(request.Deposit()))
+
+			decoded := decodeCall(inboxABI, sent[0])
+			Expect(decoded.FunctionName).To(Equal("createRetryableTicket"))
+			Expect(decoded.Args[0]).To(Equal(targetAddr))
+			Expect(decoded.Args[3]).To(Equal(address))
+			Expect(decoded.Args[4]).To(Equal(address))
+			Expect(decoded.Args[5]).To(Equal(big.NewInt(100_000)))
+		})
+
+		It("redeems retryable tickets through ArbRetryableTx", func() {
+			ticketID := common.HexToHash("0x71c7")
+			_, err := arbitrum.RedeemRetryable(ctx, l2Wallet, arbitrum.RedeemRetryableParameters{TicketID: ticketID})
+			Expect(err).NotTo(HaveOccurred())
+
+			sent := node.SentTxs()
+			Expect(sent).To(HaveLen(1))
+			Expect(*sent[0].To()).To(Equal(arbitrum.ArbRetryableTxAddress))
+			decoded := decodeCall(arbRetryableTxABI, sent[0])
+			Expect(decoded.FunctionName).To(Equal("redeem"))
+			Expect(decoded.Args[0]).To(Equal([32]byte(ticketID)))
+		})
+
+		Describe("L2 -> L1 messages", func() {
+			var message arbitrum.L2ToL1Message
+
+			BeforeEach(func() {
+				message = arbitrum.L2ToL1Message{
+					Caller:      senderAddr,
+					Destination: targetAddr,
+					Hash:        big.NewInt(0xabc),
+					Position:    big.NewInt(5),
+					ArbBlockNum: big.NewInt(100),
+					EthBlockNum: big.NewInt(0x1234),
+					Timestamp:   big.NewInt(1_000),
+					CallValue:   big.NewInt(1e18),
+					Data:        []byte{0x01},
+				}
+			})
+
+			It("parses messages from the L2 receipt", func() {
+				receipt := &types.Receipt{Logs: []types.Log{l2ToL1Log(message)}}
+				messages, err := arbitrum.GetL2ToL1Messages(receipt)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(messages).To(Equal([]arbitrum.L2ToL1Message{message}))
+			})
+
+			It("rejects nil receipts and malformed L2ToL1Tx events", func() {
+				_, err := arbitrum.GetL2ToL1Messages(nil)
+				Expect(errors.Is(err, arbitrum.ErrInvalidL2ToL1Message)).To(BeTrue())
+
+				log := l2ToL1Log(message)
+				log.Data = log.Data[:32]
+				_, err = arbitrum.GetL2ToL1Messages(&types.Receipt{Logs: []types.Log{log}})
+				Expect(errors.Is(err, arbitrum.ErrInvalidL2ToL1Message)).To(BeTrue())
+			})
+
+			It("builds the outbox proof against the latest send count", func() {
+				var args []any
+				node.Stub(arbitrum.NodeInterfaceAddress, nodeInterfaceABI, map[string]func([]any) []any{
+					"constructOutboxProof": func(a []any) []any {
+						args = a
+						return []any{[32]byte{0x0a}, [32]byte{0x0b}, [][32]byte{{0x01}, {0x02}}}
+					},
+				})
+
+				proof, err := arbitrum.BuildOutboxProof(ctx, pc, arbitrum.BuildOutboxProofParameters{Message: message})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(args).To(Equal([]any{uint64(16), uint64(5)}))
+				Expect(proof.Send).To(Equal(common.Hash{0x0a}))
+				Expect(proof.Root).To(Equal(common.Hash{0x0b}))
+				Expect(proof.Proof).To(Equal([]common.Hash{{0x01}, {0x02}}))
+			})
+
+			It("rejects messages outside the send tree", func() {
+				_, err := arbitrum.BuildOutboxProof(ctx, pc, arbitrum.BuildOutboxProofParameters{Message: message, SendCount: 5})
+				Expect(errors.Is(err, arbitrum.ErrMessageNotInSendTree)).To(BeTrue())
+			})
+
+			It("executes messages through the Outbox", func() {
+				proof := arbitrum.OutboxProof{Proof: []common.Hash{{0x01}, {0x02}}}
+				_, err := arbitrum.ExecuteL2ToL1Message(ctx, l1Wallet, arbitrum.ExecuteL2ToL1MessageParameters{
+					Message:     message,
+					Proof:       proof,
+					TargetChain: &definitions.Arbitrum,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				contracts, _ := arbitrum.GetL1Contracts(42_161)
+				sent := l1Node.SentTxs()
+				Expect(sent).To(HaveLen(1))
+				Expect(*sent[0].To()).To(Equal(contracts.Outbox))
+				decoded := decodeCall(outboxABI, sent[0])
+				Expect(decoded.FunctionName).To(Equal("executeTransaction"))
+				Expect(decoded.Args[0]).To(Equal([][32]byte{{0x01}, {0x02}}))
+				Expect(decoded.Args[1]).To(Equal(big.NewInt(5)))
+				Expect(decoded.Args[2]).To(Equal(senderAddr))
+				Expect(decoded.Args[3]).To(Equal(targetAddr))
+				Expect(decoded.Args[7]).To(Equal(big.NewInt(1e18)))
+			})
+
+			It("reports whether messages were executed", func() {
+				contracts, _ := arbitrum.GetL1Contracts(42_161)
+				l1Node.Stub(contracts.Outbox, outboxABI, map[string]func([]any) []any{
+					"isSpent": rpctest.Returns(true),
+				})
+				l1Client, err := client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(l1Node.URL)})
+				Expect(err).NotTo(HaveOccurred())
+
+				executed, err := arbitrum.IsL2ToL1MessageExecuted(ctx, l1Client, arbitrum.IsL2ToL1MessageExecutedParameters{
+					Message:     message,
+					TargetChain: &definitions.Arbitrum,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(executed).To(BeTrue())
+			})
+		})
+
+		It("binds the actions for client extensions", func() {
+			node.Stub(arbitrum.ArbGasInfoAddress, arbGasInfoABI, map[string]func([]any) []any{
+				"getL1BaseFeeEstimate": rpctest.Returns(big.NewInt(30)),
+			})
+			pc.Extend("arbitrum", arbitrum.PublicActionsL2(pc))
+			ext, ok := pc.GetExtension("arbitrum")
+			Expect(ok).To(BeTrue())
+			getL1BaseFeeEstimate, ok := ext.(map[string]any)["getL1BaseFeeEstimate"].(func(context.Context) (*big.Int, error))
+			Expect(ok).To(BeTrue())
+			fee, err := getL1BaseFeeEstimate(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fee).To(Equal(big.NewInt(30)))
+
+			l2Actions := arbitrum.WalletActionsL2(l2Wallet)
+			Expect(l2Actions).To(HaveKey("redeemRetryable"))
+			l1Actions := arbitrum.WalletActionsL1(l1Wallet)
+			Expect(l1Actions).To(HaveKey("createRetryableTicket"))
+			Expect(l1Actions).To(HaveKey("executeL2ToL1Message"))
+			Expect(arbitrum.PublicActionsL1(pc)).To(HaveKey("getRetryableSubmissionFee"))
+		})
+	})
+})
@@ -0,0 +1,184 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	json "github.com/goccy/go-json"
+)
+
+// GetGenesis returns the genesis time, validators root and fork version.
+// The result is cached after the first successful call.
+func (c *Client) GetGenesis(ctx context.Context) (*Genesis, error) {
+	c.mu.Lock()
+	cached := c.genesis
+	c.mu.Unlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	var genesis Genesis
+	if err := c.Request(ctx, "/eth/v1/beacon/genesis", nil, &genesis); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.genesis = &genesis
+	c.mu.Unlock()
+	return &genesis, nil
+}
+
+// GetSpec returns the chain specification of /eth/v1/config/spec. Values
+// are kept as raw JSON (most are decimal or hex strings).
+func (c *Client) GetSpec(ctx context.Context) (map[string]json.RawMessage, error) {
+	var spec map[string]json.RawMessage
+	if err := c.Request(ctx, "/eth/v1/config/spec", nil, &spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// GetSecondsPerSlot returns the SECONDS_PER_SLOT value of the spec. The
+// result is cached after the first successful call.
+func (c *Client) GetSecondsPerSlot(ctx context.Context) (uint64, error) {
+	c.mu.Lock()
+	cached := c.secondsPerSlot
+	c.mu.Unlock()
+	if cached != 0 {
+		return cached, nil
+	}
+
+	spec, err := c.GetSpec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var value string
+	if err := json.Unmarshal(spec["SECONDS_PER_SLOT"], &value); err != nil {
+		return 0, fmt.Errorf("beacon: invalid SECONDS_PER_SLOT: %w", err)
+	}
+	seconds, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || seconds == 0 {
+		return 0, fmt.Errorf("beacon: invalid SECONDS_PER_SLOT %q", value)
+	}
+	c.mu.Lock()
+	c.secondsPerSlot = seconds
+	c.mu.Unlock()
+	return seconds, nil
+}
+
+// SlotAt returns the slot of a post-merge execution block from its
+// timestamp.
+func (c *Client) SlotAt(ctx context.Context, timestamp uint64) (uint64, error) {
+	genesis, err := c.GetGenesis(ctx)
+	if err != nil {
+		return 0, err
+	}
+	seconds, err := c.GetSecondsPerSlot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if timestamp < genesis.GenesisTime {
+		return 0, fmt.Errorf("beacon: timestamp %d is before genesis time %d", timestamp, genesis.GenesisTime)
+	}
+	return (timestamp - genesis.GenesisTime) / seconds, nil
+}
+
+// GetHeader returns the header of a block. blockID is a slot number, a
+// 0x-prefixed block root, or BlockHead, BlockGenesis or BlockFinalized.
+//
+// Example:
+//
+//	header, err := bc.GetHeader(ctx, beacon.BlockFinalized)
+//	fmt.Println(header.Header.Message.Slot)
+func (c *Client) GetHeader(ctx context.Context, blockID string) (*BlockHeader, error) {
+	var header BlockHeader
+	if err := c.Request(ctx, "/eth/v1/beacon/headers/"+url.PathEscape(blockID), nil, &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+// GetFinalityCheckpoints returns the justified and finalized checkpoints of
+// a state. stateID is a slot number, a 0x-prefixed state root, or one of the
+// State* identifiers.
+func (c *Client) GetFinalityCheckpoints(ctx context.Context, stateID string) (*FinalityCheckpoints, error) {
+	var checkpoints FinalityCheckpoints
+	path := "/eth/v1/beacon/states/" + url.PathEscape(stateID) + "/finality_checkpoints"
+	if err := c.Request(ctx, path, nil, &checkpoints); err != nil {
+		return nil, err
+	}
+	return &checkpoints, nil
+}
+
+// GetValidator returns a validator of a state by index or 0x-prefixed
+// public key.
+func (c *Client) GetValidator(ctx context.Context, stateID, validatorID string) (*Validator, error) {
+	var validator Validator
+	path := "/eth/v1/beacon/states/" + url.PathEscape(stateID) + "/validators/" + url.PathEscape(validatorID)
+	if err := c.Request(ctx, path, nil, &validator); err != nil {
+		return nil, err
+	}
+	return &validator, nil
+}
+
+// GetValidatorsParameters filters the validators returned by GetValidators.
+type GetValidatorsParameters struct {
+	// IDs are validator indices or 0x-prefixed public keys. Empty returns
+	// every validator, which is a very large response on mainnet.
+	IDs []string
+	// Statuses restricts the result to validators with these statuses.
+	Statuses []ValidatorStatus
+}
+
+// GetValidators returns the validators of a state.
+//
+// Example:
+//
+//	validators, err := bc.GetValidators(ctx, beacon.StateHead, beacon.GetValidatorsParameters{
+//		IDs: []string{"1", "2"},
+//	})
+func (c *Client) GetValidators(ctx context.Context, stateID string, params GetValidatorsParameters) ([]Validator, error) {
+	query := url.Values{}
+	if len(params.IDs) > 0 {
+		query.Set("id", strings.Join(params.IDs, ","))
+	}
+	if len(params.Statuses) > 0 {
+		statuses := make([]string, len(params.Statuses))
+		for i, s := range params.Statuses {
+			statuses[i] = string(s)
+		}
+		query.Set("status", strings.Join(statuses, ","))
+	}
+
+	var validators []Validator
+	path := "/eth/v1/beacon/states/" + url.PathEscape(stateID) + "/validators"
+	if err := c.Request(ctx, path, query, &validators); err != nil {
+		return nil, err
+	}
+	return validators, nil
+}
+
+// GetBlobSidecars returns the blob sidecars of a block. indices restricts
+// the result to the given blob indices; nil returns every sidecar.
+//
+// Example:
+//
+//	sidecars, err := bc.GetBlobSidecars(ctx, "8626178", nil)
+func (c *Client) GetBlobSidecars(ctx context.Context, blockID string, indices []uint64) ([]BlobSidecar, error) {
+	query := url.Values{}
+	if len(indices) > 0 {
+		parts := make([]string, len(indices))
+		for i, index := range indices {
+			parts[i] = strconv.FormatUint(index, 10)
+		}
+		query.Set("indices", strings.Join(parts, ","))
+	}
+
+	var sidecars []BlobSidecar
+	if err := c.Request(ctx, "/eth/v1/beacon/blob_sidecars/"+url.PathEscape(blockID), query, &sidecars); err != nil {
+		return nil, err
+	}
+	return sidecars, nil
+}
@@ -0,0 +1,128 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/utils/blob"
+	"github.com/ChefBingbong/viem-go/utils/kzg"
+)
+
+var (
+	// ErrNotBlobTransaction is returned by GetBlobData for transactions
+	// without blob versioned hashes.
+	ErrNotBlobTransaction = errors.New("beacon: transaction has no blobs")
+	// ErrTransactionPending is returned by GetBlobData for transactions that
+	// are not included in a block yet.
+	ErrTransactionPending = errors.New("beacon: transaction is pending")
+	// ErrBlobNotFound is returned when the beacon node has no sidecar for a
+	// versioned hash of the transaction (e.g. it was pruned after the
+	// retention period of about 18 days).
+	ErrBlobNotFound = errors.New("beacon: blob sidecar not found")
+	// ErrBlobMismatch is returned when a sidecar's blob does not match its
+	// KZG commitment.
+	ErrBlobMismatch = errors.New("beacon: blob does not match its commitment")
+)
+
+// GetBlobDataParameters contains the parameters for GetBlobData.
+type GetBlobDataParameters struct {
+	// Hash is the hash of the blob transaction. Required.
+	Hash common.Hash
+	// Kzg recomputes the commitment of each blob to verify it. Default:
+	// kzg.Default().
+	Kzg kzg.Kzg
+}
+
+// BlobData is the result of GetBlobData.
+type BlobData struct {
+	// Slot is the beacon slot of the block that included the transaction.
+	Slot uint64
+	// Sidecars are the verified sidecars, in the order of the transaction's
+	// versioned hashes.
+	Sidecars []BlobSidecar
+	// Blobs are the raw blobs, in the same order.
+	Blobs [][]byte
+	// Data is the payload decoded from the blobs with blob.FromBlobs.
+	Data []byte
+}
+
+// GetBlobData fetches the blobs of an included blob transaction from a
+// beacon node, verifies them against the transaction's versioned hashes and
+// returns the decoded payload.
+//
+// The transaction and its block are read from the execution client, the
+// block timestamp is converted to a slot, and the slot's sidecars are
+// fetched. Each blob's KZG commitment is recomputed with params.Kzg and must
+// match both the sidecar commitment and the versioned hash.
+//
+// Example:
+//
+//	data, err := beacon.GetBlobData(ctx, publicClient, bc, beacon.GetBlobDataParameters{
+//		Hash: txHash,
+//	})
+//	fmt.Println(string(data.Data))
+func GetBlobData(ctx context.Context, client public.Client, bc *Client, params GetBlobDataParameters) (*BlobData, error) {
+	kzgImpl := params.Kzg
+	if kzgImpl == nil {
+		kzgImpl = kzg.Default()
+	}
+
+	tx, err := public.GetTransaction(ctx, client, public.GetTransactionParameters{Hash: &params.Hash})
+	if err != nil {
+		return nil, err
+	}
+	if len(tx.BlobVersionedHashes) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotBlobTransaction, params.Hash.Hex())
+	}
+	if tx.BlockNumber == nil {
+		return nil, fmt.Errorf("%w: %s", ErrTransactionPending, params.Hash.Hex())
+	}
+
+	block, err := public.GetBlock(ctx, client, public.GetBlockParameters{BlockNumber: tx.BlockNumber})
+	if err != nil {
+		return nil, err
+	}
+	slot, err := bc.SlotAt(ctx, block.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	sidecars, err := bc.GetBlobSidecars(ctx, strconv.FormatUint(slot, 10), nil)
+	if err != nil {
+		return nil, fmt.Errorf("beacon: failed to get blob sidecars of slot %d: %w", slot, err)
+	}
+
+	byHash := make(map[common.Hash]BlobSidecar, len(sidecars))
+	for _, sidecar := range sidecars {
+		hash := common.BytesToHash(blob.CommitmentToVersionedHashDefault(sidecar.KzgCommitment))
+		byHash[hash] = sidecar
+	}
+
+	result := &BlobData{Slot: slot}
+	for _, hash := range tx.BlobVersionedHashes {
+		sidecar, ok := byHash[hash]
+		if !ok {
+			return nil, fmt.Errorf("%w: versioned hash %s in slot %d", ErrBlobNotFound, hash.Hex(), slot)
+		}
+		commitment, err := kzgImpl.BlobToKzgCommitment(sidecar.Blob)
+		if err != nil {
+			return nil, fmt.Errorf("beacon: failed to compute commitment of blob %d: %w", sidecar.Index, err)
+		}
+		if !bytes.Equal(commitment, sidecar.KzgCommitment) {
+			return nil, fmt.Errorf("%w: blob %d in slot %d", ErrBlobMismatch, sidecar.Index, slot)
+		}
+		result.Sidecars = append(result.Sidecars, sidecar)
+		result.Blobs = append(result.Blobs, sidecar.Blob)
+	}
+
+	result.Data, err = blob.FromBlobs(result.Blobs)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,172 @@
+// Package beacon implements a client for the consensus-layer Beacon node API
+// (https://ethereum.github.io/beacon-APIs) over HTTP.
+//
+// The client covers block headers, finality checkpoints, validators, genesis
+// and spec data, and blob sidecars. GetBlobData combines it with an execution
+// client to fetch the blobs of a transaction after inclusion, verify them
+// against the transaction's versioned hashes and decode the payload.
+//
+// Example:
+//
+//	bc, err := beacon.NewClient(beacon.Config{URL: "http://localhost:5052"})
+//	header, err := bc.GetHeader(ctx, beacon.BlockHead)
+//	data, err := beacon.GetBlobData(ctx, publicClient, bc, beacon.GetBlobDataParameters{
+//		Hash: txHash,
+//	})
+package beacon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/utils/rpc"
+)
+
+var (
+	// ErrNoURL is returned when Config.URL is empty.
+	ErrNoURL = errors.New("beacon: no URL configured")
+	// ErrNotFound is returned when the beacon node has no data for the
+	// requested block, state or validator (HTTP 404).
+	ErrNotFound = errors.New("beacon: not found")
+)
+
+// APIError is an error reported by the beacon node in a non-2xx response.
+type APIError struct {
+	// StatusCode is the HTTP status code.
+	StatusCode int
+	// Message is the error message of the response body.
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("beacon: request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("beacon: %s (status %d)", e.Message, e.StatusCode)
+}
+
+// Unwrap maps 404 responses to ErrNotFound.
+func (e *APIError) Unwrap() error {
+	if e.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Config configures a Client.
+type Config struct {
+	// URL is the beacon node API base URL, e.g. "http://localhost:5052".
+	// Required.
+	URL string
+	// Headers are added to every request (e.g. an API key for hosted nodes).
+	Headers map[string]string
+	// Timeout is the request timeout. Defaults to 30s, as blob sidecar
+	// responses can be several megabytes.
+	Timeout time.Duration
+	// HTTPClient allows providing a custom HTTP client.
+	HTTPClient *http.Client
+}
+
+// Client queries a Beacon node API. It is safe for concurrent use.
+type Client struct {
+	url        string
+	headers    map[string]string
+	httpClient *http.Client
+
+	mu             sync.Mutex
+	genesis        *Genesis
+	secondsPerSlot uint64
+}
+
+// NewClient creates a beacon client.
+func NewClient(config Config) (*Client, error) {
+	if config.URL == "" {
+		return nil, ErrNoURL
+	}
+	if _, err := url.Parse(config.URL); err != nil {
+		return nil, fmt.Errorf("beacon: invalid URL: %w", err)
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: timeout}
+	}
+	return &Client{
+		url:        strings.TrimSuffix(config.URL, "/"),
+		headers:    config.Headers,
+		httpClient: httpClient,
+	}, nil
+}
+
+// URL returns the beacon node API base URL.
+func (c *Client) URL() string {
+	return c.url
+}
+
+// Close releases idle connections.
+func (c *Client) Close() error {
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// Request performs a GET request on path (e.g. "/eth/v1/beacon/genesis")
+// and decodes the "data" field of the response into result (if non-nil).
+func (c *Client) Request(ctx context.Context, path string, query url.Values, result any) error {
+	reqURL := c.url + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return rpc.NewHTTPRequestError(reqURL, 0, "", nil, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return rpc.NewHTTPRequestError(reqURL, 0, "", nil, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return rpc.NewHTTPRequestError(reqURL, resp.StatusCode, resp.Status, nil, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(body, &apiErr)
+		return fmt.Errorf("%w: %w", &APIError{StatusCode: resp.StatusCode, Message: apiErr.Message},
+			rpc.NewHTTPRequestError(reqURL, resp.StatusCode, resp.Status, string(body), nil))
+	}
+	if result == nil {
+		return nil
+	}
+
+	var env struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &env); err != nil {
+		return fmt.Errorf("beacon: failed to unmarshal response of %s: %w", path, err)
+	}
+	if err := json.Unmarshal(env.Data, result); err != nil {
+		return fmt.Errorf("beacon: failed to unmarshal data of %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,355 @@
+package beacon_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/beacon"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/utils/blob"
+	"github.com/ChefBingbong/viem-go/utils/kzg"
+)
+
+const (
+	genesisTime = 1606824023
+	blockSlot   = 100
+)
+
+var (
+	blobTxHash     = common.HexToHash("0x01")
+	tamperedTxHash = common.HexToHash("0x02")
+	plainTxHash    = common.HexToHash("0x03")
+	pendingTxHash  = common.HexToHash("0x04")
+)
+
+// fixture serves both a Beacon API and an execution JSON-RPC endpoint.
+type fixture struct {
+	*httptest.Server
+	mu      sync.Mutex
+	paths   []string
+	queries []url.Values
+	payload []byte
+	hashes  map[common.Hash][]common.Hash
+}
+
+// blobFixture holds the sidecars served by every fixture, computed once as
+// KZG commitments are slow to compute.
+var blobFixture struct {
+	once     sync.Once
+	payload  []byte
+	hashes   map[common.Hash][]common.Hash
+	sidecars []map[string]any
+}
+
+func loadBlobFixture() {
+	blobFixture.once.Do(func() {
+		payload := []byte(strings.Repeat("hello beacon ", 12000))
+		blobs, err := blob.ToBlobs(payload)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(blobs)).To(Equal(2))
+
+		hashes := map[common.Hash][]common.Hash{}
+		var sidecars []map[string]any
+		for i, b := range blobs {
+			commitment, err := kzg.Default().BlobToKzgCommitment(b)
+			Expect(err).NotTo(HaveOccurred())
+			proof, err := kzg.Default().ComputeBlobKzgProof(b, commitment)
+			Expect(err).NotTo(HaveOccurred())
+			hashes[blobTxHash] = append(hashes[blobTxHash], common.BytesToHash(blob.CommitmentToVersionedHashDefault(commitment)))
+			sidecars = append(sidecars, sidecar(i, b, commitment, proof))
+		}
+
+		// A third sidecar whose blob does not match its commitment.
+		tampered := make([]byte, kzg.BytesPerBlob)
+		copy(tampered, blobs[0])
+		tampered[1] ^= 0xff
+		commitment, err := kzg.Default().BlobToKzgCommitment(blobs[1])
+		Expect(err).NotTo(HaveOccurred())
+		commitment[47] ^= 0x01
+		hashes[tamperedTxHash] = []common.Hash{common.BytesToHash(blob.CommitmentToVersionedHashDefault(commitment))}
+		sidecars = append(sidecars, sidecar(2, tampered, commitment, make([]byte, 48)))
+
+		blobFixture.payload = payload
+		blobFixture.hashes = hashes
+		blobFixture.sidecars = sidecars
+	})
+}
+
+func newFixture() *fixture {
+	loadBlobFixture()
+	f := &fixture{payload: blobFixture.payload, hashes: blobFixture.hashes}
+	sidecars := blobFixture.sidecars
+
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			f.rpc(w, r)
+			return
+		}
+
+		f.mu.Lock()
+		f.paths = append(f.paths, r.URL.Path)
+		f.queries = append(f.queries, r.URL.Query())
+		f.mu.Unlock()
+
+		var data any
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			data = map[string]any{
+				"genesis_time":            "1606824023",
+				"genesis_validators_root": "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+				"genesis_fork_version":    "0x00000000",
+			}
+		case "/eth/v1/config/spec":
+			data = map[string]any{"SECONDS_PER_SLOT": "12", "BLOB_SCHEDULE": []any{}}
+		case "/eth/v1/beacon/headers/head":
+			data = map[string]any{
+				"root":      "0x00000000000000000000000000000000000000000000000000000000000000aa",
+				"canonical": true,
+				"header": map[string]any{
+					"message": map[string]any{
+						"slot":           "100",
+						"proposer_index": "7",
+						"parent_root":    "0x00000000000000000000000000000000000000000000000000000000000000bb",
+						"state_root":     "0x00000000000000000000000000000000000000000000000000000000000000cc",
+						"body_root":      "0x00000000000000000000000000000000000000000000000000000000000000dd",
+					},
+					"signature": "0x" + strings.Repeat("00", 96),
+				},
+			}
+		case "/eth/v1/beacon/states/head/finality_checkpoints":
+			checkpoint := func(epoch string) map[string]any {
+				return map[string]any{"epoch": epoch, "root": "0x00000000000000000000000000000000000000000000000000000000000000ee"}
+			}
+			data = map[string]any{
+				"previous_justified": checkpoint("2"),
+				"current_justified":  checkpoint("3"),
+				"finalized":          checkpoint("1"),
+			}
+		case "/eth/v1/beacon/states/head/validators", "/eth/v1/beacon/states/head/validators/1":
+			v := map[string]any{
+				"index":   "1",
+				"balance": "32000000000",
+				"status":  "active_ongoing",
+				"validator": map[string]any{
+					"pubkey":                       "0x" + strings.Repeat("ab", 48),
+					"withdrawal_credentials":       "0x00000000000000000000000000000000000000000000000000000000000000ff",
+					"effective_balance":            "32000000000",
+					"slashed":                      false,
+					"activation_eligibility_epoch": "0",
+					"activation_epoch":             "0",
+					"exit_epoch":                   "18446744073709551615",
+					"withdrawable_epoch":           "18446744073709551615",
+				},
+			}
+			if strings.HasSuffix(r.URL.Path, "/1") {
+				data = v
+			} else {
+				data = []any{v}
+			}
+		case "/eth/v1/beacon/blob_sidecars/100":
+			data = sidecars
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"NOT_FOUND: block not found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	return f
+}
+
+func sidecar(index int, b, commitment, proof []byte) map[string]any {
+	return map[string]any{
+		"index":          strconv.Itoa(index),
+		"blob":           hexutil.Encode(b),
+		"kzg_commitment": hexutil.Encode(commitment),
+		"kzg_proof":      hexutil.Encode(proof),
+		"signed_block_header": map[string]any{
+			"message": map[string]any{
+				"slot":           "100",
+				"proposer_index": "7",
+				"parent_root":    common.Hash{}.Hex(),
+				"state_root":     common.Hash{}.Hex(),
+				"body_root":      common.Hash{}.Hex(),
+			},
+			"signature": "0x" + strings.Repeat("00", 96),
+		},
+		"kzg_commitment_inclusion_proof": []string{common.Hash{}.Hex()},
+	}
+}
+
+// rpc answers eth_getTransactionByHash and eth_getBlockByNumber.
+func (f *fixture) rpc(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     any    `json:"id"`
+		Method string `json:"method"`
+		Params []any  `json:"params"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+
+	var result any
+	switch req.Method {
+	case "eth_getTransactionByHash":
+		hash := common.HexToHash(req.Params[0].(string))
+		tx := map[string]any{
+			"hash": hash.Hex(), "from": common.Address{}.Hex(), "nonce": "0x0", "gas": "0x5208",
+			"value": "0x0", "input": "0x", "type": "0x3",
+		}
+		if hash != pendingTxHash {
+			tx["blockNumber"] = "0x10"
+			tx["blockHash"] = common.HexToHash("0x10").Hex()
+		}
+		if hashes, ok := f.hashes[hash]; ok {
+			tx["blobVersionedHashes"] = hashes
+		}
+		if hash == pendingTxHash {
+			tx["blobVersionedHashes"] = f.hashes[blobTxHash]
+		}
+		result = tx
+	case "eth_getBlockByNumber":
+		result = map[string]any{
+			"number":       "0x10",
+			"hash":         common.HexToHash("0x10").Hex(),
+			"timestamp":    hexutil.EncodeUint64(genesisTime + blockSlot*12),
+			"transactions": []any{},
+		}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+var _ = Describe("Beacon client", func() {
+	var (
+		ctx context.Context
+		f   *fixture
+		bc  *beacon.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		f = newFixture()
+		var err error
+		bc, err = beacon.NewClient(beacon.Config{URL: f.URL + "/"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		f.Close()
+	})
+
+	It("requires a URL", func() {
+		_, err := beacon.NewClient(beacon.Config{})
+		Expect(err).To(MatchError(beacon.ErrNoURL))
+	})
+
+	It("gets headers", func() {
+		header, err := bc.GetHeader(ctx, beacon.BlockHead)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(header.Canonical).To(BeTrue())
+		Expect(header.Header.Message.Slot).To(Equal(uint64(100)))
+		Expect(header.Header.Message.ProposerIndex).To(Equal(uint64(7)))
+		Expect(header.Root).To(Equal(common.HexToHash("0xaa")))
+	})
+
+	It("gets finality checkpoints", func() {
+		checkpoints, err := bc.GetFinalityCheckpoints(ctx, beacon.StateHead)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checkpoints.Finalized.Epoch).To(Equal(uint64(1)))
+		Expect(checkpoints.CurrentJustified.Epoch).To(Equal(uint64(3)))
+	})
+
+	It("gets validators", func() {
+		validator, err := bc.GetValidator(ctx, beacon.StateHead, "1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(validator.Status).To(Equal(beacon.ValidatorActiveOngoing))
+		Expect(validator.Balance).To(Equal(uint64(32000000000)))
+		Expect(validator.Validator.ExitEpoch).To(Equal(uint64(18446744073709551615)))
+
+		validators, err := bc.GetValidators(ctx, beacon.StateHead, beacon.GetValidatorsParameters{
+			IDs:      []string{"1", "2"},
+			Statuses: []beacon.ValidatorStatus{beacon.ValidatorActiveOngoing},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(validators).To(HaveLen(1))
+		query := f.queries[len(f.queries)-1]
+		Expect(query.Get("id")).To(Equal("1,2"))
+		Expect(query.Get("status")).To(Equal("active_ongoing"))
+	})
+
+	It("gets blob sidecars", func() {
+		sidecars, err := bc.GetBlobSidecars(ctx, "100", []uint64{0, 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sidecars).To(HaveLen(3))
+		Expect(sidecars[1].Index).To(Equal(uint64(1)))
+		Expect(sidecars[0].Blob).To(HaveLen(kzg.BytesPerBlob))
+		Expect(kzg.VerifyBlobKzgProof(sidecars[0].Blob, sidecars[0].KzgCommitment, sidecars[0].KzgProof)).To(Succeed())
+		Expect(f.queries[len(f.queries)-1].Get("indices")).To(Equal("0,1"))
+	})
+
+	It("reports missing data as ErrNotFound", func() {
+		_, err := bc.GetBlobSidecars(ctx, "99", nil)
+		Expect(err).To(MatchError(beacon.ErrNotFound))
+		var apiErr *beacon.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.Message).To(ContainSubstring("block not found"))
+	})
+
+	It("converts timestamps to slots and caches genesis and spec", func() {
+		slot, err := bc.SlotAt(ctx, genesisTime+blockSlot*12+5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(slot).To(Equal(uint64(blockSlot)))
+
+		_, err = bc.SlotAt(ctx, genesisTime-1)
+		Expect(err).To(HaveOccurred())
+		Expect(f.paths).To(HaveLen(2))
+	})
+
+	Describe("GetBlobData", func() {
+		var pc *client.PublicClient
+
+		BeforeEach(func() {
+			var err error
+			pc, err = client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(f.URL)})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			pc.Close()
+		})
+
+		It("fetches, verifies and decodes the blobs of a transaction", func() {
+			data, err := beacon.GetBlobData(ctx, pc, bc, beacon.GetBlobDataParameters{Hash: blobTxHash})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data.Slot).To(Equal(uint64(blockSlot)))
+			Expect(data.Blobs).To(HaveLen(2))
+			Expect(data.Sidecars[1].Index).To(Equal(uint64(1)))
+			Expect(data.Data).To(Equal(f.payload))
+		})
+
+		It("rejects blobs that do not match their commitment", func() {
+			_, err := beacon.GetBlobData(ctx, pc, bc, beacon.GetBlobDataParameters{Hash: tamperedTxHash})
+			Expect(err).To(MatchError(beacon.ErrBlobMismatch))
+		})
+
+		It("rejects transactions without blobs or not yet included", func() {
+			_, err := beacon.GetBlobData(ctx, pc, bc, beacon.GetBlobDataParameters{Hash: plainTxHash})
+			Expect(err).To(MatchError(beacon.ErrNotBlobTransaction))
+
+			_, err = beacon.GetBlobData(ctx, pc, bc, beacon.GetBlobDataParameters{Hash: pendingTxHash})
+			Expect(err).To(MatchError(beacon.ErrTransactionPending))
+		})
+	})
+})
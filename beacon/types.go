@@ -0,0 +1,114 @@
+package beacon
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Block identifiers accepted wherever a block ID is expected, besides a slot
+// number or a 0x-prefixed block root.
+const (
+	BlockHead      = "head"
+	BlockGenesis   = "genesis"
+	BlockFinalized = "finalized"
+)
+
+// State identifiers accepted wherever a state ID is expected, besides a slot
+// number or a 0x-prefixed state root.
+const (
+	StateHead      = "head"
+	StateGenesis   = "genesis"
+	StateFinalized = "finalized"
+	StateJustified = "justified"
+)
+
+// Genesis is the response of /eth/v1/beacon/genesis.
+type Genesis struct {
+	GenesisTime           uint64        `json:"genesis_time,string"`
+	GenesisValidatorsRoot common.Hash   `json:"genesis_validators_root"`
+	GenesisForkVersion    hexutil.Bytes `json:"genesis_fork_version"`
+}
+
+// BeaconBlockHeader is the header of a beacon block.
+type BeaconBlockHeader struct {
+	Slot          uint64      `json:"slot,string"`
+	ProposerIndex uint64      `json:"proposer_index,string"`
+	ParentRoot    common.Hash `json:"parent_root"`
+	StateRoot     common.Hash `json:"state_root"`
+	BodyRoot      common.Hash `json:"body_root"`
+}
+
+// SignedBeaconBlockHeader is a beacon block header with the proposer's
+// BLS signature.
+type SignedBeaconBlockHeader struct {
+	Message   BeaconBlockHeader `json:"message"`
+	Signature hexutil.Bytes     `json:"signature"`
+}
+
+// BlockHeader is the response of /eth/v1/beacon/headers/{block_id}.
+type BlockHeader struct {
+	Root      common.Hash             `json:"root"`
+	Canonical bool                    `json:"canonical"`
+	Header    SignedBeaconBlockHeader `json:"header"`
+}
+
+// Checkpoint is a finality checkpoint.
+type Checkpoint struct {
+	Epoch uint64      `json:"epoch,string"`
+	Root  common.Hash `json:"root"`
+}
+
+// FinalityCheckpoints is the response of
+// /eth/v1/beacon/states/{state_id}/finality_checkpoints.
+type FinalityCheckpoints struct {
+	PreviousJustified Checkpoint `json:"previous_justified"`
+	CurrentJustified  Checkpoint `json:"current_justified"`
+	Finalized         Checkpoint `json:"finalized"`
+}
+
+// ValidatorStatus is the status of a validator, e.g. "active_ongoing".
+type ValidatorStatus string
+
+// Validator statuses.
+const (
+	ValidatorPendingInitialized ValidatorStatus = "pending_initialized"
+	ValidatorPendingQueued      ValidatorStatus = "pending_queued"
+	ValidatorActiveOngoing      ValidatorStatus = "active_ongoing"
+	ValidatorActiveExiting      ValidatorStatus = "active_exiting"
+	ValidatorActiveSlashed      ValidatorStatus = "active_slashed"
+	ValidatorExitedUnslashed    ValidatorStatus = "exited_unslashed"
+	ValidatorExitedSlashed      ValidatorStatus = "exited_slashed"
+	ValidatorWithdrawalPossible ValidatorStatus = "withdrawal_possible"
+	ValidatorWithdrawalDone     ValidatorStatus = "withdrawal_done"
+)
+
+// ValidatorData is the registry entry of a validator.
+type ValidatorData struct {
+	Pubkey                     hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials      common.Hash   `json:"withdrawal_credentials"`
+	EffectiveBalance           uint64        `json:"effective_balance,string"`
+	Slashed                    bool          `json:"slashed"`
+	ActivationEligibilityEpoch uint64        `json:"activation_eligibility_epoch,string"`
+	ActivationEpoch            uint64        `json:"activation_epoch,string"`
+	ExitEpoch                  uint64        `json:"exit_epoch,string"`
+	WithdrawableEpoch          uint64        `json:"withdrawable_epoch,string"`
+}
+
+// Validator is an entry of /eth/v1/beacon/states/{state_id}/validators.
+// Balances are in gwei.
+type Validator struct {
+	Index     uint64          `json:"index,string"`
+	Balance   uint64          `json:"balance,string"`
+	Status    ValidatorStatus `json:"status"`
+	Validator ValidatorData   `json:"validator"`
+}
+
+// BlobSidecar is an entry of /eth/v1/beacon/blob_sidecars/{block_id}.
+type BlobSidecar struct {
+	Index                       uint64                  `json:"index,string"`
+	Blob                        hexutil.Bytes           `json:"blob"`
+	KzgCommitment               hexutil.Bytes           `json:"kzg_commitment"`
+	KzgProof                    hexutil.Bytes           `json:"kzg_proof"`
+	SignedBlockHeader           SignedBeaconBlockHeader `json:"signed_block_header"`
+	KzgCommitmentInclusionProof []common.Hash           `json:"kzg_commitment_inclusion_proof"`
+}
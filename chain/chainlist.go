@@ -0,0 +1,155 @@
+package chain
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	json "github.com/goccy/go-json"
+)
+
+// chainlistEntry is a chain in the ethereum-lists/chains format used by
+// chainlist.org (chains.json and rpcs.json).
+type chainlistEntry struct {
+	Name           string              `json:"name"`
+	ChainID        int64               `json:"chainId"`
+	NativeCurrency ChainNativeCurrency `json:"nativeCurrency"`
+	RPC            []chainlistRPC      `json:"rpc"`
+	Explorers      []struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"explorers"`
+	Parent *struct {
+		Type  string `json:"type"`
+		Chain string `json:"chain"`
+	} `json:"parent"`
+	Slip44    *int `json:"slip44"`
+	IsTestnet bool `json:"isTestnet"`
+}
+
+// chainlistRPC is an RPC entry, either a plain URL (chains.json) or an
+// object with a url field (rpcs.json).
+type chainlistRPC struct {
+	URL string
+}
+
+func (r *chainlistRPC) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &r.URL)
+	}
+	var obj struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	r.URL = obj.URL
+	return nil
+}
+
+// ParseChainlist parses chains in the chainlist.org / ethereum-lists format,
+// either a single chain object or an array of them.
+//
+// RPC URLs containing API key placeholders (such as "${INFURA_API_KEY}") are
+// skipped, the first explorer becomes the default block explorer, testnets
+// are detected from isTestnet or slip44 = 1, and L2 parents of the form
+// "eip155-<id>" set SourceID. Chainlist data has no contract addresses, so
+// Contracts is left nil.
+//
+// Example:
+//
+//	data, _ := os.ReadFile("rpcs.json")
+//	chains, err := chain.ParseChainlist(data)
+func ParseChainlist(data []byte) ([]Chain, error) {
+	var entries []chainlistEntry
+	if err := unmarshalOneOrMany(data, &entries); err != nil {
+		return nil, fmt.Errorf("chain: failed to parse chainlist JSON: %w", err)
+	}
+
+	chains := make([]Chain, 0, len(entries))
+	for _, e := range entries {
+		if e.ChainID <= 0 {
+			return nil, fmt.Errorf("%w: %q has chain ID %d", ErrInvalidChainID, e.Name, e.ChainID)
+		}
+		c := Chain{
+			ID:             e.ChainID,
+			Name:           e.Name,
+			NativeCurrency: e.NativeCurrency,
+			Testnet:        e.IsTestnet || (e.Slip44 != nil && *e.Slip44 == 1),
+		}
+
+		var urls ChainRpcUrls
+		for _, rpc := range e.RPC {
+			switch {
+			case rpc.URL == "" || strings.Contains(rpc.URL, "${"):
+			case strings.HasPrefix(rpc.URL, "ws://") || strings.HasPrefix(rpc.URL, "wss://"):
+				urls.WebSocket = append(urls.WebSocket, rpc.URL)
+			default:
+				urls.HTTP = append(urls.HTTP, rpc.URL)
+			}
+		}
+		if len(urls.HTTP) > 0 || len(urls.WebSocket) > 0 {
+			c.RpcUrls = map[string]ChainRpcUrls{"default": urls}
+		}
+
+		if len(e.Explorers) > 0 {
+			c.BlockExplorers = map[string]ChainBlockExplorer{
+				"default": {Name: e.Explorers[0].Name, URL: strings.TrimSuffix(e.Explorers[0].URL, "/")},
+			}
+		}
+
+		if e.Parent != nil && e.Parent.Type == "L2" {
+			if id, err := strconv.ParseInt(strings.TrimPrefix(e.Parent.Chain, "eip155-"), 10, 64); err == nil {
+				c.SourceID = &id
+			}
+		}
+		chains = append(chains, c)
+	}
+	return chains, nil
+}
+
+// ParseChains parses chains in this package's JSON format (the field names
+// of Chain, as in viem's chain objects), either a single chain or an array.
+func ParseChains(data []byte) ([]Chain, error) {
+	var chains []Chain
+	if err := unmarshalOneOrMany(data, &chains); err != nil {
+		return nil, fmt.Errorf("chain: failed to parse chain JSON: %w", err)
+	}
+	for i, c := range chains {
+		if c.ID <= 0 {
+			return nil, fmt.Errorf("%w: %q has chain ID %d", ErrInvalidChainID, c.Name, c.ID)
+		}
+		chains[i] = DefineChain(c)
+	}
+	return chains, nil
+}
+
+// LoadChainsFile reads chains from a JSON file in either the chainlist
+// format (detected by a "chainId" field) or this package's format.
+func LoadChainsFile(path string) ([]Chain, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("chain: failed to read %s: %w", path, err)
+	}
+	if bytes.Contains(data, []byte(`"chainId"`)) {
+		return ParseChainlist(data)
+	}
+	return ParseChains(data)
+}
+
+// unmarshalOneOrMany decodes a JSON array into out, or a single object as a
+// one-element array.
+func unmarshalOneOrMany[T any](data []byte, out *[]T) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		return json.Unmarshal(data, out)
+	}
+	var one T
+	if err := json.Unmarshal(data, &one); err != nil {
+		return err
+	}
+	*out = []T{one}
+	return nil
+}
@@ -17,10 +17,21 @@ func DefineChain(c Chain) Chain {
 		SourceID:                        copyInt64Ptr(c.SourceID),
 		Testnet:                         c.Testnet,
 		ExperimentalPreconfirmationTime: copyInt64Ptr(c.ExperimentalPreconfirmationTime),
+		Fees:                            copyFees(c.Fees),
+		Formatters:                      copyFormatters(c.Formatters),
+		Serializers:                     copySerializers(c.Serializers),
 	}
 	return out
 }
 
+// GetID returns the chain ID, or 0 for a nil chain.
+func (c *Chain) GetID() int64 {
+	if c == nil {
+		return 0
+	}
+	return c.ID
+}
+
 // DefaultRpcUrl returns the first HTTP URL from the "default" RPC entry, or empty string if not set.
 func (c *Chain) DefaultRpcUrl() string {
 	if c.RpcUrls == nil {
@@ -1,8 +1,11 @@
 package definitions
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 
+	arbitrumconfig "github.com/ChefBingbong/viem-go/arbitrum/chainconfig"
 	"github.com/ChefBingbong/viem-go/chain"
 )
 
@@ -15,7 +18,12 @@ var Arbitrum = chain.DefineChain(chain.Chain{
 		Symbol:   "ETH",
 		Decimals: 18,
 	},
-	BlockTime: int64Ptr(250),
+	BlockTime:  int64Ptr(250),
+	Formatters: arbitrumconfig.Formatters,
+	// Priority fees are not used by the sequencer.
+	Fees: &chain.ChainFees{
+		DefaultPriorityFee: big.NewInt(0),
+	},
 	RpcUrls: map[string]chain.ChainRpcUrls{
 		"default": {
 			HTTP: []string{"https://arb1.arbitrum.io/rpc"},
@@ -0,0 +1,47 @@
+package definitions
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	arbitrumconfig "github.com/ChefBingbong/viem-go/arbitrum/chainconfig"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// ArbitrumSepolia is the Arbitrum Sepolia testnet chain definition.
+var ArbitrumSepolia = chain.DefineChain(chain.Chain{
+	ID:   421_614,
+	Name: "Arbitrum Sepolia",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Arbitrum Sepolia Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(250),
+	// Priority fees are not used by the sequencer.
+	Fees: &chain.ChainFees{
+		DefaultPriorityFee: big.NewInt(0),
+	},
+	SourceID:   int64Ptr(11_155_111),
+	Formatters: arbitrumconfig.Formatters,
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://sepolia-rollup.arbitrum.io/rpc"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Arbiscan",
+			URL:    "https://sepolia.arbiscan.io",
+			ApiURL: "https://api-sepolia.arbiscan.io/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(81_930),
+		},
+	},
+	Testnet: true,
+})
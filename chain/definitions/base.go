@@ -0,0 +1,75 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	opstackconfig "github.com/ChefBingbong/viem-go/opstack/chainconfig"
+)
+
+// Base is the Base mainnet chain definition.
+var Base = chain.DefineChain(chain.Chain{
+	ID:   8_453,
+	Name: "Base",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime:   int64Ptr(2_000),
+	SourceID:    int64Ptr(1),
+	Formatters:  opstackconfig.Formatters,
+	Serializers: opstackconfig.Serializers,
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://mainnet.base.org"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Basescan",
+			URL:    "https://basescan.org",
+			ApiURL: "https://api.basescan.org/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(5_022),
+		},
+	},
+})
+
+// BaseSepolia is the Base Sepolia testnet chain definition.
+var BaseSepolia = chain.DefineChain(chain.Chain{
+	ID:   84_532,
+	Name: "Base Sepolia",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Sepolia Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime:   int64Ptr(2_000),
+	SourceID:    int64Ptr(11_155_111),
+	Formatters:  opstackconfig.Formatters,
+	Serializers: opstackconfig.Serializers,
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://sepolia.base.org"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Basescan",
+			URL:    "https://sepolia.basescan.org",
+			ApiURL: "https://api-sepolia.basescan.org/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(1_059_647),
+		},
+	},
+	Testnet: true,
+})
@@ -0,0 +1,38 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Blast is the Blast mainnet chain definition.
+var Blast = chain.DefineChain(chain.Chain{
+	ID:   81_457,
+	Name: "Blast",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(2_000),
+	SourceID:  int64Ptr(1),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://rpc.blast.io"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Blastscan",
+			URL:    "https://blastscan.io",
+			ApiURL: "https://api.blastscan.io/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(212_929),
+		},
+	},
+})
@@ -1,6 +1,8 @@
 package definitions
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ChefBingbong/viem-go/chain"
@@ -16,6 +18,11 @@ var Bsc = chain.DefineChain(chain.Chain{
 		Decimals: 18,
 	},
 	BlockTime: int64Ptr(750),
+	// The base fee is always zero, so only the tip pays for inclusion.
+	Fees: &chain.ChainFees{
+		BaseFeeMultiplier: float64Ptr(1),
+		MinPriorityFee:    big.NewInt(100_000_000),
+	},
 	RpcUrls: map[string]chain.ChainRpcUrls{
 		"default": {
 			HTTP: []string{"https://56.rpc.thirdweb.com"},
@@ -0,0 +1,70 @@
+package definitions
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+var (
+	catalogMu sync.RWMutex
+	catalog   = map[int64]*chain.Chain{}
+)
+
+func init() {
+	for _, c := range []*chain.Chain{
+		&Mainnet, &Sepolia, &Holesky, &Hoodi,
+		&Optimism, &OptimismSepolia,
+		&Arbitrum, &ArbitrumSepolia,
+		&Base, &BaseSepolia,
+		&ZkSync, &ZkSyncSepolia,
+		&Linea, &Scroll, &Blast, &Mantle,
+		&Polygon, &Avalanche, &Bsc, &Gnosis, &Celo,
+		&Anvil, &Localhost,
+	} {
+		catalog[c.ID] = c
+	}
+}
+
+// ByID returns the chain with the given ID from the catalog of built-in and
+// registered chains.
+//
+// Example:
+//
+//	base, ok := definitions.ByID(8453)
+func ByID(id int64) (*chain.Chain, bool) {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	c, ok := catalog[id]
+	return c, ok
+}
+
+// All returns every chain in the catalog, ordered by ID.
+func All() []*chain.Chain {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	out := make([]*chain.Chain, 0, len(catalog))
+	for _, c := range catalog {
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// Register adds chains to the catalog so ByID and All can find them,
+// replacing any chain with the same ID. Use it with chain.LoadChainsFile or
+// chain.ParseChainlist to support networks without a library release.
+//
+// Example:
+//
+//	chains, err := chain.LoadChainsFile("chains.json")
+//	definitions.Register(chains...)
+func Register(chains ...chain.Chain) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	for _, c := range chains {
+		c := chain.DefineChain(c)
+		catalog[c.ID] = &c
+	}
+}
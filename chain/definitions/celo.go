@@ -0,0 +1,37 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Celo is the Celo mainnet chain definition.
+var Celo = chain.DefineChain(chain.Chain{
+	ID:   42_220,
+	Name: "Celo",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "CELO",
+		Symbol:   "CELO",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(1_000),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://forno.celo.org"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Celo Explorer",
+			URL:    "https://celoscan.io",
+			ApiURL: "https://api.celoscan.io/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(13_112_599),
+		},
+	},
+})
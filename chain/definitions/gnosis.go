@@ -0,0 +1,38 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Gnosis is the Gnosis chain definition.
+var Gnosis = chain.DefineChain(chain.Chain{
+	ID:   100,
+	Name: "Gnosis",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "xDAI",
+		Symbol:   "XDAI",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(5_000),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP:      []string{"https://rpc.gnosischain.com"},
+			WebSocket: []string{"wss://rpc.gnosischain.com/wss"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Gnosisscan",
+			URL:    "https://gnosisscan.io",
+			ApiURL: "https://api.gnosisscan.io/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(21_022_491),
+		},
+	},
+})
@@ -0,0 +1,72 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Holesky is the Ethereum Holesky testnet chain definition.
+var Holesky = chain.DefineChain(chain.Chain{
+	ID:   17_000,
+	Name: "Holesky",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Holesky Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(12_000),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://ethereum-holesky-rpc.publicnode.com"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Etherscan",
+			URL:    "https://holesky.etherscan.io",
+			ApiURL: "https://api-holesky.etherscan.io/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(77),
+		},
+		EnsRegistry: &chain.ChainContract{
+			Address:      common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"),
+			BlockCreated: uint64Ptr(801_613),
+		},
+	},
+	Testnet: true,
+})
+
+// Hoodi is the Ethereum Hoodi testnet chain definition.
+var Hoodi = chain.DefineChain(chain.Chain{
+	ID:   560_048,
+	Name: "Hoodi",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Hoodi Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(12_000),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://rpc.hoodi.ethpandaops.io"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name: "Etherscan",
+			URL:  "https://hoodi.etherscan.io",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(2_589),
+		},
+	},
+	Testnet: true,
+})
@@ -0,0 +1,39 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Linea is the Linea mainnet chain definition.
+var Linea = chain.DefineChain(chain.Chain{
+	ID:   59_144,
+	Name: "Linea Mainnet",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Linea Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(2_000),
+	SourceID:  int64Ptr(1),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP:      []string{"https://rpc.linea.build"},
+			WebSocket: []string{"wss://rpc.linea.build"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Lineascan",
+			URL:    "https://lineascan.build",
+			ApiURL: "https://api.lineascan.build/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(42),
+		},
+	},
+})
@@ -0,0 +1,40 @@
+package definitions
+
+import (
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Anvil is the Foundry Anvil local development chain definition.
+var Anvil = chain.DefineChain(chain.Chain{
+	ID:   31_337,
+	Name: "Anvil",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP:      []string{"http://127.0.0.1:8545"},
+			WebSocket: []string{"ws://127.0.0.1:8545"},
+		},
+	},
+	Testnet: true,
+})
+
+// Localhost is a generic local development chain definition (e.g. Ganache or geth --dev).
+var Localhost = chain.DefineChain(chain.Chain{
+	ID:   1_337,
+	Name: "Localhost",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"http://127.0.0.1:8545"},
+		},
+	},
+	Testnet: true,
+})
@@ -29,6 +29,10 @@ var Mainnet = chain.DefineChain(chain.Chain{
 		},
 	},
 	Contracts: &chain.ChainContracts{
+		EnsRegistry: &chain.ChainContract{
+			Address:      common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"),
+			BlockCreated: uint64Ptr(9_380_380),
+		},
 		EnsUniversalResolver: &chain.ChainContract{
 			Address:      common.HexToAddress("0xeeeeeeee14d718c2b47d9923deab1335e144eeee"),
 			BlockCreated: uint64Ptr(23_085_558),
@@ -0,0 +1,38 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Mantle is the Mantle mainnet chain definition.
+var Mantle = chain.DefineChain(chain.Chain{
+	ID:   5_000,
+	Name: "Mantle",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "MNT",
+		Symbol:   "MNT",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(2_000),
+	SourceID:  int64Ptr(1),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://rpc.mantle.xyz"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Mantle Explorer",
+			URL:    "https://mantlescan.xyz",
+			ApiURL: "https://api.mantlescan.xyz/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(304_717),
+		},
+	},
+})
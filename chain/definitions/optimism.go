@@ -4,6 +4,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ChefBingbong/viem-go/chain"
+	opstackconfig "github.com/ChefBingbong/viem-go/opstack/chainconfig"
 )
 
 // Optimism is the OP Mainnet (Optimism) chain definition.
@@ -15,8 +16,10 @@ var Optimism = chain.DefineChain(chain.Chain{
 		Symbol:   "ETH",
 		Decimals: 18,
 	},
-	BlockTime: int64Ptr(2_000),
-	SourceID:  int64Ptr(1), // mainnet L1
+	BlockTime:   int64Ptr(2_000),
+	SourceID:    int64Ptr(1), // mainnet L1
+	Formatters:  opstackconfig.Formatters,
+	Serializers: opstackconfig.Serializers,
 	RpcUrls: map[string]chain.ChainRpcUrls{
 		"default": {
 			HTTP: []string{"https://mainnet.optimism.io"},
@@ -0,0 +1,42 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	opstackconfig "github.com/ChefBingbong/viem-go/opstack/chainconfig"
+)
+
+// OptimismSepolia is the OP Sepolia testnet chain definition.
+var OptimismSepolia = chain.DefineChain(chain.Chain{
+	ID:   11_155_420,
+	Name: "OP Sepolia",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Sepolia Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime:   int64Ptr(2_000),
+	SourceID:    int64Ptr(11_155_111),
+	Formatters:  opstackconfig.Formatters,
+	Serializers: opstackconfig.Serializers,
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://sepolia.optimism.io"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Blockscout",
+			URL:    "https://optimism-sepolia.blockscout.com",
+			ApiURL: "https://optimism-sepolia.blockscout.com/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(1_620_204),
+		},
+	},
+	Testnet: true,
+})
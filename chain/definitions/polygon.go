@@ -1,6 +1,8 @@
 package definitions
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ChefBingbong/viem-go/chain"
@@ -16,6 +18,10 @@ var Polygon = chain.DefineChain(chain.Chain{
 		Decimals: 18,
 	},
 	BlockTime: int64Ptr(2_000),
+	// Validators reject transactions tipping less than 30 gwei.
+	Fees: &chain.ChainFees{
+		MinPriorityFee: big.NewInt(30_000_000_000),
+	},
 	RpcUrls: map[string]chain.ChainRpcUrls{
 		"default": {
 			HTTP: []string{"https://polygon-rpc.com"},
@@ -0,0 +1,39 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Scroll is the Scroll mainnet chain definition.
+var Scroll = chain.DefineChain(chain.Chain{
+	ID:   534_352,
+	Name: "Scroll",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(3_000),
+	SourceID:  int64Ptr(1),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP:      []string{"https://rpc.scroll.io"},
+			WebSocket: []string{"wss://wss-rpc.scroll.io/ws"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Scrollscan",
+			URL:    "https://scrollscan.com",
+			ApiURL: "https://api.scrollscan.com/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(14),
+		},
+	},
+})
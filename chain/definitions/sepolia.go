@@ -0,0 +1,45 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// Sepolia is the Ethereum Sepolia testnet chain definition.
+var Sepolia = chain.DefineChain(chain.Chain{
+	ID:   11_155_111,
+	Name: "Sepolia",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Sepolia Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(12_000),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP: []string{"https://sepolia.drpc.org"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Etherscan",
+			URL:    "https://sepolia.etherscan.io",
+			ApiURL: "https://api-sepolia.etherscan.io/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
+			BlockCreated: uint64Ptr(751_532),
+		},
+		EnsRegistry: &chain.ChainContract{
+			Address: common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e"),
+		},
+		EnsUniversalResolver: &chain.ChainContract{
+			Address:      common.HexToAddress("0xeeeeeeee14d718c2b47d9923deab1335e144eeee"),
+			BlockCreated: uint64Ptr(8_928_790),
+		},
+	},
+	Testnet: true,
+})
@@ -1,4 +1,5 @@
 package definitions
 
-func int64Ptr(n int64) *int64    { return &n }
-func uint64Ptr(n uint64) *uint64 { return &n }
+func int64Ptr(n int64) *int64       { return &n }
+func uint64Ptr(n uint64) *uint64    { return &n }
+func float64Ptr(n float64) *float64 { return &n }
@@ -0,0 +1,71 @@
+package definitions
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// ZkSync is the ZKsync Era mainnet chain definition. ZKsync deploys Multicall3
+// at a different address than other EVM chains.
+var ZkSync = chain.DefineChain(chain.Chain{
+	ID:   324,
+	Name: "ZKsync Era",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(1_000),
+	SourceID:  int64Ptr(1),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP:      []string{"https://mainnet.era.zksync.io"},
+			WebSocket: []string{"wss://mainnet.era.zksync.io/ws"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Etherscan",
+			URL:    "https://era.zksync.network",
+			ApiURL: "https://api-era.zksync.network/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address: common.HexToAddress("0xF9cda624FBC7e059355ce98a31693d299FACd963"),
+		},
+	},
+})
+
+// ZkSyncSepolia is the ZKsync Sepolia testnet chain definition.
+var ZkSyncSepolia = chain.DefineChain(chain.Chain{
+	ID:   300,
+	Name: "ZKsync Sepolia Testnet",
+	NativeCurrency: chain.ChainNativeCurrency{
+		Name:     "Ether",
+		Symbol:   "ETH",
+		Decimals: 18,
+	},
+	BlockTime: int64Ptr(1_000),
+	SourceID:  int64Ptr(11_155_111),
+	RpcUrls: map[string]chain.ChainRpcUrls{
+		"default": {
+			HTTP:      []string{"https://sepolia.era.zksync.dev"},
+			WebSocket: []string{"wss://sepolia.era.zksync.dev/ws"},
+		},
+	},
+	BlockExplorers: map[string]chain.ChainBlockExplorer{
+		"default": {
+			Name:   "Etherscan",
+			URL:    "https://sepolia-era.zksync.network",
+			ApiURL: "https://api-sepolia-era.zksync.network/api",
+		},
+	},
+	Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{
+			Address: common.HexToAddress("0xF9cda624FBC7e059355ce98a31693d299FACd963"),
+		},
+	},
+	Testnet: true,
+})
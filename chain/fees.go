@@ -0,0 +1,81 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// ChainFees configures fee estimation for a chain, mirroring viem's
+// ChainFees. It is honored by public.EstimateFeesPerGas and, through it, by
+// wallet.PrepareTransactionRequest and wallet.SendTransaction.
+type ChainFees struct {
+	// BaseFeeMultiplier is applied to the base fee (or gas price for legacy
+	// transactions) as a safety buffer. A per-call multiplier takes
+	// precedence. Must be at least 1. Default: 1.2
+	BaseFeeMultiplier *float64 `json:"baseFeeMultiplier,omitempty"`
+
+	// DefaultPriorityFee is used as the max priority fee per gas instead of
+	// querying eth_maxPriorityFeePerGas, e.g. on chains that ignore tips.
+	DefaultPriorityFee *big.Int `json:"defaultPriorityFee,omitempty"`
+
+	// MinPriorityFee is the lowest max priority fee per gas that is
+	// estimated, for chains whose validators reject lower tips.
+	MinPriorityFee *big.Int `json:"minPriorityFee,omitempty"`
+
+	// EstimateFeesPerGas replaces the default fee estimation. Returning nil
+	// values without an error falls back to the default estimation.
+	EstimateFeesPerGas func(ctx context.Context, params EstimateFeesPerGasParameters) (*FeeValues, error) `json:"-"`
+}
+
+// EstimateFeesPerGasParameters are the parameters passed to a chain's
+// EstimateFeesPerGas function.
+type EstimateFeesPerGasParameters struct {
+	// Block is the latest block.
+	Block *types.Block
+
+	// Type is the type of fee values to return: "eip1559" or "legacy".
+	Type string
+
+	// Multiply applies the resolved base fee multiplier to a value.
+	Multiply func(value *big.Int) *big.Int
+
+	// Request sends a JSON-RPC request through the client and returns the
+	// raw result.
+	Request func(ctx context.Context, method string, params ...any) (json.RawMessage, error)
+}
+
+// FeeValues are the fee values returned by a chain's EstimateFeesPerGas
+// function. Set MaxFeePerGas and MaxPriorityFeePerGas for "eip1559"
+// estimates and GasPrice for "legacy" ones.
+type FeeValues struct {
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+}
+
+func copyFees(f *ChainFees) *ChainFees {
+	if f == nil {
+		return nil
+	}
+	out := &ChainFees{
+		DefaultPriorityFee: copyBigInt(f.DefaultPriorityFee),
+		MinPriorityFee:     copyBigInt(f.MinPriorityFee),
+		EstimateFeesPerGas: f.EstimateFeesPerGas,
+	}
+	if f.BaseFeeMultiplier != nil {
+		m := *f.BaseFeeMultiplier
+		out.BaseFeeMultiplier = &m
+	}
+	return out
+}
+
+func copyBigInt(n *big.Int) *big.Int {
+	if n == nil {
+		return nil
+	}
+	return new(big.Int).Set(n)
+}
@@ -0,0 +1,60 @@
+package chain
+
+import (
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// ChainFormatters overrides how RPC data is formatted for a chain, mirroring
+// viem's ChainFormatters. Chains with extra block, transaction or receipt
+// fields (e.g. L2 deposit transactions or L1 fee data) use them to preserve
+// those fields instead of dropping them.
+//
+// The Block, Transaction and TransactionReceipt formatters receive the raw
+// RPC object after the standard decoding succeeded, and return the
+// chain-specific fields, which are stored as ChainFields on the result of
+// public.GetBlock, public.GetTransaction and public.GetTransactionReceipt.
+type ChainFormatters struct {
+	// Block formats the chain-specific fields of a block.
+	Block func(raw json.RawMessage) (any, error)
+
+	// Transaction formats the chain-specific fields of a transaction.
+	Transaction func(raw json.RawMessage) (any, error)
+
+	// TransactionReceipt formats the chain-specific fields of a receipt.
+	TransactionReceipt func(raw json.RawMessage) (any, error)
+
+	// TransactionRequest adds chain-specific fields to an RPC transaction
+	// request before it is sent with eth_estimateGas, eth_sendTransaction or
+	// eth_signTransaction. fields is the ChainFields of the request and may
+	// be nil.
+	TransactionRequest func(request map[string]any, fields any) error
+}
+
+// ChainSerializers overrides how transactions are serialized for a chain,
+// mirroring viem's ChainSerializers.
+type ChainSerializers struct {
+	// Transaction serializes a transaction, with an optional signature, in
+	// place of transaction.SerializeTransaction. It is used when signing with
+	// local accounts. tx.ChainFields carries the chain-specific fields of the
+	// request; implementations typically handle their own transaction types
+	// and delegate the rest to transaction.SerializeTransaction.
+	Transaction func(tx *transaction.Transaction, signature *transaction.Signature) (string, error)
+}
+
+func copyFormatters(f *ChainFormatters) *ChainFormatters {
+	if f == nil {
+		return nil
+	}
+	out := *f
+	return &out
+}
+
+func copySerializers(s *ChainSerializers) *ChainSerializers {
+	if s == nil {
+		return nil
+	}
+	out := *s
+	return &out
+}
@@ -0,0 +1,162 @@
+package chain_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const chainlistJSON = `[
+  {
+    "name": "Example L2",
+    "chainId": 777777,
+    "nativeCurrency": {"name": "Ether", "symbol": "ETH", "decimals": 18},
+    "rpc": [
+      "https://rpc.example.org",
+      "https://mainnet.infura.io/v3/${INFURA_API_KEY}",
+      "wss://ws.example.org"
+    ],
+    "explorers": [{"name": "Example Scan", "url": "https://scan.example.org/", "standard": "EIP3091"}],
+    "parent": {"type": "L2", "chain": "eip155-1"}
+  },
+  {
+    "name": "Example Testnet",
+    "chainId": 777778,
+    "nativeCurrency": {"name": "Test Ether", "symbol": "tETH", "decimals": 18},
+    "rpc": [{"url": "https://testnet.example.org", "tracking": "none"}],
+    "slip44": 1
+  }
+]`
+
+var _ = Describe("Chainlist loading", func() {
+	It("parses chainlist entries", func() {
+		chains, err := chain.ParseChainlist([]byte(chainlistJSON))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chains).To(HaveLen(2))
+
+		l2 := chains[0]
+		Expect(l2.ID).To(Equal(int64(777777)))
+		Expect(l2.Name).To(Equal("Example L2"))
+		Expect(l2.NativeCurrency.Symbol).To(Equal("ETH"))
+		Expect(l2.RpcUrls["default"].HTTP).To(Equal([]string{"https://rpc.example.org"}))
+		Expect(l2.RpcUrls["default"].WebSocket).To(Equal([]string{"wss://ws.example.org"}))
+		Expect(l2.DefaultBlockExplorer()).To(Equal(chain.ChainBlockExplorer{Name: "Example Scan", URL: "https://scan.example.org"}))
+		Expect(*l2.SourceID).To(Equal(int64(1)))
+		Expect(l2.Testnet).To(BeFalse())
+
+		testnet := chains[1]
+		Expect(testnet.DefaultRpcUrl()).To(Equal("https://testnet.example.org"))
+		Expect(testnet.Testnet).To(BeTrue())
+		Expect(testnet.SourceID).To(BeNil())
+	})
+
+	It("parses a single chainlist object", func() {
+		chains, err := chain.ParseChainlist([]byte(`{"name": "Solo", "chainId": 5, "rpc": []}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chains).To(HaveLen(1))
+		Expect(chains[0].RpcUrls).To(BeNil())
+	})
+
+	It("rejects invalid chain IDs", func() {
+		_, err := chain.ParseChainlist([]byte(`[{"name": "Bad", "chainId": 0}]`))
+		Expect(err).To(MatchError(chain.ErrInvalidChainID))
+	})
+
+	It("parses chains in viem's JSON format", func() {
+		chains, err := chain.ParseChains([]byte(`{
+			"id": 999,
+			"name": "Custom",
+			"nativeCurrency": {"name": "Ether", "symbol": "ETH", "decimals": 18},
+			"rpcUrls": {"default": {"http": ["https://custom.example.org"]}},
+			"contracts": {"multicall3": {"address": "0xca11bde05977b3631167028862be2a173976ca11", "blockCreated": 1}}
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chains).To(HaveLen(1))
+		Expect(chains[0].DefaultRpcUrl()).To(Equal("https://custom.example.org"))
+		Expect(chains[0].Contracts.Multicall3.Address).To(Equal(definitions.Mainnet.Contracts.Multicall3.Address))
+	})
+
+	It("detects the file format when loading", func() {
+		dir, err := os.MkdirTemp("", "chains")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		chainlistPath := filepath.Join(dir, "chainlist.json")
+		Expect(os.WriteFile(chainlistPath, []byte(chainlistJSON), 0o644)).To(Succeed())
+		chains, err := chain.LoadChainsFile(chainlistPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chains).To(HaveLen(2))
+
+		viemPath := filepath.Join(dir, "viem.json")
+		Expect(os.WriteFile(viemPath, []byte(`[{"id": 42, "name": "Answer"}]`), 0o644)).To(Succeed())
+		chains, err = chain.LoadChainsFile(viemPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chains[0].ID).To(Equal(int64(42)))
+	})
+})
+
+var _ = Describe("Chain catalog", func() {
+	It("looks up built-in chains by ID", func() {
+		for id, name := range map[int64]string{
+			1:        "Ethereum",
+			11155111: "Sepolia",
+			17000:    "Holesky",
+			560048:   "Hoodi",
+			8453:     "Base",
+			84532:    "Base Sepolia",
+			59144:    "Linea Mainnet",
+			534352:   "Scroll",
+			324:      "ZKsync Era",
+			100:      "Gnosis",
+			81457:    "Blast",
+			5000:     "Mantle",
+			42220:    "Celo",
+			31337:    "Anvil",
+		} {
+			c, ok := definitions.ByID(id)
+			Expect(ok).To(BeTrue(), "chain %d", id)
+			Expect(c.Name).To(Equal(name))
+		}
+
+		_, ok := definitions.ByID(123456789)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("returns the package variables", func() {
+		c, ok := definitions.ByID(8453)
+		Expect(ok).To(BeTrue())
+		Expect(c).To(BeIdenticalTo(&definitions.Base))
+	})
+
+	It("gives every non-local chain a Multicall3 contract", func() {
+		for _, c := range definitions.All() {
+			if c.ID == definitions.Anvil.ID || c.ID == definitions.Localhost.ID {
+				continue
+			}
+			Expect(c.Contracts).NotTo(BeNil(), c.Name)
+			Expect(c.Contracts.Multicall3).NotTo(BeNil(), c.Name)
+		}
+	})
+
+	It("lists chains ordered by ID", func() {
+		all := definitions.All()
+		for i := 1; i < len(all); i++ {
+			Expect(all[i-1].ID).To(BeNumerically("<", all[i].ID))
+		}
+	})
+
+	It("registers loaded chains", func() {
+		chains, err := chain.ParseChainlist([]byte(chainlistJSON))
+		Expect(err).NotTo(HaveOccurred())
+		definitions.Register(chains...)
+
+		c, ok := definitions.ByID(777778)
+		Expect(ok).To(BeTrue())
+		Expect(c.Name).To(Equal("Example Testnet"))
+	})
+})
@@ -0,0 +1,37 @@
+package chain_test
+
+import (
+	"math/big"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chain fees", func() {
+	It("copies the fee configuration in DefineChain", func() {
+		multiplier := 1.5
+		fees := &chain.ChainFees{
+			BaseFeeMultiplier: &multiplier,
+			MinPriorityFee:    big.NewInt(10),
+		}
+		c := chain.DefineChain(chain.Chain{ID: 1, Fees: fees})
+
+		multiplier = 3
+		fees.MinPriorityFee.SetInt64(20)
+		Expect(*c.Fees.BaseFeeMultiplier).To(Equal(1.5))
+		Expect(c.Fees.MinPriorityFee.Int64()).To(Equal(int64(10)))
+	})
+
+	It("configures fees for built-in chains", func() {
+		Expect(definitions.Polygon.Fees).NotTo(BeNil())
+		Expect(definitions.Polygon.Fees.MinPriorityFee.String()).To(Equal("30000000000"))
+
+		Expect(definitions.Arbitrum.Fees).NotTo(BeNil())
+		Expect(definitions.Arbitrum.Fees.DefaultPriorityFee.Sign()).To(BeZero())
+
+		Expect(definitions.Mainnet.Fees).To(BeNil())
+	})
+})
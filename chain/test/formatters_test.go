@@ -0,0 +1,41 @@
+package chain_test
+
+import (
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chain formatters and serializers", func() {
+	It("copies formatters and serializers in DefineChain", func() {
+		formatters := &chain.ChainFormatters{
+			Block: func(raw json.RawMessage) (any, error) { return "block", nil },
+		}
+		serializers := &chain.ChainSerializers{
+			Transaction: func(tx *transaction.Transaction, sig *transaction.Signature) (string, error) {
+				return "0x7e", nil
+			},
+		}
+		c := chain.DefineChain(chain.Chain{ID: 10, Formatters: formatters, Serializers: serializers})
+
+		formatters.Block = nil
+		serializers.Transaction = nil
+		Expect(c.Formatters.Block).NotTo(BeNil())
+		fields, err := c.Formatters.Block(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fields).To(Equal("block"))
+		Expect(c.Serializers.Transaction).NotTo(BeNil())
+	})
+
+	It("omits formatters and serializers from JSON", func() {
+		c := chain.Chain{ID: 10, Name: "Test", Formatters: &chain.ChainFormatters{}, Serializers: &chain.ChainSerializers{}}
+		encoded, err := json.Marshal(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(encoded)).NotTo(ContainSubstring("ormatters"))
+		Expect(string(encoded)).NotTo(ContainSubstring("erializers"))
+	})
+})
@@ -38,7 +38,7 @@ type ChainContracts struct {
 }
 
 // Chain is the basic chain definition, mirroring viem's Chain type.
-// It omits formatters, fees, serializers, and other chain config for simplicity.
+// Formatters and Serializers are not serialized to JSON.
 type Chain struct {
 	ID                              int64                         `json:"id"`
 	Name                            string                        `json:"name"`
@@ -51,4 +51,7 @@ type Chain struct {
 	SourceID                        *int64                        `json:"sourceId,omitempty"`
 	Testnet                         bool                          `json:"testnet,omitempty"`
 	ExperimentalPreconfirmationTime *int64                        `json:"experimental_preconfirmationTime,omitempty"`
+	Fees                            *ChainFees                    `json:"fees,omitempty"`
+	Formatters                      *ChainFormatters              `json:"-"`
+	Serializers                     *ChainSerializers             `json:"-"`
 }
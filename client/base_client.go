@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"log/slog"
 	"sync"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/metrics"
 	"github.com/ChefBingbong/viem-go/types"
 )
 
@@ -73,6 +75,13 @@ type ClientConfig struct {
 	ExperimentalBlockTag BlockTag
 	// Key is a key for the client.
 	Key string
+	// Logger receives structured debug/info/warn events from the client's
+	// transport and from polling actions (optional). URLs are logged with
+	// API keys redacted. It is passed to transports that do not set their own.
+	Logger *slog.Logger
+	// Metrics receives request and multicall measurements (optional).
+	// It is passed to transports that do not set their own collector.
+	Metrics metrics.Collector
 	// Name is a name for the client.
 	Name string
 	// PollingInterval is the frequency (in ms) for polling enabled actions & events.
@@ -114,6 +123,10 @@ type BaseClient struct {
 	experimentalBlockTag BlockTag
 	// Key is a key for the client.
 	key string
+	// Logger is the logger, if any.
+	logger *slog.Logger
+	// Metrics is the metrics collector, if any.
+	metrics metrics.Collector
 	// Name is a name for the client.
 	name string
 	// PollingInterval is the frequency for polling.
@@ -170,6 +183,8 @@ func CreateClient(config ClientConfig) (*BaseClient, error) {
 	tr, err := config.Transport(transport.TransportParams{
 		Chain:           config.Chain,
 		PollingInterval: config.PollingInterval,
+		Metrics:         config.Metrics,
+		Logger:          config.Logger,
 	})
 	if err != nil {
 		return nil, err
@@ -187,6 +202,8 @@ func CreateClient(config ClientConfig) (*BaseClient, error) {
 		dataSuffix:           config.DataSuffix,
 		experimentalBlockTag: experimentalBlockTag,
 		key:                  config.Key,
+		logger:               config.Logger,
+		metrics:              config.Metrics,
 		name:                 config.Name,
 		pollingInterval:      config.PollingInterval,
 		transport:            tr,
@@ -238,6 +255,16 @@ func (c *BaseClient) Key() string {
 	return c.key
 }
 
+// Logger returns the client's logger, or nil if logging is disabled.
+func (c *BaseClient) Logger() *slog.Logger {
+	return c.logger
+}
+
+// Metrics returns the metrics collector, or nil if metrics are disabled.
+func (c *BaseClient) Metrics() metrics.Collector {
+	return c.metrics
+}
+
 // Name returns the client name.
 func (c *BaseClient) Name() string {
 	return c.name
@@ -16,28 +16,33 @@ import (
 func PublicActions(c *client.PublicClient) map[string]any {
 	return map[string]any{
 		// Read actions
-		"getBlockNumber":            c.GetBlockNumber,
-		"getChainId":                c.GetChainID,
-		"getGasPrice":               c.GetGasPrice,
-		"getBalance":                c.GetBalance,
-		"getTransactionCount":       c.GetTransactionCount,
-		"getCode":                   c.GetCode,
-		"getStorageAt":              c.GetStorageAt,
-		"call":                      c.Call,
-		"estimateGas":               c.EstimateGas,
-		"getBlock":                  c.GetBlock,
-		"getBlockByNumber":          c.GetBlockByNumber,
-		"getBlockByHash":            c.GetBlockByHash,
-		"getTransaction":            c.GetTransaction,
-		"getTransactionReceipt":     c.GetTransactionReceipt,
-		"getLogs":                   c.GetLogs,
-		"getFeeHistory":             c.GetFeeHistory,
-		"getMaxPriorityFeePerGas":   c.GetMaxPriorityFeePerGas,
-		"getProof":                  c.GetProof,
-		"waitForTransactionReceipt": c.WaitForTransactionReceipt,
-		"readContract":              c.ReadContract,
-		"simulateContract":          c.SimulateContract,
-		"prepareContractWrite":      c.PrepareContractWrite,
+		"getBlockNumber":              c.GetBlockNumber,
+		"getChainId":                  c.GetChainID,
+		"getGasPrice":                 c.GetGasPrice,
+		"getBalance":                  c.GetBalance,
+		"getTransactionCount":         c.GetTransactionCount,
+		"getCode":                     c.GetCode,
+		"getStorageAt":                c.GetStorageAt,
+		"call":                        c.Call,
+		"estimateGas":                 c.EstimateGas,
+		"getBlock":                    c.GetBlock,
+		"getBlockByNumber":            c.GetBlockByNumber,
+		"getBlockByHash":              c.GetBlockByHash,
+		"getTransaction":              c.GetTransaction,
+		"getTransactionReceipt":       c.GetTransactionReceipt,
+		"getLogs":                     c.GetLogs,
+		"getFeeHistory":               c.GetFeeHistory,
+		"getMaxPriorityFeePerGas":     c.GetMaxPriorityFeePerGas,
+		"getProof":                    c.GetProof,
+		"getVerifiedBalance":          c.GetVerifiedBalance,
+		"getVerifiedCode":             c.GetVerifiedCode,
+		"getVerifiedStorageAt":        c.GetVerifiedStorageAt,
+		"getVerifiedTransactionCount": c.GetVerifiedTransactionCount,
+		"verifyBlock":                 c.VerifyBlock,
+		"waitForTransactionReceipt":   c.WaitForTransactionReceipt,
+		"readContract":                c.ReadContract,
+		"simulateContract":            c.SimulateContract,
+		"prepareContractWrite":        c.PrepareContractWrite,
 
 		// Watch actions
 		"watchBlockNumber":         c.WatchBlockNumber,
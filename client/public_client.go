@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"log/slog"
 	"math/big"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/ChefBingbong/viem-go/actions/public"
 	"github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/metrics"
 	"github.com/ChefBingbong/viem-go/types"
 )
 
@@ -31,6 +33,10 @@ type PublicClientConfig struct {
 	ExperimentalBlockTag BlockTag
 	// Key is a key for the client (default: "public").
 	Key string
+	// Logger receives structured log events (optional).
+	Logger *slog.Logger
+	// Metrics receives request and multicall measurements (optional).
+	Metrics metrics.Collector
 	// Name is a name for the client (default: "Public Client").
 	Name string
 	// PollingInterval is the frequency (in ms) for polling enabled actions & events.
@@ -74,6 +80,8 @@ func CreatePublicClient(config PublicClientConfig) (*PublicClient, error) {
 		Chain:                config.Chain,
 		ExperimentalBlockTag: config.ExperimentalBlockTag,
 		Key:                  key,
+		Logger:               config.Logger,
+		Metrics:              config.Metrics,
 		Name:                 name,
 		PollingInterval:      config.PollingInterval,
 		Transport:            config.Transport,
@@ -369,20 +377,46 @@ func (c *PublicClient) GetMaxPriorityFeePerGas(ctx context.Context) (*big.Int, e
 }
 
 // GetProof returns the account and storage values with Merkle proof.
-func (c *PublicClient) GetProof(ctx context.Context, address common.Address, storageKeys []common.Hash, blockTag ...BlockTag) (json.RawMessage, error) {
-	tag := c.resolveBlockTag(blockTag)
+// This delegates to the standalone public.GetProof action; use
+// proof.VerifyProof or the GetVerified* methods to check the proof.
+func (c *PublicClient) GetProof(ctx context.Context, address common.Address, storageKeys []common.Hash, blockTag ...BlockTag) (public.GetProofReturnType, error) {
+	return public.GetProof(ctx, c, public.GetProofParameters{
+		Address:     address,
+		StorageKeys: storageKeys,
+		BlockTag:    c.resolveBlockTag(blockTag),
+	})
+}
 
-	keys := make([]string, len(storageKeys))
-	for i, k := range storageKeys {
-		keys[i] = k.Hex()
-	}
+// GetVerifiedBalance returns the balance of an address, proven with
+// eth_getProof against the trusted state root or block hash in params.
+// This delegates to the standalone public.GetVerifiedBalance action.
+func (c *PublicClient) GetVerifiedBalance(ctx context.Context, params public.GetVerifiedAccountParameters) (*big.Int, error) {
+	return public.GetVerifiedBalance(ctx, c, params)
+}
 
-	resp, err := c.Request(ctx, "eth_getProof", address.Hex(), keys, string(tag))
-	if err != nil {
-		return nil, err
-	}
+// GetVerifiedTransactionCount returns the nonce of an address, proven with
+// eth_getProof against the trusted state root or block hash in params.
+func (c *PublicClient) GetVerifiedTransactionCount(ctx context.Context, params public.GetVerifiedAccountParameters) (uint64, error) {
+	return public.GetVerifiedTransactionCount(ctx, c, params)
+}
 
-	return resp.Result, nil
+// GetVerifiedCode returns the bytecode at an address, checked against the
+// code hash proven with eth_getProof against the trusted state root or
+// block hash in params.
+func (c *PublicClient) GetVerifiedCode(ctx context.Context, params public.GetVerifiedAccountParameters) ([]byte, error) {
+	return public.GetVerifiedCode(ctx, c, params)
+}
+
+// GetVerifiedStorageAt returns the value at a storage position, proven with
+// eth_getProof against the trusted state root or block hash in params.
+func (c *PublicClient) GetVerifiedStorageAt(ctx context.Context, params public.GetVerifiedAccountParameters, slot common.Hash) ([]byte, error) {
+	return public.GetVerifiedStorageAt(ctx, c, params, slot)
+}
+
+// VerifyBlock fetches a block and checks its hash, transactions root and
+// receipts root against the recomputed values.
+func (c *PublicClient) VerifyBlock(ctx context.Context, params public.VerifyBlockParameters) (*public.VerifyBlockReturnType, error) {
+	return public.VerifyBlock(ctx, c, params)
 }
 
 // WaitForTransactionReceipt waits for a transaction to be mined and returns its receipt.
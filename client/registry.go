@@ -0,0 +1,327 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/metrics"
+)
+
+// DefaultRegistryEnvPrefix is the prefix of the environment variables that
+// override a chain's RPC URL (see RegistryConfig.EnvPrefix).
+const DefaultRegistryEnvPrefix = "RPC_URL_"
+
+var (
+	// ErrRegistryClosed is returned by a Registry after Close.
+	ErrRegistryClosed = errors.New("client: registry is closed")
+	// ErrUnknownChain is returned when a chain is not in the registry.
+	ErrUnknownChain = errors.New("client: chain not in registry")
+)
+
+// RegistryChain configures one chain of a Registry.
+type RegistryChain struct {
+	// Chain is the chain definition. Required.
+	Chain *chain.Chain
+	// URL overrides the chain's default RPC URL. URLs starting with ws:// or
+	// wss:// use a WebSocket transport, anything else HTTP.
+	URL string
+	// Transport overrides the transport entirely (e.g. a Fallback). When set,
+	// URL and environment overrides are ignored.
+	Transport transport.TransportFactory
+}
+
+// RegistryConfig configures a Registry. The batching, polling, logging and
+// metrics settings are shared by every client the registry creates.
+type RegistryConfig struct {
+	// Chains lists the chains served by the registry.
+	Chains []RegistryChain
+	// EnvPrefix is the prefix of the environment variables overriding RPC
+	// URLs. A chain's URL is read from <prefix><ID> (e.g. RPC_URL_8453) or
+	// <prefix><NAME> with the name upper-cased and non-alphanumerics replaced
+	// by underscores (e.g. RPC_URL_BASE). Default: DefaultRegistryEnvPrefix.
+	// Set DisableEnv to ignore the environment.
+	EnvPrefix string
+	// DisableEnv disables environment variable overrides.
+	DisableEnv bool
+
+	// Batch contains batch settings for public clients.
+	Batch *BatchOptions
+	// CacheTime is the time that cached data will remain in memory.
+	CacheTime time.Duration
+	// PollingInterval is the frequency for polling enabled actions & events.
+	PollingInterval time.Duration
+	// Logger receives structured log events (optional).
+	Logger *slog.Logger
+	// Metrics receives request and multicall measurements (optional).
+	Metrics metrics.Collector
+}
+
+// Registry lazily creates and caches public and wallet clients for a set of
+// chains, so that multi-chain services share one client per chain instead of
+// building their own maps. It is safe for concurrent use.
+//
+// Example:
+//
+//	reg, err := client.NewRegistry(client.RegistryConfig{
+//	    Chains: []client.RegistryChain{
+//	        {Chain: &definitions.Mainnet},
+//	        {Chain: &definitions.Optimism, URL: "https://opt-mainnet.example"},
+//	    },
+//	})
+//	defer reg.Close()
+//
+//	mainnet, err := reg.Public(1)
+//	op, err := reg.PublicByName("OP Mainnet")
+type Registry struct {
+	config RegistryConfig
+	chains map[int64]RegistryChain
+	names  map[string]int64
+	order  []int64
+
+	mu      sync.Mutex
+	public  map[int64]*PublicClient
+	wallets map[walletKey]*WalletClient
+	closed  bool
+}
+
+// walletKey identifies a cached wallet client.
+type walletKey struct {
+	chainID int64
+	account common.Address
+}
+
+// NewRegistry creates a registry. Clients are not created until first use.
+func NewRegistry(config RegistryConfig) (*Registry, error) {
+	if config.EnvPrefix == "" {
+		config.EnvPrefix = DefaultRegistryEnvPrefix
+	}
+	r := &Registry{
+		config:  config,
+		chains:  make(map[int64]RegistryChain, len(config.Chains)),
+		names:   make(map[string]int64, len(config.Chains)),
+		public:  make(map[int64]*PublicClient),
+		wallets: make(map[walletKey]*WalletClient),
+	}
+	for _, c := range config.Chains {
+		if c.Chain == nil {
+			return nil, errors.New("client: registry chain definition is required")
+		}
+		if _, ok := r.chains[c.Chain.ID]; ok {
+			return nil, fmt.Errorf("client: duplicate registry chain %d", c.Chain.ID)
+		}
+		r.chains[c.Chain.ID] = c
+		r.names[strings.ToLower(c.Chain.Name)] = c.Chain.ID
+		r.order = append(r.order, c.Chain.ID)
+	}
+	sort.Slice(r.order, func(i, j int) bool { return r.order[i] < r.order[j] })
+	return r, nil
+}
+
+// Chains returns the registered chains, ordered by ID.
+func (r *Registry) Chains() []*chain.Chain {
+	out := make([]*chain.Chain, len(r.order))
+	for i, id := range r.order {
+		out[i] = r.chains[id].Chain
+	}
+	return out
+}
+
+// Chain returns the chain with the given ID.
+func (r *Registry) Chain(id int64) (*chain.Chain, bool) {
+	c, ok := r.chains[id]
+	return c.Chain, ok
+}
+
+// ChainByName returns the chain with the given name (case-insensitive).
+func (r *Registry) ChainByName(name string) (*chain.Chain, bool) {
+	id, ok := r.names[strings.ToLower(name)]
+	if !ok {
+		return nil, false
+	}
+	return r.Chain(id)
+}
+
+// URL returns the RPC URL the registry uses for a chain, after applying the
+// configured and environment overrides. It is empty for chains configured
+// with a custom Transport.
+func (r *Registry) URL(id int64) (string, error) {
+	c, ok := r.chains[id]
+	if !ok {
+		return "", fmt.Errorf("%w: %d", ErrUnknownChain, id)
+	}
+	if c.Transport != nil {
+		return "", nil
+	}
+	return r.url(c), nil
+}
+
+// Public returns the public client of a chain, creating it on first use.
+func (r *Registry) Public(id int64) (*PublicClient, error) {
+	c, ok := r.chains[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownChain, id)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, ErrRegistryClosed
+	}
+	if pc, ok := r.public[id]; ok {
+		return pc, nil
+	}
+	pc, err := CreatePublicClient(PublicClientConfig{
+		Batch:           r.config.Batch,
+		CacheTime:       r.config.CacheTime,
+		Chain:           c.Chain,
+		Logger:          r.config.Logger,
+		Metrics:         r.config.Metrics,
+		PollingInterval: r.config.PollingInterval,
+		Transport:       r.transport(c),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("client: failed to create client for chain %d: %w", id, err)
+	}
+	r.public[id] = pc
+	return pc, nil
+}
+
+// PublicByName returns the public client of the chain with the given name.
+func (r *Registry) PublicByName(name string) (*PublicClient, error) {
+	id, ok := r.names[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownChain, name)
+	}
+	return r.Public(id)
+}
+
+// Wallet returns the wallet client of a chain for account, creating it on
+// first use. Clients are cached per chain and account address.
+func (r *Registry) Wallet(id int64, account Account) (*WalletClient, error) {
+	if account == nil {
+		return nil, errors.New("client: registry wallet account is required")
+	}
+	c, ok := r.chains[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownChain, id)
+	}
+
+	key := walletKey{chainID: id, account: account.Address()}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, ErrRegistryClosed
+	}
+	if wc, ok := r.wallets[key]; ok {
+		return wc, nil
+	}
+	wc, err := CreateWalletClient(WalletClientConfig{
+		Account:         account,
+		CacheTime:       r.config.CacheTime,
+		Chain:           c.Chain,
+		Logger:          r.config.Logger,
+		Metrics:         r.config.Metrics,
+		PollingInterval: r.config.PollingInterval,
+		Transport:       r.transport(c),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("client: failed to create wallet client for chain %d: %w", id, err)
+	}
+	r.wallets[key] = wc
+	return wc, nil
+}
+
+// WalletByName returns the wallet client of the chain with the given name.
+func (r *Registry) WalletByName(name string, account Account) (*WalletClient, error) {
+	id, ok := r.names[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownChain, name)
+	}
+	return r.Wallet(id, account)
+}
+
+// Close closes every client created by the registry. Further lookups return
+// ErrRegistryClosed. Closing an already closed registry is a no-op.
+func (r *Registry) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	var errs []error
+	for id, pc := range r.public {
+		if err := pc.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("chain %d: %w", id, err))
+		}
+	}
+	for key, wc := range r.wallets {
+		if err := wc.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("chain %d wallet %s: %w", key.chainID, key.account.Hex(), err))
+		}
+	}
+	r.public = nil
+	r.wallets = nil
+	return errors.Join(errs...)
+}
+
+// transport returns the transport factory of a chain.
+func (r *Registry) transport(c RegistryChain) transport.TransportFactory {
+	if c.Transport != nil {
+		return c.Transport
+	}
+	url := r.url(c)
+	if strings.HasPrefix(url, "ws://") || strings.HasPrefix(url, "wss://") {
+		return transport.WebSocket(url)
+	}
+	// An empty URL makes the HTTP transport fall back to the chain default.
+	return transport.HTTP(url)
+}
+
+// url resolves the RPC URL of a chain: environment, then configuration,
+// then the chain's default HTTP URL.
+func (r *Registry) url(c RegistryChain) string {
+	if !r.config.DisableEnv {
+		for _, name := range []string{
+			r.config.EnvPrefix + strconv.FormatInt(c.Chain.ID, 10),
+			r.config.EnvPrefix + envName(c.Chain.Name),
+		} {
+			if v := os.Getenv(name); v != "" {
+				return v
+			}
+		}
+	}
+	if c.URL != "" {
+		return c.URL
+	}
+	if urls, ok := c.Chain.RpcUrls["default"]; ok && len(urls.HTTP) > 0 {
+		return urls.HTTP[0]
+	}
+	return ""
+}
+
+// envName converts a chain name to an environment variable suffix:
+// "OP Mainnet" becomes "OP_MAINNET".
+func envName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(name) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
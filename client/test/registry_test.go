@@ -0,0 +1,163 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/client"
+)
+
+func registryChain(id int64, name, url string) *chain.Chain {
+	return &chain.Chain{
+		ID:             id,
+		Name:           name,
+		NativeCurrency: chain.ChainNativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+		RpcUrls:        map[string]chain.ChainRpcUrls{"default": {HTTP: []string{url}}},
+	}
+}
+
+func blockNumberServer(t *testing.T, block string) string {
+	server := createTestServer(t, func(method string, params []any) any {
+		return block
+	})
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	reg, err := client.NewRegistry(client.RegistryConfig{
+		Chains: []client.RegistryChain{
+			{Chain: registryChain(10, "OP Mainnet", "http://op.invalid")},
+			{Chain: registryChain(1, "Ethereum", "http://eth.invalid")},
+		},
+		DisableEnv: true,
+	})
+	require.NoError(t, err)
+	defer reg.Close()
+
+	chains := reg.Chains()
+	require.Len(t, chains, 2)
+	assert.Equal(t, int64(1), chains[0].ID)
+	assert.Equal(t, int64(10), chains[1].ID)
+
+	c, ok := reg.ChainByName("op mainnet")
+	require.True(t, ok)
+	assert.Equal(t, int64(10), c.ID)
+
+	_, ok = reg.Chain(137)
+	assert.False(t, ok)
+	_, err = reg.Public(137)
+	assert.ErrorIs(t, err, client.ErrUnknownChain)
+	_, err = reg.PublicByName("Polygon")
+	assert.ErrorIs(t, err, client.ErrUnknownChain)
+}
+
+func TestRegistry_DuplicateChain(t *testing.T) {
+	_, err := client.NewRegistry(client.RegistryConfig{
+		Chains: []client.RegistryChain{
+			{Chain: registryChain(1, "Ethereum", "http://a.invalid")},
+			{Chain: registryChain(1, "Ethereum", "http://b.invalid")},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestRegistry_PublicIsCached(t *testing.T) {
+	url := blockNumberServer(t, "0x10")
+	reg, err := client.NewRegistry(client.RegistryConfig{
+		Chains:     []client.RegistryChain{{Chain: registryChain(1, "Ethereum", url)}},
+		DisableEnv: true,
+	})
+	require.NoError(t, err)
+	defer reg.Close()
+
+	first, err := reg.Public(1)
+	require.NoError(t, err)
+	second, err := reg.PublicByName("Ethereum")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, int64(1), first.Chain().ID)
+
+	n, err := first.GetBlockNumber(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(16), n)
+}
+
+func TestRegistry_URLOverrides(t *testing.T) {
+	configured := blockNumberServer(t, "0x1")
+	byID := blockNumberServer(t, "0x2")
+	byName := blockNumberServer(t, "0x3")
+
+	reg, err := client.NewRegistry(client.RegistryConfig{
+		Chains: []client.RegistryChain{
+			{Chain: registryChain(1, "Ethereum", "http://default.invalid"), URL: configured},
+			{Chain: registryChain(10, "OP Mainnet", "http://default.invalid")},
+			{Chain: registryChain(8453, "Base", "http://default.invalid")},
+		},
+		EnvPrefix: "TEST_RPC_",
+	})
+	require.NoError(t, err)
+	defer reg.Close()
+
+	t.Setenv("TEST_RPC_10", byID)
+	t.Setenv("TEST_RPC_BASE", byName)
+
+	for id, want := range map[int64]uint64{1: 1, 10: 2, 8453: 3} {
+		pc, err := reg.Public(id)
+		require.NoError(t, err)
+		n, err := pc.GetBlockNumber(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, want, n, "chain %d", id)
+	}
+
+	url, err := reg.URL(8453)
+	require.NoError(t, err)
+	assert.Equal(t, byName, url)
+}
+
+func TestRegistry_WalletCachedPerAccount(t *testing.T) {
+	reg, err := client.NewRegistry(client.RegistryConfig{
+		Chains:     []client.RegistryChain{{Chain: registryChain(1, "Ethereum", blockNumberServer(t, "0x1"))}},
+		DisableEnv: true,
+	})
+	require.NoError(t, err)
+	defer reg.Close()
+
+	alice := client.NewAddressAccount(common.HexToAddress("0x000000000000000000000000000000000000a11c"))
+	bob := client.NewAddressAccount(common.HexToAddress("0x0000000000000000000000000000000000000b0b"))
+
+	a1, err := reg.Wallet(1, alice)
+	require.NoError(t, err)
+	a2, err := reg.WalletByName("ethereum", alice)
+	require.NoError(t, err)
+	b, err := reg.Wallet(1, bob)
+	require.NoError(t, err)
+
+	assert.Same(t, a1, a2)
+	assert.NotSame(t, a1, b)
+	assert.Equal(t, alice.Address(), a1.Account().Address())
+}
+
+func TestRegistry_Close(t *testing.T) {
+	reg, err := client.NewRegistry(client.RegistryConfig{
+		Chains:     []client.RegistryChain{{Chain: registryChain(1, "Ethereum", blockNumberServer(t, "0x1"))}},
+		DisableEnv: true,
+	})
+	require.NoError(t, err)
+
+	_, err = reg.Public(1)
+	require.NoError(t, err)
+
+	require.NoError(t, reg.Close())
+	require.NoError(t, reg.Close())
+
+	_, err = reg.Public(1)
+	assert.ErrorIs(t, err, client.ErrRegistryClosed)
+	_, err = reg.Wallet(1, client.NewAddressAccount(common.Address{}))
+	assert.ErrorIs(t, err, client.ErrRegistryClosed)
+}
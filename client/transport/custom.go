@@ -2,7 +2,10 @@ package transport
 
 import (
 	"context"
+	"log/slog"
 	"time"
+
+	"github.com/ChefBingbong/viem-go/metrics"
 )
 
 // CustomTransportConfig contains configuration for a custom transport.
@@ -21,6 +24,12 @@ type CustomTransportConfig struct {
 	RetryDelay time.Duration
 	// Timeout is the request timeout.
 	Timeout time.Duration
+	// Metrics receives request and retry measurements, labelled with Key.
+	// Defaults to the client's collector.
+	Metrics metrics.Collector
+	// Logger receives request and retry events. Defaults to the client's
+	// logger.
+	Logger *slog.Logger
 }
 
 // DefaultCustomTransportConfig returns default custom transport configuration.
@@ -37,6 +46,7 @@ func DefaultCustomTransportConfig() CustomTransportConfig {
 // CustomTransport implements a custom transport.
 type CustomTransport struct {
 	config CustomTransportConfig
+	instr  instrumentation
 }
 
 // Custom creates a new custom transport factory.
@@ -49,6 +59,12 @@ func Custom(config CustomTransportConfig) TransportFactory {
 		if params.Timeout != nil {
 			config.Timeout = *params.Timeout
 		}
+		if config.Metrics == nil {
+			config.Metrics = params.Metrics
+		}
+		if config.Logger == nil {
+			config.Logger = params.Logger
+		}
 
 		return NewCustomTransport(config), nil
 	}
@@ -65,6 +81,7 @@ func NewCustomTransport(config CustomTransportConfig) *CustomTransport {
 
 	return &CustomTransport{
 		config: config,
+		instr:  newInstrumentation(config.Key, "", config.Metrics, config.Logger),
 	}
 }
 
@@ -97,7 +114,11 @@ func (t *CustomTransport) Request(ctx context.Context, req RPCRequest) (*RPCResp
 	}
 
 	// Send request with retry
-	return t.retryRequest(ctx, req)
+	ctx, span := t.instr.start(ctx, req.Method)
+	start := time.Now()
+	resp, err := t.retryRequest(ctx, req)
+	t.instr.request(span, req.Method, start, err)
+	return resp, err
 }
 
 // retryRequest sends a request with retry logic.
@@ -140,6 +161,7 @@ func (t *CustomTransport) retryRequest(ctx context.Context, req RPCRequest) (*RP
 		// Wait before retry
 		if attempt < t.config.RetryCount {
 			delay := t.config.RetryDelay * time.Duration(1<<attempt)
+			t.instr.retry(req.Method, attempt, delay, lastErr)
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
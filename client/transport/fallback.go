@@ -3,8 +3,13 @@ package transport
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"slices"
 	"sync"
 	"time"
+
+	"github.com/ChefBingbong/viem-go/metrics"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
 )
 
 // FallbackTransportConfig contains configuration for the fallback transport.
@@ -23,6 +28,14 @@ type FallbackTransportConfig struct {
 	RetryDelay time.Duration
 	// Timeout is the request timeout.
 	Timeout time.Duration
+	// Metrics is passed to the underlying transports, which record their
+	// own requests, and receives the latency, stability and selection of
+	// every member, labelled with its redacted URL. Defaults to the client's
+	// collector.
+	Metrics metrics.Collector
+	// Logger receives fallback switches and re-ranking events, and is passed
+	// to the underlying transports. Defaults to the client's logger.
+	Logger *slog.Logger
 }
 
 // RankConfig contains configuration for transport ranking.
@@ -70,13 +83,24 @@ type transportStats struct {
 	mu        sync.RWMutex
 }
 
+// stability returns the share of successful requests. The caller must hold mu.
+func (s *transportStats) stability() float64 {
+	total := s.successes + s.failures
+	if total == 0 {
+		return 0
+	}
+	return float64(s.successes) / float64(total)
+}
+
 // FallbackTransport implements a fallback transport that tries multiple transports.
 type FallbackTransport struct {
 	config     FallbackTransportConfig
 	transports []Transport
 	stats      []*transportStats
+	urls       []string // redacted member URLs, the metric labels
 	order      []int
 	orderMu    sync.RWMutex
+	logger     *slog.Logger
 }
 
 // Fallback creates a new fallback transport factory.
@@ -91,6 +115,13 @@ func FallbackWithConfig(factories []TransportFactory, config FallbackTransportCo
 			return nil, errors.New("at least one transport factory is required")
 		}
 
+		if config.Metrics != nil {
+			params.Metrics = config.Metrics
+		}
+		if config.Logger != nil {
+			params.Logger = config.Logger
+		}
+
 		// Create transports
 		transports := make([]Transport, 0, len(factories))
 		for _, factory := range factories {
@@ -114,7 +145,10 @@ func FallbackWithConfig(factories []TransportFactory, config FallbackTransportCo
 			config.Timeout = *params.Timeout
 		}
 
-		return NewFallbackTransport(transports, config)
+		cfg := config
+		cfg.Metrics = params.Metrics
+		cfg.Logger = params.Logger
+		return NewFallbackTransport(transports, cfg)
 	}
 }
 
@@ -126,9 +160,14 @@ func NewFallbackTransport(transports []Transport, config FallbackTransportConfig
 
 	// Initialize stats
 	stats := make([]*transportStats, len(transports))
+	urls := make([]string, len(transports))
 	order := make([]int, len(transports))
-	for i := range transports {
+	for i, transport := range transports {
 		stats[i] = &transportStats{}
+		urls[i] = transport.Config().Key
+		if value := transport.Value(); value != nil && value.URL != "" {
+			urls[i] = rpc.SanitizeURL(value.URL)
+		}
 		order[i] = i
 	}
 
@@ -136,8 +175,11 @@ func NewFallbackTransport(transports []Transport, config FallbackTransportConfig
 		config:     config,
 		transports: transports,
 		stats:      stats,
+		urls:       urls,
 		order:      order,
+		logger:     rpc.LoggerOrDiscard(config.Logger).With("transport", config.Key),
 	}
+	ft.observe()
 
 	// Start ranking if enabled
 	if config.Rank != nil && config.Rank.Enabled {
@@ -174,6 +216,7 @@ func (t *FallbackTransport) Request(ctx context.Context, req RPCRequest) (*RPCRe
 	if req.JSONRPC == "" {
 		req.JSONRPC = "2.0"
 	}
+	defer t.observe()
 
 	// Get transport order
 	t.orderMu.RLock()
@@ -209,6 +252,12 @@ func (t *FallbackTransport) Request(ctx context.Context, req RPCRequest) (*RPCRe
 		stats.mu.Unlock()
 
 		lastErr = err
+		t.logger.Warn("fallback transport failed",
+			"method", req.Method,
+			"failed", transport.Config().Key,
+			"index", idx,
+			"error", err,
+		)
 
 		// Check context
 		select {
@@ -284,11 +333,7 @@ func (t *FallbackTransport) updateRanking() {
 
 	for i, stats := range t.stats {
 		stats.mu.RLock()
-		total := stats.successes + stats.failures
-		stability := 0.0
-		if total > 0 {
-			stability = float64(stats.successes) / float64(total)
-		}
+		stability := stats.stability()
 		latencyScore := 1.0 - (float64(stats.latency) / float64(maxLatency))
 		if latencyScore < 0 {
 			latencyScore = 0
@@ -314,8 +359,32 @@ func (t *FallbackTransport) updateRanking() {
 	}
 
 	t.orderMu.Lock()
+	changed := !slices.Equal(t.order, order)
 	t.order = order
 	t.orderMu.Unlock()
+
+	if changed {
+		t.logger.Info("fallback transports re-ranked", "order", order, "scores", scores)
+	}
+	t.observe()
+}
+
+// observe reports the latency, stability and selection of every member to
+// the metrics collector.
+func (t *FallbackTransport) observe() {
+	if t.config.Metrics == nil {
+		return
+	}
+	t.orderMu.RLock()
+	first := t.order[0]
+	t.orderMu.RUnlock()
+
+	for i, stats := range t.stats {
+		stats.mu.RLock()
+		latency, stability := stats.latency, stats.stability()
+		stats.mu.RUnlock()
+		t.config.Metrics.ObserveFallback(t.config.Key, t.urls[i], latency, stability, i == first)
+	}
 }
 
 // Transports returns the underlying transports.
@@ -3,8 +3,10 @@ package transport
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
+	"github.com/ChefBingbong/viem-go/metrics"
 	"github.com/ChefBingbong/viem-go/utils/rpc"
 )
 
@@ -34,6 +36,12 @@ type HTTPTransportConfig struct {
 	OnResponse func(resp any) error
 	// Raw returns RPC errors as responses instead of throwing.
 	Raw bool
+	// Metrics receives request, retry and batch measurements, labelled
+	// with Key. Defaults to the client's collector.
+	Metrics metrics.Collector
+	// Logger receives request and retry events. The URL is logged with
+	// credentials redacted. Defaults to the client's logger.
+	Logger *slog.Logger
 }
 
 // BatchConfig contains batching configuration.
@@ -62,6 +70,7 @@ type HTTPTransport struct {
 	config         HTTPTransportConfig
 	client         *rpc.HTTPClient
 	batchScheduler *rpc.BatchScheduler
+	instr          instrumentation
 }
 
 // HTTP creates a new HTTP transport factory.
@@ -95,6 +104,12 @@ func HTTP(url string, config ...HTTPTransportConfig) TransportFactory {
 		if params.Timeout != nil {
 			cfg.Timeout = *params.Timeout
 		}
+		if cfg.Metrics == nil {
+			cfg.Metrics = params.Metrics
+		}
+		if cfg.Logger == nil {
+			cfg.Logger = params.Logger
+		}
 
 		return NewHTTPTransport(cfg)
 	}
@@ -102,6 +117,10 @@ func HTTP(url string, config ...HTTPTransportConfig) TransportFactory {
 
 // NewHTTPTransport creates a new HTTP transport.
 func NewHTTPTransport(config HTTPTransportConfig) (*HTTPTransport, error) {
+	if config.Key == "" {
+		config.Key = "http"
+	}
+
 	// Create HTTP client
 	clientOpts := rpc.HTTPClientOptions{
 		Timeout: config.Timeout,
@@ -116,6 +135,7 @@ func NewHTTPTransport(config HTTPTransportConfig) (*HTTPTransport, error) {
 	transport := &HTTPTransport{
 		config: config,
 		client: client,
+		instr:  newInstrumentation(config.Key, config.URL, config.Metrics, config.Logger),
 	}
 
 	// Create batch scheduler if batching is enabled
@@ -127,6 +147,11 @@ func NewHTTPTransport(config HTTPTransportConfig) (*HTTPTransport, error) {
 		if batchOpts.BatchSize == 0 {
 			batchOpts.BatchSize = 1000
 		}
+		if config.Metrics != nil {
+			batchOpts.OnFlush = func(size int) {
+				config.Metrics.ObserveBatch(config.Key, size)
+			}
+		}
 		transport.batchScheduler = rpc.NewBatchScheduler(client, batchOpts)
 	}
 
@@ -164,13 +189,21 @@ func (t *HTTPTransport) Request(ctx context.Context, req RPCRequest) (*RPCRespon
 		body.ID = NextID()
 	}
 
+	ctx, span := t.instr.start(ctx, body.Method)
+	start := time.Now()
+	var resp *RPCResponse
+	var err error
+
 	// Use batch scheduler if available
 	if t.batchScheduler != nil {
-		return t.batchedRequest(ctx, body)
+		resp, err = t.batchedRequest(ctx, body)
+	} else {
+		// Send request with retry
+		resp, err = t.retryRequest(ctx, body)
 	}
 
-	// Send request with retry
-	return t.retryRequest(ctx, body)
+	t.instr.request(span, body.Method, start, err)
+	return resp, err
 }
 
 // batchedRequest sends a request through the batch scheduler.
@@ -246,6 +279,7 @@ func (t *HTTPTransport) retryRequest(ctx context.Context, body RPCRequest) (*RPC
 		// Wait before retry
 		if attempt < t.config.RetryCount {
 			delay := t.calculateRetryDelay(attempt, lastErr)
+			t.instr.retry(body.Method, attempt, delay, lastErr)
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
@@ -2,6 +2,7 @@ package transport_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
 )
 
 func TestHTTPTransport_BasicRequest(t *testing.T) {
@@ -98,6 +100,53 @@ func TestHTTPTransport_BatchRequest(t *testing.T) {
 	assert.Equal(t, "HTTP Test", cfg.Name)
 }
 
+func TestBatchScheduler_LargeRequestIDs(t *testing.T) {
+	// As float64, 1000001 prints as 1.000001e+06 and 2^53+1 rounds to 2^53;
+	// batch responses must still be matched to their requests.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqs []struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&reqs))
+
+		responses := make([]map[string]any, 0, len(reqs))
+		for i := len(reqs) - 1; i >= 0; i-- {
+			responses = append(responses, map[string]any{
+				"jsonrpc": "2.0",
+				"id":      reqs[i].ID,
+				"result":  reqs[i].Method,
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(responses)
+	}))
+	defer server.Close()
+
+	client, err := rpc.NewHTTPClient(server.URL)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ids := []uint64{1_000_000, 1_000_001, 123_456_789, 1 << 53, 1<<53 + 1}
+	scheduler := rpc.NewBatchScheduler(client, rpc.BatchSchedulerOptions{BatchSize: len(ids), Wait: time.Second})
+	defer scheduler.Close()
+
+	results := make(chan error, len(ids))
+	for _, id := range ids {
+		go func(id uint64) {
+			method := fmt.Sprintf("method_%d", id)
+			resp, err := scheduler.Schedule(context.Background(), rpc.RPCRequest{JSONRPC: "2.0", ID: id, Method: method})
+			if err == nil && string(resp.Result) != `"`+method+`"` {
+				err = fmt.Errorf("request %d got result %s", id, resp.Result)
+			}
+			results <- err
+		}(id)
+	}
+	for range ids {
+		require.NoError(t, <-results)
+	}
+}
+
 func TestHTTPTransport_Error(t *testing.T) {
 	// Create a test server that returns an error
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -32,7 +32,12 @@ package transport
 
 import (
 	"context"
+	"log/slog"
 	"time"
+
+	"github.com/ChefBingbong/viem-go/metrics"
+	"github.com/ChefBingbong/viem-go/tracing"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
 )
 
 // CreateTransportConfig contains configuration for creating a transport.
@@ -251,3 +256,53 @@ func (t *TransportInstance) Close() error {
 func (t *TransportInstance) Transport() Transport {
 	return t.transport
 }
+
+// instrumentation holds a transport's optional metrics collector and logger,
+// and starts tracing spans for its requests.
+type instrumentation struct {
+	key     string
+	metrics metrics.Collector
+	logger  *slog.Logger
+}
+
+// newInstrumentation creates the instrumentation for a transport. The logger
+// is annotated with the transport key and, if set, the redacted URL.
+func newInstrumentation(key, url string, collector metrics.Collector, logger *slog.Logger) instrumentation {
+	logger = rpc.LoggerOrDiscard(logger).With("transport", key)
+	if url != "" {
+		logger = logger.With("url", rpc.SanitizeURL(url))
+	}
+	return instrumentation{key: key, metrics: collector, logger: logger}
+}
+
+// start starts the span for a request. The span is a no-op unless ctx
+// carries a tracer.
+func (in instrumentation) start(ctx context.Context, method string) (context.Context, tracing.Span) {
+	return tracing.Start(ctx, method,
+		tracing.String(tracing.AttrRPCSystem, "jsonrpc"),
+		tracing.String(tracing.AttrRPCMethod, method),
+		tracing.String(tracing.AttrTransport, in.key),
+	)
+}
+
+// request reports a finished request, after retries, and ends its span.
+func (in instrumentation) request(span tracing.Span, method string, start time.Time, err error) {
+	tracing.End(span, err)
+	duration := time.Since(start)
+	if in.metrics != nil {
+		in.metrics.ObserveRequest(in.key, method, duration, err)
+	}
+	if err != nil {
+		in.logger.Debug("rpc request failed", "method", method, "duration", duration, "error", err)
+		return
+	}
+	in.logger.Debug("rpc request", "method", method, "duration", duration)
+}
+
+// retry reports a failed attempt that will be retried after delay.
+func (in instrumentation) retry(method string, attempt int, delay time.Duration, err error) {
+	if in.metrics != nil {
+		in.metrics.ObserveRetry(in.key, method, err)
+	}
+	in.logger.Warn("retrying rpc request", "method", method, "attempt", attempt+1, "delay", delay, "error", err)
+}
@@ -2,9 +2,11 @@ package transport
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/metrics"
 	"github.com/ChefBingbong/viem-go/utils/rpc"
 )
 
@@ -133,6 +135,10 @@ type TransportParams struct {
 	RetryCount *int
 	// Timeout overrides the default timeout.
 	Timeout *time.Duration
+	// Metrics is the collector used by transports that do not set their own.
+	Metrics metrics.Collector
+	// Logger is the logger used by transports that do not set their own.
+	Logger *slog.Logger
 }
 
 // TransportFactory is a function that creates a transport instance.
@@ -2,10 +2,13 @@ package transport
 
 import (
 	"context"
+	"log/slog"
+	"sync"
 	"time"
 
 	json "github.com/goccy/go-json"
 
+	"github.com/ChefBingbong/viem-go/metrics"
 	"github.com/ChefBingbong/viem-go/utils/rpc"
 )
 
@@ -29,6 +32,13 @@ type WebSocketTransportConfig struct {
 	RetryDelay time.Duration
 	// Timeout is the request timeout.
 	Timeout time.Duration
+	// Metrics receives request, retry and subscription measurements,
+	// labelled with Key. Defaults to the client's collector.
+	Metrics metrics.Collector
+	// Logger receives request, retry, reconnect and subscription events.
+	// The URL is logged with credentials redacted. Defaults to the client's
+	// logger.
+	Logger *slog.Logger
 }
 
 // KeepAliveConfig contains keep-alive configuration.
@@ -73,6 +83,7 @@ func DefaultWebSocketTransportConfig() WebSocketTransportConfig {
 type WebSocketTransport struct {
 	config WebSocketTransportConfig
 	client *rpc.WebSocketClient
+	instr  instrumentation
 }
 
 // WebSocket creates a new WebSocket transport factory.
@@ -106,6 +117,12 @@ func WebSocket(url string, config ...WebSocketTransportConfig) TransportFactory
 		if params.Timeout != nil {
 			cfg.Timeout = *params.Timeout
 		}
+		if cfg.Metrics == nil {
+			cfg.Metrics = params.Metrics
+		}
+		if cfg.Logger == nil {
+			cfg.Logger = params.Logger
+		}
 
 		return NewWebSocketTransport(cfg)
 	}
@@ -113,8 +130,16 @@ func WebSocket(url string, config ...WebSocketTransportConfig) TransportFactory
 
 // NewWebSocketTransport creates a new WebSocket transport.
 func NewWebSocketTransport(config WebSocketTransportConfig) (*WebSocketTransport, error) {
+	if config.Key == "" {
+		config.Key = "webSocket"
+	}
+
+	instr := newInstrumentation(config.Key, config.URL, config.Metrics, config.Logger)
+
 	// Build client options
-	clientOpts := rpc.WebSocketClientOptions{}
+	clientOpts := rpc.WebSocketClientOptions{
+		Logger: rpc.LoggerOrDiscard(config.Logger).With("transport", config.Key),
+	}
 
 	if config.KeepAlive != nil {
 		clientOpts.KeepAlive = &rpc.KeepAliveConfig{
@@ -140,6 +165,7 @@ func NewWebSocketTransport(config WebSocketTransportConfig) (*WebSocketTransport
 	return &WebSocketTransport{
 		config: config,
 		client: client,
+		instr:  instr,
 	}, nil
 }
 
@@ -175,7 +201,11 @@ func (t *WebSocketTransport) Request(ctx context.Context, req RPCRequest) (*RPCR
 	}
 
 	// Send request with retry
-	return t.retryRequest(ctx, body)
+	ctx, span := t.instr.start(ctx, body.Method)
+	start := time.Now()
+	resp, err := t.retryRequest(ctx, body)
+	t.instr.request(span, body.Method, start, err)
+	return resp, err
 }
 
 // retryRequest sends a request with retry logic.
@@ -222,6 +252,7 @@ func (t *WebSocketTransport) retryRequest(ctx context.Context, body RPCRequest)
 		// Wait before retry
 		if attempt < t.config.RetryCount {
 			delay := t.config.RetryDelay * time.Duration(1<<attempt)
+			t.instr.retry(body.Method, attempt, delay, lastErr)
 			select {
 			case <-ctx.Done():
 				return nil, ctx.Err()
@@ -320,7 +351,30 @@ func (t *WebSocketTransport) Subscribe(
 		subParams = append(subParams, params.Params)
 	}
 
-	return t.client.Subscribe(subParams, onData, onError)
+	sub, err := t.client.Subscribe(subParams, onData, onError)
+	if err != nil {
+		t.instr.logger.Warn("subscribe failed", "type", params.Type, "error", err)
+		return nil, err
+	}
+	t.instr.logger.Debug("subscribed", "type", params.Type, "id", sub.ID)
+
+	// Track the subscription until it is cancelled.
+	collector, key, logger := t.config.Metrics, t.config.Key, t.instr.logger
+	if collector != nil {
+		collector.AddSubscriptions(key, params.Type, 1)
+	}
+	unsubscribe := sub.Unsubscribe
+	var once sync.Once
+	sub.Unsubscribe = func() error {
+		once.Do(func() {
+			if collector != nil {
+				collector.AddSubscriptions(key, params.Type, -1)
+			}
+			logger.Debug("unsubscribed", "type", params.Type, "id", sub.ID)
+		})
+		return unsubscribe()
+	}
+	return sub, nil
 }
 
 // SubscribeNewHeads subscribes to new block headers.
@@ -2,12 +2,14 @@ package client
 
 import (
 	"context"
+	"log/slog"
 	"math/big"
 	"time"
 
 	"github.com/ChefBingbong/viem-go/actions/wallet"
 	"github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/metrics"
 	"github.com/ChefBingbong/viem-go/types"
 	"github.com/ChefBingbong/viem-go/utils/address"
 	"github.com/ChefBingbong/viem-go/utils/signature"
@@ -24,6 +26,10 @@ type WalletClientConfig struct {
 	CacheTime time.Duration
 	// Key is a key for the client (default: "wallet").
 	Key string
+	// Logger receives structured log events (optional).
+	Logger *slog.Logger
+	// Metrics receives request and multicall measurements (optional).
+	Metrics metrics.Collector
 	// Name is a name for the client (default: "Wallet Client").
 	Name string
 	// PollingInterval is the frequency (in ms) for polling enabled actions & events.
@@ -70,6 +76,8 @@ func CreateWalletClient(config WalletClientConfig) (*WalletClient, error) {
 		CacheTime:       config.CacheTime,
 		Chain:           config.Chain,
 		Key:             key,
+		Logger:          config.Logger,
+		Metrics:         config.Metrics,
 		Name:            name,
 		PollingInterval: config.PollingInterval,
 		Transport:       config.Transport,
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/utils/hash"
+	"github.com/ChefBingbong/viem-go/utils/unit"
+)
+
+func runAbiEncode(_ context.Context, args []string) error {
+	o := newOptions("abi-encode")
+	if err := o.parse(args, 1, -1); err != nil {
+		return err
+	}
+	sig, err := parseFunction(o.arg(0))
+	if err != nil {
+		return err
+	}
+	data, err := sig.encodeArgs(o.args[1:])
+	if err != nil {
+		return err
+	}
+	return o.out.value(hexutil.Encode(data))
+}
+
+func runCalldata(_ context.Context, args []string) error {
+	o := newOptions("calldata")
+	if err := o.parse(args, 1, -1); err != nil {
+		return err
+	}
+	sig, err := parseFunction(o.arg(0))
+	if err != nil {
+		return err
+	}
+	data, err := sig.calldata(o.args[1:])
+	if err != nil {
+		return err
+	}
+	return o.out.value(hexutil.Encode(data))
+}
+
+func runAbiDecode(_ context.Context, args []string) error {
+	o := newOptions("abi-decode")
+	input := o.fs.Bool("input", false, "Decode the data against the input parameters instead of the outputs")
+	if err := o.parse(args, 2, 2); err != nil {
+		return err
+	}
+	sig, err := parseFunction(o.arg(0))
+	if err != nil {
+		return err
+	}
+	data, err := hexutil.Decode(o.arg(1))
+	if err != nil {
+		return fmt.Errorf("invalid data %q", o.arg(1))
+	}
+
+	params := sig.outputs()
+	if *input || len(params) == 0 {
+		params = sig.inputs()
+	}
+	values, err := abi.DecodeAbiParameters(params, data)
+	if err != nil {
+		return err
+	}
+	return o.out.values(values)
+}
+
+func runCalldataDecode(_ context.Context, args []string) error {
+	o := newOptions("calldata-decode")
+	if err := o.parse(args, 2, 2); err != nil {
+		return err
+	}
+	sig, err := parseFunction(o.arg(0))
+	if err != nil {
+		return err
+	}
+	data, err := hexutil.Decode(o.arg(1))
+	if err != nil {
+		return fmt.Errorf("invalid calldata %q", o.arg(1))
+	}
+	if len(data) < 4 {
+		return fmt.Errorf("calldata too short: %d bytes", len(data))
+	}
+	if [4]byte(data[:4]) != sig.fn.Selector {
+		return fmt.Errorf("selector %s does not match %s (%s)",
+			hexutil.Encode(data[:4]), sig.fn.Signature, hexutil.Encode(sig.fn.Selector[:]))
+	}
+	values, err := abi.DecodeAbiParameters(sig.inputs(), data[4:])
+	if err != nil {
+		return err
+	}
+	return o.out.values(values)
+}
+
+func runKeccak(_ context.Context, args []string) error {
+	o := newOptions("keccak")
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	return o.out.value(hash.Keccak256(parseData(o.arg(0))))
+}
+
+func runSig(_ context.Context, args []string) error {
+	o := newOptions("sig")
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	sig := o.arg(0)
+	if strings.HasPrefix(sig, "0x") {
+		// Already a selector or calldata: print its first four bytes.
+		data, err := hexutil.Decode(sig)
+		if err != nil || len(data) < 4 {
+			return fmt.Errorf("invalid selector %q", sig)
+		}
+		return o.out.value(hexutil.Encode(data[:4]))
+	}
+	selector, err := hash.ToFunctionSelector(sig)
+	if err != nil {
+		return err
+	}
+	return o.out.value(selector)
+}
+
+func runSigEvent(_ context.Context, args []string) error {
+	o := newOptions("sig-event")
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	topic, err := hash.ToEventSelector(o.arg(0))
+	if err != nil {
+		return err
+	}
+	return o.out.value(topic)
+}
+
+func runToWei(_ context.Context, args []string) error {
+	o := newOptions("to-wei")
+	if err := o.parse(args, 1, 2); err != nil {
+		return err
+	}
+	decimals, err := parseUnit(o.arg(1))
+	if err != nil {
+		return err
+	}
+	wei, err := unit.ParseUnits(o.arg(0), decimals)
+	if err != nil {
+		return err
+	}
+	return o.out.value(wei)
+}
+
+func runFromWei(_ context.Context, args []string) error {
+	o := newOptions("from-wei")
+	if err := o.parse(args, 1, 2); err != nil {
+		return err
+	}
+	decimals, err := parseUnit(o.arg(1))
+	if err != nil {
+		return err
+	}
+	wei, err := parseInteger(o.arg(0))
+	if err != nil {
+		return err
+	}
+	return o.out.value(unit.FormatUnits(wei, decimals))
+}
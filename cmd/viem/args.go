@@ -0,0 +1,329 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	gethABI "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/utils/unit"
+)
+
+// funcSig is a parsed human-readable function signature.
+type funcSig struct {
+	fn     abi.Function
+	method gethABI.Method
+}
+
+// parseFunction parses a function signature in any of these forms:
+//
+//	transfer(address,uint256)
+//	balanceOf(address)(uint256)
+//	balanceOf(address owner) view returns (uint256)
+//	function balanceOf(address) view returns (uint256)
+//	(uint256,address)            // anonymous, for abi-encode/abi-decode
+func parseFunction(sig string) (*funcSig, error) {
+	sig = strings.TrimSpace(sig)
+	if !strings.HasPrefix(sig, "function ") {
+		if strings.HasPrefix(sig, "(") {
+			sig = "f" + sig
+		}
+		open := strings.Index(sig, "(")
+		if open < 0 {
+			return nil, fmt.Errorf("invalid signature %q: missing parameter list", sig)
+		}
+		end, err := matchingParen(sig, open)
+		if err != nil {
+			return nil, err
+		}
+		// Cast-style output list: "name(inputs)(outputs)".
+		if rest := strings.TrimSpace(sig[end+1:]); strings.HasPrefix(rest, "(") {
+			sig = sig[:end+1] + " returns " + rest
+		}
+		sig = "function " + sig
+	}
+
+	parsed, err := abi.ParseAbi([]string{sig})
+	if err != nil {
+		if strings.Contains(err.Error(), "anonymous") {
+			return nil, fmt.Errorf("invalid signature: tuple components must be named, e.g. (uint256 a,address b): %w", err)
+		}
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	for name, fn := range parsed.Functions {
+		return &funcSig{fn: fn, method: parsed.GethABI().Methods[name]}, nil
+	}
+	return nil, fmt.Errorf("invalid signature %q", sig)
+}
+
+// inputs returns the function's input parameters.
+func (s *funcSig) inputs() []abi.AbiParam {
+	return toAbiParams(s.method.Inputs)
+}
+
+// outputs returns the function's output parameters.
+func (s *funcSig) outputs() []abi.AbiParam {
+	return toAbiParams(s.method.Outputs)
+}
+
+// encodeArgs parses string arguments and ABI-encodes them without a selector.
+func (s *funcSig) encodeArgs(args []string) ([]byte, error) {
+	params := s.inputs()
+	values, err := parseArgs(params, args)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 1 {
+		// EncodeFrom takes a single parameter's value directly.
+		return abi.EncodeFrom(params, values[0])
+	}
+	return abi.EncodeFrom(params, values)
+}
+
+// calldata parses string arguments and encodes the function call.
+func (s *funcSig) calldata(args []string) ([]byte, error) {
+	encoded, err := s.encodeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	return append(s.fn.Selector[:], encoded...), nil
+}
+
+// toAbiParams converts go-ethereum arguments into encoding parameters.
+func toAbiParams(args gethABI.Arguments) []abi.AbiParam {
+	out := make([]abi.AbiParam, len(args))
+	for i, arg := range args {
+		out[i] = toAbiParam(arg.Name, arg.Type)
+	}
+	return out
+}
+
+// toAbiParam converts a go-ethereum type, keeping the components of tuples
+// nested inside arrays.
+func toAbiParam(name string, typ gethABI.Type) abi.AbiParam {
+	switch typ.T {
+	case gethABI.TupleTy:
+		components := make([]abi.AbiParam, len(typ.TupleElems))
+		for i, elem := range typ.TupleElems {
+			components[i] = toAbiParam(typ.TupleRawNames[i], *elem)
+		}
+		return abi.AbiParam{Name: name, Type: "tuple", Components: components}
+	case gethABI.SliceTy:
+		p := toAbiParam(name, *typ.Elem)
+		p.Type += "[]"
+		return p
+	case gethABI.ArrayTy:
+		p := toAbiParam(name, *typ.Elem)
+		p.Type += fmt.Sprintf("[%d]", typ.Size)
+		return p
+	}
+	return abi.AbiParam{Name: name, Type: typ.String()}
+}
+
+// parseArgs converts command-line arguments into values for params.
+func parseArgs(params []abi.AbiParam, args []string) ([]any, error) {
+	if len(args) != len(params) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(params), len(args))
+	}
+	values := make([]any, len(args))
+	for i, arg := range args {
+		v, err := parseValue(params[i], arg)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d (%s): %w", i+1, params[i].Type, err)
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// parseValue converts a string into a value of the given ABI type. Arrays are
+// written as "[a,b]" and tuples as "(a,b)"; integers accept decimal, hex,
+// scientific notation ("1e18") and unit suffixes ("1.5ether", "20gwei").
+func parseValue(param abi.AbiParam, s string) (any, error) {
+	s = strings.TrimSpace(s)
+	typ := param.Type
+
+	if strings.HasSuffix(typ, "]") {
+		open := strings.LastIndex(typ, "[")
+		elem := abi.AbiParam{Type: typ[:open], Components: param.Components}
+		if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+			return nil, fmt.Errorf("expected an array like [a,b], got %q", s)
+		}
+		parts, err := splitList(s[1 : len(s)-1])
+		if err != nil {
+			return nil, err
+		}
+		if size := typ[open+1 : len(typ)-1]; size != "" && size != strconv.Itoa(len(parts)) {
+			return nil, fmt.Errorf("expected %s elements, got %d", size, len(parts))
+		}
+		values := make([]any, len(parts))
+		for i, part := range parts {
+			if values[i], err = parseValue(elem, part); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	}
+
+	switch {
+	case typ == "tuple":
+		if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+			return nil, fmt.Errorf("expected a tuple like (a,b), got %q", s)
+		}
+		parts, err := splitList(s[1 : len(s)-1])
+		if err != nil {
+			return nil, err
+		}
+		return parseArgs(param.Components, parts)
+	case typ == "address":
+		addr, err := parseAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		return addr, nil
+	case typ == "bool":
+		return strconv.ParseBool(s)
+	case typ == "string":
+		return unquote(s), nil
+	case strings.HasPrefix(typ, "bytes"):
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex %q", s)
+		}
+		if size := typ[len("bytes"):]; size != "" && size != strconv.Itoa(len(b)) {
+			return nil, fmt.Errorf("expected %s bytes, got %d", size, len(b))
+		}
+		return b, nil
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		return parseInteger(s)
+	}
+	return nil, fmt.Errorf("unsupported type %s", typ)
+}
+
+// units maps unit suffixes to decimals.
+var units = map[string]int{"wei": 0, "gwei": 9, "ether": 18, "eth": 18}
+
+// parseInteger parses decimal, hex, scientific ("1e18") or unit-suffixed
+// ("1.5ether") integers.
+func parseInteger(s string) (*big.Int, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), "_", "")
+	lower := strings.ToLower(s)
+
+	for _, suffix := range []string{"gwei", "ether", "eth", "wei"} {
+		if strings.HasSuffix(lower, suffix) {
+			return unit.ParseUnits(strings.TrimSpace(s[:len(s)-len(suffix)]), units[suffix])
+		}
+	}
+	if strings.HasPrefix(lower, "0x") || strings.HasPrefix(lower, "-0x") {
+		neg := strings.HasPrefix(lower, "-")
+		n, ok := new(big.Int).SetString(strings.TrimPrefix(lower, "-")[2:], 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", s)
+		}
+		if neg {
+			n.Neg(n)
+		}
+		return n, nil
+	}
+	if mantissa, exp, ok := strings.Cut(lower, "e"); ok {
+		e, err := strconv.Atoi(exp)
+		if err != nil || e < 0 {
+			return nil, fmt.Errorf("invalid integer %q", s)
+		}
+		return unit.ParseUnits(mantissa, e)
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	return n, nil
+}
+
+// splitList splits a comma-separated list at the top level, respecting
+// nested brackets, parentheses and double quotes.
+func splitList(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var (
+		parts []string
+		depth int
+		quote bool
+		start int
+	)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"':
+			quote = !quote
+		case quote:
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced brackets in %q", s)
+			}
+		case c == ',' && depth == 0:
+			parts = append(parts, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	if depth != 0 || quote {
+		return nil, fmt.Errorf("unbalanced brackets or quotes in %q", s)
+	}
+	return append(parts, strings.TrimSpace(s[start:])), nil
+}
+
+// matchingParen returns the index of the parenthesis closing the one at open.
+func matchingParen(s string, open int) (int, error) {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("invalid signature %q: unbalanced parentheses", s)
+}
+
+// unquote strips surrounding double quotes from a string argument.
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// parseData parses a hex string, or returns the UTF-8 bytes of s if it is
+// not hex.
+func parseData(s string) []byte {
+	if b, err := hexutil.Decode(s); err == nil {
+		return b
+	}
+	return []byte(s)
+}
+
+// parseUnit returns the decimals of a unit name or number ("ether", "gwei",
+// "wei", "6"). An empty unit means ether.
+func parseUnit(s string) (int, error) {
+	if s == "" {
+		return 18, nil
+	}
+	if d, ok := units[strings.ToLower(s)]; ok {
+		return d, nil
+	}
+	d, err := strconv.Atoi(s)
+	if err != nil || d < 0 || d > 77 {
+		return 0, fmt.Errorf("invalid unit %q", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/abi"
+)
+
+func bigString(t *testing.T, s string) *big.Int {
+	t.Helper()
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("invalid big.Int %q", s)
+	}
+	return n
+}
+
+func TestParseInteger(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0", "0"},
+		{"42", "42"},
+		{"1_000_000", "1000000"},
+		{"-7", "-7"},
+		{"0x2a", "42"},
+		{"0X2A", "42"},
+		{"-0x10", "-16"},
+		{"1e18", "1000000000000000000"},
+		{"1.5e3", "1500"},
+		{"1ether", "1000000000000000000"},
+		{"1.5 ether", "1500000000000000000"},
+		{"2eth", "2000000000000000000"},
+		{"20gwei", "20000000000"},
+		{"0.1gwei", "100000000"},
+		{"5wei", "5"},
+		{"-1gwei", "-1000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseInteger(tt.input)
+			if err != nil {
+				t.Fatalf("parseInteger(%q) error: %v", tt.input, err)
+			}
+			if got.Cmp(bigString(t, tt.expected)) != 0 {
+				t.Errorf("parseInteger(%q) = %s, want %s", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "abc", "0xzz", "1e-3", "1ex", "12.5"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			if got, err := parseInteger(input); err == nil {
+				t.Errorf("parseInteger(%q) = %s, want error", input, got)
+			}
+		})
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"", nil},
+		{"  ", nil},
+		{"a", []string{"a"}},
+		{"a, b ,c", []string{"a", "b", "c"}},
+		{"[1,2],(3,[4,5]),6", []string{"[1,2]", "(3,[4,5])", "6"}},
+		{`"a,b",c`, []string{`"a,b"`, "c"}},
+		{`"(",x`, []string{`"("`, "x"}},
+		{"a,,b", []string{"a", "", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := splitList(tt.input)
+			if err != nil {
+				t.Fatalf("splitList(%q) error: %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("splitList(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	for _, input := range []string{"[1,2", "1,2]", "(a,b", `"a,b`} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			if got, err := splitList(input); err == nil {
+				t.Errorf("splitList(%q) = %q, want error", input, got)
+			}
+		})
+	}
+}
+
+func TestParseFunction(t *testing.T) {
+	tests := []struct {
+		sig      string
+		name     string
+		inputs   []string
+		outputs  []string
+		selector string
+	}{
+		{"transfer(address,uint256)", "transfer", []string{"address", "uint256"}, nil, "0xa9059cbb"},
+		{"balanceOf(address)(uint256)", "balanceOf", []string{"address"}, []string{"uint256"}, "0x70a08231"},
+		{"balanceOf(address) (uint256)", "balanceOf", []string{"address"}, []string{"uint256"}, "0x70a08231"},
+		{"getReserves()(uint112,uint112,uint32)", "getReserves", nil, []string{"uint112", "uint112", "uint32"}, "0x0902f1ac"},
+		{"balanceOf(address owner) view returns (uint256)", "balanceOf", []string{"address"}, []string{"uint256"}, "0x70a08231"},
+		{"function balanceOf(address) view returns (uint256)", "balanceOf", []string{"address"}, []string{"uint256"}, "0x70a08231"},
+		{"swap((address a,uint256 b)[])(bool)", "swap", []string{"tuple[]"}, []string{"bool"}, ""},
+		{"(uint256,address)", "f", []string{"uint256", "address"}, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sig, func(t *testing.T) {
+			fn, err := parseFunction(tt.sig)
+			if err != nil {
+				t.Fatalf("parseFunction(%q) error: %v", tt.sig, err)
+			}
+			if fn.fn.Name != tt.name {
+				t.Errorf("name = %q, want %q", fn.fn.Name, tt.name)
+			}
+			if got := paramTypes(fn.inputs()); !reflect.DeepEqual(got, tt.inputs) {
+				t.Errorf("inputs = %q, want %q", got, tt.inputs)
+			}
+			if got := paramTypes(fn.outputs()); !reflect.DeepEqual(got, tt.outputs) {
+				t.Errorf("outputs = %q, want %q", got, tt.outputs)
+			}
+			if tt.selector != "" && hexutil.Encode(fn.fn.Selector[:]) != tt.selector {
+				t.Errorf("selector = %x, want %s", fn.fn.Selector, tt.selector)
+			}
+		})
+	}
+
+	for _, sig := range []string{"transfer", "transfer(address", "transfer(notatype)"} {
+		t.Run("invalid "+sig, func(t *testing.T) {
+			if _, err := parseFunction(sig); err == nil {
+				t.Errorf("parseFunction(%q) succeeded, want error", sig)
+			}
+		})
+	}
+}
+
+// paramTypes returns the types of params, or nil if there are none.
+func paramTypes(params []abi.AbiParam) []string {
+	var out []string
+	for _, p := range params {
+		out = append(out, p.Type)
+	}
+	return out
+}
+
+func TestParseValue(t *testing.T) {
+	alice := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	pair := abi.AbiParam{Type: "tuple", Components: []abi.AbiParam{
+		{Name: "to", Type: "address"},
+		{Name: "amount", Type: "uint256"},
+	}}
+
+	tests := []struct {
+		name     string
+		param    abi.AbiParam
+		input    string
+		expected any
+	}{
+		{"uint", abi.AbiParam{Type: "uint256"}, "1gwei", big.NewInt(1_000_000_000)},
+		{"int", abi.AbiParam{Type: "int8"}, "-0x1", big.NewInt(-1)},
+		{"bool", abi.AbiParam{Type: "bool"}, "true", true},
+		{"string", abi.AbiParam{Type: "string"}, `"hello, world"`, "hello, world"},
+		{"address", abi.AbiParam{Type: "address"}, alice.Hex(), alice},
+		{"bytes", abi.AbiParam{Type: "bytes"}, "0xdeadbeef", []byte{0xde, 0xad, 0xbe, 0xef}},
+		{"bytes4", abi.AbiParam{Type: "bytes4"}, "0xa9059cbb", []byte{0xa9, 0x05, 0x9c, 0xbb}},
+		{"bytes1", abi.AbiParam{Type: "bytes1"}, "0x01", []byte{0x01}},
+		{"array", abi.AbiParam{Type: "uint256[]"}, "[1, 0x2, 3e1]", []any{big.NewInt(1), big.NewInt(2), big.NewInt(30)}},
+		{"empty array", abi.AbiParam{Type: "uint256[]"}, "[]", []any{}},
+		{"fixed array", abi.AbiParam{Type: "bool[2]"}, "[true,false]", []any{true, false}},
+		{"nested array", abi.AbiParam{Type: "uint8[][]"}, "[[1],[2,3]]", []any{[]any{big.NewInt(1)}, []any{big.NewInt(2), big.NewInt(3)}}},
+		{"string array", abi.AbiParam{Type: "string[]"}, `["a,b","c"]`, []any{"a,b", "c"}},
+		{"tuple", pair, "(" + alice.Hex() + ", 1ether)", []any{alice, bigString(t, "1000000000000000000")}},
+		{"tuple array", abi.AbiParam{Type: "tuple[]", Components: pair.Components}, "[(" + alice.Hex() + ",1),(" + alice.Hex() + ",2)]",
+			[]any{[]any{alice, big.NewInt(1)}, []any{alice, big.NewInt(2)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseValue(tt.param, tt.input)
+			if err != nil {
+				t.Fatalf("parseValue(%s, %q) error: %v", tt.param.Type, tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseValue(%s, %q) = %#v, want %#v", tt.param.Type, tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name  string
+		param abi.AbiParam
+		input string
+	}{
+		{"array without brackets", abi.AbiParam{Type: "uint256[]"}, "1,2"},
+		{"fixed array length", abi.AbiParam{Type: "bool[2]"}, "[true]"},
+		{"tuple without parentheses", pair, alice.Hex() + ",1"},
+		{"tuple arity", pair, "(" + alice.Hex() + ")"},
+		{"address", abi.AbiParam{Type: "address"}, "0x1234"},
+		{"bool", abi.AbiParam{Type: "bool"}, "yes"},
+		{"bytes hex", abi.AbiParam{Type: "bytes"}, "deadbeef"},
+		{"bytes4 length", abi.AbiParam{Type: "bytes4"}, "0xa9059c"},
+		{"bytes32 length", abi.AbiParam{Type: "bytes32"}, "0x01"},
+		{"integer", abi.AbiParam{Type: "uint256"}, "one"},
+	}
+	for _, tt := range invalid {
+		t.Run("invalid "+tt.name, func(t *testing.T) {
+			if got, err := parseValue(tt.param, tt.input); err == nil {
+				t.Errorf("parseValue(%s, %q) = %#v, want error", tt.param.Type, tt.input, got)
+			}
+		})
+	}
+}
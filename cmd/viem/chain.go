@@ -0,0 +1,458 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/utils/hash"
+	"github.com/ChefBingbong/viem-go/utils/unit"
+)
+
+func runBlockNumber(ctx context.Context, args []string) error {
+	o := newOptions("block-number").withRPC()
+	if err := o.parse(args, 0, 0); err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	n, err := c.GetBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	return o.out.value(n)
+}
+
+func runChainID(ctx context.Context, args []string) error {
+	o := newOptions("chain-id").withRPC()
+	if err := o.parse(args, 0, 0); err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	id, err := c.GetChainID(ctx)
+	if err != nil {
+		return err
+	}
+	return o.out.value(id)
+}
+
+func runGasPrice(ctx context.Context, args []string) error {
+	o := newOptions("gas-price").withRPC()
+	if err := o.parse(args, 0, 0); err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	price, err := c.GetGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+	return o.out.value(price)
+}
+
+func runBlock(ctx context.Context, args []string) error {
+	o := newOptions("block").withRPC()
+	full := o.fs.Bool("full", false, "Include full transaction objects")
+	if err := o.parse(args, 0, 1); err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	params := public.GetBlockParameters{IncludeTransactions: *full}
+	id := o.arg(0)
+	if id == "" {
+		id = o.block
+	}
+	if len(id) == 66 && strings.HasPrefix(id, "0x") {
+		h, err := parseHash(id)
+		if err != nil {
+			return err
+		}
+		params.BlockHash = &h
+	} else if params.BlockNumber, params.BlockTag, err = parseBlock(id); err != nil {
+		return err
+	}
+
+	block, err := public.GetBlock(ctx, c, params)
+	if err != nil {
+		return err
+	}
+	return o.out.record(block)
+}
+
+func runTx(ctx context.Context, args []string) error {
+	o := newOptions("tx").withRPC()
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	h, err := parseHash(o.arg(0))
+	if err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	tx, err := public.GetTransaction(ctx, c, public.GetTransactionParameters{Hash: &h})
+	if err != nil {
+		return err
+	}
+	return o.out.record(tx)
+}
+
+func runReceipt(ctx context.Context, args []string) error {
+	o := newOptions("receipt").withRPC()
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	h, err := parseHash(o.arg(0))
+	if err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	receipt, err := public.GetTransactionReceipt(ctx, c, public.GetTransactionReceiptParameters{Hash: h})
+	if err != nil {
+		return err
+	}
+	return o.out.record(receipt)
+}
+
+func runLogs(ctx context.Context, args []string) error {
+	o := newOptions("logs").withRPC()
+	address := o.fs.String("address", "", "Contract address to filter by")
+	fromBlock := o.fs.String("from-block", "latest", "First block of the range")
+	toBlock := o.fs.String("to-block", "latest", "Last block of the range")
+	if err := o.parse(args, 0, 4); err != nil {
+		return err
+	}
+
+	var params public.GetLogsParameters
+	var err error
+	if params.FromBlock, params.FromBlockTag, err = parseBlock(*fromBlock); err != nil {
+		return err
+	}
+	if params.ToBlock, params.ToBlockTag, err = parseBlock(*toBlock); err != nil {
+		return err
+	}
+	if *address != "" {
+		addr, err := parseAddress(*address)
+		if err != nil {
+			return err
+		}
+		params.Address = addr
+	}
+
+	// The first argument is an event signature or a raw topic0; the rest
+	// are indexed topics, where "" or "null" matches anything.
+	for i, arg := range o.args {
+		switch {
+		case arg == "" || arg == "null":
+			params.Topics = append(params.Topics, nil)
+		case i == 0 && !strings.HasPrefix(arg, "0x"):
+			topic, err := hash.ToEventSelector(arg)
+			if err != nil {
+				return err
+			}
+			params.Topics = append(params.Topics, topic)
+		default:
+			topic, err := parseTopic(arg)
+			if err != nil {
+				return err
+			}
+			params.Topics = append(params.Topics, topic)
+		}
+	}
+
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	logs, err := public.GetLogs(ctx, c, params)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, len(logs))
+	for i, log := range logs {
+		var topic0, txHash, index string
+		if len(log.Topics) > 0 {
+			topic0 = log.Topics[0]
+		}
+		if log.TransactionHash != nil {
+			txHash = *log.TransactionHash
+		}
+		if log.LogIndex != nil {
+			index = strconv.Itoa(*log.LogIndex)
+		}
+		rows[i] = []string{formatText(log.BlockNumber), txHash, index, log.Address, topic0, log.Data}
+	}
+	return o.out.table(logs, []string{"BLOCK", "TX", "INDEX", "ADDRESS", "TOPIC0", "DATA"}, rows)
+}
+
+// parseTopic parses a topic filter value: a 32-byte hash, or an address or
+// shorter hex value that is left-padded to 32 bytes.
+func parseTopic(s string) (common.Hash, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil || len(b) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid topic %q", s)
+	}
+	return common.BytesToHash(b), nil
+}
+
+func runBalance(ctx context.Context, args []string) error {
+	o := newOptions("balance").withRPC()
+	ether := o.fs.Bool("ether", false, "Print the balance in ether instead of wei")
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	blockNumber, blockTag, err := o.blockParams()
+	if err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	addr, err := o.resolveTarget(ctx, c, o.arg(0))
+	if err != nil {
+		return err
+	}
+	balance, err := public.GetBalance(ctx, c, public.GetBalanceParameters{
+		Address:     addr,
+		BlockNumber: blockNumber,
+		BlockTag:    blockTag,
+	})
+	if err != nil {
+		return err
+	}
+	if *ether {
+		return o.out.value(unit.FormatEther(balance))
+	}
+	return o.out.value(balance)
+}
+
+func runNonce(ctx context.Context, args []string) error {
+	o := newOptions("nonce").withRPC()
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	blockNumber, blockTag, err := o.blockParams()
+	if err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	addr, err := o.resolveTarget(ctx, c, o.arg(0))
+	if err != nil {
+		return err
+	}
+	nonce, err := public.GetTransactionCount(ctx, c, public.GetTransactionCountParameters{
+		Address:     addr,
+		BlockNumber: blockNumber,
+		BlockTag:    blockTag,
+	})
+	if err != nil {
+		return err
+	}
+	return o.out.value(nonce)
+}
+
+func runCode(ctx context.Context, args []string) error {
+	o := newOptions("code").withRPC()
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	blockNumber, blockTag, err := o.blockParams()
+	if err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	addr, err := o.resolveTarget(ctx, c, o.arg(0))
+	if err != nil {
+		return err
+	}
+	code, err := public.GetCode(ctx, c, public.GetCodeParameters{
+		Address:     addr,
+		BlockNumber: blockNumber,
+		BlockTag:    blockTag,
+	})
+	if err != nil {
+		return err
+	}
+	return o.out.value(hexutil.Encode(code))
+}
+
+func runStorage(ctx context.Context, args []string) error {
+	o := newOptions("storage").withRPC()
+	if err := o.parse(args, 2, 2); err != nil {
+		return err
+	}
+	slot, err := parseInteger(o.arg(1))
+	if err != nil || slot.Sign() < 0 || slot.BitLen() > 256 {
+		return fmt.Errorf("invalid slot %q", o.arg(1))
+	}
+	blockNumber, blockTag, err := o.blockParams()
+	if err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	addr, err := o.resolveTarget(ctx, c, o.arg(0))
+	if err != nil {
+		return err
+	}
+	value, err := public.GetStorageAt(ctx, c, public.GetStorageAtParameters{
+		Address:     addr,
+		Slot:        common.BigToHash(slot),
+		BlockNumber: blockNumber,
+		BlockTag:    blockTag,
+	})
+	if err != nil {
+		return err
+	}
+	return o.out.value(common.BytesToHash(value))
+}
+
+func runCall(ctx context.Context, args []string) error {
+	o := newOptions("call").withRPC()
+	from := o.fs.String("from", "", "Sender address (msg.sender)")
+	value := o.fs.String("value", "", "Value to send, e.g. 1ether or 1000000000 (wei)")
+	if err := o.parse(args, 2, -1); err != nil {
+		return err
+	}
+	sig, err := parseFunction(o.arg(1))
+	if err != nil {
+		return err
+	}
+	data, err := sig.calldata(o.args[2:])
+	if err != nil {
+		return err
+	}
+	blockNumber, blockTag, err := o.blockParams()
+	if err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	to, err := o.resolveTarget(ctx, c, o.arg(0))
+	if err != nil {
+		return err
+	}
+	params := public.CallParameters{To: &to, Data: data, BlockNumber: blockNumber, BlockTag: blockTag}
+	if *from != "" {
+		addr, err := parseAddress(*from)
+		if err != nil {
+			return err
+		}
+		params.Account = &addr
+	}
+	if *value != "" {
+		if params.Value, err = parseInteger(*value); err != nil {
+			return err
+		}
+	}
+
+	result, err := public.Call(ctx, c, params)
+	if err != nil {
+		return err
+	}
+	if len(sig.fn.Outputs) == 0 {
+		return o.out.value(hexutil.Encode(result.Data))
+	}
+	values, err := abi.DecodeAbiParameters(sig.outputs(), result.Data)
+	if err != nil {
+		return err
+	}
+	return o.out.values(values)
+}
+
+func runEstimate(ctx context.Context, args []string) error {
+	o := newOptions("estimate").withRPC()
+	from := o.fs.String("from", "", "Sender address")
+	value := o.fs.String("value", "", "Value to send, e.g. 1ether or 1000000000 (wei)")
+	if err := o.parse(args, 2, -1); err != nil {
+		return err
+	}
+	sig, err := parseFunction(o.arg(1))
+	if err != nil {
+		return err
+	}
+	data, err := sig.calldata(o.args[2:])
+	if err != nil {
+		return err
+	}
+	blockNumber, blockTag, err := o.blockParams()
+	if err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	to, err := o.resolveTarget(ctx, c, o.arg(0))
+	if err != nil {
+		return err
+	}
+	params := public.EstimateGasParameters{To: &to, Data: data, BlockNumber: blockNumber, BlockTag: blockTag}
+	if *from != "" {
+		addr, err := parseAddress(*from)
+		if err != nil {
+			return err
+		}
+		params.Account = &addr
+	}
+	if *value != "" {
+		if params.Value, err = parseInteger(*value); err != nil {
+			return err
+		}
+	}
+
+	gas, err := public.EstimateGas(ctx, c, params)
+	if err != nil {
+		return err
+	}
+	return o.out.value(gas)
+}
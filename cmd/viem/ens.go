@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/client"
+)
+
+// errNameNotFound is returned when a name has no address record.
+var errNameNotFound = errors.New("ENS name does not resolve to an address")
+
+func runResolveName(ctx context.Context, args []string) error {
+	o := newOptions("resolve-name").withRPC()
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	addr, err := o.resolveName(ctx, c, o.arg(0))
+	if err != nil {
+		return err
+	}
+	return o.out.value(addr)
+}
+
+func runLookupAddress(ctx context.Context, args []string) error {
+	o := newOptions("lookup-address").withRPC()
+	if err := o.parse(args, 1, 1); err != nil {
+		return err
+	}
+	addr, err := parseAddress(o.arg(0))
+	if err != nil {
+		return err
+	}
+	c, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	resolver, err := o.universalResolver()
+	if err != nil {
+		return err
+	}
+	blockNumber, blockTag, err := o.blockParams()
+	if err != nil {
+		return err
+	}
+	name, err := public.GetEnsName(ctx, c, public.GetEnsNameParameters{
+		Address:                  addr,
+		UniversalResolverAddress: &resolver,
+		BlockNumber:              blockNumber,
+		BlockTag:                 blockTag,
+	})
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return fmt.Errorf("%s has no primary ENS name", addr.Hex())
+	}
+	return o.out.value(name)
+}
+
+// resolveTarget parses an address argument, resolving it through ENS if it
+// looks like a name.
+func (o *options) resolveTarget(ctx context.Context, c *client.PublicClient, s string) (common.Address, error) {
+	if !strings.Contains(s, ".") {
+		return parseAddress(s)
+	}
+	return o.resolveName(ctx, c, s)
+}
+
+// resolveName resolves the ETH address record of an ENS name at --block.
+func (o *options) resolveName(ctx context.Context, c *client.PublicClient, name string) (common.Address, error) {
+	resolver, err := o.universalResolver()
+	if err != nil {
+		return common.Address{}, err
+	}
+	blockNumber, blockTag, err := o.blockParams()
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr, err := public.GetEnsAddress(ctx, c, public.GetEnsAddressParameters{
+		Name:                     name,
+		UniversalResolverAddress: &resolver,
+		BlockNumber:              blockNumber,
+		BlockTag:                 blockTag,
+	})
+	if err != nil {
+		return common.Address{}, err
+	}
+	if addr == nil {
+		return common.Address{}, errNameNotFound
+	}
+	return *addr, nil
+}
+
+// universalResolver returns the --ens-resolver address.
+func (o *options) universalResolver() (common.Address, error) {
+	resolver, err := parseAddress(o.ensResolver)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid --ens-resolver: %w", err)
+	}
+	return resolver, nil
+}
@@ -0,0 +1,173 @@
+// viem is a command-line tool for interacting with Ethereum, built on viem-go.
+//
+// Usage:
+//
+//	viem <command> [flags] [args]
+//
+// Chain commands read the RPC endpoint from --rpc-url or $ETH_RPC_URL:
+//
+//	viem block-number
+//	viem block latest
+//	viem tx 0x<hash>
+//	viem receipt 0x<hash>
+//	viem logs --address 0x... --from-block 19000000 "Transfer(address indexed,address indexed,uint256)"
+//	viem balance vitalik.eth
+//	viem call 0x<token> "balanceOf(address)(uint256)" 0x<owner>
+//	viem estimate 0x<token> "transfer(address,uint256)" 0x<to> 1e18
+//	viem send --private-key $KEY 0x<token> "transfer(address,uint256)" 0x<to> 1.5ether
+//
+// Offline commands:
+//
+//	viem abi-encode "f(uint256,address)" 1 0x...
+//	viem calldata "transfer(address,uint256)" 0x... 1e18
+//	viem abi-decode "balanceOf(address)(uint256)" 0x...
+//	viem calldata-decode "transfer(address,uint256)" 0xa9059cbb...
+//	viem keccak "hello"
+//	viem sig "transfer(address,uint256)"
+//	viem sig-event "Transfer(address,address,uint256)"
+//	viem to-wei 1.5 gwei
+//	viem from-wei 1500000000 gwei
+//	viem sign --private-key $KEY "hello"
+//	viem verify 0x<address> "hello" 0x<signature>
+//	viem wallet new
+//
+// Every command accepts --json to print machine-readable output; records
+// such as blocks and receipts are otherwise printed as aligned tables.
+// Run "viem <command> -h" for the flags of a command.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+)
+
+// command is a viem subcommand.
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(ctx context.Context, args []string) error
+}
+
+// commands lists every subcommand, grouped by topic. It is populated in init
+// because the subcommands look up their own usage text in it.
+var commands []command
+
+func init() {
+	commands = []command{
+		// Chain queries
+		{"block-number", "", "Print the latest block number", runBlockNumber},
+		{"chain-id", "", "Print the chain ID", runChainID},
+		{"gas-price", "", "Print the current gas price in wei", runGasPrice},
+		{"block", "[number|tag|hash]", "Print a block", runBlock},
+		{"tx", "<hash>", "Print a transaction", runTx},
+		{"receipt", "<hash>", "Print a transaction receipt", runReceipt},
+		{"logs", "[event signature] [topics...]", "Print logs matching a filter", runLogs},
+		{"balance", "<address|name>", "Print an account balance", runBalance},
+		{"nonce", "<address|name>", "Print an account nonce", runNonce},
+		{"code", "<address|name>", "Print the bytecode of an account", runCode},
+		{"storage", "<address|name> <slot>", "Print a storage slot", runStorage},
+		{"call", "<to> <signature> [args...]", "Call a function without sending a transaction", runCall},
+		{"estimate", "<to> <signature> [args...]", "Estimate the gas of a function call", runEstimate},
+		{"send", "<to> [signature] [args...]", "Sign and send a transaction", runSend},
+
+		// ABI and hashing
+		{"abi-encode", "<signature> [args...]", "ABI-encode arguments (without selector)", runAbiEncode},
+		{"calldata", "<signature> [args...]", "ABI-encode a function call (with selector)", runCalldata},
+		{"abi-decode", "<signature> <data>", "ABI-decode return data (or input data with --input)", runAbiDecode},
+		{"calldata-decode", "<signature> <calldata>", "Decode function call data", runCalldataDecode},
+		{"keccak", "<data>", "Hash hex data or a UTF-8 string with keccak256", runKeccak},
+		{"sig", "<signature>", "Print the 4-byte selector of a function or error", runSig},
+		{"sig-event", "<signature>", "Print the topic of an event", runSigEvent},
+
+		// Units
+		{"to-wei", "<value> [unit]", "Convert a value in ether (or unit) to wei", runToWei},
+		{"from-wei", "<value> [unit]", "Convert a value in wei to ether (or unit)", runFromWei},
+
+		// Signing and wallets
+		{"sign", "<message>", "Sign a message (EIP-191) or typed data (--typed-data)", runSign},
+		{"verify", "<address> <message> <signature>", "Verify a message or typed data signature", runVerify},
+		{"wallet", "<new|address|from-mnemonic|from-keystore>", "Create or inspect wallets", runWallet},
+
+		// ENS
+		{"resolve-name", "<name>", "Resolve an ENS name to an address", runResolveName},
+		{"lookup-address", "<address>", "Look up the primary ENS name of an address", runLookupAddress},
+	}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run dispatches args to a subcommand and returns the exit code.
+func run(args []string) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		printUsage()
+		if len(args) == 0 {
+			return 1
+		}
+		return 0
+	}
+
+	cmd, ok := findCommand(args[0])
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: unknown command %q\n\n", args[0])
+		printUsage()
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := cmd.run(ctx, args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// findCommand looks up a subcommand by name.
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// printUsage prints the list of subcommands.
+func printUsage() {
+	fmt.Fprintf(os.Stderr, "viem - Ethereum command-line tool\n\n")
+	fmt.Fprintf(os.Stderr, "Usage:\n  viem <command> [flags] [args]\n\nCommands:\n")
+
+	width := 0
+	for _, cmd := range commands {
+		width = max(width, len(cmd.name))
+	}
+	names := make([]string, 0, len(commands))
+	for _, cmd := range commands {
+		names = append(names, fmt.Sprintf("  %-*s  %s", width, cmd.name, cmd.summary))
+	}
+	fmt.Fprintln(os.Stderr, strings.Join(names, "\n"))
+	fmt.Fprintf(os.Stderr, "\nRun \"viem <command> -h\" for the flags of a command.\n")
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
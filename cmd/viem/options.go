@@ -0,0 +1,224 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/accounts"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// errNoRPCURL is returned by chain commands when no endpoint is configured.
+var errNoRPCURL = errors.New("no RPC URL: pass --rpc-url or set ETH_RPC_URL")
+
+// options holds the flags shared by subcommands.
+type options struct {
+	fs   *flag.FlagSet
+	args []string
+	out  *printer
+
+	rpcURL string
+	block  string
+
+	privateKey    string
+	mnemonic      string
+	mnemonicIndex int
+	keystorePath  string
+	password      string
+
+	ensResolver string
+}
+
+// newOptions creates the flag set of a subcommand with the --json flag.
+func newOptions(name string) *options {
+	cmd, _ := findCommand(name)
+	o := &options{
+		fs:  flag.NewFlagSet("viem "+name, flag.ContinueOnError),
+		out: &printer{w: os.Stdout},
+	}
+	o.fs.BoolVar(&o.out.json, "json", false, "Print JSON output")
+	o.fs.Usage = func() {
+		fmt.Fprintf(o.fs.Output(), "Usage: viem %s [flags] %s\n\n%s\n\nFlags:\n", name, cmd.usage, cmd.summary)
+		o.fs.PrintDefaults()
+	}
+	return o
+}
+
+// withRPC registers the --rpc-url, --block and --ens-resolver flags.
+func (o *options) withRPC() *options {
+	o.fs.StringVar(&o.rpcURL, "rpc-url", os.Getenv("ETH_RPC_URL"), "RPC endpoint (default: $ETH_RPC_URL)")
+	o.fs.StringVar(&o.block, "block", "latest", "Block number or tag (latest, pending, safe, finalized, earliest)")
+	o.fs.StringVar(&o.ensResolver, "ens-resolver", definitions.Mainnet.Contracts.EnsUniversalResolver.Address.Hex(),
+		"ENS Universal Resolver used for names")
+	return o
+}
+
+// withSigner registers the flags selecting the signing account.
+func (o *options) withSigner() *options {
+	o.fs.StringVar(&o.privateKey, "private-key", "", "Hex-encoded private key")
+	o.fs.StringVar(&o.mnemonic, "mnemonic", "", "BIP-39 mnemonic phrase")
+	o.fs.IntVar(&o.mnemonicIndex, "mnemonic-index", 0, "Address index of the mnemonic account (m/44'/60'/0'/0/<index>)")
+	o.fs.StringVar(&o.keystorePath, "keystore", "", "Path to an encrypted JSON keystore")
+	o.fs.StringVar(&o.password, "password", os.Getenv("ETH_PASSWORD"), "Keystore password (default: $ETH_PASSWORD)")
+	return o
+}
+
+// parse parses flags, which may appear before or after positional arguments,
+// and checks the number of positional arguments is within [min, max]
+// (max < 0 means unbounded). Arguments after "--" are never parsed as flags,
+// which allows negative numbers.
+func (o *options) parse(args []string, min, max int) error {
+	var positional []string
+	for {
+		if err := o.fs.Parse(args); err != nil {
+			return err
+		}
+		rest := o.fs.Args()
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			positional = append(positional, rest...)
+			break
+		}
+		if len(rest) == 0 {
+			break
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+
+	if len(positional) < min || (max >= 0 && len(positional) > max) {
+		o.fs.Usage()
+		return fmt.Errorf("wrong number of arguments")
+	}
+	o.args = positional
+	return nil
+}
+
+// arg returns the i-th positional argument, or "" if absent.
+func (o *options) arg(i int) string {
+	if i < len(o.args) {
+		return o.args[i]
+	}
+	return ""
+}
+
+// publicClient creates a client for the configured RPC endpoint.
+func (o *options) publicClient() (*client.PublicClient, error) {
+	if o.rpcURL == "" {
+		return nil, errNoRPCURL
+	}
+	return client.CreatePublicClient(client.PublicClientConfig{Transport: o.transport()})
+}
+
+// transport returns the transport for the configured RPC endpoint.
+func (o *options) transport() transport.TransportFactory {
+	if strings.HasPrefix(o.rpcURL, "ws://") || strings.HasPrefix(o.rpcURL, "wss://") {
+		return transport.WebSocket(o.rpcURL)
+	}
+	return transport.HTTP(o.rpcURL)
+}
+
+// blockParams parses --block into a block number or tag.
+func (o *options) blockParams() (*uint64, public.BlockTag, error) {
+	return parseBlock(o.block)
+}
+
+// parseBlock parses a decimal or hex block number, or a block tag.
+func parseBlock(s string) (*uint64, public.BlockTag, error) {
+	switch s {
+	case "", "latest":
+		return nil, public.BlockTagLatest, nil
+	case "pending", "safe", "finalized", "earliest":
+		return nil, public.BlockTag(s), nil
+	}
+	var (
+		n   uint64
+		err error
+	)
+	if strings.HasPrefix(s, "0x") {
+		n, err = hexutil.DecodeUint64(s)
+	} else {
+		n, err = strconv.ParseUint(s, 10, 64)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid block %q", s)
+	}
+	return &n, "", nil
+}
+
+// account loads the signing account selected by the signer flags.
+func (o *options) account() (*accounts.LocalAccount, error) {
+	switch {
+	case o.privateKey != "":
+		key := o.privateKey
+		if !strings.HasPrefix(key, "0x") {
+			key = "0x" + key
+		}
+		acc, err := accounts.PrivateKeyToAccount(key)
+		if err != nil {
+			return nil, err
+		}
+		return acc.LocalAccount, nil
+
+	case o.mnemonic != "":
+		acc, err := accounts.MnemonicToAccount(o.mnemonic, accounts.MnemonicToAccountOptions{
+			HDOptions: accounts.HDOptions{AddressIndex: o.mnemonicIndex},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return acc.LocalAccount, nil
+
+	case o.keystorePath != "":
+		key, err := decryptKeystore(o.keystorePath, o.password)
+		if err != nil {
+			return nil, err
+		}
+		acc, err := accounts.PrivateKeyToAccount(key)
+		if err != nil {
+			return nil, err
+		}
+		return acc.LocalAccount, nil
+	}
+	return nil, errors.New("no signer: pass --private-key, --mnemonic or --keystore")
+}
+
+// decryptKeystore decrypts a JSON keystore file and returns the hex private key.
+func decryptKeystore(path, password string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read keystore: %w", err)
+	}
+	key, err := keystore.DecryptKey(data, password)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+	return hexutil.Encode(key.PrivateKey.D.FillBytes(make([]byte, 32))), nil
+}
+
+// parseAddress parses a hex address.
+func parseAddress(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("invalid address %q", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+// parseHash parses a 32-byte hex hash.
+func parseHash(s string) (common.Hash, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid hash %q", s)
+	}
+	return common.BytesToHash(b), nil
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	json "github.com/goccy/go-json"
+)
+
+// printer writes command results as plain text/tables or as JSON.
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+// value prints a single result: plain values as text, anything else as JSON.
+func (p *printer) value(v any) error {
+	if p.json {
+		return p.writeJSON(toJSONValue(v))
+	}
+	_, err := fmt.Fprintln(p.w, formatText(v))
+	return err
+}
+
+// values prints a list of results, one per line in text mode.
+func (p *printer) values(vs []any) error {
+	if p.json {
+		return p.writeJSON(toJSONValue(vs))
+	}
+	for _, v := range vs {
+		if _, err := fmt.Fprintln(p.w, formatText(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// record prints an object. Text mode renders it as a two-column table of its
+// top-level fields, sorted by name.
+func (p *printer) record(v any) error {
+	if p.json {
+		return p.writeJSON(v)
+	}
+	fields, err := toFields(v)
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	for _, key := range sortedKeys(fields) {
+		fmt.Fprintf(tw, "%s\t%s\n", key, formatText(fields[key]))
+	}
+	return tw.Flush()
+}
+
+// table prints rows under headers in text mode and raw as JSON otherwise.
+func (p *printer) table(raw any, headers []string, rows [][]string) error {
+	if p.json {
+		return p.writeJSON(raw)
+	}
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func (p *printer) writeJSON(v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(p.w, string(data))
+	return err
+}
+
+// toFields converts a value to its JSON object fields.
+func toFields(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("cannot print %T as a record", v)
+	}
+	return fields, nil
+}
+
+// formatText renders a value on a single line: numbers in decimal, byte
+// strings and addresses in hex, compound values as compact JSON.
+func formatText(v any) string {
+	switch v := toJSONValue(v).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool, int64, uint64, float64:
+		return fmt.Sprint(v)
+	case []any, map[string]any:
+		data, _ := json.Marshal(v)
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+var (
+	bigIntType  = reflect.TypeOf((*big.Int)(nil))
+	addressType = reflect.TypeOf(common.Address{})
+	hashType    = reflect.TypeOf(common.Hash{})
+)
+
+// toJSONValue converts decoded ABI values into JSON-friendly values: *big.Int
+// becomes a decimal string (preserving precision), byte strings and fixed
+// byte arrays become hex, tuples become objects keyed by field name.
+func toJSONValue(v any) any {
+	return jsonValue(reflect.ValueOf(v))
+}
+
+func jsonValue(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	switch v.Type() {
+	case bigIntType:
+		if v.IsNil() {
+			return nil
+		}
+		return v.Interface().(*big.Int).String()
+	case addressType:
+		return v.Interface().(common.Address).Hex()
+	case hashType:
+		return v.Interface().(common.Hash).Hex()
+	}
+
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		return jsonValue(v.Elem())
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		out := make([]any, v.Len())
+		for i := range out {
+			out[i] = jsonValue(v.Index(i))
+		}
+		return out
+	case reflect.Map:
+		out := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out[fmt.Sprint(iter.Key().Interface())] = jsonValue(iter.Value())
+		}
+		return out
+	case reflect.Struct:
+		out := make(map[string]any, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := field.Name
+			if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" && tag != "-" {
+				name = tag
+			}
+			out[name] = jsonValue(v.Field(i))
+		}
+		return out
+	}
+	return fmt.Sprint(v.Interface())
+}
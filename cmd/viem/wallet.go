@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/accounts"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/utils/signature"
+)
+
+func runSend(ctx context.Context, args []string) error {
+	o := newOptions("send").withRPC().withSigner()
+	value := o.fs.String("value", "", "Value to send, e.g. 1ether or 1000000000 (wei)")
+	gas := o.fs.String("gas", "", "Gas limit (estimated if unset)")
+	async := o.fs.Bool("async", false, "Print the transaction hash without waiting for the receipt")
+	timeout := o.fs.Duration("timeout", 2*time.Minute, "How long to wait for the receipt")
+	if err := o.parse(args, 1, -1); err != nil {
+		return err
+	}
+	account, err := o.account()
+	if err != nil {
+		return err
+	}
+
+	params := wallet.SendTransactionParameters{}
+	if o.arg(1) != "" {
+		sig, err := parseFunction(o.arg(1))
+		if err != nil {
+			return err
+		}
+		data, err := sig.calldata(o.args[2:])
+		if err != nil {
+			return err
+		}
+		params.Data = hexutil.Encode(data)
+	}
+	if *value != "" {
+		if params.Value, err = parseInteger(*value); err != nil {
+			return err
+		}
+	}
+	if *gas != "" {
+		if params.Gas, err = parseInteger(*gas); err != nil {
+			return err
+		}
+	}
+
+	pc, err := o.publicClient()
+	if err != nil {
+		return err
+	}
+	defer pc.Close()
+	to, err := o.resolveTarget(ctx, pc, o.arg(0))
+	if err != nil {
+		return err
+	}
+	params.To = to.Hex()
+
+	wc, err := client.CreateWalletClient(client.WalletClientConfig{
+		Account:   account,
+		Transport: o.transport(),
+	})
+	if err != nil {
+		return err
+	}
+	defer wc.Close()
+
+	txHash, err := wc.SendTransaction(ctx, params)
+	if err != nil {
+		return err
+	}
+	if *async {
+		return o.out.value(txHash)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	receipt, err := public.WaitForTransactionReceipt(ctx, pc, public.WaitForTransactionReceiptParameters{
+		Hash: common.HexToHash(txHash),
+	})
+	if err != nil {
+		return fmt.Errorf("transaction %s sent but no receipt: %w", txHash, err)
+	}
+	return o.out.record(receipt)
+}
+
+func runSign(_ context.Context, args []string) error {
+	o := newOptions("sign").withSigner()
+	typedData := o.fs.String("typed-data", "", "Path to an EIP-712 typed data JSON file to sign instead of a message")
+	raw := o.fs.Bool("raw", false, "Treat the message as hex-encoded bytes")
+	if err := o.parse(args, 0, 1); err != nil {
+		return err
+	}
+	account, err := o.account()
+	if err != nil {
+		return err
+	}
+
+	var sig string
+	if *typedData != "" {
+		if len(o.args) != 0 {
+			return errors.New("pass either a message or --typed-data, not both")
+		}
+		data, err := readTypedData(*typedData)
+		if err != nil {
+			return err
+		}
+		sig, err = account.SignTypedData(data)
+		if err != nil {
+			return err
+		}
+	} else {
+		if len(o.args) != 1 {
+			return errors.New("missing message")
+		}
+		msg, err := signableMessage(o.arg(0), *raw)
+		if err != nil {
+			return err
+		}
+		if sig, err = account.SignMessage(msg); err != nil {
+			return err
+		}
+	}
+	return o.out.value(sig)
+}
+
+func runVerify(_ context.Context, args []string) error {
+	o := newOptions("verify")
+	typedData := o.fs.String("typed-data", "", "Path to an EIP-712 typed data JSON file instead of a message")
+	raw := o.fs.Bool("raw", false, "Treat the message as hex-encoded bytes")
+	if err := o.parse(args, 2, 3); err != nil {
+		return err
+	}
+	addr, err := parseAddress(o.arg(0))
+	if err != nil {
+		return err
+	}
+
+	var valid bool
+	if *typedData != "" {
+		if len(o.args) != 2 {
+			return errors.New("usage: viem verify --typed-data <file> <address> <signature>")
+		}
+		data, err := readTypedData(*typedData)
+		if err != nil {
+			return err
+		}
+		if valid, err = signature.VerifyTypedData(addr.Hex(), data, o.arg(1)); err != nil {
+			return err
+		}
+	} else {
+		if len(o.args) != 3 {
+			return errors.New("usage: viem verify <address> <message> <signature>")
+		}
+		msg, err := signableMessage(o.arg(1), *raw)
+		if err != nil {
+			return err
+		}
+		if valid, err = signature.VerifyMessage(addr.Hex(), msg, o.arg(2)); err != nil {
+			return err
+		}
+	}
+
+	if err := o.out.value(valid); err != nil {
+		return err
+	}
+	if !valid {
+		return errors.New("signature does not match address")
+	}
+	return nil
+}
+
+func runWallet(_ context.Context, args []string) error {
+	o := newOptions("wallet").withSigner()
+	words := o.fs.Int("words", 0, "Generate a mnemonic with this many words (12, 15, 18, 21 or 24) instead of a bare key")
+	if err := o.parse(args, 1, 2); err != nil {
+		return err
+	}
+
+	switch sub := o.arg(0); sub {
+	case "new":
+		if *words == 0 {
+			key := accounts.GeneratePrivateKey()
+			acc, err := accounts.PrivateKeyToAccount(key)
+			if err != nil {
+				return err
+			}
+			return o.out.record(walletInfo{Address: acc.Address().Hex(), PrivateKey: key})
+		}
+		mnemonic, err := accounts.GenerateMnemonic(accounts.GenerateMnemonicOptions{
+			Strength: accounts.MnemonicStrength(*words * 32 / 3),
+		})
+		if err != nil {
+			return err
+		}
+		return o.printMnemonicWallet(mnemonic)
+
+	case "address":
+		account, err := o.account()
+		if err != nil {
+			return err
+		}
+		return o.out.value(account.Address())
+
+	case "from-mnemonic":
+		mnemonic := o.arg(1)
+		if mnemonic == "" {
+			mnemonic = o.mnemonic
+		}
+		if mnemonic == "" {
+			return errors.New("usage: viem wallet from-mnemonic [--mnemonic-index N] \"<mnemonic>\"")
+		}
+		return o.printMnemonicWallet(mnemonic)
+
+	case "from-keystore":
+		path := o.arg(1)
+		if path == "" {
+			path = o.keystorePath
+		}
+		if path == "" {
+			return errors.New("usage: viem wallet from-keystore [--password P] <path>")
+		}
+		key, err := decryptKeystore(path, o.password)
+		if err != nil {
+			return err
+		}
+		acc, err := accounts.PrivateKeyToAccount(key)
+		if err != nil {
+			return err
+		}
+		return o.out.record(walletInfo{Address: acc.Address().Hex(), PrivateKey: key})
+
+	default:
+		return fmt.Errorf("unknown wallet command %q (want new, address, from-mnemonic or from-keystore)", sub)
+	}
+}
+
+// walletInfo is the output of the wallet subcommands.
+type walletInfo struct {
+	Address    string `json:"address"`
+	PrivateKey string `json:"privateKey"`
+	Mnemonic   string `json:"mnemonic,omitempty"`
+	Path       string `json:"path,omitempty"`
+}
+
+// printMnemonicWallet derives the account at --mnemonic-index and prints it.
+func (o *options) printMnemonicWallet(mnemonic string) error {
+	acc, err := accounts.MnemonicToAccount(mnemonic, accounts.MnemonicToAccountOptions{
+		HDOptions: accounts.HDOptions{AddressIndex: o.mnemonicIndex},
+	})
+	if err != nil {
+		return err
+	}
+	return o.out.record(walletInfo{
+		Address:    acc.Address().Hex(),
+		PrivateKey: hexutil.Encode(acc.GetHdKey().PrivateKey()),
+		Mnemonic:   mnemonic,
+		Path:       fmt.Sprintf("m/44'/60'/0'/0/%d", o.mnemonicIndex),
+	})
+}
+
+// signableMessage builds an EIP-191 message from a string, or from hex bytes
+// when raw is set.
+func signableMessage(msg string, raw bool) (signature.SignableMessage, error) {
+	if !raw {
+		return signature.NewSignableMessage(msg), nil
+	}
+	if _, err := hexutil.Decode(msg); err != nil {
+		return signature.SignableMessage{}, fmt.Errorf("invalid hex message %q", msg)
+	}
+	return signature.NewSignableMessageRawHex(msg), nil
+}
+
+// readTypedData reads an EIP-712 typed data definition from a JSON file.
+func readTypedData(path string) (signature.TypedDataDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return signature.TypedDataDefinition{}, fmt.Errorf("failed to read typed data: %w", err)
+	}
+	return signature.ParseTypedData(data)
+}
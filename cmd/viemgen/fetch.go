@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/codegen"
+	"github.com/ChefBingbong/viem-go/explorer"
+)
+
+// runFetch runs the fetch subcommand: it downloads a verified ABI from an
+// Etherscan-compatible explorer, saves it to the default ABI directory and
+// generates bindings for it. It returns the exit code.
+func runFetch(args []string) int {
+	fs := flag.NewFlagSet("viemgen fetch", flag.ExitOnError)
+	address := fs.String("address", "", "Contract address (required)")
+	packageName := fs.String("pkg", "", "Go package name for the generated code (required)")
+	contractName := fs.String("name", "", "Contract name (optional)")
+	chainID := fs.Int64("chain", 1, "Chain ID of the contract")
+	apiURL := fs.String("api-url", explorer.EtherscanV2URL, "Explorer API URL")
+	apiKey := fs.String("api-key", os.Getenv("ETHERSCAN_API_KEY"), "Explorer API key (default: $ETHERSCAN_API_KEY)")
+	proxy := fs.Bool("proxy", false, "Use the implementation ABI if the contract is a proxy")
+	outDir := fs.String("out", "", "Output directory (default: _contracts_typed/contract_templates/<pkg>/)")
+	mock := fs.Bool("mock", false, "Also generate an in-memory mock (<pkg>_mock.go)")
+	_ = fs.Parse(args)
+
+	if *packageName == "" || !common.IsHexAddress(*address) {
+		fmt.Fprintln(os.Stderr, "Error: --pkg and a valid --address are required")
+		fs.Usage()
+		return 1
+	}
+	addr := common.HexToAddress(*address)
+
+	exp, err := explorer.NewClient(explorer.Config{
+		URL:     *apiURL,
+		ChainID: *chainID,
+		APIKey:  *apiKey,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	defer exp.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	var abiJSON []byte
+	if *proxy {
+		abiJSON, err = exp.GetImplementationABI(ctx, addr)
+	} else {
+		abiJSON, err = exp.GetABI(ctx, addr)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching ABI for %s: %v\n", addr.Hex(), err)
+		return 1
+	}
+
+	if err := ensureDefaultDirectories(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating default directories: %v\n", err)
+		return 1
+	}
+	abiPath := filepath.Join(defaultBaseDir, defaultJSONDir, *packageName+".json")
+	if err := os.WriteFile(abiPath, abiJSON, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing ABI file: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Saved ABI to %s\n", abiPath)
+
+	if *outDir == "" {
+		*outDir = filepath.Join(defaultBaseDir, defaultTemplateDir, *packageName)
+	}
+	if *contractName == "" {
+		*contractName = codegen.DefaultContractName(*packageName)
+	}
+
+	gen, err := codegen.NewGeneratorWithOptions(*packageName, *contractName, abiJSON, codegen.Options{
+		Addresses: map[uint64]common.Address{uint64(*chainID): addr},
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating generator: %v\n", err)
+		return 1
+	}
+	code, err := gen.Generate()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating code: %v\n", err)
+		return 1
+	}
+	if err := os.MkdirAll(*outDir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating output directory: %v\n", err)
+		return 1
+	}
+	outFile := filepath.Join(*outDir, *packageName+".go")
+	if err := os.WriteFile(outFile, code, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing output file: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Generated %s\n", outFile)
+
+	if *mock {
+		mockCode, err := gen.GenerateMock()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error generating mock: %v\n", err)
+			return 1
+		}
+		mockFile := filepath.Join(*outDir, *packageName+"_mock.go")
+		if err := os.WriteFile(mockFile, mockCode, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing mock file: %v\n", err)
+			return 1
+		}
+		fmt.Printf("Generated %s\n", mockFile)
+	}
+	return 0
+}
@@ -5,6 +5,9 @@
 //	viemgen --abi ./MyContract.json --pkg mycontract
 //	viemgen --pkg mycontract                           # Uses default ABI path: _contracts_typed/json/mycontract.json
 //	viemgen init                                        # Initialize default directory structure
+//	viemgen generate [--config viemgen.yaml] [--force]   # Generate every contract listed in a config file
+//	viemgen check [--config viemgen.yaml]                # Fail if generated bindings are stale
+//	viemgen fetch --address 0x... --pkg usdc [--chain 1] # Fetch a verified ABI from Etherscan and generate
 //
 // Default Directories:
 //
@@ -18,15 +21,44 @@
 //	--pkg    Go package name for the generated code (required)
 //	--name   Contract name (optional, defaults to package name capitalized)
 //	--out    Output directory (default: _contracts_typed/contract_templates/<pkg>/)
+//	--mock   Also generate an in-memory mock implementing the <Name>API interface
+//
+// Project Config:
+//
+// The generate and check subcommands read viemgen.yaml (or viemgen.yml /
+// viemgen.json) from the current directory, listing many contracts at once:
+//
+//	out: ./bindings
+//	mock: true                # also write <pkg>_mock.go for every contract
+//	contracts:
+//	  - abi: ./abis/ERC20.json
+//	    package: erc20
+//	    names:
+//	      balanceOf: Balance
+//	    addresses:
+//	      1: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+//	  - abi: ./out/**/*.json
+//
+// Explorer ABIs:
+//
+// The fetch subcommand downloads a verified ABI from an Etherscan-compatible
+// explorer (the Etherscan V2 endpoint by default, authenticated with
+// $ETHERSCAN_API_KEY), saves it to _contracts_typed/json/<pkg>.json and
+// generates bindings with the address recorded for the chain. Pass --proxy to
+// use the implementation ABI of a proxy contract.
+//
+// generate skips contracts whose inputs are unchanged since the last run, so it
+// is cheap to wire into go:generate:
+//
+//	//go:generate go run github.com/ChefBingbong/viem-go/cmd/viemgen generate
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
-	"unicode"
 
 	"github.com/ChefBingbong/viem-go/codegen"
 )
@@ -52,11 +84,22 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Check for fetch command
+	if len(os.Args) > 1 && os.Args[1] == "fetch" {
+		os.Exit(runFetch(os.Args[2:]))
+	}
+
+	// Check for config-driven subcommands
+	if len(os.Args) > 1 && (os.Args[1] == "generate" || os.Args[1] == "check") {
+		os.Exit(runProject(os.Args[1], os.Args[2:]))
+	}
+
 	var (
 		abiPath      string
 		packageName  string
 		contractName string
 		outDir       string
+		mock         bool
 		help         bool
 	)
 
@@ -64,6 +107,7 @@ func main() {
 	flag.StringVar(&packageName, "pkg", "", "Go package name for the generated code (required)")
 	flag.StringVar(&contractName, "name", "", "Contract name (optional)")
 	flag.StringVar(&outDir, "out", "", "Output directory (default: _contracts_typed/contract_templates/<pkg>/)")
+	flag.BoolVar(&mock, "mock", false, "Also generate an in-memory mock (<pkg>_mock.go)")
 	flag.BoolVar(&help, "h", false, "Show help")
 	flag.BoolVar(&help, "help", false, "Show help")
 
@@ -71,7 +115,10 @@ func main() {
 		fmt.Fprintf(os.Stderr, "viemgen - Generate Go bindings from Ethereum contract ABIs\n\n")
 		fmt.Fprintf(os.Stderr, "Usage:\n")
 		fmt.Fprintf(os.Stderr, "  viemgen --pkg <package> [--abi <path>] [--name <name>] [--out <dir>]\n")
-		fmt.Fprintf(os.Stderr, "  viemgen init                  # Initialize default directory structure\n\n")
+		fmt.Fprintf(os.Stderr, "  viemgen init                  # Initialize default directory structure\n")
+		fmt.Fprintf(os.Stderr, "  viemgen generate [--config <path>] [--force]   # Generate all contracts in viemgen.yaml\n")
+		fmt.Fprintf(os.Stderr, "  viemgen check [--config <path>]                # Fail if bindings are stale\n")
+		fmt.Fprintf(os.Stderr, "  viemgen fetch --address <addr> --pkg <package> [--chain <id>] [--proxy]   # Fetch ABI from Etherscan\n\n")
 		fmt.Fprintf(os.Stderr, "Default Directories:\n")
 		fmt.Fprintf(os.Stderr, "  %s/\n", defaultBaseDir)
 		fmt.Fprintf(os.Stderr, "  ├── %s/                  # Place ABI JSON files here\n", defaultJSONDir)
@@ -118,7 +165,7 @@ func main() {
 
 	// Default contract name to capitalized package name
 	if contractName == "" {
-		contractName = capitalize(packageName)
+		contractName = codegen.DefaultContractName(packageName)
 	}
 
 	// Check if ABI file exists
@@ -130,7 +177,14 @@ func main() {
 	}
 
 	// Read ABI file
-	abiJSON, err := os.ReadFile(abiPath)
+	abiFile, err := os.ReadFile(abiPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading ABI file: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Accept both plain ABI arrays and compiler artifacts
+	abiJSON, _, err := codegen.ExtractABI(abiFile)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error reading ABI file: %v\n", err)
 		os.Exit(1)
@@ -164,6 +218,20 @@ func main() {
 	}
 
 	fmt.Printf("Generated %s\n", outFile)
+
+	if mock {
+		mockCode, err := gen.GenerateMock()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error generating mock: %v\n", err)
+			os.Exit(1)
+		}
+		mockFile := filepath.Join(outDir, packageName+"_mock.go")
+		if err := os.WriteFile(mockFile, mockCode, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing mock file: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Generated %s\n", mockFile)
+	}
 }
 
 // initDirectories creates the default directory structure.
@@ -235,17 +303,57 @@ func ensureDefaultDirectories() error {
 	return nil
 }
 
-// capitalize returns the string with the first letter capitalized.
-func capitalize(s string) string {
-	if s == "" {
-		return s
+// runProject runs the generate or check subcommand and returns the exit code.
+func runProject(cmd string, args []string) int {
+	fs := flag.NewFlagSet("viemgen "+cmd, flag.ExitOnError)
+	configPath := fs.String("config", "", "Path to the project config (default: viemgen.yaml, viemgen.yml or viemgen.json)")
+	force := fs.Bool("force", false, "Regenerate all contracts even if inputs are unchanged (generate only)")
+	quiet := fs.Bool("q", false, "Only print errors and stale bindings")
+	_ = fs.Parse(args)
+
+	path := *configPath
+	if path == "" {
+		found, err := codegen.FindConfig(".")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v (looked for %v)\n", err, codegen.DefaultConfigFiles)
+			return 1
+		}
+		path = found
 	}
-	// Handle special cases like erc20 -> ERC20
-	upper := strings.ToUpper(s)
-	if upper == "ERC20" || upper == "ERC721" || upper == "ERC1155" {
-		return upper
+
+	cfg, err := codegen.LoadConfig(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		return 1
+	}
+
+	if cmd == "check" {
+		results, err := codegen.CheckProject(cfg)
+		for _, r := range results {
+			if r.Status != codegen.StatusUpToDate || !*quiet {
+				fmt.Printf("%-10s %s\n", r.Status, r.Target.OutFile)
+			}
+		}
+		if errors.Is(err, codegen.ErrStale) {
+			fmt.Fprintln(os.Stderr, "Error: generated bindings are stale, run \"viemgen generate\"")
+			return 1
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	results, err := codegen.GenerateProject(cfg, codegen.ProjectOptions{Force: *force})
+	for _, r := range results {
+		if !*quiet {
+			fmt.Printf("%-10s %s\n", r.Status, r.Target.OutFile)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
 	}
-	runes := []rune(s)
-	runes[0] = unicode.ToUpper(runes[0])
-	return string(runes)
+	return 0
 }
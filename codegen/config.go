@@ -0,0 +1,375 @@
+package codegen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	json "github.com/goccy/go-json"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultConfigFiles lists the file names searched for when no config path is given.
+var DefaultConfigFiles = []string{"viemgen.yaml", "viemgen.yml", "viemgen.json"}
+
+// ErrConfigNotFound is returned when no project config file can be located.
+var ErrConfigNotFound = errors.New("viemgen config file not found")
+
+// Config describes a viemgen project: a set of contracts to generate bindings for.
+//
+// Example viemgen.yaml:
+//
+//	out: ./bindings
+//	contracts:
+//	  - abi: ./abis/ERC20.json
+//	    package: erc20
+//	    addresses:
+//	      1: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+//	  - abi: ./out/**/*.json     # one package per matched artifact
+//	    names:
+//	      balanceOf: Balance
+type Config struct {
+	// Out is the default output root. Each contract is written to
+	// <Out>/<package>/<package>.go unless it sets its own Out.
+	Out string `json:"out" yaml:"out"`
+	// Mock generates an in-memory mock (<package>_mock.go) next to every
+	// binding unless a contract overrides it.
+	Mock bool `json:"mock,omitempty" yaml:"mock,omitempty"`
+	// Contracts lists the contracts (or artifact globs) to generate.
+	Contracts []ContractConfig `json:"contracts" yaml:"contracts"`
+
+	// path is the location of the config file. Relative paths are resolved
+	// against its directory.
+	path string
+}
+
+// ContractConfig describes a single contract entry in a Config.
+type ContractConfig struct {
+	// ABI is the path or glob of the ABI JSON file(s). Both plain ABI arrays
+	// and Hardhat/Foundry artifacts (objects with an "abi" field) are accepted.
+	// Globs support "*", "?" and "**" (any number of directories).
+	ABI string `json:"abi" yaml:"abi"`
+	// Package is the Go package name. Defaults to the lowercased file name.
+	// Must be empty when ABI matches more than one file.
+	Package string `json:"package,omitempty" yaml:"package,omitempty"`
+	// Name is the contract type name. Defaults to the artifact's contractName
+	// or the capitalized package name.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Out is the output directory for this contract, overriding Config.Out.
+	Out string `json:"out,omitempty" yaml:"out,omitempty"`
+	// Names overrides generated Go identifiers keyed by ABI function/event name.
+	Names map[string]string `json:"names,omitempty" yaml:"names,omitempty"`
+	// Addresses holds deployed addresses keyed by chain ID.
+	Addresses map[uint64]string `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+	// Mock overrides Config.Mock for this contract.
+	Mock *bool `json:"mock,omitempty" yaml:"mock,omitempty"`
+}
+
+// Target is a fully resolved unit of generation: one ABI file producing one Go file.
+type Target struct {
+	// Package is the Go package name.
+	Package string
+	// ContractName is the generated contract type name.
+	ContractName string
+	// ABIPath is the resolved path of the ABI source file.
+	ABIPath string
+	// OutFile is the path of the generated Go file.
+	OutFile string
+	// MockFile is the path of the generated mock file, or empty if mocks are disabled.
+	MockFile string
+	// Options carries naming overrides and deployment addresses.
+	Options Options
+}
+
+// LoadConfig reads a project config from a YAML or JSON file.
+// The format is chosen by file extension (.json for JSON, YAML otherwise).
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	cfg, err := ParseConfig(data, strings.EqualFold(filepath.Ext(path), ".json"))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	cfg.path = path
+	return cfg, nil
+}
+
+// ParseConfig parses a project config from YAML or JSON bytes.
+func ParseConfig(data []byte, isJSON bool) (*Config, error) {
+	var cfg Config
+	if isJSON {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config: %w", err)
+		}
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config: %w", err)
+		}
+	}
+
+	if len(cfg.Contracts) == 0 {
+		return nil, errors.New("config must list at least one contract")
+	}
+	for i, c := range cfg.Contracts {
+		if c.ABI == "" {
+			return nil, fmt.Errorf("contracts[%d]: abi is required", i)
+		}
+		for chainID, addr := range c.Addresses {
+			if !common.IsHexAddress(addr) {
+				return nil, fmt.Errorf("contracts[%d]: invalid address %q for chain %d", i, addr, chainID)
+			}
+		}
+	}
+	return &cfg, nil
+}
+
+// FindConfig looks for one of DefaultConfigFiles in dir.
+func FindConfig(dir string) (string, error) {
+	for _, name := range DefaultConfigFiles {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", ErrConfigNotFound
+}
+
+// Dir returns the directory relative paths in the config are resolved against.
+func (c *Config) Dir() string {
+	if c.path == "" {
+		return "."
+	}
+	return filepath.Dir(c.path)
+}
+
+// Path returns the path the config was loaded from, if any.
+func (c *Config) Path() string {
+	return c.path
+}
+
+// Targets expands the config into generation targets, resolving globs and defaults.
+// Targets are returned sorted by output file.
+func (c *Config) Targets() ([]Target, error) {
+	var targets []Target
+	seen := make(map[string]string)
+
+	for i, entry := range c.Contracts {
+		matches, err := expandGlob(c.resolve(entry.ABI))
+		if err != nil {
+			return nil, fmt.Errorf("contracts[%d]: %w", i, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("contracts[%d]: no ABI files match %q", i, entry.ABI)
+		}
+		if len(matches) > 1 && (entry.Package != "" || entry.Name != "") {
+			return nil, fmt.Errorf("contracts[%d]: package and name cannot be set when %q matches %d files", i, entry.ABI, len(matches))
+		}
+
+		addresses := make(map[uint64]common.Address, len(entry.Addresses))
+		for chainID, addr := range entry.Addresses {
+			addresses[chainID] = common.HexToAddress(addr)
+		}
+
+		for _, abiPath := range matches {
+			pkg := entry.Package
+			if pkg == "" {
+				pkg = PackageNameFromFile(abiPath)
+			}
+
+			outDir := entry.Out
+			if outDir == "" {
+				root := c.Out
+				if root == "" {
+					root = "."
+				}
+				outDir = filepath.Join(root, pkg)
+			}
+			outFile := filepath.Join(c.resolve(outDir), pkg+".go")
+
+			if prev, dup := seen[outFile]; dup {
+				return nil, fmt.Errorf("contracts[%d]: %s and %s both generate %s", i, prev, abiPath, outFile)
+			}
+			seen[outFile] = abiPath
+
+			mock := c.Mock
+			if entry.Mock != nil {
+				mock = *entry.Mock
+			}
+			var mockFile string
+			if mock {
+				mockFile = filepath.Join(filepath.Dir(outFile), pkg+"_mock.go")
+			}
+
+			targets = append(targets, Target{
+				Package:      pkg,
+				ContractName: entry.Name,
+				ABIPath:      abiPath,
+				OutFile:      outFile,
+				MockFile:     mockFile,
+				Options: Options{
+					Names:     entry.Names,
+					Addresses: addresses,
+				},
+			})
+		}
+	}
+
+	sort.Slice(targets, func(i, j int) bool { return targets[i].OutFile < targets[j].OutFile })
+	return targets, nil
+}
+
+// resolve makes a config-relative path absolute with respect to the config directory.
+func (c *Config) resolve(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(c.Dir(), path)
+}
+
+// ExtractABI returns the ABI array from either a plain JSON ABI or a compiler
+// artifact (Hardhat, Foundry, Truffle) that stores it under an "abi" key.
+// The artifact's contractName, if present, is returned alongside.
+func ExtractABI(data []byte) (abiJSON []byte, contractName string, err error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, "", errors.New("empty ABI file")
+	}
+	if trimmed[0] == '[' {
+		return trimmed, "", nil
+	}
+
+	var artifact struct {
+		ABI          json.RawMessage `json:"abi"`
+		ContractName string          `json:"contractName"`
+	}
+	if err := json.Unmarshal(trimmed, &artifact); err != nil {
+		return nil, "", fmt.Errorf("failed to parse artifact: %w", err)
+	}
+	if len(artifact.ABI) == 0 {
+		return nil, "", errors.New(`artifact has no "abi" field`)
+	}
+	return artifact.ABI, artifact.ContractName, nil
+}
+
+// PackageNameFromFile derives a Go package name from an ABI file path,
+// e.g. "out/MyToken.sol/MyToken.json" -> "mytoken".
+func PackageNameFromFile(path string) string {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	var b strings.Builder
+	for _, r := range strings.ToLower(base) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "contract" + name
+	}
+	return name
+}
+
+// DefaultContractName returns the contract type name used when none is configured,
+// which is the capitalized package name (with ERC standards upper-cased).
+func DefaultContractName(packageName string) string {
+	if packageName == "" {
+		return packageName
+	}
+	// Handle special cases like erc20 -> ERC20
+	upper := strings.ToUpper(packageName)
+	if upper == "ERC20" || upper == "ERC721" || upper == "ERC1155" {
+		return upper
+	}
+	return titleCase(packageName)
+}
+
+// expandGlob returns the files matching pattern, supporting "**" to match any
+// number of directories. Patterns without glob metacharacters are returned as-is.
+func expandGlob(pattern string) ([]string, error) {
+	if !strings.ContainsAny(pattern, "*?[") {
+		if _, err := os.Stat(pattern); err != nil {
+			return nil, fmt.Errorf("ABI file not found: %s", pattern)
+		}
+		return []string{pattern}, nil
+	}
+
+	if !strings.Contains(pattern, "**") {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", pattern, err)
+		}
+		sort.Strings(matches)
+		return matches, nil
+	}
+
+	// Walk from the longest directory prefix without metacharacters.
+	root := pattern[:strings.Index(pattern, "**")]
+	root = filepath.Dir(root + "x")
+	re, err := globToRegexp(filepath.ToSlash(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	walkErr := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && re.MatchString(filepath.ToSlash(path)) {
+			matches = append(matches, path)
+		}
+		return nil
+	})
+	if walkErr != nil {
+		return nil, fmt.Errorf("failed to expand %q: %w", pattern, walkErr)
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// globToRegexp converts a slash-separated glob with "**" support into a regexp.
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		ch := pattern[i]
+		switch ch {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					// "**/" matches zero or more directories
+					i++
+					b.WriteString("(?:.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob %q: %w", pattern, err)
+	}
+	return re, nil
+}
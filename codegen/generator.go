@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"go/format"
 	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 	"unicode"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/ChefBingbong/viem-go/abi"
 )
 
@@ -18,10 +21,27 @@ type Generator struct {
 	contractName string
 	abi          *abi.ABI
 	abiJSON      []byte
+	options      Options
+}
+
+// Options contains optional settings for code generation.
+type Options struct {
+	// Names overrides the Go identifier used for ABI functions and events,
+	// keyed by the ABI item name (e.g. {"balanceOf": "Balance"}).
+	Names map[string]string
+	// Addresses holds known deployments of the contract keyed by chain ID.
+	// When set, the generated package exposes an Addresses map and AddressFor helper.
+	Addresses map[uint64]common.Address
 }
 
 // NewGenerator creates a new code generator.
 func NewGenerator(packageName, contractName string, abiJSON []byte) (*Generator, error) {
+	return NewGeneratorWithOptions(packageName, contractName, abiJSON, Options{})
+}
+
+// NewGeneratorWithOptions creates a new code generator with naming overrides
+// and deployment addresses.
+func NewGeneratorWithOptions(packageName, contractName string, abiJSON []byte, opts Options) (*Generator, error) {
 	parsedABI, err := abi.Parse(abiJSON)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse ABI: %w", err)
@@ -32,14 +52,72 @@ func NewGenerator(packageName, contractName string, abiJSON []byte) (*Generator,
 		contractName: contractName,
 		abi:          parsedABI,
 		abiJSON:      abiJSON,
+		options:      opts,
 	}, nil
 }
 
 // Generate generates the Go code for the contract.
 func (g *Generator) Generate() ([]byte, error) {
+	return g.render("contract", contractTemplate)
+}
+
+// GenerateMock generates an in-memory mock implementation of the contract's
+// API interface. The output belongs in the same package as Generate's output.
+func (g *Generator) GenerateMock() ([]byte, error) {
+	if err := checkMockNames(g.buildTemplateData()); err != nil {
+		return nil, err
+	}
+	return g.render("mock", mockTemplate)
+}
+
+// mockHelpers are the methods every generated mock defines on top of the
+// contract API.
+var mockHelpers = []string{"Address", "SetError", "Revert", "SetGas", "Emit", "Calls", "CallCount", "Reset"}
+
+// checkMockNames reports mock methods that would be generated twice, such as a
+// contract function named reset next to the mock's Reset helper. Colliding ABI
+// items can be renamed with Options.Names.
+func checkMockNames(data TemplateData) error {
+	owners := make(map[string]string)
+	for _, name := range mockHelpers {
+		owners[name] = "the mock helper"
+	}
+	claim := func(method, owner string) error {
+		if prev, ok := owners[method]; ok {
+			return fmt.Errorf("mock method %s for %s collides with %s; rename it with a names override", method, owner, prev)
+		}
+		owners[method] = owner
+		return nil
+	}
+
+	for _, fn := range data.Functions {
+		owner := fmt.Sprintf("function %q", fn.Name)
+		methods := []string{"Prepare" + fn.GoName, "Estimate" + fn.GoName}
+		if fn.IsReadOnly {
+			methods = []string{fn.GoName, "Set" + fn.GoName}
+		}
+		for _, method := range methods {
+			if err := claim(method, owner); err != nil {
+				return err
+			}
+		}
+	}
+	for _, ev := range data.Events {
+		owner := fmt.Sprintf("event %q", ev.Name)
+		for _, method := range []string{"Add" + ev.GoName + "Event", "Get" + ev.GoName + "Events"} {
+			if err := claim(method, owner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// render executes a template against the contract data and formats the result.
+func (g *Generator) render(name, text string) ([]byte, error) {
 	data := g.buildTemplateData()
 
-	tmpl, err := template.New("contract").Funcs(templateFuncs).Parse(contractTemplate)
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(text)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse template: %w", err)
 	}
@@ -67,6 +145,13 @@ type TemplateData struct {
 	Functions    []FunctionData
 	Events       []EventData
 	HasEvents    bool
+	Addresses    []AddressData
+}
+
+// AddressData holds a single chain deployment.
+type AddressData struct {
+	ChainID uint64
+	Address string
 }
 
 // FunctionData holds data for a single function.
@@ -106,11 +191,12 @@ func (g *Generator) buildTemplateData() TemplateData {
 		HasEvents:    len(g.abi.Events) > 0,
 	}
 
-	// Process functions
-	for _, fn := range g.abi.Functions {
+	// Process functions (sorted so the generated output is deterministic)
+	for _, name := range sortedKeys(g.abi.Functions) {
+		fn := g.abi.Functions[name]
 		fnData := FunctionData{
 			Name:            fn.Name,
-			GoName:          toExportedName(fn.Name),
+			GoName:          g.goName(fn.Name),
 			IsReadOnly:      fn.IsReadOnly(),
 			StateMutability: fn.StateMutability.String(),
 			Signature:       fn.Signature,
@@ -149,10 +235,11 @@ func (g *Generator) buildTemplateData() TemplateData {
 	}
 
 	// Process events
-	for _, ev := range g.abi.Events {
+	for _, name := range sortedKeys(g.abi.Events) {
+		ev := g.abi.Events[name]
 		evData := EventData{
 			Name:      ev.Name,
-			GoName:    toExportedName(ev.Name),
+			GoName:    g.goName(ev.Name),
 			Signature: ev.Signature,
 		}
 
@@ -165,16 +252,42 @@ func (g *Generator) buildTemplateData() TemplateData {
 				Name:   name,
 				GoName: toExportedName(name),
 				Type:   input.Type,
-				GoType: solidityToGoType(input.Type),
+				GoType: eventFieldGoType(input),
 			})
 		}
 
 		data.Events = append(data.Events, evData)
 	}
 
+	// Process deployment addresses
+	for chainID, addr := range g.options.Addresses {
+		data.Addresses = append(data.Addresses, AddressData{ChainID: chainID, Address: addr.Hex()})
+	}
+	sort.Slice(data.Addresses, func(i, j int) bool {
+		return data.Addresses[i].ChainID < data.Addresses[j].ChainID
+	})
+
 	return data
 }
 
+// goName returns the Go identifier for an ABI item, honoring name overrides.
+func (g *Generator) goName(name string) string {
+	if override, ok := g.options.Names[name]; ok && override != "" {
+		return override
+	}
+	return toExportedName(name)
+}
+
+// sortedKeys returns the keys of a map in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // solidityToGoType converts a Solidity type to a Go type.
 func solidityToGoType(solType string) string {
 	// Handle arrays
@@ -249,6 +362,29 @@ func solidityToGoType(solType string) string {
 	}
 }
 
+// eventFieldGoType returns the Go type an event input decodes to. Indexed
+// values are read back from their topic: integers as *big.Int, and dynamic
+// types, fixed bytes and tuples as the raw topic hash. Types the generator
+// cannot describe, such as tuples, decode to interface{}.
+func eventFieldGoType(input abi.Parameter) string {
+	if input.Indexed {
+		switch {
+		case input.Type == "address", input.Type == "bool":
+			return solidityToGoType(input.Type)
+		case strings.HasPrefix(input.Type, "uint"), strings.HasPrefix(input.Type, "int"):
+			if !strings.HasSuffix(input.Type, "]") {
+				return "*big.Int"
+			}
+		}
+		return "common.Hash"
+	}
+	goType := solidityToGoType(input.Type)
+	if strings.Contains(goType, "interface{}") {
+		return "interface{}"
+	}
+	return goType
+}
+
 // toExportedName converts a name to an exported Go identifier.
 func toExportedName(name string) string {
 	if name == "" {
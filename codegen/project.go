@@ -0,0 +1,304 @@
+package codegen
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	json "github.com/goccy/go-json"
+)
+
+// ManifestFile is the name of the file, stored next to the config, that records
+// input and output hashes so unchanged contracts can be skipped.
+const ManifestFile = ".viemgen.sum"
+
+// ErrStale is returned by CheckProject when generated bindings are out of date.
+var ErrStale = errors.New("generated bindings are stale")
+
+// Status describes what happened to a target during generation or checking.
+type Status string
+
+const (
+	// StatusGenerated means the output file was (re)written.
+	StatusGenerated Status = "generated"
+	// StatusUnchanged means the inputs were unchanged and generation was skipped.
+	StatusUnchanged Status = "unchanged"
+	// StatusUpToDate means the output file matches freshly generated code.
+	StatusUpToDate Status = "up-to-date"
+	// StatusStale means the output file differs from freshly generated code.
+	StatusStale Status = "stale"
+	// StatusMissing means the output file does not exist.
+	StatusMissing Status = "missing"
+)
+
+// Result reports the outcome for a single target.
+type Result struct {
+	Target Target
+	Status Status
+}
+
+// ProjectOptions contains options for GenerateProject.
+type ProjectOptions struct {
+	// Force regenerates every target even when its inputs are unchanged.
+	Force bool
+}
+
+// manifest records per-output hashes from the last generation run.
+type manifest struct {
+	Version int                      `json:"version"`
+	Outputs map[string]manifestEntry `json:"outputs"`
+}
+
+// manifestEntry holds the hashes for a single generated file.
+type manifestEntry struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+}
+
+// GenerateProject generates bindings for every target in the config.
+// Targets whose ABI, settings and generator templates are unchanged since the
+// last run (and whose output file is untouched) are skipped.
+func GenerateProject(cfg *Config, opts ProjectOptions) ([]Result, error) {
+	targets, err := cfg.Targets()
+	if err != nil {
+		return nil, err
+	}
+
+	manifestPath := filepath.Join(cfg.Dir(), ManifestFile)
+	prev := loadManifest(manifestPath)
+	next := manifest{Version: 1, Outputs: make(map[string]manifestEntry, len(targets))}
+
+	results := make([]Result, 0, len(targets))
+	for _, target := range targets {
+		abiJSON, contractName, err := readTargetABI(target)
+		if err != nil {
+			return results, err
+		}
+		target.ContractName = contractName
+
+		inputHash := hashInputs(target, abiJSON)
+		if !opts.Force && upToDate(cfg, prev, target, inputHash) {
+			for _, path := range targetFiles(target) {
+				key := manifestKey(cfg, path)
+				next.Outputs[key] = prev.Outputs[key]
+			}
+			results = append(results, Result{Target: target, Status: StatusUnchanged})
+			continue
+		}
+
+		files, err := generateTarget(target, abiJSON)
+		if err != nil {
+			return results, err
+		}
+		for _, path := range targetFiles(target) {
+			code := files[path]
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return results, fmt.Errorf("failed to create output directory: %w", err)
+			}
+			if err := os.WriteFile(path, code, 0644); err != nil {
+				return results, fmt.Errorf("failed to write %s: %w", path, err)
+			}
+			next.Outputs[manifestKey(cfg, path)] = manifestEntry{Input: inputHash, Output: hashBytes(code)}
+		}
+		results = append(results, Result{Target: target, Status: StatusGenerated})
+	}
+
+	if err := saveManifest(manifestPath, next); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// CheckProject regenerates every target in memory and compares the result with
+// the files on disk without writing anything. It returns ErrStale if any output
+// is missing or differs, so it can be used as a CI gate.
+func CheckProject(cfg *Config) ([]Result, error) {
+	targets, err := cfg.Targets()
+	if err != nil {
+		return nil, err
+	}
+
+	stale := false
+	results := make([]Result, 0, len(targets))
+	for _, target := range targets {
+		abiJSON, contractName, err := readTargetABI(target)
+		if err != nil {
+			return results, err
+		}
+		target.ContractName = contractName
+
+		files, err := generateTarget(target, abiJSON)
+		if err != nil {
+			return results, err
+		}
+
+		status := StatusUpToDate
+		for _, path := range targetFiles(target) {
+			existing, err := os.ReadFile(path)
+			if err != nil {
+				status = StatusMissing
+				break
+			}
+			if !bytes.Equal(existing, files[path]) {
+				status = StatusStale
+			}
+		}
+		if status != StatusUpToDate {
+			stale = true
+		}
+		results = append(results, Result{Target: target, Status: status})
+	}
+
+	if stale {
+		return results, ErrStale
+	}
+	return results, nil
+}
+
+// readTargetABI loads the ABI for a target and resolves its contract name.
+func readTargetABI(target Target) ([]byte, string, error) {
+	data, err := os.ReadFile(target.ABIPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read ABI file: %w", err)
+	}
+	abiJSON, artifactName, err := ExtractABI(data)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", target.ABIPath, err)
+	}
+
+	name := target.ContractName
+	if name == "" {
+		name = artifactName
+	}
+	if name == "" {
+		name = DefaultContractName(target.Package)
+	}
+	return abiJSON, name, nil
+}
+
+// generateTarget runs the generator for a single target, returning the
+// generated code keyed by output path.
+func generateTarget(target Target, abiJSON []byte) (map[string][]byte, error) {
+	gen, err := NewGeneratorWithOptions(target.Package, target.ContractName, abiJSON, target.Options)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", target.ABIPath, err)
+	}
+	code, err := gen.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", target.ABIPath, err)
+	}
+	files := map[string][]byte{target.OutFile: code}
+
+	if target.MockFile != "" {
+		mock, err := gen.GenerateMock()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", target.ABIPath, err)
+		}
+		files[target.MockFile] = mock
+	}
+	return files, nil
+}
+
+// targetFiles returns the output paths a target produces.
+func targetFiles(target Target) []string {
+	if target.MockFile == "" {
+		return []string{target.OutFile}
+	}
+	return []string{target.OutFile, target.MockFile}
+}
+
+// upToDate reports whether every output of target was generated from the same
+// inputs and has not been modified since.
+func upToDate(cfg *Config, prev manifest, target Target, inputHash string) bool {
+	for _, path := range targetFiles(target) {
+		entry, ok := prev.Outputs[manifestKey(cfg, path)]
+		if !ok || entry.Input != inputHash || fileHash(path) != entry.Output {
+			return false
+		}
+	}
+	return true
+}
+
+// hashInputs hashes everything that influences a target's generated output:
+// the templates, the target settings and the ABI itself.
+func hashInputs(target Target, abiJSON []byte) string {
+	h := sha256.New()
+	h.Write([]byte(contractTemplate))
+	h.Write([]byte{0})
+	if target.MockFile != "" {
+		h.Write([]byte(mockTemplate))
+		h.Write([]byte{0})
+	}
+	h.Write([]byte(target.Package + "\x00" + target.ContractName + "\x00"))
+
+	for _, k := range sortedKeys(target.Options.Names) {
+		h.Write([]byte(k + "=" + target.Options.Names[k] + "\x00"))
+	}
+
+	chainIDs := make([]uint64, 0, len(target.Options.Addresses))
+	for id := range target.Options.Addresses {
+		chainIDs = append(chainIDs, id)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+	for _, id := range chainIDs {
+		h.Write([]byte(strconv.FormatUint(id, 10) + "=" + target.Options.Addresses[id].Hex() + "\x00"))
+	}
+
+	h.Write(abiJSON)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// hashBytes returns the hex-encoded SHA-256 of data.
+func hashBytes(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// fileHash returns the hash of a file's contents, or "" if it cannot be read.
+func fileHash(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return hashBytes(data)
+}
+
+// manifestKey returns the config-relative, slash-separated key for an output file.
+func manifestKey(cfg *Config, outFile string) string {
+	rel, err := filepath.Rel(cfg.Dir(), outFile)
+	if err != nil {
+		rel = outFile
+	}
+	return filepath.ToSlash(rel)
+}
+
+// loadManifest reads the manifest, returning an empty one if it is missing or invalid.
+func loadManifest(path string) manifest {
+	m := manifest{Outputs: make(map[string]manifestEntry)}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return m
+	}
+	if err := json.Unmarshal(data, &m); err != nil || m.Outputs == nil {
+		return manifest{Outputs: make(map[string]manifestEntry)}
+	}
+	return m
+}
+
+// saveManifest writes the manifest as indented JSON.
+func saveManifest(path string, m manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
@@ -1,11 +1,14 @@
 package codegen
 
+import "strings"
+
 // contractTemplate is the main template for generating contract bindings.
 const contractTemplate = `// Code generated by viemgen. DO NOT EDIT.
 package {{.PackageName}}
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"sync"
 
@@ -19,6 +22,7 @@ import (
 // Suppress unused import warnings
 var (
 	_ = big.NewInt
+	_ = fmt.Errorf
 	_ = common.Address{}
 	_ types.Transaction
 	_ sync.Once
@@ -56,6 +60,27 @@ func MustParsedABI() *abi.ABI {
 	return parsed
 }
 
+{{if .Addresses}}
+// Addresses holds the known deployments of the {{.ContractName}} contract keyed by chain ID.
+var Addresses = map[uint64]common.Address{
+{{range .Addresses}}	{{.ChainID}}: common.HexToAddress("{{.Address}}"),
+{{end}}}
+
+// AddressFor returns the deployed {{.ContractName}} address on the given chain.
+func AddressFor(chainID uint64) (common.Address, bool) {
+	addr, ok := Addresses[chainID]
+	return addr, ok
+}
+
+// NewForChain creates a {{.ContractName}} binding for its known deployment on the given chain.
+func NewForChain(chainID uint64, c *client.PublicClient) (*{{.ContractName}}, error) {
+	addr, ok := AddressFor(chainID)
+	if !ok {
+		return nil, fmt.Errorf("{{.ContractName}} is not deployed on chain %d", chainID)
+	}
+	return New(addr, c)
+}
+{{end}}
 // ============================================================================
 // Typed Method Descriptors
 // ============================================================================
@@ -131,10 +156,10 @@ func (c *{{.ContractName}}) ParsedABI() (*abi.ABI, error) {
 {{if .IsReadOnly}}
 // {{.GoName}} calls the {{.Name}} function.
 // Solidity: {{.Signature}}
-func (c *{{$.ContractName}}) {{.GoName}}(ctx context.Context{{range .Inputs}}, {{.GoName}} {{.GoType}}{{end}}) ({{if eq (len .Outputs) 1}}{{(index .Outputs 0).GoType}}{{else if gt (len .Outputs) 1}}({{range $i, $o := .Outputs}}{{if $i}}, {{end}}{{$o.GoType}}{{end}}){{else}}error{{end}}, error) {
-	result, err := c.contract.Read(ctx, "{{.Name}}"{{range .Inputs}}, {{.GoName}}{{end}})
+func (c *{{$.ContractName}}) {{.GoName}}(ctx context.Context{{range .Inputs}}, {{.GoName}} {{.GoType}}{{end}}) {{resultSig .Outputs}} {
+	{{if .Outputs}}result{{else}}_{{end}}, err := c.contract.Read(ctx, "{{.Name}}"{{range .Inputs}}, {{.GoName}}{{end}})
 	if err != nil {
-		return {{if eq (len .Outputs) 1}}{{zeroValue (index .Outputs 0).GoType}}{{else if gt (len .Outputs) 1}}{{range $i, $o := .Outputs}}{{if $i}}, {{end}}{{zeroValue $o.GoType}}{{end}}{{end}}, err
+		return {{zeroResults .Outputs}}err
 	}
 	{{if eq (len .Outputs) 0}}
 	return nil
@@ -158,6 +183,83 @@ func (c *{{$.ContractName}}) Estimate{{.GoName}}(ctx context.Context, opts contr
 {{end}}
 {{end}}
 
+{{range $event := .Events}}
+// Parse{{.GoName}} decodes a {{.Name}} event from a log.
+func (c *{{$.ContractName}}) Parse{{.GoName}}(log types.Log) (*{{.GoName}}Event, error) {
+	decoded, err := c.contract.DecodeEvent("{{.Name}}", log.Topics, log.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	ev := &{{.GoName}}Event{}
+	{{range .Inputs}}
+	{{if eq .GoType "interface{}"}}
+	ev.{{.GoName}} = decoded["{{.Name}}"]
+	{{else}}
+	switch v := decoded["{{.Name}}"].(type) {
+	case {{.GoType}}:
+		ev.{{.GoName}} = v
+	default:
+		return nil, fmt.Errorf("{{$event.Name}} event: unexpected type %T for {{.Name}}", v)
+	}
+	{{end}}
+	{{end}}
+	return ev, nil
+}
+
+// Get{{.GoName}}Events returns the {{.Name}} events emitted by the contract.
+func (c *{{$.ContractName}}) Get{{.GoName}}Events(ctx context.Context, opts contract.EventOptions) ([]*{{.GoName}}Event, error) {
+	logs, err := c.contract.GetEventLogs(ctx, "{{.Name}}", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*{{.GoName}}Event, 0, len(logs))
+	for _, log := range logs {
+		ev, err := c.Parse{{.GoName}}(log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+{{end}}
+
+// ============================================================================
+// Interfaces
+// ============================================================================
+
+// {{.ContractName}}Reader is implemented by types that can call the read-only
+// functions of the {{.ContractName}} contract.
+type {{.ContractName}}Reader interface {
+{{range .Functions}}{{if .IsReadOnly}}	{{.GoName}}(ctx context.Context{{range .Inputs}}, {{.GoName}} {{.GoType}}{{end}}) {{resultSig .Outputs}}
+{{end}}{{end}}}
+
+// {{.ContractName}}Writer is implemented by types that can prepare and estimate
+// transactions for the {{.ContractName}} contract.
+type {{.ContractName}}Writer interface {
+{{range .Functions}}{{if not .IsReadOnly}}	Prepare{{.GoName}}(ctx context.Context, opts contract.WriteOptions{{range .Inputs}}, {{.GoName}} {{.GoType}}{{end}}) (*types.Transaction, error)
+	Estimate{{.GoName}}(ctx context.Context, opts contract.WriteOptions{{range .Inputs}}, {{.GoName}} {{.GoType}}{{end}}) (uint64, error)
+{{end}}{{end}}}
+
+// {{.ContractName}}Events is implemented by types that can query the events of
+// the {{.ContractName}} contract.
+type {{.ContractName}}Events interface {
+{{range .Events}}	Get{{.GoName}}Events(ctx context.Context, opts contract.EventOptions) ([]*{{.GoName}}Event, error)
+{{end}}}
+
+// {{.ContractName}}API is the full interface of the {{.ContractName}} binding.
+// Depend on it instead of *{{.ContractName}} so tests can substitute a mock.
+type {{.ContractName}}API interface {
+	Address() common.Address
+	{{.ContractName}}Reader
+	{{.ContractName}}Writer
+	{{.ContractName}}Events
+}
+
+var _ {{.ContractName}}API = (*{{.ContractName}})(nil)
+
 {{if .HasEvents}}
 // Event types
 {{range .Events}}
@@ -170,9 +272,34 @@ type {{.GoName}}Event struct {
 {{end}}
 `
 
-// init adds zero value helper to template funcs.
+// init adds zero value helpers to template funcs.
 func init() {
 	templateFuncs["zeroValue"] = zeroValue
+	templateFuncs["resultSig"] = resultSig
+	templateFuncs["zeroResults"] = zeroResults
+}
+
+// resultSig renders the result list of a read method: the outputs followed by error.
+func resultSig(outputs []ParamData) string {
+	if len(outputs) == 0 {
+		return "error"
+	}
+	types := make([]string, 0, len(outputs)+1)
+	for _, o := range outputs {
+		types = append(types, o.GoType)
+	}
+	return "(" + strings.Join(append(types, "error"), ", ") + ")"
+}
+
+// zeroResults renders the zero values of outputs, each followed by ", ",
+// for use in front of an error in a return statement.
+func zeroResults(outputs []ParamData) string {
+	var b strings.Builder
+	for _, o := range outputs {
+		b.WriteString(zeroValue(o.GoType))
+		b.WriteString(", ")
+	}
+	return b.String()
 }
 
 // zeroValue returns the zero value for a Go type.
@@ -204,27 +331,6 @@ func zeroValue(goType string) string {
 	}
 }
 
-// eventTemplate is the template for event parsing helpers.
-// nolint:unused // Reserved for future use
-var _ = `
-// Parse{{.GoName}} parses a {{.Name}} event from a log.
-func (c *{{$.ContractName}}) Parse{{.GoName}}(log types.Log) (*{{.GoName}}Event, error) {
-	event, err := c.contract.DecodeEvent("{{.Name}}", log.Topics, log.Data)
-	if err != nil {
-		return nil, err
-	}
-	
-	ev := &{{.GoName}}Event{}
-	{{range $i, $input := .Inputs}}
-	if v, ok := event["{{$input.Name}}"]; ok {
-		ev.{{$input.GoName}} = v.({{$input.GoType}})
-	}
-	{{end}}
-	
-	return ev, nil
-}
-`
-
 // multicallTemplate generates multicall helper code.
 // nolint:unused // Reserved for future use
 var _ = `
@@ -257,3 +363,226 @@ func (b *{{.ContractName}}Calls) Calldata() [][]byte {
 	return b.calls
 }
 `
+
+// mockTemplate is the template for generating in-memory mock implementations.
+const mockTemplate = `// Code generated by viemgen. DO NOT EDIT.
+package {{.PackageName}}
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ChefBingbong/viem-go/contract"
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Suppress unused import warnings
+var (
+	_ = big.NewInt
+	_ = context.Background
+)
+
+var _ {{.ContractName}}API = (*Mock{{.ContractName}})(nil)
+
+// Mock{{.ContractName}} is an in-memory implementation of {{.ContractName}}API for unit tests.
+//
+// Program read results with the Set<Method> helpers, failures with Revert or
+// SetError, and the events a write emits with Emit. Every call is recorded and
+// can be inspected with Calls and CallCount. Methods are keyed by their ABI name.
+type Mock{{.ContractName}} struct {
+	mu      sync.Mutex
+	address common.Address
+	results map[string][]any
+	errs    map[string]error
+	gas     map[string]uint64
+	emits   map[string][]any
+	calls   map[string][][]any
+{{range .Events}}	events{{.GoName}} []*{{.GoName}}Event
+{{end}}}
+
+// NewMock creates a mock {{.ContractName}} at the given address.
+func NewMock(address common.Address) *Mock{{.ContractName}} {
+	return &Mock{{.ContractName}}{
+		address: address,
+		results: make(map[string][]any),
+		errs:    make(map[string]error),
+		gas:     make(map[string]uint64),
+		emits:   make(map[string][]any),
+		calls:   make(map[string][][]any),
+	}
+}
+
+// Address returns the mock contract address.
+func (mock *Mock{{.ContractName}}) Address() common.Address {
+	return mock.address
+}
+
+// SetError makes every subsequent call to method fail with err.
+// Pass a nil error to clear it.
+func (mock *Mock{{.ContractName}}) SetError(method string, err error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	if err == nil {
+		delete(mock.errs, method)
+		return
+	}
+	mock.errs[method] = err
+}
+
+// Revert makes every subsequent call to method fail with a contract.RevertError.
+func (mock *Mock{{.ContractName}}) Revert(method string, reason string) {
+	mock.SetError(method, contract.Revert(reason))
+}
+
+// SetGas programs the gas returned by the Estimate helper of a write method.
+func (mock *Mock{{.ContractName}}) SetGas(method string, gas uint64) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.gas[method] = gas
+}
+
+// Emit programs the events recorded each time the Prepare helper of a write
+// method succeeds. Events must be pointers to the generated event types.
+func (mock *Mock{{.ContractName}}) Emit(method string, events ...any) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.emits[method] = append(mock.emits[method], events...)
+}
+
+// Calls returns the arguments of every recorded call to method.
+func (mock *Mock{{.ContractName}}) Calls(method string) [][]any {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return append([][]any(nil), mock.calls[method]...)
+}
+
+// CallCount returns how many times method was called.
+func (mock *Mock{{.ContractName}}) CallCount(method string) int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return len(mock.calls[method])
+}
+
+// Reset clears all programmed behavior, recorded calls and emitted events.
+func (mock *Mock{{.ContractName}}) Reset() {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.results = make(map[string][]any)
+	mock.errs = make(map[string]error)
+	mock.gas = make(map[string]uint64)
+	mock.emits = make(map[string][]any)
+	mock.calls = make(map[string][][]any)
+{{range .Events}}	mock.events{{.GoName}} = nil
+{{end}}}
+
+// record stores a call and returns its programmed results and error.
+func (mock *Mock{{.ContractName}}) record(method string, args ...any) ([]any, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.calls[method] = append(mock.calls[method], args)
+	return mock.results[method], mock.errs[method]
+}
+
+// emit records the events programmed for a write method.
+func (mock *Mock{{.ContractName}}) emit(method string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+{{if .HasEvents}}	for _, ev := range mock.emits[method] {
+		switch e := ev.(type) {
+{{range .Events}}		case *{{.GoName}}Event:
+			mock.events{{.GoName}} = append(mock.events{{.GoName}}, e)
+{{end}}		}
+	}
+{{end}}}
+
+// mockResult returns the i-th programmed result as T, or the zero value.
+func mockResult[T any](results []any, i int) T {
+	var zero T
+	if i >= len(results) {
+		return zero
+	}
+	v, ok := results[i].(T)
+	if !ok {
+		return zero
+	}
+	return v
+}
+
+{{range .Functions}}
+{{if .IsReadOnly}}
+// Set{{.GoName}} programs the values returned by {{.GoName}}.
+func (mock *Mock{{$.ContractName}}) Set{{.GoName}}({{range $i, $o := .Outputs}}{{if $i}}, {{end}}{{$o.GoName}} {{$o.GoType}}{{end}}) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.results["{{.Name}}"] = []any{ {{- range $i, $o := .Outputs}}{{if $i}}, {{end}}{{$o.GoName}}{{end -}} }
+}
+
+// {{.GoName}} returns the values programmed with Set{{.GoName}}.
+func (mock *Mock{{$.ContractName}}) {{.GoName}}(ctx context.Context{{range .Inputs}}, {{.GoName}} {{.GoType}}{{end}}) {{resultSig .Outputs}} {
+	{{if .Outputs}}results{{else}}_{{end}}, err := mock.record("{{.Name}}"{{range .Inputs}}, {{.GoName}}{{end}})
+	if err != nil {
+		return {{zeroResults .Outputs}}err
+	}
+	return {{range $i, $o := .Outputs}}mockResult[{{$o.GoType}}](results, {{$i}}), {{end}}nil
+}
+{{else}}
+// Prepare{{.GoName}} records a call to {{.Name}}, emits its programmed events and
+// returns an unsigned transaction carrying the encoded calldata.
+func (mock *Mock{{$.ContractName}}) Prepare{{.GoName}}(ctx context.Context, opts contract.WriteOptions{{range .Inputs}}, {{.GoName}} {{.GoType}}{{end}}) (*types.Transaction, error) {
+	if _, err := mock.record("{{.Name}}"{{range .Inputs}}, {{.GoName}}{{end}}); err != nil {
+		return nil, err
+	}
+	parsed, err := ParsedABI()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.EncodeCall("{{.Name}}"{{range .Inputs}}, {{.GoName}}{{end}})
+	if err != nil {
+		return nil, err
+	}
+	mock.emit("{{.Name}}")
+	return &types.Transaction{
+		From:                 opts.From,
+		To:                   &mock.address,
+		Data:                 data,
+		Value:                opts.Value,
+		Nonce:                opts.Nonce,
+		Gas:                  opts.Gas,
+		GasPrice:             opts.GasPrice,
+		MaxFeePerGas:         opts.MaxFeePerGas,
+		MaxPriorityFeePerGas: opts.MaxPriorityFeePerGas,
+	}, nil
+}
+
+// Estimate{{.GoName}} records a call to {{.Name}} and returns the gas programmed
+// with SetGas.
+func (mock *Mock{{$.ContractName}}) Estimate{{.GoName}}(ctx context.Context, opts contract.WriteOptions{{range .Inputs}}, {{.GoName}} {{.GoType}}{{end}}) (uint64, error) {
+	if _, err := mock.record("{{.Name}}"{{range .Inputs}}, {{.GoName}}{{end}}); err != nil {
+		return 0, err
+	}
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return mock.gas["{{.Name}}"], nil
+}
+{{end}}
+{{end}}
+
+{{range .Events}}
+// Add{{.GoName}}Event records a {{.Name}} event as if it had been emitted on-chain.
+func (mock *Mock{{$.ContractName}}) Add{{.GoName}}Event(ev *{{.GoName}}Event) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.events{{.GoName}} = append(mock.events{{.GoName}}, ev)
+}
+
+// Get{{.GoName}}Events returns every recorded {{.Name}} event. Block ranges and
+// topic filters in opts are ignored.
+func (mock *Mock{{$.ContractName}}) Get{{.GoName}}Events(ctx context.Context, opts contract.EventOptions) ([]*{{.GoName}}Event, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return append([]*{{.GoName}}Event(nil), mock.events{{.GoName}}...), nil
+}
+{{end}}
+`
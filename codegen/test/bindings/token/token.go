@@ -0,0 +1,354 @@
+// Code generated by viemgen. DO NOT EDIT.
+package token
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/contract"
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Suppress unused import warnings
+var (
+	_ = big.NewInt
+	_ = fmt.Errorf
+	_ = common.Address{}
+	_ types.Transaction
+	_ sync.Once
+	_ *abi.ABI
+)
+
+// ContractABI is the raw JSON ABI of the Token contract.
+var ContractABI = `[
+  {
+    "inputs": [
+      {
+        "name": "owner",
+        "type": "address"
+      }
+    ],
+    "name": "balanceOf",
+    "outputs": [
+      {
+        "name": "",
+        "type": "uint256"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [],
+    "name": "name",
+    "outputs": [
+      {
+        "name": "",
+        "type": "string"
+      }
+    ],
+    "stateMutability": "view",
+    "type": "function"
+  },
+  {
+    "inputs": [
+      {
+        "name": "to",
+        "type": "address"
+      },
+      {
+        "name": "value",
+        "type": "uint256"
+      }
+    ],
+    "name": "transfer",
+    "outputs": [
+      {
+        "name": "",
+        "type": "bool"
+      }
+    ],
+    "stateMutability": "nonpayable",
+    "type": "function"
+  },
+  {
+    "anonymous": false,
+    "inputs": [
+      {
+        "indexed": true,
+        "name": "from",
+        "type": "address"
+      },
+      {
+        "indexed": true,
+        "name": "to",
+        "type": "address"
+      },
+      {
+        "indexed": false,
+        "name": "value",
+        "type": "uint256"
+      }
+    ],
+    "name": "Transfer",
+    "type": "event"
+  }
+]`
+
+// parsedABI holds the parsed ABI (lazily initialized).
+var (
+	parsedABI     *abi.ABI
+	parsedABIOnce sync.Once
+	parsedABIErr  error
+)
+
+// ParsedABI returns the pre-parsed ABI for the Token contract.
+// This is useful for efficient multicall operations where you want to avoid
+// re-parsing the ABI JSON on every call.
+// The ABI is parsed once and cached for subsequent calls.
+func ParsedABI() (*abi.ABI, error) {
+	parsedABIOnce.Do(func() {
+		parsedABI, parsedABIErr = abi.Parse([]byte(ContractABI))
+	})
+	return parsedABI, parsedABIErr
+}
+
+// MustParsedABI returns the pre-parsed ABI, panicking on error.
+// Use this when you're confident the ABI is valid (e.g., in init or tests).
+func MustParsedABI() *abi.ABI {
+	parsed, err := ParsedABI()
+	if err != nil {
+		panic("failed to parse Token ABI: " + err.Error())
+	}
+	return parsed
+}
+
+// Addresses holds the known deployments of the Token contract keyed by chain ID.
+var Addresses = map[uint64]common.Address{
+	1: common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+}
+
+// AddressFor returns the deployed Token address on the given chain.
+func AddressFor(chainID uint64) (common.Address, bool) {
+	addr, ok := Addresses[chainID]
+	return addr, ok
+}
+
+// NewForChain creates a Token binding for its known deployment on the given chain.
+func NewForChain(chainID uint64, c *client.PublicClient) (*Token, error) {
+	addr, ok := AddressFor(chainID)
+	if !ok {
+		return nil, fmt.Errorf("Token is not deployed on chain %d", chainID)
+	}
+	return New(addr, c)
+}
+
+// ============================================================================
+// Typed Method Descriptors
+// ============================================================================
+
+// TokenMethods defines typed method descriptors for the Token contract.
+// Use these with contract.ReadTyped() for type-safe calls.
+type TokenMethods struct {
+	BalanceOf contract.ReadBigInt
+	Name      contract.ReadString
+	Transfer  contract.WriteMethod
+}
+
+// Methods is the typed method descriptors instance for Token.
+// Use with contract.ReadTyped(c.Contract(), ctx, Methods.MethodName, args...)
+var Methods = TokenMethods{
+	BalanceOf: contract.ReadBigInt{Name: "balanceOf"},
+	Name:      contract.ReadString{Name: "name"},
+	Transfer:  contract.WriteMethod{Name: "transfer"},
+}
+
+// ============================================================================
+// Contract Binding
+// ============================================================================
+
+// Token is a binding to the Token contract.
+type Token struct {
+	contract *contract.Contract
+	M        TokenMethods // Typed method descriptors
+}
+
+// New creates a new Token contract binding.
+func New(address common.Address, c *client.PublicClient) (*Token, error) {
+	cont, err := contract.NewContract(address, []byte(ContractABI), c)
+	if err != nil {
+		return nil, err
+	}
+	return &Token{contract: cont, M: Methods}, nil
+}
+
+// MustNew creates a new Token contract binding, panicking on error.
+func MustNew(address common.Address, c *client.PublicClient) *Token {
+	cont, err := New(address, c)
+	if err != nil {
+		panic(err)
+	}
+	return cont
+}
+
+// Address returns the contract address.
+func (c *Token) Address() common.Address {
+	return c.contract.Address()
+}
+
+// Contract returns the underlying contract instance.
+func (c *Token) Contract() *contract.Contract {
+	return c.contract
+}
+
+// ABI returns the raw JSON ABI string.
+func (c *Token) ABI() string {
+	return ContractABI
+}
+
+// ABIBytes returns the raw JSON ABI as bytes.
+// This is the format expected by multicall and other ABI-consuming functions.
+func (c *Token) ABIBytes() []byte {
+	return []byte(ContractABI)
+}
+
+// ParsedABI returns the pre-parsed ABI for efficient reuse.
+// Useful for multicall operations to avoid re-parsing the ABI.
+func (c *Token) ParsedABI() (*abi.ABI, error) {
+	return ParsedABI()
+}
+
+// BalanceOf calls the balanceOf function.
+// Solidity: balanceOf(address)
+func (c *Token) BalanceOf(ctx context.Context, owner common.Address) (*big.Int, error) {
+	result, err := c.contract.Read(ctx, "balanceOf", owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return result[0].(*big.Int), nil
+
+}
+
+// Name calls the name function.
+// Solidity: name()
+func (c *Token) Name(ctx context.Context) (string, error) {
+	result, err := c.contract.Read(ctx, "name")
+	if err != nil {
+		return "", err
+	}
+
+	return result[0].(string), nil
+
+}
+
+// PrepareTransfer prepares a transaction for the transfer function.
+// Solidity: transfer(address,uint256)
+func (c *Token) PrepareTransfer(ctx context.Context, opts contract.WriteOptions, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return c.contract.PrepareTransaction(ctx, opts, "transfer", to, value)
+}
+
+// EstimateTransfer estimates gas for the transfer function.
+func (c *Token) EstimateTransfer(ctx context.Context, opts contract.WriteOptions, to common.Address, value *big.Int) (uint64, error) {
+	return c.contract.EstimateGas(ctx, opts, "transfer", to, value)
+}
+
+// ParseTransfer decodes a Transfer event from a log.
+func (c *Token) ParseTransfer(log types.Log) (*TransferEvent, error) {
+	decoded, err := c.contract.DecodeEvent("Transfer", log.Topics, log.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	ev := &TransferEvent{}
+
+	switch v := decoded["from"].(type) {
+	case common.Address:
+		ev.From = v
+	default:
+		return nil, fmt.Errorf("Transfer event: unexpected type %T for from", v)
+	}
+
+	switch v := decoded["to"].(type) {
+	case common.Address:
+		ev.To = v
+	default:
+		return nil, fmt.Errorf("Transfer event: unexpected type %T for to", v)
+	}
+
+	switch v := decoded["value"].(type) {
+	case *big.Int:
+		ev.Value = v
+	default:
+		return nil, fmt.Errorf("Transfer event: unexpected type %T for value", v)
+	}
+
+	return ev, nil
+}
+
+// GetTransferEvents returns the Transfer events emitted by the contract.
+func (c *Token) GetTransferEvents(ctx context.Context, opts contract.EventOptions) ([]*TransferEvent, error) {
+	logs, err := c.contract.GetEventLogs(ctx, "Transfer", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*TransferEvent, 0, len(logs))
+	for _, log := range logs {
+		ev, err := c.ParseTransfer(log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// ============================================================================
+// Interfaces
+// ============================================================================
+
+// TokenReader is implemented by types that can call the read-only
+// functions of the Token contract.
+type TokenReader interface {
+	BalanceOf(ctx context.Context, owner common.Address) (*big.Int, error)
+	Name(ctx context.Context) (string, error)
+}
+
+// TokenWriter is implemented by types that can prepare and estimate
+// transactions for the Token contract.
+type TokenWriter interface {
+	PrepareTransfer(ctx context.Context, opts contract.WriteOptions, to common.Address, value *big.Int) (*types.Transaction, error)
+	EstimateTransfer(ctx context.Context, opts contract.WriteOptions, to common.Address, value *big.Int) (uint64, error)
+}
+
+// TokenEvents is implemented by types that can query the events of
+// the Token contract.
+type TokenEvents interface {
+	GetTransferEvents(ctx context.Context, opts contract.EventOptions) ([]*TransferEvent, error)
+}
+
+// TokenAPI is the full interface of the Token binding.
+// Depend on it instead of *Token so tests can substitute a mock.
+type TokenAPI interface {
+	Address() common.Address
+	TokenReader
+	TokenWriter
+	TokenEvents
+}
+
+var _ TokenAPI = (*Token)(nil)
+
+// Event types
+
+// TransferEvent represents a Transfer event.
+type TransferEvent struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+}
@@ -0,0 +1,230 @@
+// Code generated by viemgen. DO NOT EDIT.
+package token
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ChefBingbong/viem-go/contract"
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Suppress unused import warnings
+var (
+	_ = big.NewInt
+	_ = context.Background
+)
+
+var _ TokenAPI = (*MockToken)(nil)
+
+// MockToken is an in-memory implementation of TokenAPI for unit tests.
+//
+// Program read results with the Set<Method> helpers, failures with Revert or
+// SetError, and the events a write emits with Emit. Every call is recorded and
+// can be inspected with Calls and CallCount. Methods are keyed by their ABI name.
+type MockToken struct {
+	mu             sync.Mutex
+	address        common.Address
+	results        map[string][]any
+	errs           map[string]error
+	gas            map[string]uint64
+	emits          map[string][]any
+	calls          map[string][][]any
+	eventsTransfer []*TransferEvent
+}
+
+// NewMock creates a mock Token at the given address.
+func NewMock(address common.Address) *MockToken {
+	return &MockToken{
+		address: address,
+		results: make(map[string][]any),
+		errs:    make(map[string]error),
+		gas:     make(map[string]uint64),
+		emits:   make(map[string][]any),
+		calls:   make(map[string][][]any),
+	}
+}
+
+// Address returns the mock contract address.
+func (mock *MockToken) Address() common.Address {
+	return mock.address
+}
+
+// SetError makes every subsequent call to method fail with err.
+// Pass a nil error to clear it.
+func (mock *MockToken) SetError(method string, err error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	if err == nil {
+		delete(mock.errs, method)
+		return
+	}
+	mock.errs[method] = err
+}
+
+// Revert makes every subsequent call to method fail with a contract.RevertError.
+func (mock *MockToken) Revert(method string, reason string) {
+	mock.SetError(method, contract.Revert(reason))
+}
+
+// SetGas programs the gas returned by the Estimate helper of a write method.
+func (mock *MockToken) SetGas(method string, gas uint64) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.gas[method] = gas
+}
+
+// Emit programs the events recorded each time the Prepare helper of a write
+// method succeeds. Events must be pointers to the generated event types.
+func (mock *MockToken) Emit(method string, events ...any) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.emits[method] = append(mock.emits[method], events...)
+}
+
+// Calls returns the arguments of every recorded call to method.
+func (mock *MockToken) Calls(method string) [][]any {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return append([][]any(nil), mock.calls[method]...)
+}
+
+// CallCount returns how many times method was called.
+func (mock *MockToken) CallCount(method string) int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return len(mock.calls[method])
+}
+
+// Reset clears all programmed behavior, recorded calls and emitted events.
+func (mock *MockToken) Reset() {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.results = make(map[string][]any)
+	mock.errs = make(map[string]error)
+	mock.gas = make(map[string]uint64)
+	mock.emits = make(map[string][]any)
+	mock.calls = make(map[string][][]any)
+	mock.eventsTransfer = nil
+}
+
+// record stores a call and returns its programmed results and error.
+func (mock *MockToken) record(method string, args ...any) ([]any, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.calls[method] = append(mock.calls[method], args)
+	return mock.results[method], mock.errs[method]
+}
+
+// emit records the events programmed for a write method.
+func (mock *MockToken) emit(method string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	for _, ev := range mock.emits[method] {
+		switch e := ev.(type) {
+		case *TransferEvent:
+			mock.eventsTransfer = append(mock.eventsTransfer, e)
+		}
+	}
+}
+
+// mockResult returns the i-th programmed result as T, or the zero value.
+func mockResult[T any](results []any, i int) T {
+	var zero T
+	if i >= len(results) {
+		return zero
+	}
+	v, ok := results[i].(T)
+	if !ok {
+		return zero
+	}
+	return v
+}
+
+// SetBalanceOf programs the values returned by BalanceOf.
+func (mock *MockToken) SetBalanceOf(ret0 *big.Int) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.results["balanceOf"] = []any{ret0}
+}
+
+// BalanceOf returns the values programmed with SetBalanceOf.
+func (mock *MockToken) BalanceOf(ctx context.Context, owner common.Address) (*big.Int, error) {
+	results, err := mock.record("balanceOf", owner)
+	if err != nil {
+		return nil, err
+	}
+	return mockResult[*big.Int](results, 0), nil
+}
+
+// SetName programs the values returned by Name.
+func (mock *MockToken) SetName(ret0 string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.results["name"] = []any{ret0}
+}
+
+// Name returns the values programmed with SetName.
+func (mock *MockToken) Name(ctx context.Context) (string, error) {
+	results, err := mock.record("name")
+	if err != nil {
+		return "", err
+	}
+	return mockResult[string](results, 0), nil
+}
+
+// PrepareTransfer records a call to transfer, emits its programmed events and
+// returns an unsigned transaction carrying the encoded calldata.
+func (mock *MockToken) PrepareTransfer(ctx context.Context, opts contract.WriteOptions, to common.Address, value *big.Int) (*types.Transaction, error) {
+	if _, err := mock.record("transfer", to, value); err != nil {
+		return nil, err
+	}
+	parsed, err := ParsedABI()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.EncodeCall("transfer", to, value)
+	if err != nil {
+		return nil, err
+	}
+	mock.emit("transfer")
+	return &types.Transaction{
+		From:                 opts.From,
+		To:                   &mock.address,
+		Data:                 data,
+		Value:                opts.Value,
+		Nonce:                opts.Nonce,
+		Gas:                  opts.Gas,
+		GasPrice:             opts.GasPrice,
+		MaxFeePerGas:         opts.MaxFeePerGas,
+		MaxPriorityFeePerGas: opts.MaxPriorityFeePerGas,
+	}, nil
+}
+
+// EstimateTransfer records a call to transfer and returns the gas programmed
+// with SetGas.
+func (mock *MockToken) EstimateTransfer(ctx context.Context, opts contract.WriteOptions, to common.Address, value *big.Int) (uint64, error) {
+	if _, err := mock.record("transfer", to, value); err != nil {
+		return 0, err
+	}
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return mock.gas["transfer"], nil
+}
+
+// AddTransferEvent records a Transfer event as if it had been emitted on-chain.
+func (mock *MockToken) AddTransferEvent(ev *TransferEvent) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.eventsTransfer = append(mock.eventsTransfer, ev)
+}
+
+// GetTransferEvents returns every recorded Transfer event. Block ranges and
+// topic filters in opts are ignored.
+func (mock *MockToken) GetTransferEvents(ctx context.Context, opts contract.EventOptions) ([]*TransferEvent, error) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return append([]*TransferEvent(nil), mock.eventsTransfer...), nil
+}
@@ -0,0 +1,15 @@
+package codegen_test
+
+//go:generate go run ../../cmd/viemgen generate
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCodegen(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Codegen Suite")
+}
@@ -0,0 +1,150 @@
+package codegen_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/codegen"
+	"github.com/ChefBingbong/viem-go/codegen/test/bindings/token"
+	"github.com/ChefBingbong/viem-go/contract"
+	"github.com/ChefBingbong/viem-go/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// totalHeld is business logic written against the generated interface.
+func totalHeld(ctx context.Context, t token.TokenReader, owners ...common.Address) (*big.Int, error) {
+	total := new(big.Int)
+	for _, owner := range owners {
+		bal, err := t.BalanceOf(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		total.Add(total, bal)
+	}
+	return total, nil
+}
+
+var _ = Describe("Mock", func() {
+	ctx := context.Background()
+	tokenAddr := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	alice := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	bob := common.HexToAddress("0x00000000000000000000000000000000000000b0")
+
+	It("should keep the checked-in bindings up to date", func() {
+		cfg, err := codegen.LoadConfig("viemgen.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = codegen.CheckProject(cfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should return programmed values and record calls", func() {
+		mock := token.NewMock(tokenAddr)
+		mock.SetBalanceOf(big.NewInt(7))
+
+		total, err := totalHeld(ctx, mock, alice, bob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(total).To(Equal(big.NewInt(14)))
+		Expect(mock.CallCount("balanceOf")).To(Equal(2))
+		Expect(mock.Calls("balanceOf")[1]).To(Equal([]any{bob}))
+	})
+
+	It("should return zero values when nothing is programmed", func() {
+		name, err := token.NewMock(tokenAddr).Name(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(BeEmpty())
+	})
+
+	It("should simulate reverts", func() {
+		mock := token.NewMock(tokenAddr)
+		mock.Revert("balanceOf", "paused")
+
+		_, err := totalHeld(ctx, mock, alice)
+		var revert *contract.RevertError
+		Expect(err).To(BeAssignableToTypeOf(revert))
+		Expect(err.Error()).To(Equal("execution reverted: paused"))
+	})
+
+	It("should emit programmed events on writes", func() {
+		var api token.TokenAPI = token.NewMock(tokenAddr)
+		mock := api.(*token.MockToken)
+		mock.Emit("transfer", &token.TransferEvent{From: alice, To: bob, Value: big.NewInt(5)})
+		mock.SetGas("transfer", 51000)
+
+		gas, err := api.EstimateTransfer(ctx, contract.WriteOptions{From: alice}, bob, big.NewInt(5))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gas).To(Equal(uint64(51000)))
+
+		tx, err := api.PrepareTransfer(ctx, contract.WriteOptions{From: alice}, bob, big.NewInt(5))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*tx.To).To(Equal(tokenAddr))
+		Expect(tx.Data[:4]).To(Equal([]byte{0xa9, 0x05, 0x9c, 0xbb}))
+		Expect(mock.CallCount("transfer")).To(Equal(2))
+		Expect(mock.Calls("transfer")[0]).To(Equal([]any{bob, big.NewInt(5)}))
+
+		events, err := api.GetTransferEvents(ctx, contract.EventOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Value).To(Equal(big.NewInt(5)))
+	})
+})
+
+var _ = Describe("Generator", func() {
+	It("should reject contract methods that collide with mock helpers", func() {
+		abiJSON := []byte(`[{"inputs":[],"name":"reset","outputs":[],"stateMutability":"view","type":"function"}]`)
+		gen, err := codegen.NewGenerator("paused", "Paused", abiJSON)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = gen.GenerateMock()
+		Expect(err).To(MatchError(ContainSubstring(`mock method Reset for function "reset" collides with the mock helper`)))
+
+		gen, err = codegen.NewGeneratorWithOptions("paused", "Paused", abiJSON, codegen.Options{
+			Names: map[string]string{"reset": "ResetState"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = gen.GenerateMock()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject setters that collide with mock helpers", func() {
+		abiJSON := []byte(`[{"inputs":[],"name":"gas","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`)
+		gen, err := codegen.NewGenerator("meter", "Meter", abiJSON)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = gen.GenerateMock()
+		Expect(err).To(MatchError(ContainSubstring(`mock method SetGas for function "gas"`)))
+	})
+
+	It("should type indexed event fields as their decoded topics", func() {
+		abiJSON := []byte(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"label","type":"string"},{"indexed":true,"name":"kind","type":"uint8"},{"indexed":true,"name":"id","type":"bytes4"},{"indexed":false,"name":"amount","type":"uint8"}],"name":"Tagged","type":"event"}]`)
+		gen, err := codegen.NewGenerator("tags", "Tags", abiJSON)
+		Expect(err).ToNot(HaveOccurred())
+		code, err := gen.Generate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(code)).To(MatchRegexp(`Label\s+common\.Hash`))
+		Expect(string(code)).To(MatchRegexp(`Kind\s+\*big\.Int`))
+		Expect(string(code)).To(MatchRegexp(`Id\s+common\.Hash`))
+		Expect(string(code)).To(MatchRegexp(`Amount\s+uint8`))
+	})
+
+	It("should fail to parse events with missing fields", func() {
+		tok, err := token.New(common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"), nil)
+		Expect(err).ToNot(HaveOccurred())
+		transfer := token.MustParsedABI().Events["Transfer"]
+		log := types.Log{Topics: []common.Hash{
+			transfer.Topic,
+			common.BytesToHash(common.FromHex("0xa1")),
+			common.BytesToHash(common.FromHex("0xb0")),
+		}}
+
+		_, err = tok.ParseTransfer(log)
+		Expect(err).To(MatchError("Transfer event: unexpected type <nil> for value"))
+
+		log.Data = common.LeftPadBytes([]byte{5}, 32)
+		ev, err := tok.ParseTransfer(log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ev.To).To(Equal(common.HexToAddress("0xb0")))
+		Expect(ev.Value).To(Equal(big.NewInt(5)))
+	})
+})
@@ -0,0 +1,165 @@
+package codegen_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ChefBingbong/viem-go/codegen"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const tokenABI = `[{"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+var _ = Describe("Project", func() {
+	var dir string
+
+	writeFile := func(rel, content string) {
+		path := filepath.Join(dir, rel)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+	}
+
+	loadConfig := func() *codegen.Config {
+		path, err := codegen.FindConfig(dir)
+		Expect(err).ToNot(HaveOccurred())
+		cfg, err := codegen.LoadConfig(path)
+		Expect(err).ToNot(HaveOccurred())
+		return cfg
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "viemgen")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeFile("abis/token.json", tokenABI)
+		writeFile("out/Vault.sol/Vault.json", `{"contractName":"Vault","abi":`+tokenABI+`}`)
+		writeFile("out/Pool.sol/Pool.json", `{"abi":`+tokenABI+`}`)
+		writeFile("viemgen.yaml", `
+out: ./bindings
+contracts:
+  - abi: ./abis/token.json
+    package: mytoken
+    name: MyToken
+    names:
+      balanceOf: Balance
+    addresses:
+      1: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+  - abi: ./out/**/*.json
+`)
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	Context("when loading a config", func() {
+		It("should expand globs into targets with derived names", func() {
+			targets, err := loadConfig().Targets()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets).To(HaveLen(3))
+
+			pkgs := []string{targets[0].Package, targets[1].Package, targets[2].Package}
+			Expect(pkgs).To(ConsistOf("mytoken", "pool", "vault"))
+		})
+
+		It("should parse JSON configs", func() {
+			cfg, err := codegen.ParseConfig([]byte(`{"contracts":[{"abi":"a.json","addresses":{"10":"0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"}}]}`), true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Contracts[0].Addresses).To(HaveKey(uint64(10)))
+		})
+
+		It("should reject invalid addresses", func() {
+			_, err := codegen.ParseConfig([]byte("contracts:\n  - abi: a.json\n    addresses:\n      1: nope\n"), false)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject a package name on a multi-file glob", func() {
+			writeFile("viemgen.yaml", "contracts:\n  - abi: ./out/**/*.json\n    package: shared\n")
+			_, err := loadConfig().Targets()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when generating", func() {
+		It("should write bindings with overrides and addresses", func() {
+			results, err := codegen.GenerateProject(loadConfig(), codegen.ProjectOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			for _, r := range results {
+				Expect(r.Status).To(Equal(codegen.StatusGenerated))
+			}
+
+			code, err := os.ReadFile(filepath.Join(dir, "bindings", "mytoken", "mytoken.go"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(code)).To(ContainSubstring("type MyToken struct"))
+			Expect(string(code)).To(ContainSubstring("func (c *MyToken) Balance(ctx context.Context"))
+			Expect(string(code)).To(ContainSubstring(`1: common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")`))
+
+			vault, err := os.ReadFile(filepath.Join(dir, "bindings", "vault", "vault.go"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(vault)).To(ContainSubstring("type Vault struct"))
+		})
+
+		It("should skip unchanged inputs and regenerate changed ones", func() {
+			_, err := codegen.GenerateProject(loadConfig(), codegen.ProjectOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			results, err := codegen.GenerateProject(loadConfig(), codegen.ProjectOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			for _, r := range results {
+				Expect(r.Status).To(Equal(codegen.StatusUnchanged))
+			}
+
+			writeFile("abis/token.json", `[{"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"}]`)
+			results, err = codegen.GenerateProject(loadConfig(), codegen.ProjectOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			statuses := map[string]codegen.Status{}
+			for _, r := range results {
+				statuses[r.Target.Package] = r.Status
+			}
+			Expect(statuses["mytoken"]).To(Equal(codegen.StatusGenerated))
+			Expect(statuses["vault"]).To(Equal(codegen.StatusUnchanged))
+		})
+
+		It("should produce deterministic output", func() {
+			gen, err := codegen.NewGenerator("mytoken", "MyToken", []byte(tokenABI))
+			Expect(err).ToNot(HaveOccurred())
+			first, err := gen.Generate()
+			Expect(err).ToNot(HaveOccurred())
+			for i := 0; i < 5; i++ {
+				again, err := gen.Generate()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(again).To(Equal(first))
+			}
+		})
+	})
+
+	Context("when checking", func() {
+		It("should report missing, up-to-date and stale bindings", func() {
+			_, err := codegen.CheckProject(loadConfig())
+			Expect(err).To(MatchError(codegen.ErrStale))
+
+			_, err = codegen.GenerateProject(loadConfig(), codegen.ProjectOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			results, err := codegen.CheckProject(loadConfig())
+			Expect(err).ToNot(HaveOccurred())
+			for _, r := range results {
+				Expect(r.Status).To(Equal(codegen.StatusUpToDate))
+			}
+
+			writeFile("bindings/pool/pool.go", "package pool\n")
+			results, err = codegen.CheckProject(loadConfig())
+			Expect(err).To(MatchError(codegen.ErrStale))
+			var stale []string
+			for _, r := range results {
+				if r.Status == codegen.StatusStale {
+					stale = append(stale, r.Target.Package)
+				}
+			}
+			Expect(stale).To(ConsistOf("pool"))
+		})
+	})
+})
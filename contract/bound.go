@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/ChefBingbong/viem-go/abi"
 	"github.com/ChefBingbong/viem-go/client"
 	"github.com/ChefBingbong/viem-go/types"
 )
@@ -58,6 +59,27 @@ func BindWithABI(address common.Address, abiStr string, c *client.PublicClient)
 	return Bind(address, []byte(abiStr), c)
 }
 
+// ABIFetcher fetches the verified ABI of a deployed contract.
+// *explorer.Client satisfies it.
+type ABIFetcher interface {
+	GetABI(ctx context.Context, address common.Address) ([]byte, error)
+}
+
+// BindFromExplorer creates a BoundContract using the verified ABI published
+// by a block explorer.
+//
+// Example:
+//
+//	exp, _ := explorer.NewClient(explorer.Config{Chain: &definitions.Mainnet, APIKey: key})
+//	token, err := contract.BindFromExplorer(ctx, tokenAddr, exp, client)
+func BindFromExplorer(ctx context.Context, address common.Address, fetcher ABIFetcher, c *client.PublicClient) (*BoundContract, error) {
+	abiJSON, err := fetcher.GetABI(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch ABI for %s: %w", address.Hex(), err)
+	}
+	return Bind(address, abiJSON, c)
+}
+
 // MustBind creates a BoundContract, panicking on error.
 func MustBind(address common.Address, abiJSON []byte, c *client.PublicClient) *BoundContract {
 	bc, err := Bind(address, abiJSON, c)
@@ -160,40 +182,26 @@ func Call4WithOptions[TArg1, TArg2, TArg3, TArg4, TReturn any](bc *BoundContract
 
 // callTyped is the internal implementation for typed calls.
 func callTyped[TReturn any](bc *BoundContract, ctx context.Context, methodName string, args ...any) (TReturn, error) {
-	var zero TReturn
-
-	result, err := bc.Read(ctx, methodName, args...)
-	if err != nil {
-		return zero, err
-	}
-
-	if len(result) == 0 {
-		return zero, fmt.Errorf("method %q returned no values", methodName)
-	}
-
-	// Try direct type assertion
-	if typed, ok := result[0].(TReturn); ok {
-		return typed, nil
-	}
-
-	// Try type conversion
-	converted, err := convertResult[TReturn](result[0], methodName)
-	if err != nil {
-		return zero, err
-	}
-
-	return converted, nil
+	return callTypedWithOptions[TReturn](bc, ctx, ReadOptions{}, methodName, args...)
 }
 
 // callTypedWithOptions is the internal implementation for typed calls with options.
+// Scalar returns are taken from the first output; struct, slice and array
+// returns (including functions with several outputs) are decoded by
+// reflection using abi.DecodeFunctionResultAs.
 func callTypedWithOptions[TReturn any](bc *BoundContract, ctx context.Context, opts ReadOptions, methodName string, args ...any) (TReturn, error) {
 	var zero TReturn
 
-	result, err := bc.ReadWithOptions(ctx, opts, methodName, args...)
+	data, err := bc.callRaw(ctx, opts, methodName, args...)
 	if err != nil {
 		return zero, err
 	}
 
+	result, err := bc.abi.DecodeReturn(methodName, data)
+	if err != nil {
+		return zero, fmt.Errorf("failed to decode return for %q: %w", methodName, err)
+	}
+
 	if len(result) == 0 {
 		return zero, fmt.Errorf("method %q returned no values", methodName)
 	}
@@ -204,12 +212,12 @@ func callTypedWithOptions[TReturn any](bc *BoundContract, ctx context.Context, o
 	}
 
 	// Try type conversion
-	converted, err := convertResult[TReturn](result[0], methodName)
-	if err != nil {
-		return zero, err
+	if converted, err := convertResult[TReturn](result[0], methodName); err == nil {
+		return converted, nil
 	}
 
-	return converted, nil
+	// Fall back to reflection-based decoding (structs, tuples, arrays)
+	return abi.DecodeFunctionResultAs[TReturn](bc.abi, methodName, data)
 }
 
 // =============================================================================
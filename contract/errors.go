@@ -0,0 +1,23 @@
+package contract
+
+// RevertError represents a contract call that reverted.
+// Generated mocks return it to simulate reverts without a node.
+type RevertError struct {
+	// Reason is the revert reason string, if any.
+	Reason string
+	// Data is the raw revert data, if any.
+	Data []byte
+}
+
+// Error implements the error interface.
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return "execution reverted"
+	}
+	return "execution reverted: " + e.Reason
+}
+
+// Revert returns a RevertError with the given reason.
+func Revert(reason string) error {
+	return &RevertError{Reason: reason}
+}
@@ -0,0 +1,41 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// EventOptions contains options for fetching contract events.
+type EventOptions struct {
+	// FromBlock is the first block to search (default: latest).
+	FromBlock types.BlockNumber
+	// ToBlock is the last block to search (default: latest).
+	ToBlock types.BlockNumber
+	// Topics optionally filters on indexed arguments, following the
+	// eth_getLogs layout after the event signature topic.
+	Topics [][]common.Hash
+}
+
+// GetEventLogs returns the raw logs of the named event emitted by the contract.
+func (c *Contract) GetEventLogs(ctx context.Context, name string, opts EventOptions) ([]types.Log, error) {
+	ev, err := c.abi.GetEvent(name)
+	if err != nil {
+		return nil, err
+	}
+
+	topics := append([][]common.Hash{{ev.Topic}}, opts.Topics...)
+	logs, err := c.client.GetLogs(ctx, types.FilterQuery{
+		FromBlock: opts.FromBlock,
+		ToBlock:   opts.ToBlock,
+		Addresses: []common.Address{c.address},
+		Topics:    topics,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("eth_getLogs failed for %q: %w", name, err)
+	}
+	return logs, nil
+}
@@ -27,6 +27,22 @@ func (c *Contract) Read(ctx context.Context, method string, args ...any) ([]any,
 
 // ReadWithOptions calls a contract method with custom options.
 func (c *Contract) ReadWithOptions(ctx context.Context, opts ReadOptions, method string, args ...any) ([]any, error) {
+	result, err := c.callRaw(ctx, opts, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode the return value
+	decoded, err := c.abi.DecodeReturn(method, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode return for %q: %w", method, err)
+	}
+
+	return decoded, nil
+}
+
+// callRaw performs the eth_call for a contract method and returns the raw return data.
+func (c *Contract) callRaw(ctx context.Context, opts ReadOptions, method string, args ...any) ([]byte, error) {
 	// Validate method exists
 	fn, err := c.abi.GetFunction(method)
 	if err != nil {
@@ -61,13 +77,7 @@ func (c *Contract) ReadWithOptions(ctx context.Context, opts ReadOptions, method
 		return nil, fmt.Errorf("eth_call failed for %q: %w", method, err)
 	}
 
-	// Decode the return value
-	decoded, err := c.abi.DecodeReturn(method, result)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode return for %q: %w", method, err)
-	}
-
-	return decoded, nil
+	return result, nil
 }
 
 // ReadBigInt calls a method and returns the result as *big.Int.
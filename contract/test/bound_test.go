@@ -0,0 +1,93 @@
+package contract_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/contract"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type pairReserves struct {
+	Reserve0           *big.Int
+	Reserve1           *big.Int
+	BlockTimestampLast uint32
+}
+
+var _ = Describe("BoundContract", func() {
+	var (
+		server *httptest.Server
+		token  *contract.BoundContract
+	)
+
+	BeforeEach(func() {
+		returnData, err := abi.EncodeFrom(
+			[]abi.AbiParam{{Type: "uint112"}, {Type: "uint112"}, {Type: "uint32"}},
+			[]any{1000, 2000, 1700000000},
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     any    `json:"id"`
+				Method string `json:"method"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+
+			var result any = "0x1"
+			if req.Method == "eth_call" {
+				result = hexutil.Encode(returnData)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+		}))
+
+		c, err := client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(server.URL)})
+		Expect(err).ToNot(HaveOccurred())
+
+		pairABI := abi.MustParseAbi([]string{
+			"function getReserves() view returns (uint112 reserve0, uint112 reserve1, uint32 blockTimestampLast)",
+		})
+		token = &contract.BoundContract{Contract: contract.NewContractWithABI(common.HexToAddress("0x01"), pairABI, c)}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should decode multiple outputs into a struct-typed Fn", func() {
+		getReserves := contract.Fn[pairReserves]{Name: "getReserves"}
+
+		reserves, err := contract.Call(token, context.Background(), getReserves)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reserves.Reserve0.Int64()).To(Equal(int64(1000)))
+		Expect(reserves.Reserve1.Int64()).To(Equal(int64(2000)))
+		Expect(reserves.BlockTimestampLast).To(Equal(uint32(1700000000)))
+	})
+
+	It("should keep returning the first output for scalar Fn types", func() {
+		reserve0, err := contract.Call(token, context.Background(), contract.Fn[*big.Int]{Name: "getReserves"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reserve0.Int64()).To(Equal(int64(1000)))
+	})
+
+	It("should report shape mismatches", func() {
+		type wrong struct {
+			Reserve0 *big.Int
+		}
+		_, err := contract.Call(token, context.Background(), contract.Fn[wrong]{Name: "getReserves"})
+		Expect(err).To(MatchError(ContainSubstring(`no field for "reserve1"`)))
+	})
+})
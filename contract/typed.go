@@ -18,10 +18,20 @@ import (
 // Fn represents a zero-argument function returning TReturn.
 // Use this to define typed method descriptors for contract calls.
 //
+// TReturn may be a struct (with optional `abi:"name"` field tags) to receive
+// tuple outputs or all outputs of a multi-value function; see abi.DecodeInto.
+//
 // Example:
 //
 //	var Name = contract.Fn[string]{Name: "name"}
 //	name, err := contract.Call(bc, ctx, Name)
+//
+//	type Reserves struct {
+//	    Reserve0           *big.Int
+//	    Reserve1           *big.Int
+//	    BlockTimestampLast uint32
+//	}
+//	var GetReserves = contract.Fn[Reserves]{Name: "getReserves"}
 type Fn[TReturn any] struct {
 	Name string
 }
@@ -0,0 +1,428 @@
+package explorer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Sort is the sort order of a transaction list.
+type Sort string
+
+const (
+	SortAsc  Sort = "asc"
+	SortDesc Sort = "desc"
+)
+
+// TokenStandard selects the token transfer list.
+type TokenStandard string
+
+const (
+	// TokenERC20 lists ERC-20 transfers (action=tokentx).
+	TokenERC20 TokenStandard = "erc20"
+	// TokenERC721 lists ERC-721 transfers (action=tokennfttx).
+	TokenERC721 TokenStandard = "erc721"
+	// TokenERC1155 lists ERC-1155 transfers (action=token1155tx).
+	TokenERC1155 TokenStandard = "erc1155"
+)
+
+// ListOptions filters and paginates transaction lists.
+type ListOptions struct {
+	// StartBlock and EndBlock bound the block range. Zero values are omitted,
+	// letting the explorer default to the full history.
+	StartBlock uint64
+	EndBlock   uint64
+	// Page is the 1-based page number; Offset is the page size. Both are
+	// omitted when zero. Etherscan caps Page*Offset at 10,000 results; use
+	// the block range to walk larger histories.
+	Page   int
+	Offset int
+	// Sort defaults to ascending block order.
+	Sort Sort
+}
+
+func (o ListOptions) values() url.Values {
+	v := url.Values{}
+	if o.StartBlock != 0 {
+		v.Set("startblock", strconv.FormatUint(o.StartBlock, 10))
+	}
+	if o.EndBlock != 0 {
+		v.Set("endblock", strconv.FormatUint(o.EndBlock, 10))
+	}
+	if o.Page != 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.Offset != 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.Sort != "" {
+		v.Set("sort", string(o.Sort))
+	}
+	return v
+}
+
+// Transaction is an entry of an account's normal transaction list.
+type Transaction struct {
+	BlockNumber      uint64
+	BlockHash        common.Hash
+	Timestamp        time.Time
+	Hash             common.Hash
+	Nonce            uint64
+	TransactionIndex uint64
+	From             common.Address
+	// To is nil for contract creations.
+	To                *common.Address
+	Value             *big.Int
+	Gas               uint64
+	GasPrice          *big.Int
+	GasUsed           uint64
+	CumulativeGasUsed uint64
+	Input             hexutil.Bytes
+	// ContractAddress is set for contract creations.
+	ContractAddress *common.Address
+	IsError         bool
+	// ReceiptStatus is "1" for success, "0" for failure and empty before
+	// Byzantium.
+	ReceiptStatus string
+	MethodID      string
+	FunctionName  string
+	Confirmations uint64
+}
+
+// InternalTransaction is an entry of an account's internal transaction list.
+type InternalTransaction struct {
+	BlockNumber     uint64
+	Timestamp       time.Time
+	Hash            common.Hash
+	From            common.Address
+	To              *common.Address
+	Value           *big.Int
+	ContractAddress *common.Address
+	Input           hexutil.Bytes
+	Type            string
+	Gas             uint64
+	GasUsed         uint64
+	TraceID         string
+	IsError         bool
+	ErrCode         string
+}
+
+// TokenTransfer is an entry of an account's ERC-20, ERC-721 or ERC-1155
+// transfer list.
+type TokenTransfer struct {
+	BlockNumber      uint64
+	BlockHash        common.Hash
+	Timestamp        time.Time
+	Hash             common.Hash
+	Nonce            uint64
+	TransactionIndex uint64
+	From             common.Address
+	To               common.Address
+	ContractAddress  common.Address
+	// Value is the amount transferred; it is 1 for ERC-721 transfers.
+	Value *big.Int
+	// TokenID is set for ERC-721 and ERC-1155 transfers.
+	TokenID       *big.Int
+	TokenName     string
+	TokenSymbol   string
+	TokenDecimal  uint8
+	Gas           uint64
+	GasPrice      *big.Int
+	GasUsed       uint64
+	Confirmations uint64
+}
+
+// GetTransactions returns the normal transactions sent from or to address.
+func (c *Client) GetTransactions(ctx context.Context, address common.Address, opts ListOptions) ([]Transaction, error) {
+	params := opts.values()
+	params.Set("address", address.Hex())
+
+	var results []struct {
+		BlockNumber       string `json:"blockNumber"`
+		BlockHash         string `json:"blockHash"`
+		TimeStamp         string `json:"timeStamp"`
+		Hash              string `json:"hash"`
+		Nonce             string `json:"nonce"`
+		TransactionIndex  string `json:"transactionIndex"`
+		From              string `json:"from"`
+		To                string `json:"to"`
+		Value             string `json:"value"`
+		Gas               string `json:"gas"`
+		GasPrice          string `json:"gasPrice"`
+		GasUsed           string `json:"gasUsed"`
+		CumulativeGasUsed string `json:"cumulativeGasUsed"`
+		Input             string `json:"input"`
+		ContractAddress   string `json:"contractAddress"`
+		IsError           string `json:"isError"`
+		TxReceiptStatus   string `json:"txreceipt_status"`
+		MethodID          string `json:"methodId"`
+		FunctionName      string `json:"functionName"`
+		Confirmations     string `json:"confirmations"`
+	}
+	if err := c.Request(ctx, "account", "txlist", params, &results); err != nil {
+		return nil, err
+	}
+
+	var p parser
+	txs := make([]Transaction, len(results))
+	for i, r := range results {
+		txs[i] = Transaction{
+			BlockNumber:       p.uint64("blockNumber", r.BlockNumber),
+			BlockHash:         common.HexToHash(r.BlockHash),
+			Timestamp:         p.time("timeStamp", r.TimeStamp),
+			Hash:              common.HexToHash(r.Hash),
+			Nonce:             p.uint64("nonce", r.Nonce),
+			TransactionIndex:  p.uint64("transactionIndex", r.TransactionIndex),
+			From:              common.HexToAddress(r.From),
+			To:                optionalAddress(r.To),
+			Value:             p.big("value", r.Value),
+			Gas:               p.uint64("gas", r.Gas),
+			GasPrice:          p.big("gasPrice", r.GasPrice),
+			GasUsed:           p.uint64("gasUsed", r.GasUsed),
+			CumulativeGasUsed: p.uint64("cumulativeGasUsed", r.CumulativeGasUsed),
+			Input:             p.bytes("input", r.Input),
+			ContractAddress:   optionalAddress(r.ContractAddress),
+			IsError:           r.IsError == "1",
+			ReceiptStatus:     r.TxReceiptStatus,
+			MethodID:          r.MethodID,
+			FunctionName:      r.FunctionName,
+			Confirmations:     p.uint64("confirmations", r.Confirmations),
+		}
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return txs, nil
+}
+
+// GetInternalTransactions returns the internal transactions (message calls
+// with value) sent from or to address.
+func (c *Client) GetInternalTransactions(ctx context.Context, address common.Address, opts ListOptions) ([]InternalTransaction, error) {
+	params := opts.values()
+	params.Set("address", address.Hex())
+	return c.internalTransactions(ctx, params)
+}
+
+// GetInternalTransactionsByHash returns the internal transactions executed by
+// the transaction with the given hash.
+func (c *Client) GetInternalTransactionsByHash(ctx context.Context, hash common.Hash) ([]InternalTransaction, error) {
+	return c.internalTransactions(ctx, url.Values{"txhash": {hash.Hex()}})
+}
+
+func (c *Client) internalTransactions(ctx context.Context, params url.Values) ([]InternalTransaction, error) {
+	var results []struct {
+		BlockNumber     string `json:"blockNumber"`
+		TimeStamp       string `json:"timeStamp"`
+		Hash            string `json:"hash"`
+		From            string `json:"from"`
+		To              string `json:"to"`
+		Value           string `json:"value"`
+		ContractAddress string `json:"contractAddress"`
+		Input           string `json:"input"`
+		Type            string `json:"type"`
+		Gas             string `json:"gas"`
+		GasUsed         string `json:"gasUsed"`
+		TraceID         string `json:"traceId"`
+		IsError         string `json:"isError"`
+		ErrCode         string `json:"errCode"`
+	}
+	if err := c.Request(ctx, "account", "txlistinternal", params, &results); err != nil {
+		return nil, err
+	}
+
+	var p parser
+	txs := make([]InternalTransaction, len(results))
+	for i, r := range results {
+		txs[i] = InternalTransaction{
+			BlockNumber:     p.uint64("blockNumber", r.BlockNumber),
+			Timestamp:       p.time("timeStamp", r.TimeStamp),
+			Hash:            common.HexToHash(r.Hash),
+			From:            common.HexToAddress(r.From),
+			To:              optionalAddress(r.To),
+			Value:           p.big("value", r.Value),
+			ContractAddress: optionalAddress(r.ContractAddress),
+			Input:           p.bytes("input", r.Input),
+			Type:            r.Type,
+			Gas:             p.uint64("gas", r.Gas),
+			GasUsed:         p.uint64("gasUsed", r.GasUsed),
+			TraceID:         r.TraceID,
+			IsError:         r.IsError == "1",
+			ErrCode:         r.ErrCode,
+		}
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return txs, nil
+}
+
+// TokenTransferOptions filters token transfer lists.
+type TokenTransferOptions struct {
+	ListOptions
+	// Standard selects ERC-20, ERC-721 or ERC-1155 transfers. Defaults to
+	// TokenERC20.
+	Standard TokenStandard
+	// Address limits the list to transfers from or to this account.
+	Address *common.Address
+	// ContractAddress limits the list to transfers of this token.
+	ContractAddress *common.Address
+}
+
+// GetTokenTransfers returns token transfers filtered by account and/or token
+// contract. At least one of opts.Address and opts.ContractAddress must be set.
+func (c *Client) GetTokenTransfers(ctx context.Context, opts TokenTransferOptions) ([]TokenTransfer, error) {
+	if opts.Address == nil && opts.ContractAddress == nil {
+		return nil, fmt.Errorf("explorer: token transfers require an address or contract address")
+	}
+
+	var action string
+	switch opts.Standard {
+	case "", TokenERC20:
+		action = "tokentx"
+	case TokenERC721:
+		action = "tokennfttx"
+	case TokenERC1155:
+		action = "token1155tx"
+	default:
+		return nil, fmt.Errorf("explorer: unknown token standard %q", opts.Standard)
+	}
+
+	params := opts.values()
+	if opts.Address != nil {
+		params.Set("address", opts.Address.Hex())
+	}
+	if opts.ContractAddress != nil {
+		params.Set("contractaddress", opts.ContractAddress.Hex())
+	}
+
+	var results []struct {
+		BlockNumber      string `json:"blockNumber"`
+		BlockHash        string `json:"blockHash"`
+		TimeStamp        string `json:"timeStamp"`
+		Hash             string `json:"hash"`
+		Nonce            string `json:"nonce"`
+		TransactionIndex string `json:"transactionIndex"`
+		From             string `json:"from"`
+		To               string `json:"to"`
+		ContractAddress  string `json:"contractAddress"`
+		Value            string `json:"value"`
+		TokenID          string `json:"tokenID"`
+		TokenValue       string `json:"tokenValue"`
+		TokenName        string `json:"tokenName"`
+		TokenSymbol      string `json:"tokenSymbol"`
+		TokenDecimal     string `json:"tokenDecimal"`
+		Gas              string `json:"gas"`
+		GasPrice         string `json:"gasPrice"`
+		GasUsed          string `json:"gasUsed"`
+		Confirmations    string `json:"confirmations"`
+	}
+	if err := c.Request(ctx, "account", action, params, &results); err != nil {
+		return nil, err
+	}
+
+	var p parser
+	transfers := make([]TokenTransfer, len(results))
+	for i, r := range results {
+		t := TokenTransfer{
+			BlockNumber:      p.uint64("blockNumber", r.BlockNumber),
+			BlockHash:        common.HexToHash(r.BlockHash),
+			Timestamp:        p.time("timeStamp", r.TimeStamp),
+			Hash:             common.HexToHash(r.Hash),
+			Nonce:            p.uint64("nonce", r.Nonce),
+			TransactionIndex: p.uint64("transactionIndex", r.TransactionIndex),
+			From:             common.HexToAddress(r.From),
+			To:               common.HexToAddress(r.To),
+			ContractAddress:  common.HexToAddress(r.ContractAddress),
+			TokenName:        r.TokenName,
+			TokenSymbol:      r.TokenSymbol,
+			TokenDecimal:     uint8(p.uint64("tokenDecimal", r.TokenDecimal)),
+			Gas:              p.uint64("gas", r.Gas),
+			GasPrice:         p.big("gasPrice", r.GasPrice),
+			GasUsed:          p.uint64("gasUsed", r.GasUsed),
+			Confirmations:    p.uint64("confirmations", r.Confirmations),
+		}
+		switch action {
+		case "tokentx":
+			t.Value = p.big("value", r.Value)
+		case "tokennfttx":
+			t.Value = big.NewInt(1)
+			t.TokenID = p.big("tokenID", r.TokenID)
+		case "token1155tx":
+			t.Value = p.big("tokenValue", r.TokenValue)
+			t.TokenID = p.big("tokenID", r.TokenID)
+		}
+		transfers[i] = t
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return transfers, nil
+}
+
+// optionalAddress returns nil for an empty address field.
+func optionalAddress(s string) *common.Address {
+	if !common.IsHexAddress(s) {
+		return nil
+	}
+	addr := common.HexToAddress(s)
+	return &addr
+}
+
+// parser converts the decimal strings used by explorer responses, keeping
+// the first error.
+type parser struct {
+	err error
+}
+
+func (p *parser) fail(field, value string) {
+	if p.err == nil {
+		p.err = fmt.Errorf("explorer: invalid %s %q", field, value)
+	}
+}
+
+func (p *parser) uint64(field, s string) uint64 {
+	if s == "" {
+		return 0
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		p.fail(field, s)
+	}
+	return n
+}
+
+func (p *parser) big(field, s string) *big.Int {
+	if s == "" {
+		return new(big.Int)
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		p.fail(field, s)
+		return new(big.Int)
+	}
+	return n
+}
+
+func (p *parser) time(field, s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	return time.Unix(int64(p.uint64(field, s)), 0).UTC()
+}
+
+func (p *parser) bytes(field, s string) hexutil.Bytes {
+	if s == "" || s == "0x" || s == "deprecated" {
+		return nil
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		p.fail(field, s)
+	}
+	return b
+}
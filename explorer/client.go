@@ -0,0 +1,348 @@
+// Package explorer implements a client for Etherscan-compatible block explorer
+// APIs (Etherscan and its V2 multichain endpoint, Arbiscan, BscScan, Blockscout
+// and others exposing the same "module/action" interface).
+//
+// The client fetches verified contract ABIs and source code, contract creation
+// info, normal/internal/token transaction lists and gas oracle data. Requests
+// are spaced to stay under the explorer's rate limit, and responses rejected
+// for exceeding it are retried with backoff.
+//
+// Example:
+//
+//	exp, err := explorer.NewClient(explorer.Config{
+//		Chain:  &definitions.Mainnet,
+//		APIKey: os.Getenv("ETHERSCAN_API_KEY"),
+//	})
+//	abiJSON, err := exp.GetABI(ctx, usdc)
+//	token, err := contract.BindFromExplorer(ctx, usdc, exp, publicClient)
+package explorer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
+)
+
+const (
+	// EtherscanV2URL is the Etherscan V2 multichain endpoint. It serves every
+	// chain Etherscan supports, selected by the chainid parameter.
+	EtherscanV2URL = "https://api.etherscan.io/v2/api"
+
+	// DefaultRateLimit is the default number of requests per second, matching
+	// Etherscan's free tier.
+	DefaultRateLimit = 5
+)
+
+var (
+	// ErrNoAPIURL is returned when neither Config.URL nor the chain's default
+	// block explorer provides an API URL.
+	ErrNoAPIURL = errors.New("explorer: no API URL configured")
+	// ErrRateLimited is returned when the explorer keeps rejecting requests
+	// for exceeding its rate limit after all retries.
+	ErrRateLimited = errors.New("explorer: rate limit exceeded")
+	// ErrNotVerified is returned when a contract's source code is not verified.
+	ErrNotVerified = errors.New("explorer: contract source code not verified")
+	// ErrInvalidAPIKey is returned when the explorer rejects the API key.
+	ErrInvalidAPIKey = errors.New("explorer: invalid API key")
+)
+
+// APIError is an error reported in an explorer response body
+// (status "0" with a message and a textual result).
+type APIError struct {
+	Message string
+	Result  string
+}
+
+func (e *APIError) Error() string {
+	if e.Result == "" || e.Result == e.Message {
+		return "explorer: " + e.Message
+	}
+	return fmt.Sprintf("explorer: %s: %s", e.Message, e.Result)
+}
+
+// Unwrap maps well-known explorer messages to ErrRateLimited,
+// ErrNotVerified and ErrInvalidAPIKey.
+func (e *APIError) Unwrap() error {
+	text := strings.ToLower(e.Message + " " + e.Result)
+	switch {
+	case strings.Contains(text, "rate limit"):
+		return ErrRateLimited
+	case strings.Contains(text, "not verified"):
+		return ErrNotVerified
+	case strings.Contains(text, "invalid api key") || strings.Contains(text, "missing/invalid api key"):
+		return ErrInvalidAPIKey
+	}
+	return nil
+}
+
+// Config configures a Client.
+type Config struct {
+	// URL is the explorer API endpoint, e.g. "https://api.etherscan.io/v2/api".
+	// Defaults to the API URL of Chain's default block explorer.
+	URL string
+	// Chain selects the default URL and chain ID.
+	Chain *chain.Chain
+	// ChainID is sent as the chainid parameter, which the Etherscan V2
+	// endpoint requires. Defaults to Chain.ID; zero omits the parameter.
+	ChainID int64
+	// APIKey is sent as the apikey parameter.
+	APIKey string
+	// RateLimit is the maximum number of requests per second. Defaults to
+	// DefaultRateLimit; a negative value disables client-side limiting.
+	RateLimit float64
+	// RetryCount is the number of times a rate-limited request is retried.
+	// Defaults to 3; a negative value disables retries.
+	RetryCount int
+	// RetryDelay is the base delay between retries, doubled on each attempt.
+	// Defaults to 1s.
+	RetryDelay time.Duration
+	// Timeout is the request timeout. Defaults to 10s.
+	Timeout time.Duration
+	// HTTPClient allows providing a custom HTTP client.
+	HTTPClient *http.Client
+}
+
+// Client queries an Etherscan-compatible explorer API. It is safe for
+// concurrent use.
+type Client struct {
+	url        string
+	chainID    int64
+	apiKey     string
+	retryCount int
+	retryDelay time.Duration
+	httpClient *http.Client
+	limiter    *limiter
+}
+
+// NewClient creates an explorer client.
+func NewClient(config Config) (*Client, error) {
+	apiURL := config.URL
+	chainID := config.ChainID
+	if config.Chain != nil {
+		if apiURL == "" {
+			apiURL = config.Chain.BlockExplorers["default"].ApiURL
+		}
+		if chainID == 0 {
+			chainID = config.Chain.ID
+		}
+	}
+	if apiURL == "" {
+		return nil, ErrNoAPIURL
+	}
+	if _, err := url.Parse(apiURL); err != nil {
+		return nil, fmt.Errorf("explorer: invalid API URL: %w", err)
+	}
+
+	rate := config.RateLimit
+	if rate == 0 {
+		rate = DefaultRateLimit
+	}
+	retryCount := config.RetryCount
+	if retryCount == 0 {
+		retryCount = 3
+	} else if retryCount < 0 {
+		retryCount = 0
+	}
+	retryDelay := config.RetryDelay
+	if retryDelay <= 0 {
+		retryDelay = time.Second
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: timeout}
+	}
+
+	return &Client{
+		url:        apiURL,
+		chainID:    chainID,
+		apiKey:     config.APIKey,
+		retryCount: retryCount,
+		retryDelay: retryDelay,
+		httpClient: httpClient,
+		limiter:    newLimiter(rate),
+	}, nil
+}
+
+// URL returns the explorer API URL.
+func (c *Client) URL() string {
+	return c.url
+}
+
+// ChainID returns the chain ID sent with each request, or zero.
+func (c *Client) ChainID() int64 {
+	return c.chainID
+}
+
+// Close releases idle connections.
+func (c *Client) Close() error {
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// response is the envelope of every explorer response.
+type response struct {
+	Status  string          `json:"status"`
+	Message string          `json:"message"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// Request calls module/action with params and decodes the result into result
+// (if non-nil). The chainid and apikey parameters are added automatically.
+// Rate-limited requests are retried.
+func (c *Client) Request(ctx context.Context, module, action string, params url.Values, result any) error {
+	query := url.Values{}
+	for k, v := range params {
+		query[k] = v
+	}
+	query.Set("module", module)
+	query.Set("action", action)
+	if c.chainID != 0 {
+		query.Set("chainid", strconv.FormatInt(c.chainID, 10))
+	}
+	if c.apiKey != "" {
+		query.Set("apikey", c.apiKey)
+	}
+
+	for attempt := 0; ; attempt++ {
+		raw, err := c.do(ctx, query)
+		if err == nil {
+			if result == nil {
+				return nil
+			}
+			if err := json.Unmarshal(raw, result); err != nil {
+				return fmt.Errorf("explorer: failed to unmarshal %s/%s result: %w", module, action, err)
+			}
+			return nil
+		}
+		if !errors.Is(err, ErrRateLimited) || attempt >= c.retryCount {
+			return err
+		}
+
+		timer := time.NewTimer(c.retryDelay << attempt)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// do performs a single request and returns the raw result.
+func (c *Client) do(ctx context.Context, query url.Values) (json.RawMessage, error) {
+	if err := c.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	reqURL := c.url
+	if strings.Contains(reqURL, "?") {
+		reqURL += "&" + query.Encode()
+	} else {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, rpc.NewHTTPRequestError(reqURL, 0, "", nil, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, rpc.NewHTTPRequestError(reqURL, 0, "", nil, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, rpc.NewHTTPRequestError(reqURL, resp.StatusCode, resp.Status, nil, err)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("%w: %w", ErrRateLimited, rpc.NewHTTPRequestError(reqURL, resp.StatusCode, resp.Status, string(body), nil))
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, rpc.NewHTTPRequestError(reqURL, resp.StatusCode, resp.Status, string(body), nil)
+	}
+
+	var env response
+	if err := json.Unmarshal(body, &env); err != nil {
+		return nil, fmt.Errorf("explorer: failed to unmarshal response: %w", err)
+	}
+	if env.Status == "0" {
+		if isEmptyResult(env) {
+			return json.RawMessage("[]"), nil
+		}
+		apiErr := &APIError{Message: env.Message}
+		var text string
+		if json.Unmarshal(env.Result, &text) == nil {
+			apiErr.Result = text
+		}
+		return nil, apiErr
+	}
+	return env.Result, nil
+}
+
+// isEmptyResult reports whether a status "0" response only signals an empty
+// list (e.g. "No transactions found"), which is not an error.
+func isEmptyResult(env response) bool {
+	return strings.HasPrefix(strings.TrimSpace(string(env.Result)), "[") ||
+		strings.HasPrefix(strings.ToLower(env.Message), "no ")
+}
+
+// limiter spaces requests evenly to stay under a requests-per-second limit.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newLimiter(perSecond float64) *limiter {
+	if perSecond <= 0 {
+		return &limiter{}
+	}
+	return &limiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// wait blocks until the next request slot or until ctx is done.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
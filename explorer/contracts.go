@@ -0,0 +1,198 @@
+package explorer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	json "github.com/goccy/go-json"
+)
+
+// SourceCode is the verified source of a contract, as returned by
+// module=contract&action=getsourcecode.
+type SourceCode struct {
+	ContractName         string
+	SourceCode           string
+	ABI                  json.RawMessage
+	CompilerVersion      string
+	OptimizationUsed     bool
+	Runs                 uint64
+	ConstructorArguments string
+	EVMVersion           string
+	Library              string
+	LicenseType          string
+	// Proxy reports whether the explorer detected a proxy contract.
+	Proxy bool
+	// Implementation is the proxy's implementation address, if detected.
+	Implementation *common.Address
+	SwarmSource    string
+}
+
+// Sources returns the individual source files of a multi-file verification
+// (standard JSON input), keyed by path. Single-file sources are returned
+// under ContractName + ".sol".
+func (s *SourceCode) Sources() map[string]string {
+	src := strings.TrimSpace(s.SourceCode)
+	// Standard JSON input is wrapped in an extra pair of braces.
+	if strings.HasPrefix(src, "{{") && strings.HasSuffix(src, "}}") {
+		src = src[1 : len(src)-1]
+	}
+	if strings.HasPrefix(src, "{") {
+		var input struct {
+			Sources map[string]struct {
+				Content string `json:"content"`
+			} `json:"sources"`
+		}
+		if json.Unmarshal([]byte(src), &input) == nil && len(input.Sources) > 0 {
+			files := make(map[string]string, len(input.Sources))
+			for path, file := range input.Sources {
+				files[path] = file.Content
+			}
+			return files
+		}
+		// Some explorers return the sources map directly.
+		var sources map[string]struct {
+			Content string `json:"content"`
+		}
+		if json.Unmarshal([]byte(src), &sources) == nil && len(sources) > 0 {
+			files := make(map[string]string, len(sources))
+			for path, file := range sources {
+				files[path] = file.Content
+			}
+			return files
+		}
+	}
+	return map[string]string{s.ContractName + ".sol": s.SourceCode}
+}
+
+// ContractCreation describes the deployment of a contract, as returned by
+// module=contract&action=getcontractcreation.
+type ContractCreation struct {
+	ContractAddress common.Address
+	ContractCreator common.Address
+	TxHash          common.Hash
+	// BlockNumber is zero if the explorer does not report it.
+	BlockNumber uint64
+}
+
+// GetABI returns the verified ABI JSON of the contract at address. It returns
+// an error wrapping ErrNotVerified if the contract is not verified.
+func (c *Client) GetABI(ctx context.Context, address common.Address) ([]byte, error) {
+	var abiString string
+	if err := c.Request(ctx, "contract", "getabi", url.Values{"address": {address.Hex()}}, &abiString); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(strings.TrimSpace(abiString), "[") {
+		return nil, &APIError{Message: "NOTOK", Result: abiString}
+	}
+	return []byte(abiString), nil
+}
+
+// GetSourceCode returns the verified source code and metadata of the contract
+// at address. It returns an error wrapping ErrNotVerified if the contract is
+// not verified.
+func (c *Client) GetSourceCode(ctx context.Context, address common.Address) (*SourceCode, error) {
+	var results []struct {
+		SourceCode           string `json:"SourceCode"`
+		ABI                  string `json:"ABI"`
+		ContractName         string `json:"ContractName"`
+		CompilerVersion      string `json:"CompilerVersion"`
+		OptimizationUsed     string `json:"OptimizationUsed"`
+		Runs                 string `json:"Runs"`
+		ConstructorArguments string `json:"ConstructorArguments"`
+		EVMVersion           string `json:"EVMVersion"`
+		Library              string `json:"Library"`
+		LicenseType          string `json:"LicenseType"`
+		Proxy                string `json:"Proxy"`
+		Implementation       string `json:"Implementation"`
+		SwarmSource          string `json:"SwarmSource"`
+	}
+	if err := c.Request(ctx, "contract", "getsourcecode", url.Values{"address": {address.Hex()}}, &results); err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotVerified, address.Hex())
+	}
+
+	r := results[0]
+	if r.SourceCode == "" || !strings.HasPrefix(strings.TrimSpace(r.ABI), "[") {
+		return nil, &APIError{Message: "NOTOK", Result: r.ABI}
+	}
+
+	var p parser
+	src := &SourceCode{
+		ContractName:         r.ContractName,
+		SourceCode:           r.SourceCode,
+		ABI:                  json.RawMessage(r.ABI),
+		CompilerVersion:      r.CompilerVersion,
+		OptimizationUsed:     r.OptimizationUsed == "1",
+		Runs:                 p.uint64("Runs", r.Runs),
+		ConstructorArguments: r.ConstructorArguments,
+		EVMVersion:           r.EVMVersion,
+		Library:              r.Library,
+		LicenseType:          r.LicenseType,
+		Proxy:                r.Proxy == "1",
+		SwarmSource:          r.SwarmSource,
+	}
+	if common.IsHexAddress(r.Implementation) {
+		impl := common.HexToAddress(r.Implementation)
+		src.Implementation = &impl
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return src, nil
+}
+
+// GetContractCreation returns the creator and creation transaction of up to
+// five contracts per request.
+func (c *Client) GetContractCreation(ctx context.Context, addresses ...common.Address) ([]ContractCreation, error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+	hexes := make([]string, len(addresses))
+	for i, addr := range addresses {
+		hexes[i] = addr.Hex()
+	}
+
+	var results []struct {
+		ContractAddress string `json:"contractAddress"`
+		ContractCreator string `json:"contractCreator"`
+		TxHash          string `json:"txHash"`
+		BlockNumber     string `json:"blockNumber"`
+	}
+	if err := c.Request(ctx, "contract", "getcontractcreation", url.Values{"contractaddresses": {strings.Join(hexes, ",")}}, &results); err != nil {
+		return nil, err
+	}
+
+	var p parser
+	creations := make([]ContractCreation, len(results))
+	for i, r := range results {
+		creations[i] = ContractCreation{
+			ContractAddress: common.HexToAddress(r.ContractAddress),
+			ContractCreator: common.HexToAddress(r.ContractCreator),
+			TxHash:          common.HexToHash(r.TxHash),
+			BlockNumber:     p.uint64("blockNumber", r.BlockNumber),
+		}
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return creations, nil
+}
+
+// GetImplementationABI returns the ABI to call the contract at address with:
+// the verified implementation's ABI if the explorer detected a proxy, or the
+// contract's own ABI otherwise.
+func (c *Client) GetImplementationABI(ctx context.Context, address common.Address) ([]byte, error) {
+	src, err := c.GetSourceCode(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if src.Proxy && src.Implementation != nil && *src.Implementation != address {
+		return c.GetABI(ctx, *src.Implementation)
+	}
+	return src.ABI, nil
+}
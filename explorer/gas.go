@@ -0,0 +1,73 @@
+package explorer
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ChefBingbong/viem-go/utils/unit"
+)
+
+// GasOracle holds the explorer's gas price recommendations, converted to wei.
+type GasOracle struct {
+	LastBlock       uint64
+	SafeGasPrice    *big.Int
+	ProposeGasPrice *big.Int
+	FastGasPrice    *big.Int
+	// SuggestBaseFee is nil if the explorer does not report it.
+	SuggestBaseFee *big.Int
+	// GasUsedRatio is the gas used ratio of the most recent blocks.
+	GasUsedRatio []float64
+}
+
+// GetGasOracle returns the current gas price recommendations
+// (module=gastracker&action=gasoracle).
+func (c *Client) GetGasOracle(ctx context.Context) (*GasOracle, error) {
+	var r struct {
+		LastBlock       string `json:"LastBlock"`
+		SafeGasPrice    string `json:"SafeGasPrice"`
+		ProposeGasPrice string `json:"ProposeGasPrice"`
+		FastGasPrice    string `json:"FastGasPrice"`
+		SuggestBaseFee  string `json:"suggestBaseFee"`
+		GasUsedRatio    string `json:"gasUsedRatio"`
+	}
+	if err := c.Request(ctx, "gastracker", "gasoracle", nil, &r); err != nil {
+		return nil, err
+	}
+
+	var p parser
+	oracle := &GasOracle{
+		LastBlock:       p.uint64("LastBlock", r.LastBlock),
+		SafeGasPrice:    p.gwei("SafeGasPrice", r.SafeGasPrice),
+		ProposeGasPrice: p.gwei("ProposeGasPrice", r.ProposeGasPrice),
+		FastGasPrice:    p.gwei("FastGasPrice", r.FastGasPrice),
+	}
+	if r.SuggestBaseFee != "" {
+		oracle.SuggestBaseFee = p.gwei("suggestBaseFee", r.SuggestBaseFee)
+	}
+	for _, s := range strings.Split(r.GasUsedRatio, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		ratio, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			p.fail("gasUsedRatio", s)
+		}
+		oracle.GasUsedRatio = append(oracle.GasUsedRatio, ratio)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	return oracle, nil
+}
+
+// gwei parses a decimal gwei amount into wei.
+func (p *parser) gwei(field, s string) *big.Int {
+	wei, err := unit.ParseGwei(s)
+	if err != nil {
+		p.fail(field, s)
+		return new(big.Int)
+	}
+	return wei
+}
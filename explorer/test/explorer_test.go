@@ -0,0 +1,447 @@
+package explorer_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/contract"
+	"github.com/ChefBingbong/viem-go/explorer"
+)
+
+const (
+	tokenABI = `[{"type":"function","name":"decimals","inputs":[],"outputs":[{"name":"","type":"uint8"}],"stateMutability":"view"}]`
+	proxyABI = `[{"type":"function","name":"implementation","inputs":[],"outputs":[{"name":"","type":"address"}],"stateMutability":"view"}]`
+)
+
+var (
+	token    = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	proxy    = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	account  = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	creator  = common.HexToAddress("0x00000000000000000000000000000000000000dd")
+	unverify = common.HexToAddress("0x00000000000000000000000000000000000000ee")
+)
+
+// fixture is an Etherscan-compatible API server backed by canned responses.
+type fixture struct {
+	*httptest.Server
+	mu          sync.Mutex
+	queries     []url.Values
+	rateLimited int32
+}
+
+func ok(result any) map[string]any {
+	return map[string]any{"status": "1", "message": "OK", "result": result}
+}
+
+func notOK(result string) map[string]any {
+	return map[string]any{"status": "0", "message": "NOTOK", "result": result}
+}
+
+func newFixture() *fixture {
+	f := &fixture{}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		f.mu.Lock()
+		f.queries = append(f.queries, q)
+		f.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		respond := func(v any) { _ = json.NewEncoder(w).Encode(v) }
+
+		if q.Get("apikey") == "bad" {
+			respond(notOK("Invalid API Key"))
+			return
+		}
+		if atomic.AddInt32(&f.rateLimited, -1) >= 0 {
+			respond(notOK("Max calls per sec rate limit reached (5/sec)"))
+			return
+		}
+
+		switch q.Get("module") + "/" + q.Get("action") {
+		case "contract/getabi":
+			switch common.HexToAddress(q.Get("address")) {
+			case token:
+				respond(ok(tokenABI))
+			case proxy:
+				respond(ok(proxyABI))
+			default:
+				respond(notOK("Contract source code not verified"))
+			}
+		case "contract/getsourcecode":
+			entry := map[string]string{
+				"SourceCode": "", "ABI": "Contract source code not verified", "ContractName": "",
+				"Proxy": "0", "Implementation": "",
+			}
+			switch common.HexToAddress(q.Get("address")) {
+			case token:
+				entry = map[string]string{
+					"SourceCode":       `{{"language":"Solidity","sources":{"src/Token.sol":{"content":"contract Token {}"},"src/Lib.sol":{"content":"library Lib {}"}}}}`,
+					"ABI":              tokenABI,
+					"ContractName":     "Token",
+					"CompilerVersion":  "v0.8.24+commit.e11b9ed9",
+					"OptimizationUsed": "1",
+					"Runs":             "200",
+					"EVMVersion":       "paris",
+					"LicenseType":      "MIT",
+					"Proxy":            "0",
+					"Implementation":   "",
+				}
+			case proxy:
+				entry = map[string]string{
+					"SourceCode":     "contract Proxy {}",
+					"ABI":            proxyABI,
+					"ContractName":   "Proxy",
+					"Runs":           "200",
+					"Proxy":          "1",
+					"Implementation": token.Hex(),
+				}
+			}
+			respond(ok([]map[string]string{entry}))
+		case "contract/getcontractcreation":
+			respond(ok([]map[string]string{{
+				"contractAddress": token.Hex(),
+				"contractCreator": creator.Hex(),
+				"txHash":          "0x" + common.Bytes2Hex(common.LeftPadBytes([]byte{1}, 32)),
+				"blockNumber":     "19000000",
+			}}))
+		case "account/txlist":
+			if q.Get("page") == "2" {
+				respond(map[string]any{"status": "0", "message": "No transactions found", "result": []any{}})
+				return
+			}
+			respond(ok([]map[string]string{
+				{
+					"blockNumber": "100", "timeStamp": "1700000000", "hash": "0x" + common.Bytes2Hex(common.LeftPadBytes([]byte{2}, 32)),
+					"nonce": "7", "transactionIndex": "3", "from": account.Hex(), "to": token.Hex(),
+					"value": "1000000000000000000", "gas": "21000", "gasPrice": "30000000000", "gasUsed": "21000",
+					"cumulativeGasUsed": "500000", "input": "0x313ce567", "contractAddress": "", "isError": "0",
+					"txreceipt_status": "1", "methodId": "0x313ce567", "functionName": "decimals()", "confirmations": "12",
+				},
+				{
+					"blockNumber": "101", "timeStamp": "1700000012", "hash": "0x" + common.Bytes2Hex(common.LeftPadBytes([]byte{3}, 32)),
+					"nonce": "8", "transactionIndex": "0", "from": account.Hex(), "to": "",
+					"value": "0", "gas": "900000", "gasPrice": "30000000000", "gasUsed": "800000",
+					"cumulativeGasUsed": "800000", "input": "0x6080", "contractAddress": proxy.Hex(), "isError": "1",
+					"txreceipt_status": "0", "confirmations": "11",
+				},
+			}))
+		case "account/txlistinternal":
+			respond(ok([]map[string]string{{
+				"blockNumber": "100", "timeStamp": "1700000000", "hash": "0x" + common.Bytes2Hex(common.LeftPadBytes([]byte{2}, 32)),
+				"from": token.Hex(), "to": account.Hex(), "value": "5", "contractAddress": "", "input": "",
+				"type": "call", "gas": "2300", "gasUsed": "0", "traceId": "0_1", "isError": "0", "errCode": "",
+			}}))
+		case "account/tokentx":
+			respond(ok([]map[string]string{{
+				"blockNumber": "100", "timeStamp": "1700000000", "from": account.Hex(), "to": creator.Hex(),
+				"contractAddress": token.Hex(), "value": "2500000", "tokenName": "USD Coin", "tokenSymbol": "USDC",
+				"tokenDecimal": "6", "gasPrice": "1",
+			}}))
+		case "account/tokennfttx":
+			respond(ok([]map[string]string{{
+				"blockNumber": "100", "from": account.Hex(), "to": creator.Hex(), "contractAddress": token.Hex(),
+				"tokenID": "42", "tokenName": "Punks", "tokenSymbol": "PUNK", "tokenDecimal": "0",
+			}}))
+		case "account/token1155tx":
+			respond(ok([]map[string]string{{
+				"blockNumber": "100", "from": account.Hex(), "to": creator.Hex(), "contractAddress": token.Hex(),
+				"tokenID": "7", "tokenValue": "3", "tokenName": "Items", "tokenSymbol": "ITM",
+			}}))
+		case "gastracker/gasoracle":
+			respond(ok(map[string]string{
+				"LastBlock": "19000000", "SafeGasPrice": "1.5", "ProposeGasPrice": "2", "FastGasPrice": "3.25",
+				"suggestBaseFee": "1.234567891", "gasUsedRatio": "0.5,0.25,1",
+			}))
+		default:
+			respond(notOK("Error! Missing Or invalid Module name"))
+		}
+	}))
+	return f
+}
+
+func (f *fixture) lastQuery() url.Values {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.queries[len(f.queries)-1]
+}
+
+func (f *fixture) client(config ...explorer.Config) *explorer.Client {
+	cfg := explorer.Config{RateLimit: -1, RetryDelay: time.Millisecond}
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	if cfg.URL == "" && cfg.Chain == nil {
+		cfg.URL = f.URL + "/v2/api"
+	}
+	c, err := explorer.NewClient(cfg)
+	Expect(err).NotTo(HaveOccurred())
+	return c
+}
+
+var _ = Describe("Explorer client", func() {
+	var (
+		f   *fixture
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		f = newFixture()
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		f.Close()
+	})
+
+	It("takes the API URL and chain ID from the chain", func() {
+		c := f.client(explorer.Config{
+			Chain: &chain.Chain{
+				ID:             8453,
+				BlockExplorers: map[string]chain.ChainBlockExplorer{"default": {Name: "Basescan", ApiURL: f.URL + "/api"}},
+			},
+			APIKey:    "key",
+			RateLimit: -1,
+		})
+		Expect(c.URL()).To(Equal(f.URL + "/api"))
+		Expect(c.ChainID()).To(Equal(int64(8453)))
+
+		_, err := c.GetABI(ctx, token)
+		Expect(err).NotTo(HaveOccurred())
+		q := f.lastQuery()
+		Expect(q.Get("chainid")).To(Equal("8453"))
+		Expect(q.Get("apikey")).To(Equal("key"))
+		Expect(q.Get("module")).To(Equal("contract"))
+		Expect(q.Get("action")).To(Equal("getabi"))
+
+		_, err = explorer.NewClient(explorer.Config{Chain: &chain.Chain{ID: 1}})
+		Expect(err).To(MatchError(explorer.ErrNoAPIURL))
+	})
+
+	Describe("contracts", func() {
+		It("fetches verified ABIs", func() {
+			abiJSON, err := f.client().GetABI(ctx, token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(abiJSON)).To(Equal(tokenABI))
+
+			_, err = f.client().GetABI(ctx, unverify)
+			Expect(err).To(MatchError(explorer.ErrNotVerified))
+			var apiErr *explorer.APIError
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.Result).To(Equal("Contract source code not verified"))
+		})
+
+		It("fetches source code and metadata", func() {
+			src, err := f.client().GetSourceCode(ctx, token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(src.ContractName).To(Equal("Token"))
+			Expect(src.CompilerVersion).To(Equal("v0.8.24+commit.e11b9ed9"))
+			Expect(src.OptimizationUsed).To(BeTrue())
+			Expect(src.Runs).To(Equal(uint64(200)))
+			Expect(src.Proxy).To(BeFalse())
+			Expect(src.Implementation).To(BeNil())
+			Expect(string(src.ABI)).To(Equal(tokenABI))
+			Expect(src.Sources()).To(Equal(map[string]string{
+				"src/Token.sol": "contract Token {}",
+				"src/Lib.sol":   "library Lib {}",
+			}))
+
+			p, err := f.client().GetSourceCode(ctx, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.Proxy).To(BeTrue())
+			Expect(*p.Implementation).To(Equal(token))
+			Expect(p.Sources()).To(Equal(map[string]string{"Proxy.sol": "contract Proxy {}"}))
+
+			_, err = f.client().GetSourceCode(ctx, unverify)
+			Expect(err).To(MatchError(explorer.ErrNotVerified))
+		})
+
+		It("follows proxies to the implementation ABI", func() {
+			abiJSON, err := f.client().GetImplementationABI(ctx, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(abiJSON)).To(Equal(tokenABI))
+
+			abiJSON, err = f.client().GetImplementationABI(ctx, token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(abiJSON)).To(Equal(tokenABI))
+		})
+
+		It("fetches contract creation info", func() {
+			creations, err := f.client().GetContractCreation(ctx, token, proxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.lastQuery().Get("contractaddresses")).To(Equal(token.Hex() + "," + proxy.Hex()))
+			Expect(creations).To(HaveLen(1))
+			Expect(creations[0].ContractAddress).To(Equal(token))
+			Expect(creations[0].ContractCreator).To(Equal(creator))
+			Expect(creations[0].TxHash).To(Equal(common.BigToHash(big.NewInt(1))))
+			Expect(creations[0].BlockNumber).To(Equal(uint64(19000000)))
+		})
+	})
+
+	Describe("accounts", func() {
+		It("lists normal transactions with pagination", func() {
+			txs, err := f.client().GetTransactions(ctx, account, explorer.ListOptions{
+				StartBlock: 100, EndBlock: 200, Page: 1, Offset: 2, Sort: explorer.SortDesc,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			q := f.lastQuery()
+			Expect(q.Get("address")).To(Equal(account.Hex()))
+			Expect(q.Get("startblock")).To(Equal("100"))
+			Expect(q.Get("endblock")).To(Equal("200"))
+			Expect(q.Get("page")).To(Equal("1"))
+			Expect(q.Get("offset")).To(Equal("2"))
+			Expect(q.Get("sort")).To(Equal("desc"))
+
+			Expect(txs).To(HaveLen(2))
+			Expect(txs[0].BlockNumber).To(Equal(uint64(100)))
+			Expect(txs[0].Timestamp).To(Equal(time.Unix(1700000000, 0).UTC()))
+			Expect(txs[0].Nonce).To(Equal(uint64(7)))
+			Expect(*txs[0].To).To(Equal(token))
+			Expect(txs[0].Value.String()).To(Equal("1000000000000000000"))
+			Expect(txs[0].GasPrice.String()).To(Equal("30000000000"))
+			Expect([]byte(txs[0].Input)).To(Equal([]byte{0x31, 0x3c, 0xe5, 0x67}))
+			Expect(txs[0].ContractAddress).To(BeNil())
+			Expect(txs[0].FunctionName).To(Equal("decimals()"))
+			Expect(txs[1].To).To(BeNil())
+			Expect(*txs[1].ContractAddress).To(Equal(proxy))
+			Expect(txs[1].IsError).To(BeTrue())
+			Expect(txs[1].ReceiptStatus).To(Equal("0"))
+
+			txs, err = f.client().GetTransactions(ctx, account, explorer.ListOptions{Page: 2, Offset: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(txs).To(BeEmpty())
+		})
+
+		It("lists internal transactions", func() {
+			txs, err := f.client().GetInternalTransactions(ctx, account, explorer.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(txs).To(HaveLen(1))
+			Expect(txs[0].From).To(Equal(token))
+			Expect(txs[0].Value.Int64()).To(Equal(int64(5)))
+			Expect(txs[0].Type).To(Equal("call"))
+			Expect(txs[0].TraceID).To(Equal("0_1"))
+
+			_, err = f.client().GetInternalTransactionsByHash(ctx, common.BigToHash(big.NewInt(2)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.lastQuery().Get("txhash")).To(Equal(common.BigToHash(big.NewInt(2)).Hex()))
+		})
+
+		It("lists token transfers by standard", func() {
+			c := f.client()
+			erc20, err := c.GetTokenTransfers(ctx, explorer.TokenTransferOptions{Address: &account, ContractAddress: &token})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.lastQuery().Get("action")).To(Equal("tokentx"))
+			Expect(f.lastQuery().Get("contractaddress")).To(Equal(token.Hex()))
+			Expect(erc20[0].Value.Int64()).To(Equal(int64(2500000)))
+			Expect(erc20[0].TokenDecimal).To(Equal(uint8(6)))
+			Expect(erc20[0].TokenSymbol).To(Equal("USDC"))
+			Expect(erc20[0].TokenID).To(BeNil())
+
+			nfts, err := c.GetTokenTransfers(ctx, explorer.TokenTransferOptions{Address: &account, Standard: explorer.TokenERC721})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.lastQuery().Get("action")).To(Equal("tokennfttx"))
+			Expect(nfts[0].TokenID.Int64()).To(Equal(int64(42)))
+			Expect(nfts[0].Value.Int64()).To(Equal(int64(1)))
+
+			multi, err := c.GetTokenTransfers(ctx, explorer.TokenTransferOptions{Address: &account, Standard: explorer.TokenERC1155})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.lastQuery().Get("action")).To(Equal("token1155tx"))
+			Expect(multi[0].TokenID.Int64()).To(Equal(int64(7)))
+			Expect(multi[0].Value.Int64()).To(Equal(int64(3)))
+
+			_, err = c.GetTokenTransfers(ctx, explorer.TokenTransferOptions{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("converts the gas oracle to wei", func() {
+		oracle, err := f.client().GetGasOracle(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(oracle.LastBlock).To(Equal(uint64(19000000)))
+		Expect(oracle.SafeGasPrice.String()).To(Equal("1500000000"))
+		Expect(oracle.ProposeGasPrice.String()).To(Equal("2000000000"))
+		Expect(oracle.FastGasPrice.String()).To(Equal("3250000000"))
+		Expect(oracle.SuggestBaseFee.String()).To(Equal("1234567891"))
+		Expect(oracle.GasUsedRatio).To(Equal([]float64{0.5, 0.25, 1}))
+	})
+
+	Describe("errors and rate limits", func() {
+		It("retries rate-limited requests", func() {
+			f.rateLimited = 2
+			_, err := f.client().GetABI(ctx, token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.queries).To(HaveLen(3))
+		})
+
+		It("gives up after the retry budget", func() {
+			f.rateLimited = 10
+			_, err := f.client(explorer.Config{URL: f.URL, RateLimit: -1, RetryCount: 1, RetryDelay: time.Millisecond}).GetABI(ctx, token)
+			Expect(err).To(MatchError(explorer.ErrRateLimited))
+			Expect(f.queries).To(HaveLen(2))
+		})
+
+		It("reports invalid API keys", func() {
+			_, err := f.client(explorer.Config{URL: f.URL, APIKey: "bad", RateLimit: -1}).GetGasOracle(ctx)
+			Expect(err).To(MatchError(explorer.ErrInvalidAPIKey))
+		})
+
+		It("spaces requests to honor the rate limit", func() {
+			c := f.client(explorer.Config{URL: f.URL, RateLimit: 20})
+			start := time.Now()
+			for i := 0; i < 4; i++ {
+				_, err := c.GetGasOracle(ctx)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(time.Since(start)).To(BeNumerically(">=", 140*time.Millisecond))
+		})
+
+		It("redacts the API key from HTTP errors", func() {
+			f.Close()
+			_, err := f.client(explorer.Config{URL: f.URL, APIKey: "AbCdEf0123456789xyzXYZ", RateLimit: -1}).GetGasOracle(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).NotTo(ContainSubstring("AbCdEf0123456789xyzXYZ"))
+		})
+	})
+
+	It("binds contracts with an ABI from the explorer", func() {
+		rpcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID any `json:"id"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"jsonrpc": "2.0", "id": req.ID,
+				"result": "0x0000000000000000000000000000000000000000000000000000000000000006",
+			})
+		}))
+		defer rpcServer.Close()
+
+		pc, err := client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(rpcServer.URL)})
+		Expect(err).NotTo(HaveOccurred())
+		defer pc.Close()
+
+		bound, err := contract.BindFromExplorer(ctx, token, f.client(), pc)
+		Expect(err).NotTo(HaveOccurred())
+		decimals, err := contract.Call(bound, ctx, contract.Fn[uint8]{Name: "decimals"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decimals).To(Equal(uint8(6)))
+
+		_, err = contract.BindFromExplorer(ctx, unverify, f.client(), pc)
+		Expect(err).To(MatchError(explorer.ErrNotVerified))
+	})
+})
@@ -0,0 +1,202 @@
+package flashbots
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SendBundleParameters contains the parameters for SendBundle (eth_sendBundle).
+type SendBundleParameters struct {
+	// Transactions are the bundle's transactions, executed in order. Required.
+	Transactions []BundleTransaction
+	// BlockNumber is the block the bundle is valid for. Required.
+	BlockNumber uint64
+	// MinTimestamp and MaxTimestamp optionally bound the block timestamp.
+	MinTimestamp *uint64
+	MaxTimestamp *uint64
+	// RevertingTxHashes are hashes of transactions allowed to revert, in
+	// addition to those marked BundleTransaction.CanRevert.
+	RevertingTxHashes []common.Hash
+	// ReplacementUUID allows the bundle to be replaced or cancelled later.
+	ReplacementUUID string
+	// Builders lists the builders the bundle should be shared with.
+	Builders []string
+}
+
+// SendBundleReturnType is the return type for SendBundle.
+type SendBundleReturnType struct {
+	BundleHash common.Hash
+}
+
+// SendBundle submits a bundle for inclusion in params.BlockNumber.
+//
+// Example:
+//
+//	res, err := relay.SendBundle(ctx, flashbots.SendBundleParameters{
+//		Transactions: []flashbots.BundleTransaction{
+//			{Transaction: tx1, Signer: account},
+//			{Raw: victimTx},
+//		},
+//		BlockNumber: head + 1,
+//	})
+func (c *RelayClient) SendBundle(ctx context.Context, params SendBundleParameters) (*SendBundleReturnType, error) {
+	raws, reverting, err := serializeBundle(params.Transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	req := map[string]any{
+		"txs":         raws,
+		"blockNumber": hexutil.EncodeUint64(params.BlockNumber),
+	}
+	if params.MinTimestamp != nil {
+		req["minTimestamp"] = *params.MinTimestamp
+	}
+	if params.MaxTimestamp != nil {
+		req["maxTimestamp"] = *params.MaxTimestamp
+	}
+	if reverting = append(append([]common.Hash(nil), params.RevertingTxHashes...), reverting...); len(reverting) > 0 {
+		req["revertingTxHashes"] = reverting
+	}
+	if params.ReplacementUUID != "" {
+		req["replacementUuid"] = params.ReplacementUUID
+	}
+	if len(params.Builders) > 0 {
+		req["builders"] = params.Builders
+	}
+
+	var result bundleHashResult
+	if err := c.Request(ctx, "eth_sendBundle", []any{req}, &result); err != nil {
+		return nil, err
+	}
+	return &SendBundleReturnType{BundleHash: result.BundleHash}, nil
+}
+
+// CallBundleParameters contains the parameters for CallBundle (eth_callBundle).
+type CallBundleParameters struct {
+	// Transactions are the bundle's transactions, executed in order. Required.
+	Transactions []BundleTransaction
+	// BlockNumber is the block the bundle would be included in. Required.
+	BlockNumber uint64
+	// StateBlockNumber is the block (number or tag) whose state the
+	// simulation starts from. Defaults to "latest".
+	StateBlockNumber string
+	// Timestamp optionally overrides the simulated block timestamp.
+	Timestamp *uint64
+}
+
+// CallBundleTransactionResult is the simulation result of one bundle transaction.
+type CallBundleTransactionResult struct {
+	TxHash            common.Hash
+	FromAddress       common.Address
+	ToAddress         common.Address
+	GasUsed           uint64
+	GasPrice          *big.Int
+	GasFees           *big.Int
+	CoinbaseDiff      *big.Int
+	EthSentToCoinbase *big.Int
+	Value             string
+	// Error and Revert are set if the transaction failed.
+	Error  string
+	Revert string
+}
+
+// CallBundleReturnType is the return type for CallBundle.
+type CallBundleReturnType struct {
+	BundleHash        common.Hash
+	BundleGasPrice    *big.Int
+	CoinbaseDiff      *big.Int
+	EthSentToCoinbase *big.Int
+	GasFees           *big.Int
+	StateBlockNumber  uint64
+	TotalGasUsed      uint64
+	Results           []CallBundleTransactionResult
+}
+
+// FirstRevert returns the first failed transaction result, or nil if every
+// transaction succeeded.
+func (r *CallBundleReturnType) FirstRevert() *CallBundleTransactionResult {
+	for i := range r.Results {
+		if r.Results[i].Error != "" || r.Results[i].Revert != "" {
+			return &r.Results[i]
+		}
+	}
+	return nil
+}
+
+// CallBundle simulates a bundle on top of params.StateBlockNumber.
+func (c *RelayClient) CallBundle(ctx context.Context, params CallBundleParameters) (*CallBundleReturnType, error) {
+	raws, _, err := serializeBundle(params.Transactions)
+	if err != nil {
+		return nil, err
+	}
+	stateBlock := params.StateBlockNumber
+	if stateBlock == "" {
+		stateBlock = "latest"
+	}
+
+	req := map[string]any{
+		"txs":              raws,
+		"blockNumber":      hexutil.EncodeUint64(params.BlockNumber),
+		"stateBlockNumber": stateBlock,
+	}
+	if params.Timestamp != nil {
+		req["timestamp"] = *params.Timestamp
+	}
+
+	var raw struct {
+		BundleHash        common.Hash `json:"bundleHash"`
+		BundleGasPrice    quantity    `json:"bundleGasPrice"`
+		CoinbaseDiff      quantity    `json:"coinbaseDiff"`
+		EthSentToCoinbase quantity    `json:"ethSentToCoinbase"`
+		GasFees           quantity    `json:"gasFees"`
+		StateBlockNumber  quantity    `json:"stateBlockNumber"`
+		TotalGasUsed      quantity    `json:"totalGasUsed"`
+		Results           []struct {
+			TxHash            common.Hash    `json:"txHash"`
+			FromAddress       common.Address `json:"fromAddress"`
+			ToAddress         common.Address `json:"toAddress"`
+			GasUsed           quantity       `json:"gasUsed"`
+			GasPrice          quantity       `json:"gasPrice"`
+			GasFees           quantity       `json:"gasFees"`
+			CoinbaseDiff      quantity       `json:"coinbaseDiff"`
+			EthSentToCoinbase quantity       `json:"ethSentToCoinbase"`
+			Value             string         `json:"value"`
+			Error             string         `json:"error"`
+			Revert            string         `json:"revert"`
+		} `json:"results"`
+	}
+	if err := c.Request(ctx, "eth_callBundle", []any{req}, &raw); err != nil {
+		return nil, err
+	}
+
+	result := &CallBundleReturnType{
+		BundleHash:        raw.BundleHash,
+		BundleGasPrice:    raw.BundleGasPrice.big(),
+		CoinbaseDiff:      raw.CoinbaseDiff.big(),
+		EthSentToCoinbase: raw.EthSentToCoinbase.big(),
+		GasFees:           raw.GasFees.big(),
+		StateBlockNumber:  raw.StateBlockNumber.uint64(),
+		TotalGasUsed:      raw.TotalGasUsed.uint64(),
+		Results:           make([]CallBundleTransactionResult, len(raw.Results)),
+	}
+	for i, r := range raw.Results {
+		result.Results[i] = CallBundleTransactionResult{
+			TxHash:            r.TxHash,
+			FromAddress:       r.FromAddress,
+			ToAddress:         r.ToAddress,
+			GasUsed:           r.GasUsed.uint64(),
+			GasPrice:          r.GasPrice.big(),
+			GasFees:           r.GasFees.big(),
+			CoinbaseDiff:      r.CoinbaseDiff.big(),
+			EthSentToCoinbase: r.EthSentToCoinbase.big(),
+			Value:             r.Value,
+			Error:             r.Error,
+			Revert:            r.Revert,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,195 @@
+// Package flashbots implements a client for Flashbots-compatible MEV relays
+// and block builders: bundle submission and simulation (eth_sendBundle,
+// eth_callBundle, mev_sendBundle, mev_simBundle) and private transactions
+// (eth_sendPrivateTransaction, eth_cancelPrivateTransaction).
+//
+// Every request is authenticated with the X-Flashbots-Signature header, signed
+// by a local account that identifies the searcher (it does not need funds and
+// should not be the account sending the bundle's transactions).
+//
+// Example:
+//
+//	authSigner, _ := accounts.PrivateKeyToAccount(accounts.GeneratePrivateKey())
+//	relay, err := flashbots.NewRelayClient(flashbots.RelayClientConfig{
+//		URL:        flashbots.DefaultRelayURL,
+//		AuthSigner: authSigner,
+//	})
+//	res, err := relay.SendBundle(ctx, flashbots.SendBundleParameters{
+//		Transactions: []flashbots.BundleTransaction{{Raw: signedTx}},
+//		BlockNumber:  head + 1,
+//	})
+package flashbots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/utils/rpc"
+	"github.com/ChefBingbong/viem-go/utils/signature"
+)
+
+const (
+	// DefaultRelayURL is the Flashbots mainnet relay.
+	DefaultRelayURL = "https://relay.flashbots.net"
+	// SepoliaRelayURL is the Flashbots Sepolia relay.
+	SepoliaRelayURL = "https://relay-sepolia.flashbots.net"
+
+	// SignatureHeader is the header carrying the request signature.
+	SignatureHeader = "X-Flashbots-Signature"
+)
+
+// ErrAuthSignerRequired is returned when a relay client is created without an auth signer.
+var ErrAuthSignerRequired = errors.New("flashbots: auth signer is required")
+
+// Signer is an account that can sign messages (EIP-191). accounts.LocalAccount
+// and its derivatives satisfy it.
+type Signer interface {
+	Address() common.Address
+	SignMessage(message signature.SignableMessage) (string, error)
+}
+
+// RelayClientConfig configures a RelayClient.
+type RelayClientConfig struct {
+	// URL is the relay endpoint. Defaults to DefaultRelayURL.
+	URL string
+	// AuthSigner signs the X-Flashbots-Signature header. Required.
+	AuthSigner Signer
+	// Headers are additional HTTP headers to send with each request.
+	Headers map[string]string
+	// Timeout is the request timeout. Defaults to 10s.
+	Timeout time.Duration
+	// HTTPClient allows providing a custom HTTP client.
+	HTTPClient *http.Client
+}
+
+// RelayClient sends authenticated JSON-RPC requests to a MEV relay.
+type RelayClient struct {
+	rpc    *rpc.HTTPClient
+	signer Signer
+}
+
+// NewRelayClient creates a relay client.
+func NewRelayClient(config RelayClientConfig) (*RelayClient, error) {
+	if config.AuthSigner == nil {
+		return nil, ErrAuthSignerRequired
+	}
+	url := config.URL
+	if url == "" {
+		url = DefaultRelayURL
+	}
+
+	opts := rpc.DefaultHTTPClientOptions()
+	if config.Timeout > 0 {
+		opts.Timeout = config.Timeout
+	}
+	opts.Headers = config.Headers
+	opts.HTTPClient = config.HTTPClient
+
+	c := &RelayClient{signer: config.AuthSigner}
+	opts.OnRequest = c.signRequest
+
+	httpClient, err := rpc.NewHTTPClient(url, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.rpc = httpClient
+	return c, nil
+}
+
+// AuthAddress returns the address of the auth signer.
+func (c *RelayClient) AuthAddress() common.Address {
+	return c.signer.Address()
+}
+
+// URL returns the relay URL.
+func (c *RelayClient) URL() string {
+	return c.rpc.URL()
+}
+
+// Close releases idle connections.
+func (c *RelayClient) Close() error {
+	return c.rpc.Close()
+}
+
+// Request sends an authenticated JSON-RPC request to the relay and decodes
+// the result into result (if non-nil).
+func (c *RelayClient) Request(ctx context.Context, method string, params []any, result any) error {
+	resp, err := c.rpc.Request(ctx, rpc.RPCRequest{Method: method, Params: params})
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", method, err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s failed: %w", method, resp.Error)
+	}
+	if result == nil || len(resp.Result) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		return fmt.Errorf("failed to unmarshal %s result: %w", method, err)
+	}
+	return nil
+}
+
+// signRequest is the rpc.HTTPClient OnRequest hook that sets the
+// X-Flashbots-Signature header to "<address>:<signature>", where the
+// signature is an EIP-191 signature of the hex-encoded keccak256 hash of the
+// request body.
+func (c *RelayClient) signRequest(req *http.Request) error {
+	if req.GetBody == nil {
+		return errors.New("flashbots: request body cannot be re-read for signing")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return fmt.Errorf("flashbots: failed to read request body: %w", err)
+	}
+	defer func() { _ = body.Close() }()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return fmt.Errorf("flashbots: failed to read request body: %w", err)
+	}
+
+	header, err := SignPayload(c.signer, data)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(SignatureHeader, header)
+	return nil
+}
+
+// SignPayload returns the X-Flashbots-Signature header value for a request body.
+func SignPayload(signer Signer, body []byte) (string, error) {
+	digest := crypto.Keccak256Hash(body).Hex()
+	sig, err := signer.SignMessage(signature.NewSignableMessage(digest))
+	if err != nil {
+		return "", fmt.Errorf("flashbots: failed to sign request: %w", err)
+	}
+	return signer.Address().Hex() + ":" + sig, nil
+}
+
+// VerifyPayloadSignature checks an X-Flashbots-Signature header against a
+// request body and returns the signing address.
+func VerifyPayloadSignature(header string, body []byte) (common.Address, error) {
+	addr, sig, ok := strings.Cut(header, ":")
+	if !ok || !common.IsHexAddress(addr) {
+		return common.Address{}, errors.New("flashbots: malformed signature header")
+	}
+	claimed := common.HexToAddress(addr)
+	digest := crypto.Keccak256Hash(body).Hex()
+	recovered, err := signature.RecoverMessageAddress(signature.NewSignableMessage(digest), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("flashbots: invalid signature: %w", err)
+	}
+	if common.HexToAddress(recovered) != claimed {
+		return common.Address{}, fmt.Errorf("flashbots: signature is from %s, not %s", recovered, claimed.Hex())
+	}
+	return claimed, nil
+}
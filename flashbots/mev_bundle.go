@@ -0,0 +1,235 @@
+package flashbots
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// MevShareVersion is the MEV-Share bundle format version.
+const MevShareVersion = "v0.1"
+
+// MEV-Share hints that may be revealed to searchers.
+const (
+	HintCalldata         = "calldata"
+	HintContractAddress  = "contract_address"
+	HintLogs             = "logs"
+	HintFunctionSelector = "function_selector"
+	HintHash             = "hash"
+	HintTxHash           = "tx_hash"
+)
+
+// MevBundle is a MEV-Share bundle (mev_sendBundle / mev_simBundle).
+type MevBundle struct {
+	// Block is the first block the bundle is valid for. Required.
+	Block uint64
+	// MaxBlock is the last block the bundle is valid for. Defaults to Block.
+	MaxBlock uint64
+	// Body is the bundle's content, executed in order. Required.
+	Body []MevBundleItem
+	// Refunds split the bundle's MEV between body transactions.
+	Refunds []MevRefund
+	// RefundConfig splits this bundle's refund between addresses.
+	RefundConfig []MevRefundConfig
+	// Privacy configures which hints are shared and with which builders.
+	Privacy *MevPrivacy
+}
+
+// MevBundleItem is one element of a MEV-Share bundle body: a transaction, the
+// hash of a pending MEV-Share transaction to backrun, or a nested bundle.
+type MevBundleItem struct {
+	Transaction *BundleTransaction
+	Hash        *common.Hash
+	Bundle      *MevBundle
+}
+
+// MevRefund refunds Percent of the bundle's value to the sender of the
+// transaction at BodyIdx.
+type MevRefund struct {
+	BodyIdx int `json:"bodyIdx"`
+	Percent int `json:"percent"`
+}
+
+// MevRefundConfig sends Percent of the refund to Address.
+type MevRefundConfig struct {
+	Address common.Address `json:"address"`
+	Percent int            `json:"percent"`
+}
+
+// MevPrivacy configures MEV-Share hints and builders.
+type MevPrivacy struct {
+	Hints    []string `json:"hints,omitempty"`
+	Builders []string `json:"builders,omitempty"`
+}
+
+func (b MevBundle) encode() (map[string]any, error) {
+	if len(b.Body) == 0 {
+		return nil, fmt.Errorf("flashbots: MEV-Share bundle has an empty body")
+	}
+	maxBlock := b.MaxBlock
+	if maxBlock == 0 {
+		maxBlock = b.Block
+	}
+
+	body := make([]any, len(b.Body))
+	for i, item := range b.Body {
+		switch {
+		case item.Transaction != nil:
+			raw, err := item.Transaction.Serialize()
+			if err != nil {
+				return nil, fmt.Errorf("flashbots: body item %d: %w", i, err)
+			}
+			body[i] = map[string]any{"tx": raw, "canRevert": item.Transaction.CanRevert}
+		case item.Hash != nil:
+			body[i] = map[string]any{"hash": *item.Hash}
+		case item.Bundle != nil:
+			nested, err := item.Bundle.encode()
+			if err != nil {
+				return nil, fmt.Errorf("flashbots: body item %d: %w", i, err)
+			}
+			body[i] = map[string]any{"bundle": nested}
+		default:
+			return nil, fmt.Errorf("flashbots: body item %d is empty", i)
+		}
+	}
+
+	out := map[string]any{
+		"version": MevShareVersion,
+		"inclusion": map[string]any{
+			"block":    hexutil.EncodeUint64(b.Block),
+			"maxBlock": hexutil.EncodeUint64(maxBlock),
+		},
+		"body": body,
+	}
+	if len(b.Refunds) > 0 || len(b.RefundConfig) > 0 {
+		validity := map[string]any{}
+		if len(b.Refunds) > 0 {
+			validity["refund"] = b.Refunds
+		}
+		if len(b.RefundConfig) > 0 {
+			validity["refundConfig"] = b.RefundConfig
+		}
+		out["validity"] = validity
+	}
+	if b.Privacy != nil {
+		out["privacy"] = b.Privacy
+	}
+	return out, nil
+}
+
+// MevSendBundle submits a MEV-Share bundle and returns its hash.
+//
+// Example:
+//
+//	hash, err := relay.MevSendBundle(ctx, flashbots.MevBundle{
+//		Block: head + 1,
+//		Body: []flashbots.MevBundleItem{
+//			{Hash: &pendingHash},
+//			{Transaction: &flashbots.BundleTransaction{Transaction: backrun, Signer: account}},
+//		},
+//	})
+func (c *RelayClient) MevSendBundle(ctx context.Context, bundle MevBundle) (common.Hash, error) {
+	req, err := bundle.encode()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	var result bundleHashResult
+	if err := c.Request(ctx, "mev_sendBundle", []any{req}, &result); err != nil {
+		return common.Hash{}, err
+	}
+	return result.BundleHash, nil
+}
+
+// SimBundleOverrides optionally override the simulated block environment.
+type SimBundleOverrides struct {
+	ParentBlock *uint64
+	BlockNumber *uint64
+	Coinbase    *common.Address
+	Timestamp   *uint64
+	GasLimit    *uint64
+	BaseFee     *big.Int
+	// Timeout is the simulation timeout in seconds.
+	Timeout *uint64
+}
+
+// SimBundleReturnType is the return type for MevSimBundle.
+type SimBundleReturnType struct {
+	Success         bool
+	Error           string
+	StateBlock      uint64
+	MevGasPrice     *big.Int
+	Profit          *big.Int
+	RefundableValue *big.Int
+	GasUsed         uint64
+	Logs            []SimBundleLogs
+}
+
+// SimBundleLogs holds the logs of one body item; nested bundles report
+// their logs in BundleLogs.
+type SimBundleLogs struct {
+	TxLogs     []types.Log     `json:"txLogs,omitempty"`
+	BundleLogs []SimBundleLogs `json:"bundleLogs,omitempty"`
+}
+
+// MevSimBundle simulates a MEV-Share bundle.
+func (c *RelayClient) MevSimBundle(ctx context.Context, bundle MevBundle, overrides *SimBundleOverrides) (*SimBundleReturnType, error) {
+	req, err := bundle.encode()
+	if err != nil {
+		return nil, err
+	}
+	params := []any{req}
+	if overrides != nil {
+		o := map[string]any{}
+		if overrides.ParentBlock != nil {
+			o["parentBlock"] = hexutil.EncodeUint64(*overrides.ParentBlock)
+		}
+		if overrides.BlockNumber != nil {
+			o["blockNumber"] = hexutil.EncodeUint64(*overrides.BlockNumber)
+		}
+		if overrides.Coinbase != nil {
+			o["coinbase"] = *overrides.Coinbase
+		}
+		if overrides.Timestamp != nil {
+			o["timestamp"] = hexutil.EncodeUint64(*overrides.Timestamp)
+		}
+		if overrides.GasLimit != nil {
+			o["gasLimit"] = hexutil.EncodeUint64(*overrides.GasLimit)
+		}
+		if overrides.BaseFee != nil {
+			o["baseFee"] = hexutil.EncodeBig(overrides.BaseFee)
+		}
+		if overrides.Timeout != nil {
+			o["timeout"] = *overrides.Timeout
+		}
+		params = append(params, o)
+	}
+
+	var raw struct {
+		Success         bool            `json:"success"`
+		Error           string          `json:"error"`
+		StateBlock      quantity        `json:"stateBlock"`
+		MevGasPrice     quantity        `json:"mevGasPrice"`
+		Profit          quantity        `json:"profit"`
+		RefundableValue quantity        `json:"refundableValue"`
+		GasUsed         quantity        `json:"gasUsed"`
+		Logs            []SimBundleLogs `json:"logs"`
+	}
+	if err := c.Request(ctx, "mev_simBundle", params, &raw); err != nil {
+		return nil, err
+	}
+	return &SimBundleReturnType{
+		Success:         raw.Success,
+		Error:           raw.Error,
+		StateBlock:      raw.StateBlock.uint64(),
+		MevGasPrice:     raw.MevGasPrice.big(),
+		Profit:          raw.Profit.big(),
+		RefundableValue: raw.RefundableValue.big(),
+		GasUsed:         raw.GasUsed.uint64(),
+		Logs:            raw.Logs,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package flashbots
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SendPrivateTransactionParameters contains the parameters for
+// SendPrivateTransaction (eth_sendPrivateTransaction).
+type SendPrivateTransactionParameters struct {
+	// Transaction is the transaction to send privately. Required.
+	Transaction BundleTransaction
+	// MaxBlockNumber is the last block the relay tries to include the
+	// transaction in. Zero uses the relay default (25 blocks).
+	MaxBlockNumber uint64
+	// Fast shares the transaction with all registered builders.
+	Fast bool
+	// Privacy configures MEV-Share hints and builders.
+	Privacy *MevPrivacy
+}
+
+// SendPrivateTransaction sends a transaction to the relay, which forwards it
+// to builders without exposing it in the public mempool. It returns the
+// transaction hash.
+func (c *RelayClient) SendPrivateTransaction(ctx context.Context, params SendPrivateTransactionParameters) (common.Hash, error) {
+	raw, err := params.Transaction.Serialize()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	req := map[string]any{"tx": raw}
+	if params.MaxBlockNumber > 0 {
+		req["maxBlockNumber"] = hexutil.EncodeUint64(params.MaxBlockNumber)
+	}
+	if params.Fast || params.Privacy != nil {
+		prefs := map[string]any{"fast": params.Fast}
+		if params.Privacy != nil {
+			prefs["privacy"] = params.Privacy
+		}
+		req["preferences"] = prefs
+	}
+
+	var hash common.Hash
+	if err := c.Request(ctx, "eth_sendPrivateTransaction", []any{req}, &hash); err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// CancelPrivateTransaction stops the relay from including a transaction sent
+// with SendPrivateTransaction. It reports whether the cancellation succeeded.
+func (c *RelayClient) CancelPrivateTransaction(ctx context.Context, txHash common.Hash) (bool, error) {
+	var ok bool
+	if err := c.Request(ctx, "eth_cancelPrivateTransaction", []any{map[string]any{"txHash": txHash}}, &ok); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
@@ -0,0 +1,13 @@
+package flashbots_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFlashbots(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Flashbots Suite")
+}
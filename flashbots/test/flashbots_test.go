@@ -0,0 +1,330 @@
+package flashbots_test
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/accounts"
+	"github.com/ChefBingbong/viem-go/flashbots"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+const (
+	authKey   = "0x59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d"
+	senderKey = "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcab78f4c6f2c5ff80"
+)
+
+// stubRelay records authenticated JSON-RPC requests and answers them with
+// canned results.
+type stubRelay struct {
+	mu       sync.Mutex
+	results  map[string]string
+	methods  []string
+	params   []json.RawMessage
+	signers  []common.Address
+	sigError error
+}
+
+func (s *stubRelay) lastParams(out any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	Expect(json.Unmarshal(s.params[len(s.params)-1], out)).To(Succeed())
+}
+
+func (s *stubRelay) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	Expect(err).NotTo(HaveOccurred())
+
+	var req struct {
+		ID     any             `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	Expect(json.Unmarshal(body, &req)).To(Succeed())
+
+	signer, err := flashbots.VerifyPayloadSignature(r.Header.Get(flashbots.SignatureHeader), body)
+
+	s.mu.Lock()
+	s.methods = append(s.methods, req.Method)
+	s.params = append(s.params, req.Params)
+	s.signers = append(s.signers, signer)
+	s.sigError = err
+	result := s.results[req.Method]
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":"invalid flashbots signature"}`))
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      req.ID,
+		"result":  json.RawMessage(result),
+	})
+}
+
+var _ = Describe("RelayClient", func() {
+	var (
+		ctx     context.Context
+		relay   *stubRelay
+		server  *httptest.Server
+		auth    *accounts.PrivateKeyAccount
+		sender  *accounts.PrivateKeyAccount
+		client  *flashbots.RelayClient
+		tx      *transaction.Transaction
+		txEntry flashbots.BundleTransaction
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		relay = &stubRelay{results: map[string]string{
+			"eth_sendBundle": `{"bundleHash":"0x164d7d41f24b7f333af3b4a70b690cf93f636227165ea2b699fbb7eed09c46c7"}`,
+			"eth_callBundle": `{
+				"bundleGasPrice": "476190476193",
+				"bundleHash": "0x73b1e258c7a42fd0230b2fd05529c5d4b6fcb66c227783f8bece8aeacdd1db2e",
+				"coinbaseDiff": "20000000000126000",
+				"ethSentToCoinbase": "20000000000000000",
+				"gasFees": "126000",
+				"results": [{
+					"coinbaseDiff": "10000000000063000",
+					"ethSentToCoinbase": "10000000000000000",
+					"fromAddress": "0x02A727155aeF8609c9f7F2179b2a1f560B39F5A0",
+					"gasFees": "63000",
+					"gasPrice": "476190476193",
+					"gasUsed": 21000,
+					"toAddress": "0x73625f59CAdc5009Cb458B751b3E7b6b48C06f2C",
+					"txHash": "0x669b4704a7d993a946cdd6e2f95233f308ce0c4649d2e04944e8299efcaa098a",
+					"value": "0x"
+				}, {
+					"fromAddress": "0x02A727155aeF8609c9f7F2179b2a1f560B39F5A0",
+					"gasUsed": 30000,
+					"txHash": "0xa839ee83465657cac01adc1d50d96c1b586ed498120a84a64749c0034b4f19fa",
+					"error": "execution reverted",
+					"revert": "0x"
+				}],
+				"stateBlockNumber": 5221585,
+				"totalGasUsed": 51000
+			}`,
+			"eth_sendPrivateTransaction":   `"0x45df1bc3de765927b053ec029fc9d15d6321945b23cac0614eb0b5e61f3a2f2a"`,
+			"eth_cancelPrivateTransaction": `true`,
+			"mev_sendBundle":               `{"bundleHash":"0x8b5a3ac4dd2c9cf6e3e1ee9f18c14ec6d9f6c4eb8b5e1e6b3e1c7ff2bbb6a1c0"}`,
+			"mev_simBundle": `{
+				"success": true,
+				"stateBlock": "0x8b8da8",
+				"mevGasPrice": "0x74c7906005",
+				"profit": "0x4bc800904fc000",
+				"refundableValue": "0x4bc800904fc000",
+				"gasUsed": "0xa620",
+				"logs": [{}, {}]
+			}`,
+		}}
+		server = httptest.NewServer(relay)
+
+		var err error
+		auth, err = accounts.PrivateKeyToAccount(authKey)
+		Expect(err).NotTo(HaveOccurred())
+		sender, err = accounts.PrivateKeyToAccount(senderKey)
+		Expect(err).NotTo(HaveOccurred())
+		client, err = flashbots.NewRelayClient(flashbots.RelayClientConfig{URL: server.URL, AuthSigner: auth})
+		Expect(err).NotTo(HaveOccurred())
+
+		tx = &transaction.Transaction{
+			Type:                 transaction.TransactionTypeEIP1559,
+			ChainId:              1,
+			Nonce:                7,
+			To:                   "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+			Value:                big.NewInt(1),
+			Gas:                  big.NewInt(21000),
+			MaxFeePerGas:         big.NewInt(30_000_000_000),
+			MaxPriorityFeePerGas: big.NewInt(2_000_000_000),
+		}
+		txEntry = flashbots.BundleTransaction{Transaction: tx, Signer: sender}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("requires an auth signer", func() {
+		_, err := flashbots.NewRelayClient(flashbots.RelayClientConfig{URL: server.URL})
+		Expect(err).To(MatchError(flashbots.ErrAuthSignerRequired))
+	})
+
+	It("signs every request with the auth signer", func() {
+		_, err := client.SendBundle(ctx, flashbots.SendBundleParameters{
+			Transactions: []flashbots.BundleTransaction{txEntry},
+			BlockNumber:  100,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(relay.sigError).NotTo(HaveOccurred())
+		Expect(relay.signers).To(Equal([]common.Address{auth.Address()}))
+	})
+
+	It("rejects tampered signatures", func() {
+		header, err := flashbots.SignPayload(auth, []byte(`{"a":1}`))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = flashbots.VerifyPayloadSignature(header, []byte(`{"a":2}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("sends bundles with serialized transactions", func() {
+		signed, err := sender.SignTransaction(tx)
+		Expect(err).NotTo(HaveOccurred())
+		revertHash, err := flashbots.RawTransactionHash(signed)
+		Expect(err).NotTo(HaveOccurred())
+
+		minTs := uint64(1700000000)
+		res, err := client.SendBundle(ctx, flashbots.SendBundleParameters{
+			Transactions: []flashbots.BundleTransaction{
+				{Raw: "0x02f8"},
+				{Transaction: tx, Signer: sender, CanRevert: true},
+			},
+			BlockNumber:     0x10,
+			MinTimestamp:    &minTs,
+			ReplacementUUID: "e5c3c5b6-8f46-4c5b-9d8c-5b0b1f7a1f0e",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BundleHash).To(Equal(common.HexToHash("0x164d7d41f24b7f333af3b4a70b690cf93f636227165ea2b699fbb7eed09c46c7")))
+
+		var params []map[string]any
+		relay.lastParams(&params)
+		Expect(relay.methods).To(Equal([]string{"eth_sendBundle"}))
+		Expect(params[0]["txs"]).To(Equal([]any{"0x02f8", signed}))
+		Expect(params[0]["blockNumber"]).To(Equal("0x10"))
+		Expect(params[0]["minTimestamp"]).To(BeEquivalentTo(minTs))
+		Expect(params[0]["revertingTxHashes"]).To(Equal([]any{revertHash.Hex()}))
+		Expect(params[0]["replacementUuid"]).To(Equal("e5c3c5b6-8f46-4c5b-9d8c-5b0b1f7a1f0e"))
+		Expect(params[0]).NotTo(HaveKey("builders"))
+	})
+
+	It("serializes transactions with a detached signature", func() {
+		sig := &transaction.Signature{
+			R:       "0x60fdd29ff912ce880cd3edaf9f932dc61d3dae823ea77e0323f94adb9f6a72fe",
+			S:       "0x60fdd29ff912ce880cd3edaf9f932dc61d3dae823ea77e0323f94adb9f6a72fe",
+			YParity: 1,
+		}
+		want, err := transaction.SerializeTransaction(tx, sig)
+		Expect(err).NotTo(HaveOccurred())
+		got, err := flashbots.BundleTransaction{Transaction: tx, Signature: sig}.Serialize()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got).To(Equal(want))
+
+		_, err = flashbots.BundleTransaction{Transaction: tx}.Serialize()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("simulates bundles", func() {
+		res, err := client.CallBundle(ctx, flashbots.CallBundleParameters{
+			Transactions: []flashbots.BundleTransaction{txEntry},
+			BlockNumber:  5221586,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.BundleGasPrice.String()).To(Equal("476190476193"))
+		Expect(res.CoinbaseDiff.String()).To(Equal("20000000000126000"))
+		Expect(res.StateBlockNumber).To(Equal(uint64(5221585)))
+		Expect(res.TotalGasUsed).To(Equal(uint64(51000)))
+		Expect(res.Results).To(HaveLen(2))
+		Expect(res.Results[0].GasUsed).To(Equal(uint64(21000)))
+		Expect(res.Results[0].EthSentToCoinbase.String()).To(Equal("10000000000000000"))
+		Expect(res.FirstRevert()).To(Equal(&res.Results[1]))
+
+		var params []map[string]any
+		relay.lastParams(&params)
+		Expect(params[0]["stateBlockNumber"]).To(Equal("latest"))
+		Expect(params[0]["blockNumber"]).To(Equal("0x4facd2"))
+	})
+
+	It("sends and cancels private transactions", func() {
+		hash, err := client.SendPrivateTransaction(ctx, flashbots.SendPrivateTransactionParameters{
+			Transaction:    txEntry,
+			MaxBlockNumber: 200,
+			Fast:           true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hash).To(Equal(common.HexToHash("0x45df1bc3de765927b053ec029fc9d15d6321945b23cac0614eb0b5e61f3a2f2a")))
+
+		var params []map[string]any
+		relay.lastParams(&params)
+		Expect(params[0]["maxBlockNumber"]).To(Equal("0xc8"))
+		Expect(params[0]["preferences"]).To(Equal(map[string]any{"fast": true}))
+
+		ok, err := client.CancelPrivateTransaction(ctx, hash)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		relay.lastParams(&params)
+		Expect(params[0]["txHash"]).To(Equal(hash.Hex()))
+	})
+
+	It("sends and simulates MEV-Share bundles", func() {
+		pending := common.HexToHash("0xfb34b88cd77215867aa8e8ff0abc7060178b8fed6519a85d0b22853dfbe06480")
+		bundle := flashbots.MevBundle{
+			Block: 100,
+			Body: []flashbots.MevBundleItem{
+				{Hash: &pending},
+				{Transaction: &flashbots.BundleTransaction{Transaction: tx, Signer: sender, CanRevert: true}},
+			},
+			Refunds: []flashbots.MevRefund{{BodyIdx: 0, Percent: 90}},
+			Privacy: &flashbots.MevPrivacy{Hints: []string{flashbots.HintTxHash}, Builders: []string{"flashbots"}},
+		}
+
+		hash, err := client.MevSendBundle(ctx, bundle)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hash).To(Equal(common.HexToHash("0x8b5a3ac4dd2c9cf6e3e1ee9f18c14ec6d9f6c4eb8b5e1e6b3e1c7ff2bbb6a1c0")))
+
+		var params []map[string]any
+		relay.lastParams(&params)
+		Expect(params[0]["version"]).To(Equal(flashbots.MevShareVersion))
+		Expect(params[0]["inclusion"]).To(Equal(map[string]any{"block": "0x64", "maxBlock": "0x64"}))
+		body := params[0]["body"].([]any)
+		Expect(body[0]).To(Equal(map[string]any{"hash": pending.Hex()}))
+		Expect(body[1]).To(HaveKeyWithValue("canRevert", true))
+		Expect(params[0]["validity"]).To(Equal(map[string]any{
+			"refund": []any{map[string]any{"bodyIdx": float64(0), "percent": float64(90)}},
+		}))
+		Expect(params[0]["privacy"]).To(HaveKeyWithValue("hints", []any{"tx_hash"}))
+
+		parent := uint64(99)
+		sim, err := client.MevSimBundle(ctx, bundle, &flashbots.SimBundleOverrides{ParentBlock: &parent})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sim.Success).To(BeTrue())
+		Expect(sim.StateBlock).To(Equal(uint64(0x8b8da8)))
+		Expect(sim.GasUsed).To(Equal(uint64(0xa620)))
+		Expect(sim.Profit.Text(16)).To(Equal("4bc800904fc000"))
+		Expect(sim.Logs).To(HaveLen(2))
+
+		var simParams []map[string]any
+		relay.lastParams(&simParams)
+		Expect(simParams).To(HaveLen(2))
+		Expect(simParams[1]).To(Equal(map[string]any{"parentBlock": "0x63"}))
+	})
+
+	It("always signs with the hook and validates bundles", func() {
+		relay.results["eth_sendBundle"] = `null`
+		bad, err := flashbots.NewRelayClient(flashbots.RelayClientConfig{
+			URL:        server.URL,
+			AuthSigner: auth,
+			Headers:    map[string]string{flashbots.SignatureHeader: "garbage"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		// The hook overrides any static signature header.
+		_, err = bad.SendBundle(ctx, flashbots.SendBundleParameters{
+			Transactions: []flashbots.BundleTransaction{txEntry},
+			BlockNumber:  1,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = client.SendBundle(ctx, flashbots.SendBundleParameters{BlockNumber: 1})
+		Expect(err).To(MatchError(ContainSubstring("no transactions")))
+	})
+})
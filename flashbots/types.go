@@ -0,0 +1,125 @@
+package flashbots
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// TransactionSigner is an account that can sign transactions locally.
+// accounts.LocalAccount and its derivatives satisfy it.
+type TransactionSigner interface {
+	SignTransaction(tx *transaction.Transaction) (string, error)
+}
+
+// BundleTransaction is one transaction of a bundle. Exactly one of the
+// following forms is used, in order of precedence:
+//
+//   - Raw: an already signed, serialized transaction.
+//   - Transaction + Signature: serialized with transaction.SerializeTransaction.
+//   - Transaction + Signer: signed (and serialized) by the signer.
+type BundleTransaction struct {
+	Raw         string
+	Transaction *transaction.Transaction
+	Signature   *transaction.Signature
+	Signer      TransactionSigner
+
+	// CanRevert allows the transaction to revert without invalidating the bundle.
+	CanRevert bool
+}
+
+// Serialize returns the signed, serialized transaction.
+func (t BundleTransaction) Serialize() (string, error) {
+	switch {
+	case t.Raw != "":
+		return t.Raw, nil
+	case t.Transaction == nil:
+		return "", fmt.Errorf("flashbots: bundle transaction has neither Raw nor Transaction")
+	case t.Signature != nil:
+		return transaction.SerializeTransaction(t.Transaction, t.Signature)
+	case t.Signer != nil:
+		return t.Signer.SignTransaction(t.Transaction)
+	default:
+		return "", fmt.Errorf("flashbots: bundle transaction needs a Signature or Signer")
+	}
+}
+
+// serializeBundle serializes every transaction of a bundle and returns the
+// raw transactions along with the hashes of those allowed to revert.
+func serializeBundle(txs []BundleTransaction) ([]string, []common.Hash, error) {
+	if len(txs) == 0 {
+		return nil, nil, fmt.Errorf("flashbots: bundle has no transactions")
+	}
+	raws := make([]string, len(txs))
+	var reverting []common.Hash
+	for i, tx := range txs {
+		raw, err := tx.Serialize()
+		if err != nil {
+			return nil, nil, fmt.Errorf("flashbots: transaction %d: %w", i, err)
+		}
+		raws[i] = raw
+		if tx.CanRevert {
+			hash, err := RawTransactionHash(raw)
+			if err != nil {
+				return nil, nil, fmt.Errorf("flashbots: transaction %d: %w", i, err)
+			}
+			reverting = append(reverting, hash)
+		}
+	}
+	return raws, reverting, nil
+}
+
+// RawTransactionHash returns the hash of a signed, serialized transaction.
+func RawTransactionHash(raw string) (common.Hash, error) {
+	data, err := hexutil.Decode(raw)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("invalid raw transaction: %w", err)
+	}
+	return crypto.Keccak256Hash(data), nil
+}
+
+// quantity decodes the numeric formats relays use interchangeably: hex
+// strings, decimal strings and JSON numbers.
+type quantity struct {
+	v *big.Int
+}
+
+func (q *quantity) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" || s == "0x" {
+		return nil
+	}
+	v, ok := new(big.Int), false
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		v, ok = v.SetString(s[2:], 16)
+	} else {
+		v, ok = v.SetString(s, 10)
+	}
+	if !ok {
+		return fmt.Errorf("invalid quantity %s", data)
+	}
+	q.v = v
+	return nil
+}
+
+func (q quantity) big() *big.Int {
+	if q.v == nil {
+		return new(big.Int)
+	}
+	return q.v
+}
+
+func (q quantity) uint64() uint64 {
+	return q.big().Uint64()
+}
+
+// bundleHashResult is the result shape of eth_sendBundle and mev_sendBundle.
+type bundleHashResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
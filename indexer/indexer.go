@@ -0,0 +1,426 @@
+// Package indexer indexes contract events into application state: it backfills
+// historical logs in block ranges, then follows the chain head at a
+// configurable confirmation depth, calling a handler for every log in chain
+// order and checkpointing progress after each block so a restarted indexer
+// resumes where it stopped.
+//
+// Reorgs are detected by comparing the checkpointed block hash with the
+// canonical chain. The indexer then rewinds to the most recent common
+// ancestor, calls Config.OnReorg so the application can discard state derived
+// from the orphaned blocks, and re-indexes from there.
+//
+// Example:
+//
+//	store, _ := indexer.NewFileStore("checkpoints.json")
+//	ix, err := indexer.New(publicClient, indexer.Config{
+//		Name:          "transfers",
+//		Store:         store,
+//		Confirmations: 12,
+//		Sources: []indexer.Source{{
+//			Address:    token,
+//			ABI:        erc20ABI,
+//			EventName:  "Transfer",
+//			StartBlock: deployBlock,
+//			Handler: indexer.On(func(ctx context.Context, log indexer.Log, ev Transfer) error {
+//				return db.AddTransfer(ctx, log.BlockNumber, ev)
+//			}),
+//		}},
+//		OnReorg: func(ctx context.Context, from uint64) error {
+//			return db.DeleteTransfersFrom(ctx, from)
+//		},
+//	})
+//	err = ix.Run(ctx, func(err error) { log.Println(err) })
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/utils/formatters"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
+)
+
+// ErrReorgTooDeep is returned when a reorg goes deeper than the block
+// history kept in the checkpoint (Config.ReorgHistory).
+var ErrReorgTooDeep = errors.New("reorg deeper than the checkpoint history")
+
+// Config configures an Indexer.
+type Config struct {
+	// Name identifies the indexer's checkpoint in the store. Defaults to "default".
+	Name string
+
+	// Sources are the events to index. At least one is required.
+	Sources []Source
+
+	// Store persists checkpoints. Defaults to a MemoryStore.
+	Store Store
+
+	// Confirmations is how many blocks behind the head the indexer stays.
+	// Zero indexes up to the head, relying on reorg handling alone.
+	Confirmations uint64
+
+	// BatchSize is the maximum block range per eth_getLogs request. It is
+	// halved automatically when the node rejects a range as too wide or
+	// returning too many logs, and grows back after successful ranges.
+	// Defaults to 1000.
+	BatchSize uint64
+
+	// PollInterval is how often Run checks for new blocks once caught up.
+	// Defaults to the client's polling interval.
+	PollInterval time.Duration
+
+	// ReorgHistory is how many recently indexed blocks the checkpoint
+	// remembers to find a common ancestor after a reorg. Defaults to 128.
+	ReorgHistory int
+
+	// OnReorg is called after a reorg with the first block that will be
+	// re-indexed. State derived from logs at or after from must be discarded.
+	OnReorg func(ctx context.Context, from uint64) error
+}
+
+// Indexer indexes the logs of a set of sources. It is safe for concurrent
+// use; concurrent Sync calls are serialized.
+type Indexer struct {
+	client public.Client
+	cfg    Config
+
+	sources   map[sourceKey][]*Source
+	addresses []common.Address
+	topics    []common.Hash
+	start     uint64
+
+	mu        sync.Mutex
+	batch     uint64
+	succeeded int // consecutive successful ranges since batch last changed
+}
+
+// growAfter is how many consecutive successful ranges double a shrunk batch.
+const growAfter = 10
+
+// New creates an Indexer. The sources are validated up front.
+func New(client public.Client, cfg Config) (*Indexer, error) {
+	if len(cfg.Sources) == 0 {
+		return nil, errors.New("indexer: at least one source is required")
+	}
+	if cfg.Name == "" {
+		cfg.Name = "default"
+	}
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryStore()
+	}
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = 1000
+	}
+	if p, ok := client.(interface{ PollingInterval() time.Duration }); ok && cfg.PollInterval <= 0 {
+		cfg.PollInterval = p.PollingInterval()
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 4 * time.Second
+	}
+	if cfg.ReorgHistory <= 0 {
+		cfg.ReorgHistory = 128
+	}
+	cfg.Sources = append([]Source(nil), cfg.Sources...)
+
+	ix := &Indexer{
+		client:  client,
+		cfg:     cfg,
+		sources: make(map[sourceKey][]*Source),
+		batch:   cfg.BatchSize,
+	}
+	seenAddr := make(map[common.Address]bool)
+	seenTopic := make(map[common.Hash]bool)
+	for i := range cfg.Sources {
+		src := &cfg.Sources[i]
+		if err := src.validate(); err != nil {
+			return nil, fmt.Errorf("indexer: %w", err)
+		}
+		key := sourceKey{address: src.Address, topic: src.event.Topic}
+		ix.sources[key] = append(ix.sources[key], src)
+		if !seenAddr[src.Address] {
+			seenAddr[src.Address] = true
+			ix.addresses = append(ix.addresses, src.Address)
+		}
+		if !seenTopic[src.event.Topic] {
+			seenTopic[src.event.Topic] = true
+			ix.topics = append(ix.topics, src.event.Topic)
+		}
+		if i == 0 || src.StartBlock < ix.start {
+			ix.start = src.StartBlock
+		}
+	}
+	return ix, nil
+}
+
+// Checkpoint returns the current checkpoint, or nil if nothing was indexed yet.
+func (ix *Indexer) Checkpoint() (*Checkpoint, error) {
+	return ix.cfg.Store.Load(ix.cfg.Name)
+}
+
+// Run calls Sync every Config.PollInterval until ctx is done. Errors from
+// individual passes are passed to onError, which may be nil; the failed range
+// is retried on the next pass.
+func (ix *Indexer) Run(ctx context.Context, onError func(error)) error {
+	ticker := time.NewTicker(ix.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		if _, err := ix.Sync(ctx); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync indexes every block from the checkpoint up to the confirmed head and
+// returns the resulting checkpoint (nil if nothing has been indexed yet). It
+// first checks the checkpoint for a reorg.
+func (ix *Indexer) Sync(ctx context.Context) (*Checkpoint, error) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	cp, err := ix.cfg.Store.Load(ix.cfg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+
+	zero := time.Duration(0)
+	head, err := public.GetBlockNumber(ctx, ix.client, public.GetBlockNumberParameters{CacheTime: &zero})
+	if err != nil {
+		return cp, fmt.Errorf("failed to get block number: %w", err)
+	}
+
+	from := ix.start
+	if cp != nil {
+		if cp, err = ix.checkReorg(ctx, cp); err != nil {
+			return cp, err
+		}
+		from = cp.Block + 1
+	}
+	if head < ix.cfg.Confirmations {
+		return cp, nil
+	}
+	safe := head - ix.cfg.Confirmations
+
+	for from <= safe {
+		if err := ctx.Err(); err != nil {
+			return cp, err
+		}
+		to := min(from+ix.batch-1, safe)
+
+		logs, err := public.GetLogs(ctx, ix.client, public.GetLogsParameters{
+			Address:   ix.addresses,
+			Topics:    []any{ix.topics},
+			FromBlock: &from,
+			ToBlock:   &to,
+		})
+		if err != nil {
+			if ix.batch > 1 && isRangeLimitError(err) {
+				// Most providers cap the range or result size of eth_getLogs;
+				// retry with a smaller range.
+				ix.batch = max(ix.batch/2, 1)
+				ix.succeeded = 0
+				continue
+			}
+			return cp, fmt.Errorf("failed to get logs for blocks %d-%d: %w", from, to, err)
+		}
+
+		if cp, err = ix.handleRange(ctx, cp, logs, to); err != nil {
+			return cp, err
+		}
+		from = to + 1
+
+		if ix.batch < ix.cfg.BatchSize {
+			if ix.succeeded++; ix.succeeded >= growAfter {
+				ix.batch = min(ix.batch*2, ix.cfg.BatchSize)
+				ix.succeeded = 0
+			}
+		}
+	}
+	return cp, nil
+}
+
+// handleRange dispatches the logs of the range ending at to, checkpointing
+// after every block with logs and at the end of the range.
+func (ix *Indexer) handleRange(ctx context.Context, cp *Checkpoint, logs []formatters.Log, to uint64) (*Checkpoint, error) {
+	matched := make([]Log, 0, len(logs))
+	for _, l := range logs {
+		if l.Removed || len(l.Topics) == 0 {
+			continue
+		}
+		key := sourceKey{address: common.HexToAddress(l.Address), topic: common.HexToHash(l.Topics[0])}
+		for _, src := range ix.sources[key] {
+			log := toLog(src, l)
+			if log.BlockNumber >= src.StartBlock {
+				matched = append(matched, log)
+			}
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		if matched[i].BlockNumber != matched[j].BlockNumber {
+			return matched[i].BlockNumber < matched[j].BlockNumber
+		}
+		return matched[i].LogIndex < matched[j].LogIndex
+	})
+
+	for i := 0; i < len(matched); {
+		block := matched[i].BlockNumber
+		hash := matched[i].BlockHash
+		for ; i < len(matched) && matched[i].BlockNumber == block; i++ {
+			log := matched[i]
+			if err := log.Source.Handler(ctx, log); err != nil {
+				return cp, fmt.Errorf("handler for %s at block %d (tx %s, log %d) failed: %w",
+					log.EventName, log.BlockNumber, log.TransactionHash.Hex(), log.LogIndex, err)
+			}
+		}
+		var err error
+		if cp, err = ix.advance(cp, BlockRef{Number: block, Hash: hash}); err != nil {
+			return cp, err
+		}
+	}
+
+	if cp == nil || cp.Block < to {
+		hash, err := ix.blockHash(ctx, to)
+		if err != nil {
+			return cp, err
+		}
+		if cp, err = ix.advance(cp, BlockRef{Number: to, Hash: hash}); err != nil {
+			return cp, err
+		}
+	}
+	return cp, nil
+}
+
+// advance moves the checkpoint to ref and persists it.
+func (ix *Indexer) advance(cp *Checkpoint, ref BlockRef) (*Checkpoint, error) {
+	next := &Checkpoint{Block: ref.Number, Hash: ref.Hash}
+	if cp != nil {
+		next.Recent = append(next.Recent, cp.Recent...)
+	}
+	next.Recent = append(next.Recent, ref)
+	if n := len(next.Recent) - ix.cfg.ReorgHistory; n > 0 {
+		next.Recent = next.Recent[n:]
+	}
+	if err := ix.cfg.Store.Save(ix.cfg.Name, next); err != nil {
+		return cp, fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	return next, nil
+}
+
+// checkReorg verifies that the checkpointed block is still canonical. If it
+// is not, the checkpoint is rewound to the latest remembered block that is,
+// and Config.OnReorg is notified.
+func (ix *Indexer) checkReorg(ctx context.Context, cp *Checkpoint) (*Checkpoint, error) {
+	hash, err := ix.blockHash(ctx, cp.Block)
+	var notFound *public.BlockNotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		// A missing block means the chain got shorter: treat it as a reorg.
+	case err != nil:
+		return cp, err
+	case hash == cp.Hash:
+		return cp, nil
+	}
+
+	var ancestor *BlockRef
+	keep := 0
+	for i := len(cp.Recent) - 1; i >= 0; i-- {
+		ref := cp.Recent[i]
+		if ref.Number >= cp.Block {
+			continue
+		}
+		canonical, err := ix.blockHash(ctx, ref.Number)
+		if err != nil && !errors.As(err, &notFound) {
+			return cp, err
+		}
+		if canonical == ref.Hash {
+			ancestor = &ref
+			keep = i + 1
+			break
+		}
+	}
+	if ancestor == nil {
+		return cp, fmt.Errorf("%w: block %d (%s) is no longer canonical", ErrReorgTooDeep, cp.Block, cp.Hash.Hex())
+	}
+
+	if ix.cfg.OnReorg != nil {
+		if err := ix.cfg.OnReorg(ctx, ancestor.Number+1); err != nil {
+			return cp, fmt.Errorf("reorg handler failed: %w", err)
+		}
+	}
+	rewound := &Checkpoint{
+		Block:  ancestor.Number,
+		Hash:   ancestor.Hash,
+		Recent: append([]BlockRef(nil), cp.Recent[:keep]...),
+	}
+	if err := ix.cfg.Store.Save(ix.cfg.Name, rewound); err != nil {
+		return cp, fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	return rewound, nil
+}
+
+// rangeLimitMessages are fragments of the errors known providers (geth,
+// Infura, Alchemy, QuickNode and others) return when an eth_getLogs range is
+// too wide or matches too many logs.
+var rangeLimitMessages = []string{
+	"query returned more than",
+	"block range",
+	"range too large",
+	"range is too large",
+	"response size exceeded",
+}
+
+// rateLimitMessages are fragments of rate limiting errors, which some
+// providers report with the same -32005 code as result size limits.
+var rateLimitMessages = []string{
+	"rate limit",
+	"request rate",
+	"too many requests",
+}
+
+// limitExceededCode is the EIP-1474 "limit exceeded" error code.
+const limitExceededCode = -32005
+
+// isRangeLimitError reports whether err is the node rejecting an eth_getLogs
+// request for its range or result size. Transport failures, cancellation,
+// rate limiting and unrelated errors that merely mention a range are not.
+func isRangeLimitError(err error) bool {
+	var rpcErr *rpc.RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	msg := strings.ToLower(rpcErr.Message)
+	for _, fragment := range rateLimitMessages {
+		if strings.Contains(msg, fragment) {
+			return false
+		}
+	}
+	if rpcErr.Code == limitExceededCode {
+		return true
+	}
+	for _, fragment := range rangeLimitMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ix *Indexer) blockHash(ctx context.Context, number uint64) (common.Hash, error) {
+	block, err := public.GetBlock(ctx, ix.client, public.GetBlockParameters{BlockNumber: &number})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to get block %d: %w", number, err)
+	}
+	return block.Hash, nil
+}
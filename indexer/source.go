@@ -0,0 +1,152 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/utils/formatters"
+)
+
+// Handler processes one event log. Returning an error stops indexing before
+// the log's block is checkpointed, so the block is retried on the next run.
+//
+// Delivery is at-least-once: checkpoints are per block, so a retried block
+// replays every log in it, including those the handler already processed,
+// and a crash between a handler call and the checkpoint save replays the
+// block too. Handlers must be idempotent, for example by keying writes on
+// the log's block number and log index.
+type Handler func(ctx context.Context, log Log) error
+
+// Source is a contract event to index.
+type Source struct {
+	// Address is the contract emitting the event. Required.
+	Address common.Address
+	// ABI contains the event definition. Required.
+	ABI *abi.ABI
+	// EventName is the event to index. Required.
+	EventName string
+	// StartBlock is the first block to index for this source, usually the
+	// contract's deployment block.
+	StartBlock uint64
+	// Handler is called for every matching log, in chain order. Required.
+	Handler Handler
+
+	event *abi.Event
+}
+
+// Log is an event log matched by a Source.
+type Log struct {
+	// Source is the source that matched the log.
+	Source *Source
+	// EventName is the name of the decoded event.
+	EventName string
+
+	Address          common.Address
+	BlockNumber      uint64
+	BlockHash        common.Hash
+	TransactionHash  common.Hash
+	TransactionIndex uint
+	LogIndex         uint
+	Topics           []common.Hash
+	Data             []byte
+}
+
+// Args decodes the event arguments into a map keyed by parameter name.
+func (l Log) Args() (map[string]any, error) {
+	decoded, err := l.Source.ABI.DecodeEventLogByName(l.EventName, l.Topics, l.Data)
+	if err != nil {
+		return nil, err
+	}
+	return decoded.Args, nil
+}
+
+// Decode decodes the log's event arguments into a value of type T, following
+// the rules of abi.DecodeEventLogInto.
+func Decode[T any](l Log) (T, error) {
+	return abi.DecodeEventLogInto[T](l.Source.ABI, l.EventName, l.Topics, l.Data)
+}
+
+// On adapts a typed handler: each log is decoded into T before fn is called.
+//
+// Example:
+//
+//	type Transfer struct {
+//	    From  common.Address
+//	    To    common.Address
+//	    Value *big.Int
+//	}
+//	src := indexer.Source{
+//	    Address:   token,
+//	    ABI:       erc20ABI,
+//	    EventName: "Transfer",
+//	    Handler: indexer.On(func(ctx context.Context, log indexer.Log, ev Transfer) error {
+//	        return db.AddTransfer(ctx, log.BlockNumber, ev.From, ev.To, ev.Value)
+//	    }),
+//	}
+func On[T any](fn func(ctx context.Context, log Log, event T) error) Handler {
+	return func(ctx context.Context, log Log) error {
+		event, err := Decode[T](log)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s log %s/%d: %w", log.EventName, log.TransactionHash.Hex(), log.LogIndex, err)
+		}
+		return fn(ctx, log, event)
+	}
+}
+
+// validate resolves the source's event.
+func (s *Source) validate() error {
+	if s.ABI == nil {
+		return fmt.Errorf("source %s: ABI is required", s.Address.Hex())
+	}
+	if s.Handler == nil {
+		return fmt.Errorf("source %s/%s: handler is required", s.Address.Hex(), s.EventName)
+	}
+	event, err := s.ABI.GetEvent(s.EventName)
+	if err != nil {
+		return fmt.Errorf("source %s: %w", s.Address.Hex(), err)
+	}
+	if event.Anonymous {
+		return fmt.Errorf("source %s: anonymous event %q cannot be indexed by topic", s.Address.Hex(), s.EventName)
+	}
+	s.event = event
+	return nil
+}
+
+// toLog converts a formatted RPC log.
+func toLog(src *Source, l formatters.Log) Log {
+	out := Log{
+		Source:    src,
+		EventName: src.EventName,
+		Address:   common.HexToAddress(l.Address),
+		Data:      common.FromHex(l.Data),
+		Topics:    make([]common.Hash, len(l.Topics)),
+	}
+	for i, t := range l.Topics {
+		out.Topics[i] = common.HexToHash(t)
+	}
+	if l.BlockNumber != nil {
+		out.BlockNumber = l.BlockNumber.Uint64()
+	}
+	if l.BlockHash != nil {
+		out.BlockHash = common.HexToHash(*l.BlockHash)
+	}
+	if l.TransactionHash != nil {
+		out.TransactionHash = common.HexToHash(*l.TransactionHash)
+	}
+	if l.TransactionIndex != nil {
+		out.TransactionIndex = uint(*l.TransactionIndex)
+	}
+	if l.LogIndex != nil {
+		out.LogIndex = uint(*l.LogIndex)
+	}
+	return out
+}
+
+// sourceKey identifies a source by address and topic.
+type sourceKey struct {
+	address common.Address
+	topic   common.Hash
+}
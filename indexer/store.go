@@ -0,0 +1,144 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	json "github.com/goccy/go-json"
+)
+
+// BlockRef identifies a block by number and hash.
+type BlockRef struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+}
+
+// Checkpoint records indexing progress: every log up to and including Block
+// has been handled.
+type Checkpoint struct {
+	// Block is the last fully indexed block.
+	Block uint64 `json:"block"`
+	// Hash is the hash of Block when it was indexed.
+	Hash common.Hash `json:"hash"`
+	// Recent are recently indexed blocks, oldest first, used to find the
+	// common ancestor after a reorg.
+	Recent []BlockRef `json:"recent,omitempty"`
+}
+
+func (c *Checkpoint) clone() *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	out := *c
+	out.Recent = append([]BlockRef(nil), c.Recent...)
+	return &out
+}
+
+// Store persists checkpoints by indexer name. Implementations must be safe
+// for concurrent use.
+type Store interface {
+	// Load returns the checkpoint for name, or nil if there is none.
+	Load(name string) (*Checkpoint, error)
+	// Save stores the checkpoint for name.
+	Save(name string, checkpoint *Checkpoint) error
+}
+
+// MemoryStore is an in-memory Store. Its contents are lost on restart.
+type MemoryStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]*Checkpoint
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checkpoints: make(map[string]*Checkpoint)}
+}
+
+// Load implements Store.
+func (s *MemoryStore) Load(name string) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[name].clone(), nil
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(name string, checkpoint *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[name] = checkpoint.clone()
+	return nil
+}
+
+// FileStore is a Store backed by a single JSON file holding the checkpoints
+// of every indexer. Every save rewrites the file atomically (write to a
+// temporary file, then rename).
+type FileStore struct {
+	mu          sync.Mutex
+	path        string
+	checkpoints map[string]*Checkpoint
+}
+
+// NewFileStore opens (or creates) a file-backed store at path.
+//
+// Example:
+//
+//	store, err := indexer.NewFileStore(filepath.Join(dataDir, "checkpoints.json"))
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, checkpoints: make(map[string]*Checkpoint)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint store: %w", err)
+	}
+	if len(data) == 0 {
+		return s, nil
+	}
+	if err := json.Unmarshal(data, &s.checkpoints); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpoint store %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Path returns the file the store writes to.
+func (s *FileStore) Path() string {
+	return s.path
+}
+
+// Load implements Store.
+func (s *FileStore) Load(name string) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[name].clone(), nil
+}
+
+// Save implements Store.
+func (s *FileStore) Save(name string, checkpoint *Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[name] = checkpoint.clone()
+
+	data, err := json.MarshalIndent(s.checkpoints, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint store: %w", err)
+	}
+	if dir := filepath.Dir(s.path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create store directory: %w", err)
+		}
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write checkpoint store: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write checkpoint store: %w", err)
+	}
+	return nil
+}
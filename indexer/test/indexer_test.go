@@ -0,0 +1,493 @@
+package indexer_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/indexer"
+)
+
+var (
+	tokenABI = abi.MustParseAbi([]string{
+		"event Transfer(address indexed from, address indexed to, uint256 value)",
+		"event Approval(address indexed owner, address indexed spender, uint256 value)",
+	})
+	token   = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	other   = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	alice   = common.HexToAddress("0x0000000000000000000000000000000000000a11")
+	bob     = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	topicOf = func(name string) common.Hash {
+		e, err := tokenABI.GetEvent(name)
+		Expect(err).NotTo(HaveOccurred())
+		return e.Topic
+	}
+)
+
+type transfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+}
+
+type chainLog struct {
+	address common.Address
+	topics  []common.Hash
+	data    []byte
+}
+
+// fakeChain serves eth_blockNumber, eth_getBlockByNumber and eth_getLogs
+// for a chain whose blocks can be reorged.
+type fakeChain struct {
+	mu       sync.Mutex
+	head     uint64
+	fork     map[uint64]int // block -> fork id, changes the block hash
+	logs     map[uint64][]chainLog
+	maxRange uint64 // eth_getLogs rejects wider ranges when non-zero
+	logsErr  error  // eth_getLogs fails with logsErr when set
+	getLogs  int
+}
+
+// rpcError is returned by fakeChain.handle to answer with a specific
+// JSON-RPC error code instead of -32005.
+type rpcError struct {
+	code    int
+	message string
+}
+
+func (e rpcError) Error() string { return e.message }
+
+func newFakeChain(head uint64) *fakeChain {
+	return &fakeChain{head: head, fork: make(map[uint64]int), logs: make(map[uint64][]chainLog)}
+}
+
+func (c *fakeChain) hash(n uint64) common.Hash {
+	return crypto.Keccak256Hash([]byte(fmt.Sprintf("block-%d-%d", n, c.fork[n])))
+}
+
+func (c *fakeChain) addTransfer(block uint64, addr common.Address, from, to common.Address, value int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logs[block] = append(c.logs[block], chainLog{
+		address: addr,
+		topics:  []common.Hash{topicOf("Transfer"), common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		data:    common.BigToHash(big.NewInt(value)).Bytes(),
+	})
+}
+
+// reorg replaces every block from `from` up to the head with a new fork
+// that has no logs.
+func (c *fakeChain) reorg(from uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for n := from; n <= c.head; n++ {
+		c.fork[n]++
+		delete(c.logs, n)
+	}
+}
+
+func (c *fakeChain) setHead(n uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.head = n
+}
+
+func (c *fakeChain) handle(method string, params []any) (any, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch method {
+	case "eth_blockNumber":
+		return hexutil.EncodeUint64(c.head), nil
+	case "eth_getBlockByNumber":
+		n, err := hexutil.DecodeUint64(params[0].(string))
+		Expect(err).NotTo(HaveOccurred())
+		if n > c.head {
+			return nil, nil
+		}
+		return map[string]any{
+			"number":       hexutil.EncodeUint64(n),
+			"hash":         c.hash(n).Hex(),
+			"parentHash":   c.hash(n - 1).Hex(),
+			"timestamp":    hexutil.EncodeUint64(n * 12),
+			"gasLimit":     "0x1c9c380",
+			"gasUsed":      "0x0",
+			"transactions": []string{},
+		}, nil
+	case "eth_getLogs":
+		c.getLogs++
+		filter := params[0].(map[string]any)
+		from, _ := hexutil.DecodeUint64(filter["fromBlock"].(string))
+		to, _ := hexutil.DecodeUint64(filter["toBlock"].(string))
+		if c.logsErr != nil {
+			return nil, c.logsErr
+		}
+		if c.maxRange > 0 && to-from+1 > c.maxRange {
+			return nil, rpcError{code: -32602, message: "block range too large"}
+		}
+		addresses := map[common.Address]bool{}
+		for _, a := range filter["address"].([]any) {
+			addresses[common.HexToAddress(a.(string))] = true
+		}
+		topics := map[common.Hash]bool{}
+		for _, t := range filter["topics"].([]any)[0].([]any) {
+			topics[common.HexToHash(t.(string))] = true
+		}
+
+		var out []map[string]any
+		for n := from; n <= to && n <= c.head; n++ {
+			for i, l := range c.logs[n] {
+				if !addresses[l.address] || !topics[l.topics[0]] {
+					continue
+				}
+				ts := make([]string, len(l.topics))
+				for j, t := range l.topics {
+					ts[j] = t.Hex()
+				}
+				out = append(out, map[string]any{
+					"address":          l.address.Hex(),
+					"topics":           ts,
+					"data":             hexutil.Encode(l.data),
+					"blockNumber":      hexutil.EncodeUint64(n),
+					"blockHash":        c.hash(n).Hex(),
+					"transactionHash":  crypto.Keccak256Hash([]byte(fmt.Sprintf("tx-%d-%d", n, i))).Hex(),
+					"transactionIndex": hexutil.EncodeUint64(uint64(i)),
+					"logIndex":         hexutil.EncodeUint64(uint64(i)),
+					"removed":          false,
+				})
+			}
+		}
+		if out == nil {
+			out = []map[string]any{}
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unexpected method %s", method)
+}
+
+func (c *fakeChain) serve() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		if result, err := c.handle(req.Method, req.Params); err != nil {
+			code := -32005
+			var rpcErr rpcError
+			if errors.As(err, &rpcErr) {
+				code = rpcErr.code
+			}
+			resp["error"] = map[string]any{"code": code, "message": err.Error()}
+		} else {
+			resp["result"] = result
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+var _ = Describe("Indexer", func() {
+	var (
+		ctx       context.Context
+		chain     *fakeChain
+		server    *httptest.Server
+		rpc       *client.PublicClient
+		transfers []transfer
+		blocks    []uint64
+		handler   indexer.Handler
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		chain = newFakeChain(20)
+		server = chain.serve()
+
+		var err error
+		rpc, err = client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(server.URL)})
+		Expect(err).NotTo(HaveOccurred())
+
+		transfers, blocks = nil, nil
+		handler = indexer.On(func(ctx context.Context, log indexer.Log, ev transfer) error {
+			transfers = append(transfers, ev)
+			blocks = append(blocks, log.BlockNumber)
+			return nil
+		})
+
+		chain.addTransfer(3, token, alice, bob, 1)
+		chain.addTransfer(3, token, bob, alice, 2)
+		chain.addTransfer(7, other, alice, bob, 999) // other contract
+		chain.addTransfer(9, token, alice, bob, 3)
+		chain.addTransfer(15, token, alice, bob, 4)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newIndexer := func(cfg indexer.Config) *indexer.Indexer {
+		if cfg.Sources == nil {
+			cfg.Sources = []indexer.Source{{Address: token, ABI: tokenABI, EventName: "Transfer", Handler: handler}}
+		}
+		ix, err := indexer.New(rpc, cfg)
+		Expect(err).NotTo(HaveOccurred())
+		return ix
+	}
+
+	values := func() []int64 {
+		var out []int64
+		for _, t := range transfers {
+			out = append(out, t.Value.Int64())
+		}
+		return out
+	}
+
+	It("backfills in batches, decoding typed events in chain order", func() {
+		ix := newIndexer(indexer.Config{BatchSize: 4})
+
+		cp, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Block).To(Equal(uint64(20)))
+		Expect(cp.Hash).To(Equal(chain.hash(20)))
+		Expect(values()).To(Equal([]int64{1, 2, 3, 4}))
+		Expect(blocks).To(Equal([]uint64{3, 3, 9, 15}))
+		Expect(transfers[0]).To(Equal(transfer{From: alice, To: bob, Value: big.NewInt(1)}))
+		Expect(chain.getLogs).To(Equal(6)) // blocks 0-20 in ranges of 4
+	})
+
+	It("stays behind the head by the confirmation depth and tails new blocks", func() {
+		ix := newIndexer(indexer.Config{Confirmations: 6})
+
+		cp, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Block).To(Equal(uint64(14)))
+		Expect(values()).To(Equal([]int64{1, 2, 3}))
+
+		chain.setHead(21)
+		cp, err = ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Block).To(Equal(uint64(15)))
+		Expect(values()).To(Equal([]int64{1, 2, 3, 4}))
+	})
+
+	It("respects per-source start blocks", func() {
+		ix := newIndexer(indexer.Config{Sources: []indexer.Source{
+			{Address: token, ABI: tokenABI, EventName: "Transfer", StartBlock: 5, Handler: handler},
+		}})
+		_, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values()).To(Equal([]int64{3, 4}))
+	})
+
+	It("resumes from a file-backed checkpoint without replaying events", func() {
+		dir, err := os.MkdirTemp("", "indexer-test")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "checkpoints.json")
+
+		store, err := indexer.NewFileStore(path)
+		Expect(err).NotTo(HaveOccurred())
+		chain.setHead(10)
+		_, err = newIndexer(indexer.Config{Name: "transfers", Store: store}).Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values()).To(Equal([]int64{1, 2, 3}))
+
+		reopened, err := indexer.NewFileStore(path)
+		Expect(err).NotTo(HaveOccurred())
+		cp, err := reopened.Load("transfers")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Block).To(Equal(uint64(10)))
+
+		chain.setHead(20)
+		_, err = newIndexer(indexer.Config{Name: "transfers", Store: reopened}).Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values()).To(Equal([]int64{1, 2, 3, 4}))
+	})
+
+	It("retries a block whose handler failed", func() {
+		failAt := uint64(9)
+		ix := newIndexer(indexer.Config{Sources: []indexer.Source{{
+			Address: token, ABI: tokenABI, EventName: "Transfer",
+			Handler: func(ctx context.Context, log indexer.Log) error {
+				if log.BlockNumber == failAt {
+					return errors.New("database unavailable")
+				}
+				return handler(ctx, log)
+			},
+		}}})
+
+		cp, err := ix.Sync(ctx)
+		Expect(err).To(MatchError(ContainSubstring("database unavailable")))
+		Expect(cp.Block).To(Equal(uint64(3)))
+
+		failAt = 0
+		cp, err = ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Block).To(Equal(uint64(20)))
+		Expect(values()).To(Equal([]int64{1, 2, 3, 4}))
+	})
+
+	It("replays every log of a block whose handler failed midway", func() {
+		failed := false
+		ix := newIndexer(indexer.Config{Sources: []indexer.Source{{
+			Address: token, ABI: tokenABI, EventName: "Transfer",
+			Handler: func(ctx context.Context, log indexer.Log) error {
+				if log.BlockNumber == 3 && log.LogIndex == 1 && !failed {
+					failed = true
+					return errors.New("database unavailable")
+				}
+				return handler(ctx, log)
+			},
+		}}})
+
+		_, err := ix.Sync(ctx)
+		Expect(err).To(MatchError(ContainSubstring("database unavailable")))
+		Expect(values()).To(Equal([]int64{1}))
+
+		// Delivery is at-least-once: the first log of block 3 is seen again.
+		_, err = ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values()).To(Equal([]int64{1, 1, 2, 3, 4}))
+	})
+
+	It("rewinds to the common ancestor after a reorg", func() {
+		var reorgFrom []uint64
+		ix := newIndexer(indexer.Config{
+			BatchSize: 5,
+			OnReorg: func(ctx context.Context, from uint64) error {
+				reorgFrom = append(reorgFrom, from)
+				return nil
+			},
+		})
+		_, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Blocks 12..20 are replaced; the new fork moves transfer 4 to block 13.
+		chain.reorg(12)
+		chain.addTransfer(13, token, bob, alice, 40)
+
+		cp, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reorgFrom).To(Equal([]uint64{10}))
+		Expect(cp.Hash).To(Equal(chain.hash(20)))
+		Expect(values()).To(Equal([]int64{1, 2, 3, 4, 40}))
+		Expect(blocks[len(blocks)-1]).To(Equal(uint64(13)))
+	})
+
+	It("fails when the reorg is deeper than the remembered history", func() {
+		ix := newIndexer(indexer.Config{ReorgHistory: 1})
+		_, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		chain.reorg(0)
+		_, err = ix.Sync(ctx)
+		Expect(err).To(MatchError(indexer.ErrReorgTooDeep))
+	})
+
+	It("shrinks the block range when the node rejects it", func() {
+		chain.maxRange = 3
+		ix := newIndexer(indexer.Config{BatchSize: 16})
+		cp, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Block).To(Equal(uint64(20)))
+		Expect(values()).To(Equal([]int64{1, 2, 3, 4}))
+	})
+
+	It("grows the block range back after successful ranges", func() {
+		chain.maxRange = 3
+		ix := newIndexer(indexer.Config{BatchSize: 16})
+		_, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		// 180 new blocks take 90 requests at the shrunk range of 2.
+		chain.maxRange = 0
+		chain.setHead(200)
+		chain.getLogs = 0
+		cp, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Block).To(Equal(uint64(200)))
+		Expect(chain.getLogs).To(BeNumerically("<", 40))
+	})
+
+	It("returns other eth_getLogs errors without shrinking the block range", func() {
+		chain.logsErr = rpcError{code: -32000, message: "upstream unavailable"}
+		ix := newIndexer(indexer.Config{BatchSize: 4})
+		_, err := ix.Sync(ctx)
+		Expect(err).To(MatchError(ContainSubstring("upstream unavailable")))
+
+		chain.logsErr = nil
+		chain.getLogs = 0
+		cp, err := ix.Sync(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cp.Block).To(Equal(uint64(20)))
+		Expect(chain.getLogs).To(Equal(6)) // blocks 0-20 in ranges of 4
+	})
+
+	It("only shrinks the block range for range limit errors", func() {
+		// Disable transport retries, which would otherwise repeat -32005.
+		noRetry, err := client.CreatePublicClient(client.PublicClientConfig{
+			Transport: transport.HTTP(server.URL, transport.HTTPTransportConfig{Timeout: time.Second}),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		cases := []struct {
+			code    int
+			message string
+			shrinks bool
+		}{
+			{-32000, "query returned more than 10000 results", true},
+			{-32000, "eth_getLogs block range is too wide", true},
+			{-32602, "range too large, max 1000", true},
+			{-32000, "Log response size exceeded.", true},
+			{-32005, "limit exceeded", true},
+			{-32000, "rate limit exceeded", false},
+			{-32005, "project ID request rate exceeded", false},
+			{-32000, "execution reverted: out of range", false},
+			{-32000, "gas limit reached", false},
+		}
+		for _, tc := range cases {
+			ix, err := indexer.New(noRetry, indexer.Config{
+				BatchSize: 4,
+				Sources:   []indexer.Source{{Address: token, ABI: tokenABI, EventName: "Transfer", Handler: handler}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			chain.logsErr = rpcError{code: tc.code, message: tc.message}
+			chain.getLogs = 0
+			_, err = ix.Sync(ctx)
+			Expect(err).To(MatchError(ContainSubstring(tc.message)))
+			if tc.shrinks {
+				Expect(chain.getLogs).To(Equal(3), tc.message) // ranges of 4, 2 and 1
+			} else {
+				Expect(chain.getLogs).To(Equal(1), tc.message)
+			}
+		}
+	})
+
+	It("validates sources", func() {
+		_, err := indexer.New(rpc, indexer.Config{Sources: []indexer.Source{
+			{Address: token, ABI: tokenABI, EventName: "Mint", Handler: handler},
+		}})
+		Expect(err).To(HaveOccurred())
+		_, err = indexer.New(rpc, indexer.Config{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,52 @@
+// Package contractread reads contract state through eth_call for the chain
+// extension packages, e.g. opstack and arbitrum.
+package contractread
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/public"
+)
+
+// Parameters contains the parameters for Read.
+type Parameters struct {
+	Address      common.Address
+	ABI          *abi.ABI
+	FunctionName string
+	Args         []any
+	// Account and Value are the optional call context, e.g. for
+	// estimates that depend on them.
+	Account     *common.Address
+	Value       *big.Int
+	BlockNumber *uint64
+	BlockTag    public.BlockTag
+}
+
+// Read calls a function through eth_call and decodes its result into out,
+// which must be a pointer.
+func Read(ctx context.Context, client public.Client, params Parameters, out any) error {
+	data, err := params.ABI.EncodeFunctionData(params.FunctionName, params.Args...)
+	if err != nil {
+		return fmt.Errorf("failed to encode call for %q: %w", params.FunctionName, err)
+	}
+	result, err := public.Call(ctx, client, public.CallParameters{
+		Account:     params.Account,
+		To:          &params.Address,
+		Data:        data,
+		Value:       params.Value,
+		BlockNumber: params.BlockNumber,
+		BlockTag:    params.BlockTag,
+	})
+	if err != nil {
+		return fmt.Errorf("contract read failed for %q on %s: %w", params.FunctionName, params.Address.Hex(), err)
+	}
+	if err := params.ABI.DecodeFunctionResultInto(params.FunctionName, result.Data, out); err != nil {
+		return fmt.Errorf("failed to decode result of %q: %w", params.FunctionName, err)
+	}
+	return nil
+}
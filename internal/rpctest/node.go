@@ -0,0 +1,214 @@
+// Package rpctest provides an in-process JSON-RPC node for tests: it serves
+// chain ID and fee defaults, answers eth_call from stubbed contract ABIs,
+// records raw transactions and lets tests register handlers for any other
+// method.
+package rpctest
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/abi"
+)
+
+// ErrExecutionReverted is returned for eth_call requests no stub answers.
+var ErrExecutionReverted = errors.New("execution reverted")
+
+// Handler answers a JSON-RPC method. Returning an *Error sets the error
+// code of the response; other errors are reported with code -32000.
+type Handler func(params []json.RawMessage) (any, error)
+
+// Error is a JSON-RPC error returned by a Handler.
+type Error struct {
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// contractStub answers eth_call for the functions of an ABI.
+type contractStub struct {
+	abi *abi.ABI
+	fns map[string]func(args []any) []any
+}
+
+// Node is a JSON-RPC node serving stubbed contract calls and registered
+// handlers, and recording requests and raw transactions.
+type Node struct {
+	*httptest.Server
+	mu        sync.Mutex
+	contracts map[common.Address][]contractStub
+	handlers  map[string]Handler
+	requests  map[string][]json.RawMessage
+	raw       []string
+}
+
+// NewNode starts a node for chainID. Besides eth_call and
+// eth_sendRawTransaction it answers eth_chainId, eth_getTransactionCount
+// (0), eth_estimateGas (21000) and eth_maxPriorityFeePerGas and
+// eth_gasPrice (1 wei); Handle overrides any of them.
+func NewNode(chainID uint64) *Node {
+	n := &Node{
+		contracts: map[common.Address][]contractStub{},
+		handlers:  map[string]Handler{},
+		requests:  map[string][]json.RawMessage{},
+	}
+	n.Result("eth_chainId", hexutil.EncodeUint64(chainID))
+	n.Result("eth_getTransactionCount", "0x0")
+	n.Result("eth_estimateGas", "0x5208")
+	n.Result("eth_maxPriorityFeePerGas", "0x1")
+	n.Result("eth_gasPrice", "0x1")
+	n.Server = httptest.NewServer(http.HandlerFunc(n.serve))
+	return n
+}
+
+// Handle registers the handler of a method, replacing any previous one.
+func (n *Node) Handle(method string, h Handler) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.handlers[method] = h
+}
+
+// Result makes a method return result.
+func (n *Node) Result(method string, result any) {
+	n.Handle(method, func([]json.RawMessage) (any, error) { return result, nil })
+}
+
+// Stub answers eth_call to addr for the functions of a, encoding the values
+// returned by fns[name] as the result. Several ABIs can be stubbed on one
+// address; an unanswered call reverts.
+func (n *Node) Stub(addr common.Address, a *abi.ABI, fns map[string]func(args []any) []any) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.contracts[addr] = append(n.contracts[addr], contractStub{abi: a, fns: fns})
+}
+
+// Params returns the params of the last request for method.
+func (n *Node) Params(method string) []json.RawMessage {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.requests[method]
+}
+
+// RawTxs returns the raw transactions sent with eth_sendRawTransaction, in
+// order.
+func (n *Node) RawTxs() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.raw...)
+}
+
+// SentTxs decodes the raw transactions sent with eth_sendRawTransaction. It
+// panics on transactions go-ethereum cannot decode; use RawTxs for those.
+func (n *Node) SentTxs() []*gethtypes.Transaction {
+	var txs []*gethtypes.Transaction
+	for _, raw := range n.RawTxs() {
+		tx := new(gethtypes.Transaction)
+		if err := tx.UnmarshalBinary(common.FromHex(raw)); err != nil {
+			panic("rpctest: " + err.Error())
+		}
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+// Returns returns a stub function returning values.
+func Returns(values ...any) func([]any) []any {
+	return func([]any) []any { return values }
+}
+
+func (n *Node) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     any               `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	result, err := n.handle(req.Method, req.Params)
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	if err != nil {
+		code := -32000
+		var rpcErr *Error
+		if errors.As(err, &rpcErr) {
+			code = rpcErr.Code
+		}
+		resp["error"] = map[string]any{"code": code, "message": err.Error()}
+	} else {
+		resp["result"] = result
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// handle answers a request. Handlers and stubs run without the lock held so
+// they can use the node.
+func (n *Node) handle(method string, params []json.RawMessage) (any, error) {
+	n.mu.Lock()
+	n.requests[method] = params
+	h, ok := n.handlers[method]
+	n.mu.Unlock()
+	if ok {
+		return h(params)
+	}
+
+	switch method {
+	case "eth_sendRawTransaction":
+		var raw string
+		if err := json.Unmarshal(params[0], &raw); err != nil {
+			return nil, err
+		}
+		n.mu.Lock()
+		n.raw = append(n.raw, raw)
+		n.mu.Unlock()
+		return crypto.Keccak256Hash(common.FromHex(raw)).Hex(), nil
+	case "eth_call":
+		return n.call(params)
+	}
+	return nil, &Error{Code: -32601, Message: "method not supported: " + method}
+}
+
+// call answers eth_call from the stubs of the called address.
+func (n *Node) call(params []json.RawMessage) (any, error) {
+	var call struct {
+		To    common.Address `json:"to"`
+		Data  hexutil.Bytes  `json:"data"`
+		Input hexutil.Bytes  `json:"input"`
+	}
+	if err := json.Unmarshal(params[0], &call); err != nil {
+		return nil, err
+	}
+	data := call.Data
+	if len(data) == 0 {
+		data = call.Input
+	}
+	n.mu.Lock()
+	stubs := n.contracts[call.To]
+	n.mu.Unlock()
+	for _, stub := range stubs {
+		decoded, err := stub.abi.DecodeFunctionData(data)
+		if err != nil {
+			continue
+		}
+		fn, ok := stub.fns[decoded.FunctionName]
+		if !ok {
+			continue
+		}
+		out, err := stub.abi.EncodeFunctionResult(decoded.FunctionName, fn(decoded.Args)...)
+		if err != nil {
+			return nil, err
+		}
+		return hexutil.Encode(out), nil
+	}
+	return nil, ErrExecutionReverted
+}
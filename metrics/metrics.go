@@ -0,0 +1,107 @@
+// Package metrics provides opt-in instrumentation for clients and transports.
+//
+// Transports report every JSON-RPC request (latency, error class, retries),
+// batch flushes, active subscriptions and the ranking of fallback members to
+// a Collector; multicall reports how
+// many calls and chunks it executed. Registry is a dependency-free Collector
+// that exposes the recorded values in the Prometheus text exposition format,
+// so applications can scrape it without pulling in the Prometheus client.
+// Applications that already use a metrics library can implement Collector
+// themselves instead.
+//
+// Example:
+//
+//	reg := metrics.NewRegistry()
+//	client, err := client.CreatePublicClient(client.PublicClientConfig{
+//		Transport: transport.HTTP("https://eth.merkle.io"),
+//		Metrics:   reg,
+//	})
+//	http.Handle("/metrics", reg.Handler())
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ChefBingbong/viem-go/utils/rpc"
+)
+
+// Collector receives measurements from transports and actions.
+// Implementations must be safe for concurrent use.
+type Collector interface {
+	// ObserveRequest records a finished JSON-RPC request, after retries.
+	// err is nil on success.
+	ObserveRequest(transport, method string, duration time.Duration, err error)
+	// ObserveRetry records a failed attempt that is about to be retried.
+	ObserveRetry(transport, method string, err error)
+	// ObserveBatch records a JSON-RPC batch sent with size requests.
+	ObserveBatch(transport string, size int)
+	// ObserveMulticall records a multicall of calls split into chunks
+	// aggregate3 requests.
+	ObserveMulticall(calls, chunks int)
+	// AddSubscriptions adjusts the number of active subscriptions of the given
+	// kind (e.g. "newHeads", "logs") by delta.
+	AddSubscriptions(transport, kind string, delta int)
+	// ObserveFallback records the ranking state of one member of a fallback
+	// transport: its average latency, its stability (the share of successful
+	// requests, 0-1) and whether it is currently tried first. url identifies
+	// the member with credentials already redacted.
+	ObserveFallback(transport, url string, latency time.Duration, stability float64, selected bool)
+}
+
+// Error classes returned by ErrorClass.
+const (
+	ErrorClassTimeout     = "timeout"
+	ErrorClassCanceled    = "canceled"
+	ErrorClassRateLimited = "rate_limited"
+	ErrorClassRPC         = "rpc"
+	ErrorClassHTTP4xx     = "http_4xx"
+	ErrorClassHTTP5xx     = "http_5xx"
+	ErrorClassNetwork     = "network"
+	ErrorClassOther       = "other"
+)
+
+// ErrorClass maps a request error to a small, fixed set of classes suitable
+// for use as a metric label. It returns "" for a nil error.
+func ErrorClass(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var timeoutErr *rpc.TimeoutError
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, rpc.ErrTimeout), errors.As(err, &timeoutErr):
+		return ErrorClassTimeout
+	}
+
+	var rpcErr *rpc.RPCError
+	if errors.As(err, &rpcErr) {
+		if rpcErr.Code == rpc.RPCErrorCodeLimitExceeded {
+			return ErrorClassRateLimited
+		}
+		return ErrorClassRPC
+	}
+
+	var httpErr *rpc.HTTPRequestError
+	if errors.As(err, &httpErr) {
+		switch {
+		case httpErr.Status == 429:
+			return ErrorClassRateLimited
+		case httpErr.Status >= 500:
+			return ErrorClassHTTP5xx
+		case httpErr.Status >= 400:
+			return ErrorClassHTTP4xx
+		}
+		return ErrorClassNetwork
+	}
+
+	var wsErr *rpc.WebSocketRequestError
+	if errors.As(err, &wsErr) || errors.Is(err, rpc.ErrSocketClosed) {
+		return ErrorClassNetwork
+	}
+
+	return ErrorClassOther
+}
@@ -0,0 +1,310 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ContentType is the content type of the text exposition format written by
+// Registry.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Default histogram buckets.
+var (
+	// DefaultLatencyBuckets are the request latency buckets, in seconds.
+	DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	// DefaultBatchSizeBuckets are the JSON-RPC batch size buckets.
+	DefaultBatchSizeBuckets = []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000}
+)
+
+// RegistryConfig contains configuration for a Registry.
+type RegistryConfig struct {
+	// Namespace prefixes every metric name (default: "viem").
+	Namespace string
+	// LatencyBuckets are the upper bounds, in seconds, of the request latency
+	// histogram (default: DefaultLatencyBuckets).
+	LatencyBuckets []float64
+	// BatchSizeBuckets are the upper bounds of the batch size histogram
+	// (default: DefaultBatchSizeBuckets).
+	BatchSizeBuckets []float64
+}
+
+// Registry is an in-memory Collector that renders its metrics in the
+// Prometheus text exposition format. It exposes:
+//
+//	<ns>_rpc_requests_total{transport,method}                 counter
+//	<ns>_rpc_errors_total{transport,method,class}             counter
+//	<ns>_rpc_retries_total{transport,method}                  counter
+//	<ns>_rpc_request_duration_seconds{transport,method}       histogram
+//	<ns>_rpc_batch_size{transport}                            histogram
+//	<ns>_multicall_requests_total                             counter
+//	<ns>_multicall_calls_total                                counter
+//	<ns>_multicall_chunks_total                               counter
+//	<ns>_subscriptions_active{transport,type}                 gauge
+//	<ns>_fallback_latency_seconds{transport,url}              gauge
+//	<ns>_fallback_stability{transport,url}                    gauge
+//	<ns>_fallback_selected{transport,url}                     gauge
+type Registry struct {
+	requests     *vec
+	errors       *vec
+	retries      *vec
+	latency      *vec
+	batchSize    *vec
+	multicalls   *vec
+	calls        *vec
+	chunks       *vec
+	subscription *vec
+	fbLatency    *vec
+	fbStability  *vec
+	fbSelected   *vec
+	families     []*vec
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry(config ...RegistryConfig) *Registry {
+	cfg := RegistryConfig{}
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	if cfg.Namespace == "" {
+		cfg.Namespace = "viem"
+	}
+	if len(cfg.LatencyBuckets) == 0 {
+		cfg.LatencyBuckets = DefaultLatencyBuckets
+	}
+	if len(cfg.BatchSizeBuckets) == 0 {
+		cfg.BatchSizeBuckets = DefaultBatchSizeBuckets
+	}
+	name := func(s string) string { return cfg.Namespace + "_" + s }
+
+	r := &Registry{
+		requests:     newVec(name("rpc_requests_total"), "counter", "JSON-RPC requests sent, by transport and method.", nil, "transport", "method"),
+		errors:       newVec(name("rpc_errors_total"), "counter", "JSON-RPC requests that failed, by error class.", nil, "transport", "method", "class"),
+		retries:      newVec(name("rpc_retries_total"), "counter", "JSON-RPC request attempts that were retried.", nil, "transport", "method"),
+		latency:      newVec(name("rpc_request_duration_seconds"), "histogram", "JSON-RPC request latency in seconds, including retries.", sortedBuckets(cfg.LatencyBuckets), "transport", "method"),
+		batchSize:    newVec(name("rpc_batch_size"), "histogram", "Number of requests per JSON-RPC batch.", sortedBuckets(cfg.BatchSizeBuckets), "transport"),
+		multicalls:   newVec(name("multicall_requests_total"), "counter", "Multicalls executed.", nil),
+		calls:        newVec(name("multicall_calls_total"), "counter", "Contract calls executed through multicall.", nil),
+		chunks:       newVec(name("multicall_chunks_total"), "counter", "aggregate3 chunks executed by multicall.", nil),
+		subscription: newVec(name("subscriptions_active"), "gauge", "Active subscriptions, by transport and type.", nil, "transport", "type"),
+		fbLatency:    newVec(name("fallback_latency_seconds"), "gauge", "Average latency of a fallback transport member in seconds.", nil, "transport", "url"),
+		fbStability:  newVec(name("fallback_stability"), "gauge", "Share of successful requests of a fallback transport member.", nil, "transport", "url"),
+		fbSelected:   newVec(name("fallback_selected"), "gauge", "1 for the fallback transport member currently tried first, 0 otherwise.", nil, "transport", "url"),
+	}
+	r.families = []*vec{r.requests, r.errors, r.retries, r.latency, r.batchSize, r.multicalls, r.calls, r.chunks, r.subscription, r.fbLatency, r.fbStability, r.fbSelected}
+	return r
+}
+
+// ObserveRequest implements Collector.
+func (r *Registry) ObserveRequest(transport, method string, duration time.Duration, err error) {
+	r.requests.add(1, transport, method)
+	r.latency.observe(duration.Seconds(), transport, method)
+	if err != nil {
+		r.errors.add(1, transport, method, ErrorClass(err))
+	}
+}
+
+// ObserveRetry implements Collector.
+func (r *Registry) ObserveRetry(transport, method string, err error) {
+	r.retries.add(1, transport, method)
+}
+
+// ObserveBatch implements Collector.
+func (r *Registry) ObserveBatch(transport string, size int) {
+	r.batchSize.observe(float64(size), transport)
+}
+
+// ObserveMulticall implements Collector.
+func (r *Registry) ObserveMulticall(calls, chunks int) {
+	r.multicalls.add(1)
+	r.calls.add(float64(calls))
+	r.chunks.add(float64(chunks))
+}
+
+// AddSubscriptions implements Collector.
+func (r *Registry) AddSubscriptions(transport, kind string, delta int) {
+	r.subscription.add(float64(delta), transport, kind)
+}
+
+// ObserveFallback implements Collector.
+func (r *Registry) ObserveFallback(transport, url string, latency time.Duration, stability float64, selected bool) {
+	r.fbLatency.set(latency.Seconds(), transport, url)
+	r.fbStability.set(stability, transport, url)
+	if selected {
+		r.fbSelected.set(1, transport, url)
+	} else {
+		r.fbSelected.set(0, transport, url)
+	}
+}
+
+// WriteText writes every metric in the text exposition format.
+func (r *Registry) WriteText(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range r.families {
+		f.write(bw)
+	}
+	return bw.Flush()
+}
+
+// Handler returns an http.Handler serving the registry's metrics, suitable
+// for a Prometheus scrape target.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		_ = r.WriteText(w)
+	})
+}
+
+// vec is a metric family: a counter, gauge or histogram partitioned by labels.
+type vec struct {
+	name    string
+	kind    string
+	help    string
+	labels  []string
+	buckets []float64
+
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+// series holds the values of one label combination.
+type series struct {
+	labelValues []string
+	value       float64  // counter and gauge value
+	counts      []uint64 // histogram bucket counts (non-cumulative)
+	sum         float64
+	count       uint64
+}
+
+func newVec(name, kind, help string, buckets []float64, labels ...string) *vec {
+	return &vec{name: name, kind: kind, help: help, labels: labels, buckets: buckets, series: make(map[string]*series)}
+}
+
+func sortedBuckets(b []float64) []float64 {
+	out := append([]float64(nil), b...)
+	sort.Float64s(out)
+	return out
+}
+
+func (v *vec) get(labelValues []string) *series {
+	key := strings.Join(labelValues, "\xff")
+	s, ok := v.series[key]
+	if !ok {
+		s = &series{labelValues: append([]string(nil), labelValues...)}
+		if v.kind == "histogram" {
+			s.counts = make([]uint64, len(v.buckets))
+		}
+		v.series[key] = s
+	}
+	return s
+}
+
+func (v *vec) add(delta float64, labelValues ...string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.get(labelValues).value += delta
+}
+
+func (v *vec) set(value float64, labelValues ...string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.get(labelValues).value = value
+}
+
+func (v *vec) observe(value float64, labelValues ...string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	s := v.get(labelValues)
+	if i := sort.SearchFloat64s(v.buckets, value); i < len(v.buckets) {
+		s.counts[i]++
+	}
+	s.sum += value
+	s.count++
+}
+
+func (v *vec) write(w *bufio.Writer) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n", v.name, v.help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", v.name, v.kind)
+
+	keys := make([]string, 0, len(v.series))
+	for k := range v.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(keys) == 0 && len(v.labels) == 0 && v.kind != "histogram" {
+		fmt.Fprintf(w, "%s 0\n", v.name)
+		return
+	}
+
+	for _, k := range keys {
+		s := v.series[k]
+		if v.kind != "histogram" {
+			fmt.Fprintf(w, "%s%s %s\n", v.name, formatLabels(v.labels, s.labelValues, "", ""), formatFloat(s.value))
+			continue
+		}
+		var cumulative uint64
+		for i, upper := range v.buckets {
+			cumulative += s.counts[i]
+			fmt.Fprintf(w, "%s_bucket%s %d\n", v.name, formatLabels(v.labels, s.labelValues, "le", formatFloat(upper)), cumulative)
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", v.name, formatLabels(v.labels, s.labelValues, "le", "+Inf"), s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", v.name, formatLabels(v.labels, s.labelValues, "", ""), formatFloat(s.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", v.name, formatLabels(v.labels, s.labelValues, "", ""), s.count)
+	}
+}
+
+// formatLabels renders {name="value",...}, optionally with an extra label.
+func formatLabels(names, values []string, extraName, extraValue string) string {
+	if len(names) == 0 && extraName == "" {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(name)
+		b.WriteString(`="`)
+		b.WriteString(escapeLabel(values[i]))
+		b.WriteByte('"')
+	}
+	if extraName != "" {
+		if len(names) > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(extraName)
+		b.WriteString(`="`)
+		b.WriteString(extraValue)
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
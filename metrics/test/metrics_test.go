@@ -0,0 +1,265 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/metrics"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
+)
+
+func scrape(reg *metrics.Registry) string {
+	var b strings.Builder
+	Expect(reg.WriteText(&b)).To(Succeed())
+	return b.String()
+}
+
+// rpcServer answers every request with "0x1", failing the first `failures`
+// requests with HTTP 503.
+func rpcServer(failures int32) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if len(body) > 0 && body[0] == '[' {
+			var reqs []rpc.RPCRequest
+			Expect(json.Unmarshal(body, &reqs)).To(Succeed())
+			resps := make([]map[string]any, len(reqs))
+			for i, req := range reqs {
+				resps[i] = map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"}
+			}
+			_ = json.NewEncoder(w).Encode(resps)
+			return
+		}
+		var req rpc.RPCRequest
+		Expect(json.Unmarshal(body, &req)).To(Succeed())
+		if req.Method == "eth_fail" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "error": map[string]any{"code": 3, "message": "execution reverted"}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"})
+	}))
+	return server, &calls
+}
+
+var _ = Describe("ErrorClass", func() {
+	It("classifies transport errors", func() {
+		Expect(metrics.ErrorClass(nil)).To(Equal(""))
+		Expect(metrics.ErrorClass(context.Canceled)).To(Equal(metrics.ErrorClassCanceled))
+		Expect(metrics.ErrorClass(fmt.Errorf("wrapped: %w", context.DeadlineExceeded))).To(Equal(metrics.ErrorClassTimeout))
+		Expect(metrics.ErrorClass(rpc.NewTimeoutError("http://node", nil))).To(Equal(metrics.ErrorClassTimeout))
+		Expect(metrics.ErrorClass(&rpc.RPCError{Code: 3, Message: "execution reverted"})).To(Equal(metrics.ErrorClassRPC))
+		Expect(metrics.ErrorClass(&transport.RPCRequestError{RPCError: &rpc.RPCError{Code: rpc.RPCErrorCodeLimitExceeded}})).To(Equal(metrics.ErrorClassRateLimited))
+		Expect(metrics.ErrorClass(rpc.NewHTTPRequestError("http://node", 429, "Too Many Requests", nil, nil))).To(Equal(metrics.ErrorClassRateLimited))
+		Expect(metrics.ErrorClass(rpc.NewHTTPRequestError("http://node", 502, "Bad Gateway", nil, nil))).To(Equal(metrics.ErrorClassHTTP5xx))
+		Expect(metrics.ErrorClass(rpc.NewHTTPRequestError("http://node", 401, "Unauthorized", nil, nil))).To(Equal(metrics.ErrorClassHTTP4xx))
+		Expect(metrics.ErrorClass(rpc.NewHTTPRequestError("http://node", 0, "", nil, errors.New("connection refused")))).To(Equal(metrics.ErrorClassNetwork))
+		Expect(metrics.ErrorClass(rpc.ErrSocketClosed)).To(Equal(metrics.ErrorClassNetwork))
+		Expect(metrics.ErrorClass(errors.New("boom"))).To(Equal(metrics.ErrorClassOther))
+	})
+})
+
+var _ = Describe("Registry", func() {
+	It("renders counters, gauges and histograms in the text format", func() {
+		reg := metrics.NewRegistry(metrics.RegistryConfig{
+			Namespace:      "test",
+			LatencyBuckets: []float64{0.1, 1},
+		})
+		reg.ObserveRequest("http", "eth_call", 50*time.Millisecond, nil)
+		reg.ObserveRequest("http", "eth_call", 500*time.Millisecond, &rpc.RPCError{Code: 3})
+		reg.ObserveRequest("http", "eth_call", 5*time.Second, context.DeadlineExceeded)
+		reg.ObserveRetry("http", "eth_call", context.DeadlineExceeded)
+		reg.ObserveBatch("http", 3)
+		reg.ObserveMulticall(10, 2)
+		reg.ObserveMulticall(5, 1)
+		reg.AddSubscriptions(`ws"1`, "newHeads", 1)
+		reg.AddSubscriptions(`ws"1`, "newHeads", 1)
+		reg.AddSubscriptions(`ws"1`, "newHeads", -1)
+		reg.ObserveFallback("fb", "https://a.example", 250*time.Millisecond, 0.75, true)
+		reg.ObserveFallback("fb", "https://a.example", 500*time.Millisecond, 0.5, false)
+
+		out := scrape(reg)
+		Expect(out).To(ContainSubstring("# TYPE test_rpc_requests_total counter\n"))
+		Expect(out).To(ContainSubstring(`test_rpc_requests_total{transport="http",method="eth_call"} 3` + "\n"))
+		Expect(out).To(ContainSubstring(`test_rpc_errors_total{transport="http",method="eth_call",class="rpc"} 1` + "\n"))
+		Expect(out).To(ContainSubstring(`test_rpc_errors_total{transport="http",method="eth_call",class="timeout"} 1` + "\n"))
+		Expect(out).To(ContainSubstring(`test_rpc_retries_total{transport="http",method="eth_call"} 1` + "\n"))
+
+		Expect(out).To(ContainSubstring("# TYPE test_rpc_request_duration_seconds histogram\n"))
+		Expect(out).To(ContainSubstring(`test_rpc_request_duration_seconds_bucket{transport="http",method="eth_call",le="0.1"} 1` + "\n"))
+		Expect(out).To(ContainSubstring(`test_rpc_request_duration_seconds_bucket{transport="http",method="eth_call",le="1"} 2` + "\n"))
+		Expect(out).To(ContainSubstring(`test_rpc_request_duration_seconds_bucket{transport="http",method="eth_call",le="+Inf"} 3` + "\n"))
+		Expect(out).To(ContainSubstring(`test_rpc_request_duration_seconds_sum{transport="http",method="eth_call"} 5.55` + "\n"))
+		Expect(out).To(ContainSubstring(`test_rpc_request_duration_seconds_count{transport="http",method="eth_call"} 3` + "\n"))
+
+		Expect(out).To(ContainSubstring(`test_rpc_batch_size_bucket{transport="http",le="5"} 1` + "\n"))
+		Expect(out).To(ContainSubstring("test_multicall_requests_total 2\n"))
+		Expect(out).To(ContainSubstring("test_multicall_calls_total 15\n"))
+		Expect(out).To(ContainSubstring("test_multicall_chunks_total 3\n"))
+		Expect(out).To(ContainSubstring(`test_subscriptions_active{transport="ws\"1",type="newHeads"} 1` + "\n"))
+		Expect(out).To(ContainSubstring("# TYPE test_fallback_latency_seconds gauge\n"))
+		Expect(out).To(ContainSubstring(`test_fallback_latency_seconds{transport="fb",url="https://a.example"} 0.5` + "\n"))
+		Expect(out).To(ContainSubstring(`test_fallback_stability{transport="fb",url="https://a.example"} 0.5` + "\n"))
+		Expect(out).To(ContainSubstring(`test_fallback_selected{transport="fb",url="https://a.example"} 0` + "\n"))
+	})
+
+	It("serves the metrics over HTTP", func() {
+		reg := metrics.NewRegistry()
+		reg.ObserveRequest("http", "eth_blockNumber", time.Millisecond, nil)
+
+		server := httptest.NewServer(reg.Handler())
+		defer server.Close()
+
+		resp, err := http.Get(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.Header.Get("Content-Type")).To(Equal(metrics.ContentType))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring(`viem_rpc_requests_total{transport="http",method="eth_blockNumber"} 1`))
+		Expect(string(body)).To(ContainSubstring("viem_multicall_requests_total 0\n"))
+	})
+})
+
+var _ = Describe("Transport instrumentation", func() {
+	ctx := context.Background()
+
+	It("records requests, errors and retries of the HTTP transport", func() {
+		server, calls := rpcServer(2)
+		defer server.Close()
+
+		reg := metrics.NewRegistry()
+		tr, err := transport.HTTP(server.URL, transport.HTTPTransportConfig{
+			Key:        "primary",
+			RetryCount: 3,
+			RetryDelay: time.Millisecond,
+			Timeout:    time.Second,
+			Metrics:    reg,
+		})(transport.TransportParams{})
+		Expect(err).NotTo(HaveOccurred())
+		defer tr.Close()
+
+		_, err = tr.Request(ctx, transport.RPCRequest{Method: "eth_chainId"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(atomic.LoadInt32(calls)).To(Equal(int32(3)))
+		_, err = tr.Request(ctx, transport.RPCRequest{Method: "eth_fail"})
+		Expect(err).To(HaveOccurred())
+
+		out := scrape(reg)
+		Expect(out).To(ContainSubstring(`viem_rpc_requests_total{transport="primary",method="eth_chainId"} 1`))
+		Expect(out).To(ContainSubstring(`viem_rpc_retries_total{transport="primary",method="eth_chainId"} 2`))
+		Expect(out).To(ContainSubstring(`viem_rpc_errors_total{transport="primary",method="eth_fail",class="rpc"} 1`))
+		Expect(out).NotTo(ContainSubstring(`class="rpc"} 2`))
+		Expect(out).To(ContainSubstring(`viem_rpc_request_duration_seconds_count{transport="primary",method="eth_chainId"} 1`))
+	})
+
+	It("records batch sizes", func() {
+		server, _ := rpcServer(0)
+		defer server.Close()
+
+		reg := metrics.NewRegistry()
+		tr, err := transport.HTTP(server.URL, transport.HTTPTransportConfig{
+			Key:     "batched",
+			Timeout: time.Second,
+			Batch:   &transport.BatchConfig{Enabled: true, BatchSize: 4, Wait: 20 * time.Millisecond},
+			Metrics: reg,
+		})(transport.TransportParams{})
+		Expect(err).NotTo(HaveOccurred())
+		defer tr.Close()
+
+		done := make(chan error, 3)
+		for i := 0; i < 3; i++ {
+			go func() {
+				_, err := tr.Request(ctx, transport.RPCRequest{Method: "eth_blockNumber"})
+				done <- err
+			}()
+		}
+		for i := 0; i < 3; i++ {
+			Expect(<-done).NotTo(HaveOccurred())
+		}
+
+		out := scrape(reg)
+		Expect(out).To(ContainSubstring(`viem_rpc_batch_size_count{transport="batched"} 1`))
+		Expect(out).To(ContainSubstring(`viem_rpc_batch_size_sum{transport="batched"} 3`))
+		Expect(out).To(ContainSubstring(`viem_rpc_requests_total{transport="batched",method="eth_blockNumber"} 3`))
+	})
+
+	It("exports the ranking of fallback members with redacted URLs", func() {
+		down, _ := rpcServer(1 << 30)
+		defer down.Close()
+		up, _ := rpcServer(0)
+		defer up.Close()
+
+		const apiKey = "abcdefghij0123456789klmnop"
+		member := func(url, key string) transport.TransportFactory {
+			return transport.HTTP(url, transport.HTTPTransportConfig{Key: key, Timeout: time.Second})
+		}
+		reg := metrics.NewRegistry()
+		cfg := transport.DefaultFallbackTransportConfig()
+		cfg.Key = "fb"
+		cfg.Metrics = reg
+		cfg.Rank.Interval = 10 * time.Millisecond
+		tr, err := transport.FallbackWithConfig([]transport.TransportFactory{
+			member(down.URL+"/v2/"+apiKey, "down"),
+			member(up.URL, "up"),
+		}, cfg)(transport.TransportParams{})
+		Expect(err).NotTo(HaveOccurred())
+		defer tr.Close()
+
+		downLabel := fmt.Sprintf(`{transport="fb",url="%s/v2/***"}`, down.URL)
+		upLabel := fmt.Sprintf(`{transport="fb",url="%s"}`, up.URL)
+		out := scrape(reg)
+		Expect(out).To(ContainSubstring("viem_fallback_selected" + downLabel + " 1\n"))
+		Expect(out).To(ContainSubstring("viem_fallback_selected" + upLabel + " 0\n"))
+
+		_, err = tr.Request(ctx, transport.RPCRequest{Method: "eth_chainId"})
+		Expect(err).NotTo(HaveOccurred())
+
+		out = scrape(reg)
+		Expect(out).NotTo(ContainSubstring(apiKey))
+		Expect(out).To(ContainSubstring("viem_fallback_stability" + downLabel + " 0\n"))
+		Expect(out).To(ContainSubstring("viem_fallback_stability" + upLabel + " 1\n"))
+		Expect(out).To(MatchRegexp(`viem_fallback_latency_seconds\Q` + upLabel + `\E [0-9.e-]+\n`))
+
+		// Re-ranking prefers the healthy member.
+		Eventually(func() string { return scrape(reg) }).Should(And(
+			ContainSubstring("viem_fallback_selected"+upLabel+" 1\n"),
+			ContainSubstring("viem_fallback_selected"+downLabel+" 0\n"),
+		))
+	})
+
+	It("passes the client's collector to its transports", func() {
+		server, _ := rpcServer(0)
+		defer server.Close()
+
+		reg := metrics.NewRegistry()
+		c, err := client.CreatePublicClient(client.PublicClientConfig{
+			Transport: transport.Fallback(transport.HTTP(server.URL)),
+			Metrics:   reg,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		defer c.Close()
+		Expect(c.Metrics()).To(BeIdenticalTo(metrics.Collector(reg)))
+
+		_, err = c.GetChainID(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(scrape(reg)).To(ContainSubstring(`viem_rpc_requests_total{transport="http",method="eth_chainId"} 1`))
+	})
+})
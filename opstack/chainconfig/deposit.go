@@ -0,0 +1,113 @@
+package chainconfig
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// DepositTxType is the EIP-2718 type of deposit transactions.
+const DepositTxType = 0x7e
+
+// ErrInvalidDepositTransaction is returned when a serialized deposit
+// transaction or a TransactionDeposited event cannot be decoded.
+var ErrInvalidDepositTransaction = errors.New("opstack: invalid deposit transaction")
+
+// DepositTx is an L2 deposit transaction (type 0x7e). Deposits are
+// derived from L1 (e.g. TransactionDeposited events of the OptimismPortal)
+// and are not signed.
+type DepositTx struct {
+	// SourceHash uniquely identifies the origin of the deposit.
+	SourceHash common.Hash
+	// From is the sender. Contract senders are aliased.
+	From common.Address
+	// To is the recipient, or nil for contract creation.
+	To *common.Address
+	// Mint is the ETH minted on L2, locked on L1.
+	Mint *big.Int
+	// Value is the ETH transferred to To.
+	Value *big.Int
+	// Gas is the L2 gas limit.
+	Gas uint64
+	// IsSystemTx marks legacy system transactions.
+	IsSystemTx bool
+	// Data is the calldata.
+	Data []byte
+}
+
+// depositRLP is the consensus encoding of a deposit transaction.
+type depositRLP struct {
+	SourceHash common.Hash
+	From       common.Address
+	To         *common.Address `rlp:"nil"`
+	Mint       *big.Int
+	Value      *big.Int
+	Gas        uint64
+	IsSystemTx bool
+	Data       []byte
+}
+
+// SerializeDepositTransaction returns the serialized deposit transaction:
+// 0x7e || rlp([sourceHash, from, to, mint, value, gas, isSystemTx, data]).
+func SerializeDepositTransaction(tx *DepositTx) (string, error) {
+	enc, err := rlp.EncodeToBytes(depositRLP{
+		SourceHash: tx.SourceHash,
+		From:       tx.From,
+		To:         tx.To,
+		Mint:       bigOrZero(tx.Mint),
+		Value:      bigOrZero(tx.Value),
+		Gas:        tx.Gas,
+		IsSystemTx: tx.IsSystemTx,
+		Data:       tx.Data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidDepositTransaction, err)
+	}
+	return hexutil.Encode(append([]byte{DepositTxType}, enc...)), nil
+}
+
+// ParseDepositTransaction decodes a serialized deposit transaction.
+func ParseDepositTransaction(serialized string) (*DepositTx, error) {
+	raw, err := hexutil.Decode(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDepositTransaction, err)
+	}
+	if len(raw) == 0 || raw[0] != DepositTxType {
+		return nil, fmt.Errorf("%w: not a deposit transaction", ErrInvalidDepositTransaction)
+	}
+	var dec depositRLP
+	if err := rlp.DecodeBytes(raw[1:], &dec); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDepositTransaction, err)
+	}
+	return &DepositTx{
+		SourceHash: dec.SourceHash,
+		From:       dec.From,
+		To:         dec.To,
+		Mint:       dec.Mint,
+		Value:      dec.Value,
+		Gas:        dec.Gas,
+		IsSystemTx: dec.IsSystemTx,
+		Data:       dec.Data,
+	}, nil
+}
+
+// Hash returns the L2 transaction hash of the deposit.
+func (tx *DepositTx) Hash() (common.Hash, error) {
+	serialized, err := SerializeDepositTransaction(tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(common.FromHex(serialized)), nil
+}
+
+func bigOrZero(n *big.Int) *big.Int {
+	if n == nil {
+		return new(big.Int)
+	}
+	return n
+}
@@ -0,0 +1,143 @@
+// Package chainconfig holds the chain formatters and serializers of OP Stack
+// chains. It depends only on the chain and transaction packages, so chain
+// definitions can use it without importing the opstack actions.
+package chainconfig
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// ErrDepositNotSignable is returned when a deposit transaction is serialized
+// with a signature. Deposits are derived from L1 and never signed.
+var ErrDepositNotSignable = errors.New("opstack: deposit transactions cannot be signed")
+
+// TransactionFields are the OP Stack fields of a deposit transaction, set as
+// the ChainFields of public.GetTransaction results by Formatters. They are
+// nil for other transaction types.
+type TransactionFields struct {
+	// SourceHash uniquely identifies the origin of the deposit.
+	SourceHash common.Hash
+	// Mint is the ETH minted on L2.
+	Mint *big.Int
+	// IsSystemTx marks legacy system transactions.
+	IsSystemTx bool
+}
+
+// ReceiptFields are the OP Stack fields of a transaction receipt, set as the
+// ChainFields of public.GetTransactionReceipt results by Formatters. The L1
+// fee fields are nil for deposits, the deposit fields for other transactions.
+type ReceiptFields struct {
+	// L1GasPrice is the L1 base fee used to compute the L1 fee.
+	L1GasPrice *big.Int
+	// L1GasUsed is the L1 gas charged for the transaction data.
+	L1GasUsed *big.Int
+	// L1Fee is the L1 data fee paid by the transaction, on top of its L2
+	// execution fee.
+	L1Fee *big.Int
+	// L1FeeScalar is the pre-Ecotone L1 fee scalar.
+	L1FeeScalar *float64
+	// L1BaseFeeScalar is the Ecotone base fee scalar.
+	L1BaseFeeScalar *big.Int
+	// L1BlobBaseFee is the Ecotone L1 blob base fee.
+	L1BlobBaseFee *big.Int
+	// L1BlobBaseFeeScalar is the Ecotone blob base fee scalar.
+	L1BlobBaseFeeScalar *big.Int
+	// DepositNonce is the nonce of the deposit sender (deposits only).
+	DepositNonce *uint64
+	// DepositReceiptVersion is the deposit receipt version (deposits only).
+	DepositReceiptVersion *uint64
+}
+
+// Formatters are the chain formatters of OP Stack chains. They decode
+// TransactionFields for deposit transactions and ReceiptFields for receipts.
+var Formatters = &chain.ChainFormatters{
+	Transaction:        formatTransaction,
+	TransactionReceipt: formatTransactionReceipt,
+}
+
+// Serializers are the chain serializers of OP Stack chains.
+var Serializers = &chain.ChainSerializers{
+	Transaction: SerializeTransaction,
+}
+
+func formatTransaction(raw json.RawMessage) (any, error) {
+	var dec struct {
+		Type       hexutil.Uint64 `json:"type"`
+		SourceHash common.Hash    `json:"sourceHash"`
+		Mint       *hexutil.Big   `json:"mint"`
+		IsSystemTx bool           `json:"isSystemTx"`
+	}
+	if err := json.Unmarshal(raw, &dec); err != nil {
+		return nil, err
+	}
+	if dec.Type != DepositTxType {
+		return nil, nil
+	}
+	return &TransactionFields{
+		SourceHash: dec.SourceHash,
+		Mint:       (*big.Int)(dec.Mint),
+		IsSystemTx: dec.IsSystemTx,
+	}, nil
+}
+
+func formatTransactionReceipt(raw json.RawMessage) (any, error) {
+	var dec struct {
+		L1GasPrice            *hexutil.Big    `json:"l1GasPrice"`
+		L1GasUsed             *hexutil.Big    `json:"l1GasUsed"`
+		L1Fee                 *hexutil.Big    `json:"l1Fee"`
+		L1FeeScalar           *string         `json:"l1FeeScalar"`
+		L1BaseFeeScalar       *hexutil.Big    `json:"l1BaseFeeScalar"`
+		L1BlobBaseFee         *hexutil.Big    `json:"l1BlobBaseFee"`
+		L1BlobBaseFeeScalar   *hexutil.Big    `json:"l1BlobBaseFeeScalar"`
+		DepositNonce          *hexutil.Uint64 `json:"depositNonce"`
+		DepositReceiptVersion *hexutil.Uint64 `json:"depositReceiptVersion"`
+	}
+	if err := json.Unmarshal(raw, &dec); err != nil {
+		return nil, err
+	}
+
+	fields := &ReceiptFields{
+		L1GasPrice:            (*big.Int)(dec.L1GasPrice),
+		L1GasUsed:             (*big.Int)(dec.L1GasUsed),
+		L1Fee:                 (*big.Int)(dec.L1Fee),
+		L1BaseFeeScalar:       (*big.Int)(dec.L1BaseFeeScalar),
+		L1BlobBaseFee:         (*big.Int)(dec.L1BlobBaseFee),
+		L1BlobBaseFeeScalar:   (*big.Int)(dec.L1BlobBaseFeeScalar),
+		DepositNonce:          (*uint64)(dec.DepositNonce),
+		DepositReceiptVersion: (*uint64)(dec.DepositReceiptVersion),
+	}
+	if dec.L1FeeScalar != nil {
+		scalar, err := strconv.ParseFloat(*dec.L1FeeScalar, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid l1FeeScalar %q: %w", *dec.L1FeeScalar, err)
+		}
+		fields.L1FeeScalar = &scalar
+	}
+	return fields, nil
+}
+
+// SerializeTransaction serializes OP Stack transactions: deposits, given as
+// a *DepositTx in tx.ChainFields, are serialized with
+// SerializeDepositTransaction and every other transaction with
+// transaction.SerializeTransaction.
+func SerializeTransaction(tx *transaction.Transaction, signature *transaction.Signature) (string, error) {
+	deposit, ok := tx.ChainFields.(*DepositTx)
+	if !ok {
+		return transaction.SerializeTransaction(tx, signature)
+	}
+	if signature != nil {
+		return "", ErrDepositNotSignable
+	}
+	return SerializeDepositTransaction(deposit)
+}
@@ -0,0 +1,189 @@
+// Package opstack adds support for OP Stack chains (OP Mainnet, Base and
+// other Superchain networks), mirroring viem's op-stack extension:
+//
+//   - deposit transactions (type 0x7e): serialization, parsing, and chain
+//     formatters and serializers that preserve deposit and L1 fee fields
+//   - L2 fee estimation through the GasPriceOracle predeploy
+//     (EstimateL1Fee, EstimateTotalFee)
+//   - L1 -> L2 deposits (BuildDepositTransaction, DepositTransaction,
+//     GetL2TransactionHashes)
+//   - L2 -> L1 withdrawals (InitiateWithdrawal, GetWithdrawalStatus,
+//     GetOutput, BuildProveWithdrawal, ProveWithdrawal, FinalizeWithdrawal)
+//
+// Actions take the client of the network they run on: L2 actions a client
+// for the OP Stack chain and L1 actions a client for its settlement layer.
+// L1 actions locate the OptimismPortal and proposal contracts of the L2 with
+// their TargetChain parameter, or with an explicit Contracts override.
+//
+// Example:
+//
+//	// Bridge 1 ETH from mainnet to OP Mainnet.
+//	request, err := opstack.BuildDepositTransaction(ctx, opClient, opstack.BuildDepositTransactionParameters{
+//		Account: &account,
+//		To:      account,
+//		Mint:    big.NewInt(1e18),
+//	})
+//	hash, err := opstack.DepositTransaction(ctx, mainnetWallet, opstack.DepositTransactionParameters{
+//		Request:     *request,
+//		TargetChain: &definitions.Optimism,
+//	})
+package opstack
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// L2 predeploy addresses, identical on every OP Stack chain.
+var (
+	// GasPriceOracleAddress is the GasPriceOracle predeploy, which computes
+	// the L1 data fee of L2 transactions.
+	GasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+	// L1BlockAddress is the L1Block predeploy, which exposes L1 block
+	// attributes on L2.
+	L1BlockAddress = common.HexToAddress("0x4200000000000000000000000000000000000015")
+	// L2CrossDomainMessengerAddress is the L2CrossDomainMessenger predeploy.
+	L2CrossDomainMessengerAddress = common.HexToAddress("0x4200000000000000000000000000000000000007")
+	// L2StandardBridgeAddress is the L2StandardBridge predeploy.
+	L2StandardBridgeAddress = common.HexToAddress("0x4200000000000000000000000000000000000010")
+	// L2ToL1MessagePasserAddress is the L2ToL1MessagePasser predeploy, which
+	// records initiated withdrawals.
+	L2ToL1MessagePasserAddress = common.HexToAddress("0x4200000000000000000000000000000000000016")
+)
+
+// ErrContractNotFound is returned when the L1 contracts of a target chain are
+// unknown and no Contracts override is given.
+var ErrContractNotFound = errors.New("opstack: L1 contract address not found")
+
+// L1Contracts are the contracts of an OP Stack chain deployed on its
+// settlement layer.
+type L1Contracts struct {
+	// Portal is the OptimismPortal, the entry point for deposits and for
+	// proving and finalizing withdrawals.
+	Portal common.Address
+	// L2OutputOracle receives L2 output proposals on chains without fault
+	// proofs.
+	L2OutputOracle common.Address
+	// DisputeGameFactory creates the dispute games that propose L2 outputs
+	// on chains with fault proofs.
+	DisputeGameFactory common.Address
+	// L1StandardBridge is the bridge for ETH and ERC-20 tokens.
+	L1StandardBridge common.Address
+}
+
+// l1Contracts holds the L1 contracts of known OP Stack chains, by L2 chain ID.
+var l1Contracts = map[int64]L1Contracts{
+	// OP Mainnet
+	10: {
+		Portal:             common.HexToAddress("0xbEb5Fc579115071764c7423A4f12eDde41f106Ed"),
+		L2OutputOracle:     common.HexToAddress("0xdfe97868233d1aa22e815a266982f2cf17685a27"),
+		DisputeGameFactory: common.HexToAddress("0xe5965Ab5962eDc7477C8520243A95517CD252fA9"),
+		L1StandardBridge:   common.HexToAddress("0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1"),
+	},
+	// OP Sepolia
+	11_155_420: {
+		Portal:             common.HexToAddress("0x16Fc5058F25648194471939df75CF27A2fdC48BC"),
+		L2OutputOracle:     common.HexToAddress("0x90E9c4f8a994a250F6aEfd61CAFb4F2e895D458F"),
+		DisputeGameFactory: common.HexToAddress("0x05F9613aDB30026FFd634f38e5C4dFd30a197Fa1"),
+		L1StandardBridge:   common.HexToAddress("0xFBb0621E0B23b5478B630BD55a5f21f67730B0F1"),
+	},
+	// Base
+	8_453: {
+		Portal:             common.HexToAddress("0x49048044D57e1C92A77f79988d21Fa8fAF74E97e"),
+		L2OutputOracle:     common.HexToAddress("0x56315b90c40730925ec5485cf004d835058518A0"),
+		DisputeGameFactory: common.HexToAddress("0x43edB88C4B80fDD2AdFF2412A7BebF9dF42cB40e"),
+		L1StandardBridge:   common.HexToAddress("0x3154Cf16ccdb4C6d922629664174b904d80F2C35"),
+	},
+	// Base Sepolia
+	84_532: {
+		Portal:             common.HexToAddress("0x49f53e41452c74589e85ca1677426ba426459e85"),
+		L2OutputOracle:     common.HexToAddress("0x84457ca9D0163FbC4bbfe4Dfbb20ba46e48DF254"),
+		DisputeGameFactory: common.HexToAddress("0xd6E6dBf4F7EA0ac412fD8b65ED297e64BB7a06E1"),
+		L1StandardBridge:   common.HexToAddress("0xfd0Bf71F60660E2f608ed56e1659C450eB113120"),
+	},
+}
+
+// GetL1Contracts returns the L1 contracts of a known OP Stack chain, by L2
+// chain ID.
+func GetL1Contracts(chainID int64) (L1Contracts, bool) {
+	c, ok := l1Contracts[chainID]
+	return c, ok
+}
+
+// resolveL1Contracts returns the override if set, or the known L1 contracts
+// of the target chain.
+func resolveL1Contracts(override *L1Contracts, targetChain *chain.Chain) (L1Contracts, error) {
+	if override != nil {
+		return *override, nil
+	}
+	if targetChain == nil {
+		return L1Contracts{}, fmt.Errorf("%w: TargetChain or Contracts is required", ErrContractNotFound)
+	}
+	c, ok := l1Contracts[targetChain.ID]
+	if !ok {
+		return L1Contracts{}, fmt.Errorf("%w: unknown chain %d", ErrContractNotFound, targetChain.ID)
+	}
+	return c, nil
+}
+
+var gasPriceOracleABI = abi.MustParseAbi([]string{
+	"function getL1Fee(bytes _data) view returns (uint256)",
+	"function getL1GasUsed(bytes _data) view returns (uint256)",
+	"function l1BaseFee() view returns (uint256)",
+})
+
+var l2ToL1MessagePasserABI = abi.MustParseAbi([]string{
+	"function initiateWithdrawal(address _target, uint256 _gasLimit, bytes _data) payable",
+	"event MessagePassed(uint256 indexed nonce, address indexed sender, address indexed target, uint256 value, uint256 gasLimit, bytes data, bytes32 withdrawalHash)",
+})
+
+var portalABI = abi.MustParseAbi([]string{
+	"struct WithdrawalTransaction { uint256 nonce; address sender; address target; uint256 value; uint256 gasLimit; bytes data; }",
+	"struct OutputRootProof { bytes32 version; bytes32 stateRoot; bytes32 messagePasserStorageRoot; bytes32 latestBlockhash; }",
+	"function version() view returns (string)",
+	"function depositTransaction(address _to, uint256 _value, uint64 _gasLimit, bool _isCreation, bytes _data) payable",
+	"function proveWithdrawalTransaction(WithdrawalTransaction _tx, uint256 _disputeGameIndex, OutputRootProof _outputRootProof, bytes[] _withdrawalProof)",
+	"function finalizeWithdrawalTransaction(WithdrawalTransaction _tx)",
+	"function finalizeWithdrawalTransactionExternalProof(WithdrawalTransaction _tx, address _proofSubmitter)",
+	"function finalizedWithdrawals(bytes32) view returns (bool)",
+	"function respectedGameType() view returns (uint32)",
+	"function disputeGameBlacklist(address) view returns (bool)",
+	"function proofMaturityDelaySeconds() view returns (uint256)",
+	"function numProofSubmitters(bytes32 _withdrawalHash) view returns (uint256)",
+	"function proofSubmitters(bytes32, uint256) view returns (address)",
+	"event TransactionDeposited(address indexed from, address indexed to, uint256 indexed version, bytes opaqueData)",
+})
+
+// legacyPortalABI holds the OptimismPortal functions that changed with
+// fault proofs (portal version 3).
+var legacyPortalABI = abi.MustParseAbi([]string{
+	"function provenWithdrawals(bytes32) view returns (bytes32 outputRoot, uint128 timestamp, uint128 l2OutputIndex)",
+})
+
+var faultProofPortalABI = abi.MustParseAbi([]string{
+	"function provenWithdrawals(bytes32, address) view returns (address disputeGameProxy, uint64 timestamp)",
+})
+
+var l2OutputOracleABI = abi.MustParseAbi([]string{
+	"struct OutputProposal { bytes32 outputRoot; uint128 timestamp; uint128 l2BlockNumber; }",
+	"function latestBlockNumber() view returns (uint256)",
+	"function getL2OutputIndexAfter(uint256 _l2BlockNumber) view returns (uint256)",
+	"function getL2Output(uint256 _l2OutputIndex) view returns (OutputProposal)",
+	"function FINALIZATION_PERIOD_SECONDS() view returns (uint256)",
+})
+
+var disputeGameFactoryABI = abi.MustParseAbi([]string{
+	"struct GameSearchResult { uint256 index; bytes32 metadata; uint64 timestamp; bytes32 rootClaim; bytes extraData; }",
+	"function gameCount() view returns (uint256)",
+	"function findLatestGames(uint32 _gameType, uint256 _start, uint256 _n) view returns (GameSearchResult[] games_)",
+})
+
+var disputeGameABI = abi.MustParseAbi([]string{
+	"function status() view returns (uint8)",
+	"function gameType() view returns (uint32)",
+})
@@ -0,0 +1,114 @@
+package opstack
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChefBingbong/viem-go/opstack/chainconfig"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// DepositTxType is the EIP-2718 type of deposit transactions.
+const DepositTxType = chainconfig.DepositTxType
+
+// ErrInvalidDepositTransaction is returned when a serialized deposit
+// transaction or a TransactionDeposited event cannot be decoded.
+var ErrInvalidDepositTransaction = chainconfig.ErrInvalidDepositTransaction
+
+// DepositTx is an L2 deposit transaction (type 0x7e). See
+// chainconfig.DepositTx.
+type DepositTx = chainconfig.DepositTx
+
+// SerializeDepositTransaction returns the serialized deposit transaction:
+// 0x7e || rlp([sourceHash, from, to, mint, value, gas, isSystemTx, data]).
+func SerializeDepositTransaction(tx *DepositTx) (string, error) {
+	return chainconfig.SerializeDepositTransaction(tx)
+}
+
+// ParseDepositTransaction decodes a serialized deposit transaction.
+func ParseDepositTransaction(serialized string) (*DepositTx, error) {
+	return chainconfig.ParseDepositTransaction(serialized)
+}
+
+// UserDepositSourceHash returns the source hash of a user deposit, derived
+// from the L1 block hash and log index of its TransactionDeposited event.
+func UserDepositSourceHash(l1BlockHash common.Hash, l1LogIndex uint64) common.Hash {
+	depositID := crypto.Keccak256(l1BlockHash.Bytes(), common.BigToHash(new(big.Int).SetUint64(l1LogIndex)).Bytes())
+	// The user deposit domain is 0.
+	return crypto.Keccak256Hash(make([]byte, common.HashLength), depositID)
+}
+
+// GetL2Transactions returns the L2 deposit transactions derived from the
+// TransactionDeposited events in an L1 transaction receipt, e.g. of a
+// DepositTransaction call.
+func GetL2Transactions(receipt *types.Receipt) ([]*DepositTx, error) {
+	event, err := portalABI.GetEvent("TransactionDeposited")
+	if err != nil {
+		return nil, err
+	}
+	var txs []*DepositTx
+	for _, log := range receipt.Logs {
+		if len(log.Topics) != 4 || log.Topics[0] != event.Topic {
+			continue
+		}
+		tx, err := depositFromLog(log)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+// GetL2TransactionHashes returns the L2 transaction hashes of the deposits
+// made in an L1 transaction receipt.
+func GetL2TransactionHashes(receipt *types.Receipt) ([]common.Hash, error) {
+	txs, err := GetL2Transactions(receipt)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		if hashes[i], err = tx.Hash(); err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
+// depositFromLog decodes a TransactionDeposited event. Its opaqueData is
+// abi.encodePacked(mint, value, gasLimit, isCreation, data).
+func depositFromLog(log types.Log) (*DepositTx, error) {
+	decoded, err := portalABI.DecodeEventLogByName("TransactionDeposited", log.Topics, log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDepositTransaction, err)
+	}
+	opaque, _ := decoded.Args["opaqueData"].([]byte)
+	if len(opaque) < 73 {
+		return nil, fmt.Errorf("%w: opaque data is %d bytes", ErrInvalidDepositTransaction, len(opaque))
+	}
+
+	tx := &DepositTx{
+		SourceHash: UserDepositSourceHash(log.BlockHash, log.LogIndex),
+		From:       common.BytesToAddress(log.Topics[1].Bytes()),
+		Mint:       new(big.Int).SetBytes(opaque[0:32]),
+		Value:      new(big.Int).SetBytes(opaque[32:64]),
+		Gas:        new(big.Int).SetBytes(opaque[64:72]).Uint64(),
+		Data:       opaque[73:],
+	}
+	if opaque[72] == 0 {
+		to := common.BytesToAddress(log.Topics[2].Bytes())
+		tx.To = &to
+	}
+	return tx, nil
+}
+
+func bigOrZero(n *big.Int) *big.Int {
+	if n == nil {
+		return new(big.Int)
+	}
+	return n
+}
@@ -0,0 +1,159 @@
+package opstack
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// DepositRequest is an L1 -> L2 deposit, as sent to the OptimismPortal.
+type DepositRequest struct {
+	// To is the L2 recipient. Ignored for contract creation.
+	To common.Address
+	// Mint is the ETH locked on L1 and minted on L2 to the sender.
+	Mint *big.Int
+	// Value is the ETH transferred from the sender to To on L2.
+	Value *big.Int
+	// Gas is the L2 gas limit.
+	Gas uint64
+	// IsCreation deploys a contract with Data as init code.
+	IsCreation bool
+	// Data is the L2 calldata.
+	Data []byte
+}
+
+// BuildDepositTransactionParameters contains the parameters for the
+// BuildDepositTransaction action.
+type BuildDepositTransactionParameters struct {
+	// Account is the L1 sender of the deposit, used to estimate L2 gas.
+	Account *common.Address
+
+	// To is the L2 recipient. Ignored for contract creation.
+	To common.Address
+
+	// Mint is the ETH to lock on L1 and mint on L2.
+	Mint *big.Int
+
+	// Value is the ETH to transfer to To on L2.
+	Value *big.Int
+
+	// Data is the L2 calldata.
+	Data []byte
+
+	// Gas is the L2 gas limit. Estimated on L2 when nil.
+	Gas *uint64
+
+	// IsCreation deploys a contract with Data as init code.
+	IsCreation bool
+}
+
+// BuildDepositTransaction prepares a deposit request for DepositTransaction,
+// estimating its L2 gas limit when not given. It is run against the L2.
+//
+// This is equivalent to viem's op-stack `buildDepositTransaction` action.
+//
+// Example:
+//
+//	request, err := opstack.BuildDepositTransaction(ctx, opClient, opstack.BuildDepositTransactionParameters{
+//	    Account: &account,
+//	    To:      account,
+//	    Mint:    big.NewInt(1e18),
+//	})
+func BuildDepositTransaction(ctx context.Context, client public.Client, params BuildDepositTransactionParameters) (*DepositRequest, error) {
+	request := &DepositRequest{
+		To:         params.To,
+		Mint:       params.Mint,
+		Value:      params.Value,
+		IsCreation: params.IsCreation,
+		Data:       params.Data,
+	}
+	if params.Gas != nil {
+		request.Gas = *params.Gas
+		return request, nil
+	}
+
+	estimate := public.EstimateGasParameters{
+		Account: params.Account,
+		Data:    params.Data,
+		Value:   params.Value,
+	}
+	if !params.IsCreation {
+		estimate.To = &request.To
+	}
+	gas, err := public.EstimateGas(ctx, client, estimate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate L2 gas: %w", err)
+	}
+	request.Gas = gas
+	return request, nil
+}
+
+// DepositTransactionParameters contains the parameters for the
+// DepositTransaction action.
+type DepositTransactionParameters struct {
+	// Account is the L1 account to send from. If nil, uses the client's account.
+	Account wallet.Account
+
+	// Request is the deposit, e.g. from BuildDepositTransaction.
+	Request DepositRequest
+
+	// TargetChain is the L2 chain, whose L1 contracts are used.
+	TargetChain *chain.Chain
+
+	// Contracts overrides the L1 contracts of TargetChain.
+	Contracts *L1Contracts
+
+	// Chain optionally overrides the client's (L1) chain for chain ID validation.
+	Chain *chain.Chain
+
+	// L1 transaction fields
+	Gas                  *big.Int
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Nonce                *int
+}
+
+// DepositTransaction sends a deposit from L1 to L2 through the
+// OptimismPortal, returning the L1 transaction hash. Once the L1 transaction
+// is included, GetL2TransactionHashes returns the hash of the resulting L2
+// deposit transaction.
+//
+// This is equivalent to viem's op-stack `depositTransaction` action.
+func DepositTransaction(ctx context.Context, client wallet.Client, params DepositTransactionParameters) (string, error) {
+	contracts, err := resolveL1Contracts(params.Contracts, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+
+	request := params.Request
+	to := request.To
+	if request.IsCreation {
+		to = common.Address{}
+	}
+	data := request.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	return wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:              params.Account,
+		Address:              contracts.Portal.Hex(),
+		ABI:                  portalABI,
+		FunctionName:         "depositTransaction",
+		Args:                 []any{to, bigOrZero(request.Value), request.Gas, request.IsCreation, data},
+		Value:                request.Mint,
+		Chain:                params.Chain,
+		Gas:                  params.Gas,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		Nonce:                params.Nonce,
+	})
+}
@@ -0,0 +1,210 @@
+package opstack
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/internal/contractread"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// EstimateL1FeeParameters contains the parameters for the EstimateL1Fee and
+// EstimateTotalFee actions. Unset nonce, gas and fee fields are filled from
+// the L2 network, like wallet.PrepareTransactionRequest.
+type EstimateL1FeeParameters struct {
+	// Account is the sender of the transaction.
+	Account *common.Address
+
+	// To is the recipient address. Nil for contract deployment.
+	To *common.Address
+
+	// Data is the calldata.
+	Data []byte
+
+	// Value is the amount of wei to send.
+	Value *big.Int
+
+	// Gas is the L2 gas limit. Estimated when nil.
+	Gas *uint64
+
+	// GasPrice is the legacy gas price. When set, a legacy transaction is
+	// estimated.
+	GasPrice *big.Int
+
+	// MaxFeePerGas is the max fee per gas (EIP-1559). Estimated when nil.
+	MaxFeePerGas *big.Int
+
+	// MaxPriorityFeePerGas is the max priority fee per gas (EIP-1559).
+	MaxPriorityFeePerGas *big.Int
+
+	// Nonce is the transaction nonce. Fetched for Account when nil.
+	Nonce *uint64
+
+	// GasPriceOracleAddress overrides the GasPriceOracle predeploy address.
+	GasPriceOracleAddress *common.Address
+
+	// BlockNumber is the block number to estimate at.
+	// Mutually exclusive with BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the block tag to estimate at (e.g., "latest", "pending").
+	// Mutually exclusive with BlockNumber.
+	BlockTag public.BlockTag
+}
+
+// EstimateTotalFeeParameters contains the parameters for the EstimateTotalFee action.
+type EstimateTotalFeeParameters = EstimateL1FeeParameters
+
+// EstimateL1Fee estimates the L1 data fee of an L2 transaction: the fee
+// charged for posting the transaction to L1, on top of its L2 execution fee.
+// The transaction is prepared, serialized and priced by the GasPriceOracle
+// predeploy.
+//
+// This is equivalent to viem's op-stack `estimateL1Fee` action.
+//
+// Example:
+//
+//	fee, err := opstack.EstimateL1Fee(ctx, opClient, opstack.EstimateL1FeeParameters{
+//	    Account: &from,
+//	    To:      &to,
+//	    Value:   big.NewInt(1e18),
+//	})
+func EstimateL1Fee(ctx context.Context, client public.Client, params EstimateL1FeeParameters) (*big.Int, error) {
+	tx, err := prepareL2Transaction(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+	return estimateL1Fee(ctx, client, params, tx)
+}
+
+// EstimateTotalFee estimates the total fee of an L2 transaction: its L1 data
+// fee plus its L2 execution fee (gas * maxFeePerGas, or gas * gasPrice for
+// legacy transactions).
+//
+// This is equivalent to viem's op-stack `estimateTotalFee` action.
+func EstimateTotalFee(ctx context.Context, client public.Client, params EstimateTotalFeeParameters) (*big.Int, error) {
+	tx, err := prepareL2Transaction(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+	l1Fee, err := estimateL1Fee(ctx, client, params, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := tx.MaxFeePerGas
+	if gasPrice == nil {
+		gasPrice = tx.GasPrice
+	}
+	l2Fee := new(big.Int).Mul(tx.Gas, gasPrice)
+	return l2Fee.Add(l2Fee, l1Fee), nil
+}
+
+func estimateL1Fee(ctx context.Context, client public.Client, params EstimateL1FeeParameters, tx *transaction.Transaction) (*big.Int, error) {
+	serialized, err := transaction.SerializeTransaction(tx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize transaction: %w", err)
+	}
+
+	oracle := GasPriceOracleAddress
+	if params.GasPriceOracleAddress != nil {
+		oracle = *params.GasPriceOracleAddress
+	}
+	var fee *big.Int
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      oracle,
+		ABI:          gasPriceOracleABI,
+		FunctionName: "getL1Fee",
+		Args:         []any{common.FromHex(serialized)},
+		BlockNumber:  params.BlockNumber,
+		BlockTag:     params.BlockTag,
+	}, &fee)
+	if err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+// prepareL2Transaction builds the unsigned transaction whose L1 fee is
+// estimated, filling in the chain ID, nonce, fees and gas.
+func prepareL2Transaction(ctx context.Context, client public.Client, params EstimateL1FeeParameters) (*transaction.Transaction, error) {
+	tx := &transaction.Transaction{
+		Value:                params.Value,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+	}
+	if params.To != nil {
+		tx.To = params.To.Hex()
+	}
+	if len(params.Data) > 0 {
+		tx.Data = hexutil.Encode(params.Data)
+	}
+
+	if ch := client.Chain(); ch != nil {
+		tx.ChainId = int(ch.ID)
+	} else {
+		chainID, err := public.GetChainID(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		tx.ChainId = int(chainID)
+	}
+
+	switch {
+	case params.Nonce != nil:
+		tx.Nonce = int(*params.Nonce)
+	case params.Account != nil:
+		nonce, err := public.GetTransactionCount(ctx, client, public.GetTransactionCountParameters{
+			Address:  *params.Account,
+			BlockTag: public.BlockTagPending,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tx.Nonce = int(nonce)
+	}
+
+	if tx.GasPrice != nil {
+		tx.Type = transaction.TransactionTypeLegacy
+	} else {
+		tx.Type = transaction.TransactionTypeEIP1559
+		if tx.MaxFeePerGas == nil {
+			fees, err := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{})
+			if err != nil {
+				return nil, err
+			}
+			tx.MaxFeePerGas = fees.MaxFeePerGas
+			if tx.MaxPriorityFeePerGas == nil {
+				tx.MaxPriorityFeePerGas = fees.MaxPriorityFeePerGas
+			}
+		}
+	}
+
+	if params.Gas != nil {
+		tx.Gas = new(big.Int).SetUint64(*params.Gas)
+	} else {
+		gas, err := public.EstimateGas(ctx, client, public.EstimateGasParameters{
+			Account:              params.Account,
+			To:                   params.To,
+			Data:                 params.Data,
+			Value:                params.Value,
+			GasPrice:             params.GasPrice,
+			MaxFeePerGas:         params.MaxFeePerGas,
+			MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+			BlockNumber:          params.BlockNumber,
+			BlockTag:             params.BlockTag,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+		tx.Gas = new(big.Int).SetUint64(gas)
+	}
+
+	return tx, nil
+}
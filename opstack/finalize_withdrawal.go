@@ -0,0 +1,74 @@
+package opstack
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// FinalizeWithdrawalParameters contains the parameters for the
+// FinalizeWithdrawal action.
+type FinalizeWithdrawalParameters struct {
+	// Account is the L1 account to send from. If nil, uses the client's account.
+	Account wallet.Account
+
+	// Withdrawal is the proven withdrawal to finalize.
+	Withdrawal Withdrawal
+
+	// ProofSubmitter finalizes against the proof submitted by this address
+	// (fault proof portals only). When nil, the sender's proof is used.
+	ProofSubmitter *common.Address
+
+	// TargetChain is the L2 chain, whose L1 contracts are used.
+	TargetChain *chain.Chain
+
+	// Contracts overrides the L1 contracts of TargetChain.
+	Contracts *L1Contracts
+
+	// Chain optionally overrides the client's (L1) chain for chain ID validation.
+	Chain *chain.Chain
+
+	// L1 transaction fields
+	Gas                  *big.Int
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Nonce                *int
+}
+
+// FinalizeWithdrawal finalizes a proven withdrawal on L1 through the
+// OptimismPortal, executing its L1 call and returning the L1 transaction
+// hash.
+//
+// This is equivalent to viem's op-stack `finalizeWithdrawal` action.
+func FinalizeWithdrawal(ctx context.Context, client wallet.Client, params FinalizeWithdrawalParameters) (string, error) {
+	contracts, err := resolveL1Contracts(params.Contracts, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+
+	functionName := "finalizeWithdrawalTransaction"
+	args := []any{params.Withdrawal.tuple()}
+	if params.ProofSubmitter != nil {
+		functionName = "finalizeWithdrawalTransactionExternalProof"
+		args = append(args, *params.ProofSubmitter)
+	}
+
+	return wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:              params.Account,
+		Address:              contracts.Portal.Hex(),
+		ABI:                  portalABI,
+		FunctionName:         functionName,
+		Args:                 args,
+		Chain:                params.Chain,
+		Gas:                  params.Gas,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		Nonce:                params.Nonce,
+	})
+}
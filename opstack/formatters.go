@@ -0,0 +1,34 @@
+package opstack
+
+import (
+	"github.com/ChefBingbong/viem-go/opstack/chainconfig"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// ErrDepositNotSignable is returned when a deposit transaction is serialized
+// with a signature. Deposits are derived from L1 and never signed.
+var ErrDepositNotSignable = chainconfig.ErrDepositNotSignable
+
+// TransactionFields are the OP Stack fields of a deposit transaction. See
+// chainconfig.TransactionFields.
+type TransactionFields = chainconfig.TransactionFields
+
+// ReceiptFields are the OP Stack fields of a transaction receipt. See
+// chainconfig.ReceiptFields.
+type ReceiptFields = chainconfig.ReceiptFields
+
+// Formatters are the chain formatters of OP Stack chains, as set on the
+// chain definitions.
+var Formatters = chainconfig.Formatters
+
+// Serializers are the chain serializers of OP Stack chains, as set on the
+// chain definitions.
+var Serializers = chainconfig.Serializers
+
+// SerializeTransaction serializes OP Stack transactions: deposits, given as
+// a *DepositTx in tx.ChainFields, are serialized with
+// SerializeDepositTransaction and every other transaction with
+// transaction.SerializeTransaction.
+func SerializeTransaction(tx *transaction.Transaction, signature *transaction.Signature) (string, error) {
+	return chainconfig.SerializeTransaction(tx, signature)
+}
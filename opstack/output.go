@@ -0,0 +1,221 @@
+package opstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/internal/contractread"
+)
+
+// ErrOutputNotFound is returned when no output (or dispute game) covering
+// an L2 block has been proposed on L1 yet.
+var ErrOutputNotFound = errors.New("opstack: output not found")
+
+// Output is an L2 output proposed on L1, either by the L2OutputOracle or, on
+// chains with fault proofs, as the root claim of a dispute game.
+type Output struct {
+	// Index is the L2OutputOracle output index or the dispute game index.
+	Index *big.Int
+	// L2BlockNumber is the L2 block the output commits to.
+	L2BlockNumber uint64
+	// OutputRoot is the proposed output root.
+	OutputRoot common.Hash
+	// Timestamp is the L1 timestamp of the proposal.
+	Timestamp uint64
+}
+
+// GetOutputParameters contains the parameters for the GetOutput action.
+type GetOutputParameters struct {
+	// L2BlockNumber is the L2 block that must be covered by the output.
+	L2BlockNumber uint64
+
+	// TargetChain is the L2 chain, whose L1 contracts are used.
+	TargetChain *chain.Chain
+
+	// Contracts overrides the L1 contracts of TargetChain.
+	Contracts *L1Contracts
+
+	// Limit is the number of recent dispute games searched on chains with
+	// fault proofs. Defaults to 100.
+	Limit uint64
+}
+
+// GetOutput returns the first output proposed on L1 covering an L2 block,
+// which is needed to prove withdrawals initiated in that block. It is run
+// against the L1 and returns ErrOutputNotFound when no such output exists yet.
+//
+// On chains with fault proofs, the most recent dispute games of the respected
+// game type are searched; otherwise the L2OutputOracle is queried.
+//
+// This is equivalent to viem's op-stack `getL2Output` and `getGame` actions.
+func GetOutput(ctx context.Context, client public.Client, params GetOutputParameters) (*Output, error) {
+	contracts, err := resolveL1Contracts(params.Contracts, params.TargetChain)
+	if err != nil {
+		return nil, err
+	}
+	faultProofs, err := usesFaultProofs(ctx, client, contracts)
+	if err != nil {
+		return nil, err
+	}
+	if faultProofs {
+		return getGameOutput(ctx, client, contracts, params)
+	}
+	return getOracleOutput(ctx, client, contracts, params.L2BlockNumber)
+}
+
+// usesFaultProofs reports whether the OptimismPortal is a fault proof portal
+// (version 3 or later).
+func usesFaultProofs(ctx context.Context, client public.Client, contracts L1Contracts) (bool, error) {
+	var version string
+	err := contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.Portal,
+		ABI:          portalABI,
+		FunctionName: "version",
+	}, &version)
+	if err != nil {
+		return false, err
+	}
+	major, _, _ := strings.Cut(version, ".")
+	n, err := strconv.Atoi(major)
+	if err != nil {
+		return false, fmt.Errorf("invalid OptimismPortal version %q", version)
+	}
+	return n >= 3, nil
+}
+
+type gameSearchResult struct {
+	Index     *big.Int
+	Metadata  [32]byte
+	Timestamp uint64
+	RootClaim [32]byte
+	ExtraData []byte
+}
+
+func getGameOutput(ctx context.Context, client public.Client, contracts L1Contracts, params GetOutputParameters) (*Output, error) {
+	if contracts.DisputeGameFactory == (common.Address{}) {
+		return nil, fmt.Errorf("%w: DisputeGameFactory", ErrContractNotFound)
+	}
+
+	var gameType uint32
+	err := contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.Portal,
+		ABI:          portalABI,
+		FunctionName: "respectedGameType",
+	}, &gameType)
+	if err != nil {
+		return nil, err
+	}
+
+	var count *big.Int
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.DisputeGameFactory,
+		ABI:          disputeGameFactoryABI,
+		FunctionName: "gameCount",
+	}, &count)
+	if err != nil {
+		return nil, err
+	}
+	if count.Sign() == 0 {
+		return nil, fmt.Errorf("%w: no dispute games for L2 block %d", ErrOutputNotFound, params.L2BlockNumber)
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = 100
+	}
+	var games []gameSearchResult
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.DisputeGameFactory,
+		ABI:          disputeGameFactoryABI,
+		FunctionName: "findLatestGames",
+		Args:         []any{gameType, new(big.Int).Sub(count, big.NewInt(1)), new(big.Int).SetUint64(limit)},
+	}, &games)
+	if err != nil {
+		return nil, err
+	}
+
+	// Games are returned newest first; pick the oldest one covering the block.
+	var found *Output
+	for _, game := range games {
+		if len(game.ExtraData) < 32 {
+			continue
+		}
+		l2BlockNumber := new(big.Int).SetBytes(game.ExtraData[:32])
+		if !l2BlockNumber.IsUint64() || l2BlockNumber.Uint64() < params.L2BlockNumber {
+			continue
+		}
+		found = &Output{
+			Index:         game.Index,
+			L2BlockNumber: l2BlockNumber.Uint64(),
+			OutputRoot:    game.RootClaim,
+			Timestamp:     game.Timestamp,
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: no dispute game for L2 block %d", ErrOutputNotFound, params.L2BlockNumber)
+	}
+	return found, nil
+}
+
+type outputProposal struct {
+	OutputRoot    [32]byte
+	Timestamp     *big.Int
+	L2BlockNumber *big.Int
+}
+
+func getOracleOutput(ctx context.Context, client public.Client, contracts L1Contracts, l2BlockNumber uint64) (*Output, error) {
+	if contracts.L2OutputOracle == (common.Address{}) {
+		return nil, fmt.Errorf("%w: L2OutputOracle", ErrContractNotFound)
+	}
+
+	var latest *big.Int
+	err := contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.L2OutputOracle,
+		ABI:          l2OutputOracleABI,
+		FunctionName: "latestBlockNumber",
+	}, &latest)
+	if err != nil {
+		return nil, err
+	}
+	if latest.Cmp(new(big.Int).SetUint64(l2BlockNumber)) < 0 {
+		return nil, fmt.Errorf("%w: latest output is for L2 block %s, need %d", ErrOutputNotFound, latest, l2BlockNumber)
+	}
+
+	var index *big.Int
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.L2OutputOracle,
+		ABI:          l2OutputOracleABI,
+		FunctionName: "getL2OutputIndexAfter",
+		Args:         []any{new(big.Int).SetUint64(l2BlockNumber)},
+	}, &index)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single tuple output is decoded into the first field of a struct.
+	var result struct{ Proposal outputProposal }
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.L2OutputOracle,
+		ABI:          l2OutputOracleABI,
+		FunctionName: "getL2Output",
+		Args:         []any{index},
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	proposal := result.Proposal
+	return &Output{
+		Index:         index,
+		L2BlockNumber: proposal.L2BlockNumber.Uint64(),
+		OutputRoot:    proposal.OutputRoot,
+		Timestamp:     proposal.Timestamp.Uint64(),
+	}, nil
+}
@@ -0,0 +1,184 @@
+package opstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// ErrOutputRootMismatch is returned when the output root recomputed from L2
+// state does not match the output proposed on L1.
+var ErrOutputRootMismatch = errors.New("opstack: output root mismatch")
+
+// OutputRootProof is the preimage of an output root:
+// keccak256(version ++ stateRoot ++ messagePasserStorageRoot ++ latestBlockhash).
+type OutputRootProof struct {
+	Version                  common.Hash
+	StateRoot                common.Hash
+	MessagePasserStorageRoot common.Hash
+	LatestBlockhash          common.Hash
+}
+
+// Hash returns the output root committed to by the proof.
+func (p OutputRootProof) Hash() common.Hash {
+	return crypto.Keccak256Hash(p.Version[:], p.StateRoot[:], p.MessagePasserStorageRoot[:], p.LatestBlockhash[:])
+}
+
+// ProveWithdrawalRequest is everything needed to prove a withdrawal on L1.
+type ProveWithdrawalRequest struct {
+	Withdrawal Withdrawal
+	// OutputIndex is the L2OutputOracle output index or the dispute game index.
+	OutputIndex     *big.Int
+	OutputRootProof OutputRootProof
+	// WithdrawalProof is the storage proof of the withdrawal in the
+	// L2ToL1MessagePasser sentMessages mapping.
+	WithdrawalProof [][]byte
+}
+
+// BuildProveWithdrawalParameters contains the parameters for the
+// BuildProveWithdrawal action.
+type BuildProveWithdrawalParameters struct {
+	// Withdrawal is the withdrawal to prove, e.g. from GetWithdrawals.
+	Withdrawal Withdrawal
+
+	// Output is the L1 output covering the withdrawal's L2 block, from GetOutput.
+	Output Output
+}
+
+// BuildProveWithdrawal builds the proof of a withdrawal against an output
+// proposed on L1. It is run against the L2, reading the L2ToL1MessagePasser
+// storage proof and the header of the output's L2 block.
+//
+// This is equivalent to viem's op-stack `buildProveWithdrawal` action.
+//
+// Example:
+//
+//	output, err := opstack.GetOutput(ctx, mainnetClient, opstack.GetOutputParameters{
+//	    L2BlockNumber: receipt.BlockNumber,
+//	    TargetChain:   definitions.Optimism,
+//	})
+//	request, err := opstack.BuildProveWithdrawal(ctx, opClient, opstack.BuildProveWithdrawalParameters{
+//	    Withdrawal: withdrawals[0],
+//	    Output:     *output,
+//	})
+func BuildProveWithdrawal(ctx context.Context, client public.Client, params BuildProveWithdrawalParameters) (*ProveWithdrawalRequest, error) {
+	withdrawal := params.Withdrawal
+	if withdrawal.WithdrawalHash == (common.Hash{}) {
+		hash, err := HashWithdrawal(&withdrawal)
+		if err != nil {
+			return nil, err
+		}
+		withdrawal.WithdrawalHash = hash
+	}
+
+	// sentMessages is the first storage slot of the L2ToL1MessagePasser.
+	slot := crypto.Keccak256Hash(withdrawal.WithdrawalHash[:], make([]byte, 32))
+	blockNumber := params.Output.L2BlockNumber
+
+	proof, err := public.GetProof(ctx, client, public.GetProofParameters{
+		Address:     L2ToL1MessagePasserAddress,
+		StorageKeys: []common.Hash{slot},
+		BlockNumber: &blockNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(proof.StorageProof) != 1 {
+		return nil, fmt.Errorf("expected 1 storage proof, got %d", len(proof.StorageProof))
+	}
+	storage := proof.StorageProof[0]
+	if storage.Value == nil || storage.Value.Sign() == 0 {
+		return nil, fmt.Errorf("%w: %s not sent in L2 block %d", ErrWithdrawalNotFound, withdrawal.WithdrawalHash.Hex(), blockNumber)
+	}
+
+	block, err := public.GetBlock(ctx, client, public.GetBlockParameters{BlockNumber: &blockNumber})
+	if err != nil {
+		return nil, err
+	}
+
+	rootProof := OutputRootProof{
+		StateRoot:                block.StateRoot,
+		MessagePasserStorageRoot: common.HexToHash(proof.StorageHash),
+		LatestBlockhash:          block.Hash,
+	}
+	if params.Output.OutputRoot != (common.Hash{}) && rootProof.Hash() != params.Output.OutputRoot {
+		return nil, fmt.Errorf("%w: computed %s, proposed %s", ErrOutputRootMismatch, rootProof.Hash().Hex(), params.Output.OutputRoot.Hex())
+	}
+
+	withdrawalProof := make([][]byte, len(storage.Proof))
+	for i, node := range storage.Proof {
+		withdrawalProof[i] = common.FromHex(node)
+	}
+
+	return &ProveWithdrawalRequest{
+		Withdrawal:      withdrawal,
+		OutputIndex:     params.Output.Index,
+		OutputRootProof: rootProof,
+		WithdrawalProof: withdrawalProof,
+	}, nil
+}
+
+// ProveWithdrawalParameters contains the parameters for the ProveWithdrawal
+// action.
+type ProveWithdrawalParameters struct {
+	// Account is the L1 account to send from. If nil, uses the client's account.
+	Account wallet.Account
+
+	// Request is the proof, from BuildProveWithdrawal.
+	Request ProveWithdrawalRequest
+
+	// TargetChain is the L2 chain, whose L1 contracts are used.
+	TargetChain *chain.Chain
+
+	// Contracts overrides the L1 contracts of TargetChain.
+	Contracts *L1Contracts
+
+	// Chain optionally overrides the client's (L1) chain for chain ID validation.
+	Chain *chain.Chain
+
+	// L1 transaction fields
+	Gas                  *big.Int
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Nonce                *int
+}
+
+// ProveWithdrawal proves a withdrawal on L1 through the OptimismPortal,
+// returning the L1 transaction hash. The withdrawal can be finalized once
+// the proof maturity delay has passed (see GetWithdrawalStatus).
+//
+// This is equivalent to viem's op-stack `proveWithdrawal` action.
+func ProveWithdrawal(ctx context.Context, client wallet.Client, params ProveWithdrawalParameters) (string, error) {
+	contracts, err := resolveL1Contracts(params.Contracts, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+	request := params.Request
+	return wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:      params.Account,
+		Address:      contracts.Portal.Hex(),
+		ABI:          portalABI,
+		FunctionName: "proveWithdrawalTransaction",
+		Args: []any{
+			request.Withdrawal.tuple(),
+			bigOrZero(request.OutputIndex),
+			request.OutputRootProof,
+			request.WithdrawalProof,
+		},
+		Chain:                params.Chain,
+		Gas:                  params.Gas,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		Nonce:                params.Nonce,
+	})
+}
@@ -0,0 +1,13 @@
+package opstack_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOpstack(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OP Stack Suite")
+}
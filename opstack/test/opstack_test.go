@@ -0,0 +1,870 @@
+package opstack_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/accounts"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/internal/rpctest"
+	"github.com/ChefBingbong/viem-go/opstack"
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+const testPrivateKey = "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcab78f4c6f2c5ff80"
+
+var (
+	sourceAddr = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	targetAddr = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	gameAddr   = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+var (
+	gasPriceOracleABI = abi.MustParseAbi([]string{
+		"function getL1Fee(bytes _data) view returns (uint256)",
+	})
+	messagePasserABI = abi.MustParseAbi([]string{
+		"function initiateWithdrawal(address _target, uint256 _gasLimit, bytes _data) payable",
+		"event MessagePassed(uint256 indexed nonce, address indexed sender, address indexed target, uint256 value, uint256 gasLimit, bytes data, bytes32 withdrawalHash)",
+	})
+	portalABI = abi.MustParseAbi([]string{
+		"struct WithdrawalTransaction { uint256 nonce; address sender; address target; uint256 value; uint256 gasLimit; bytes data; }",
+		"struct OutputRootProof { bytes32 version; bytes32 stateRoot; bytes32 messagePasserStorageRoot; bytes32 latestBlockhash; }",
+		"function version() view returns (string)",
+		"function depositTransaction(address _to, uint256 _value, uint64 _gasLimit, bool _isCreation, bytes _data) payable",
+		"function proveWithdrawalTransaction(WithdrawalTransaction _tx, uint256 _disputeGameIndex, OutputRootProof _outputRootProof, bytes[] _withdrawalProof)",
+		"function finalizeWithdrawalTransaction(WithdrawalTransaction _tx)",
+		"function finalizeWithdrawalTransactionExternalProof(WithdrawalTransaction _tx, address _proofSubmitter)",
+		"function finalizedWithdrawals(bytes32) view returns (bool)",
+		"function respectedGameType() view returns (uint32)",
+		"function disputeGameBlacklist(address) view returns (bool)",
+		"function proofMaturityDelaySeconds() view returns (uint256)",
+		"function numProofSubmitters(bytes32 _withdrawalHash) view returns (uint256)",
+		"function proofSubmitters(bytes32, uint256) view returns (address)",
+		"event TransactionDeposited(address indexed from, address indexed to, uint256 indexed version, bytes opaqueData)",
+	})
+	legacyPortalABI = abi.MustParseAbi([]string{
+		"function provenWithdrawals(bytes32) view returns (bytes32 outputRoot, uint128 timestamp, uint128 l2OutputIndex)",
+	})
+	faultProofPortalABI = abi.MustParseAbi([]string{
+		"function provenWithdrawals(bytes32, address) view returns (address disputeGameProxy, uint64 timestamp)",
+	})
+	outputOracleABI = abi.MustParseAbi([]string{
+		"struct OutputProposal { bytes32 outputRoot; uint128 timestamp; uint128 l2BlockNumber; }",
+		"function latestBlockNumber() view returns (uint256)",
+		"function getL2OutputIndexAfter(uint256 _l2BlockNumber) view returns (uint256)",
+		"function getL2Output(uint256 _l2OutputIndex) view returns (OutputProposal)",
+		"function FINALIZATION_PERIOD_SECONDS() view returns (uint256)",
+	})
+	gameFactoryABI = abi.MustParseAbi([]string{
+		"struct GameSearchResult { uint256 index; bytes32 metadata; uint64 timestamp; bytes32 rootClaim; bytes extraData; }",
+		"function gameCount() view returns (uint256)",
+		"function findLatestGames(uint32 _gameType, uint256 _start, uint256 _n) view returns (GameSearchResult[] games_)",
+	})
+	disputeGameABI = abi.MustParseAbi([]string{
+		"function status() view returns (uint8)",
+		"function gameType() view returns (uint32)",
+	})
+)
+
+// fakeNode is a JSON-RPC node serving stubbed contract calls, blocks and
+// proofs, and recording raw transactions.
+type fakeNode struct {
+	*rpctest.Node
+	mu        sync.Mutex
+	blocks    map[uint64]map[string]any
+	latest    uint64
+	proof     map[string]any
+	proofKeys []string
+}
+
+func newFakeNode(chainID uint64) *fakeNode {
+	n := &fakeNode{
+		Node:   rpctest.NewNode(chainID),
+		blocks: map[uint64]map[string]any{},
+	}
+	n.Handle("eth_getBlockByNumber", n.getBlockByNumber)
+	n.Handle("eth_getProof", n.getProof)
+	return n
+}
+
+func (n *fakeNode) addBlock(number, timestamp uint64, hash, stateRoot common.Hash) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.blocks[number] = map[string]any{
+		"number":        hexutil.EncodeUint64(number),
+		"hash":          hash.Hex(),
+		"parentHash":    common.Hash{}.Hex(),
+		"stateRoot":     stateRoot.Hex(),
+		"timestamp":     hexutil.EncodeUint64(timestamp),
+		"baseFeePerGas": "0x1",
+		"gasLimit":      "0x1c9c380",
+		"gasUsed":       "0x0",
+		"transactions":  []any{},
+	}
+	if number > n.latest {
+		n.latest = number
+	}
+}
+
+func (n *fakeNode) getBlockByNumber(params []json.RawMessage) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var tag string
+	_ = json.Unmarshal(params[0], &tag)
+	number := n.latest
+	if tag != "latest" && tag != "pending" {
+		number, _ = hexutil.DecodeUint64(tag)
+	}
+	block, ok := n.blocks[number]
+	if !ok {
+		return nil, nil
+	}
+	return block, nil
+}
+
+func (n *fakeNode) getProof(params []json.RawMessage) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var keys []string
+	_ = json.Unmarshal(params[1], &keys)
+	n.proofKeys = keys
+	return n.proof, nil
+}
+
+// messagePassedLog builds the MessagePassed log of a withdrawal.
+func messagePassedLog(w opstack.Withdrawal) types.Log {
+	event, err := messagePasserABI.GetEvent("MessagePassed")
+	Expect(err).NotTo(HaveOccurred())
+	params, err := abi.ParseAbiParameters("uint256, uint256, bytes, bytes32")
+	Expect(err).NotTo(HaveOccurred())
+	data, err := abi.EncodeAbiParameters(params, []any{w.Value, w.GasLimit, w.Data, [32]byte(w.WithdrawalHash)})
+	Expect(err).NotTo(HaveOccurred())
+	return types.Log{
+		Address: opstack.L2ToL1MessagePasserAddress,
+		Topics: []common.Hash{
+			event.Topic,
+			common.BigToHash(w.Nonce),
+			common.BytesToHash(w.Sender.Bytes()),
+			common.BytesToHash(w.Target.Bytes()),
+		},
+		Data: data,
+	}
+}
+
+func testWithdrawal() opstack.Withdrawal {
+	w := opstack.Withdrawal{
+		Nonce:    new(big.Int).Lsh(big.NewInt(1), 240),
+		Sender:   sourceAddr,
+		Target:   targetAddr,
+		Value:    big.NewInt(1_000_000),
+		GasLimit: big.NewInt(21_000),
+		Data:     []byte{0xde, 0xad},
+	}
+	hash, err := opstack.HashWithdrawal(&w)
+	Expect(err).NotTo(HaveOccurred())
+	w.WithdrawalHash = hash
+	return w
+}
+
+func withdrawalReceipt(w opstack.Withdrawal, blockNumber uint64) *types.Receipt {
+	return &types.Receipt{
+		TransactionHash: common.HexToHash("0xabc"),
+		BlockNumber:     blockNumber,
+		From:            w.Sender,
+		Logs:            []types.Log{messagePassedLog(w)},
+	}
+}
+
+var _ = Describe("Deposit transactions", func() {
+	to := targetAddr
+	deposit := &opstack.DepositTx{
+		SourceHash: common.HexToHash("0x01"),
+		From:       sourceAddr,
+		To:         &to,
+		Mint:       big.NewInt(1_000),
+		Value:      big.NewInt(500),
+		Gas:        100_000,
+		Data:       []byte{0x12, 0x34},
+	}
+
+	It("serializes and parses deposits", func() {
+		serialized, err := opstack.SerializeDepositTransaction(deposit)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serialized[:4]).To(Equal("0x7e"))
+
+		parsed, err := opstack.ParseDepositTransaction(serialized)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(deposit))
+
+		hash, err := deposit.Hash()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hash).To(Equal(crypto.Keccak256Hash(common.FromHex(serialized))))
+	})
+
+	It("serializes contract creations without a recipient", func() {
+		creation := *deposit
+		creation.To = nil
+		serialized, err := opstack.SerializeDepositTransaction(&creation)
+		Expect(err).NotTo(HaveOccurred())
+		parsed, err := opstack.ParseDepositTransaction(serialized)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed.To).To(BeNil())
+	})
+
+	It("rejects other transaction types", func() {
+		_, err := opstack.ParseDepositTransaction("0x02c0")
+		Expect(errors.Is(err, opstack.ErrInvalidDepositTransaction)).To(BeTrue())
+	})
+
+	It("derives L2 transaction hashes from TransactionDeposited logs", func() {
+		event, err := portalABI.GetEvent("TransactionDeposited")
+		Expect(err).NotTo(HaveOccurred())
+
+		var opaque []byte
+		opaque = append(opaque, common.BigToHash(deposit.Mint).Bytes()...)
+		opaque = append(opaque, common.BigToHash(deposit.Value).Bytes()...)
+		opaque = append(opaque, common.BigToHash(new(big.Int).SetUint64(deposit.Gas)).Bytes()[24:]...)
+		opaque = append(opaque, 0)
+		opaque = append(opaque, deposit.Data...)
+		params, err := abi.ParseAbiParameters("bytes")
+		Expect(err).NotTo(HaveOccurred())
+		data, err := abi.EncodeAbiParameters(params, []any{opaque})
+		Expect(err).NotTo(HaveOccurred())
+
+		l1BlockHash := common.HexToHash("0xbeef")
+		receipt := &types.Receipt{Logs: []types.Log{
+			{Address: targetAddr, Topics: []common.Hash{common.HexToHash("0x99")}},
+			{
+				Topics: []common.Hash{
+					event.Topic,
+					common.BytesToHash(sourceAddr.Bytes()),
+					common.BytesToHash(targetAddr.Bytes()),
+					{},
+				},
+				Data:      data,
+				BlockHash: l1BlockHash,
+				LogIndex:  3,
+			},
+		}}
+
+		txs, err := opstack.GetL2Transactions(receipt)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(txs).To(HaveLen(1))
+
+		depositID := crypto.Keccak256(l1BlockHash.Bytes(), common.BigToHash(big.NewInt(3)).Bytes())
+		expected := *deposit
+		expected.SourceHash = crypto.Keccak256Hash(make([]byte, 32), depositID)
+		Expect(txs[0]).To(Equal(&expected))
+
+		hashes, err := opstack.GetL2TransactionHashes(receipt)
+		Expect(err).NotTo(HaveOccurred())
+		expectedHash, err := expected.Hash()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hashes).To(Equal([]common.Hash{expectedHash}))
+	})
+})
+
+var _ = Describe("Formatters", func() {
+	It("decodes deposit transaction fields", func() {
+		fields, err := opstack.Formatters.Transaction(json.RawMessage(`{
+			"type": "0x7e",
+			"sourceHash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+			"mint": "0x64",
+			"isSystemTx": false
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fields).To(Equal(&opstack.TransactionFields{
+			SourceHash: common.HexToHash("0x01"),
+			Mint:       big.NewInt(100),
+		}))
+
+		fields, err = opstack.Formatters.Transaction(json.RawMessage(`{"type": "0x2"}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fields).To(BeNil())
+	})
+
+	It("decodes receipt L1 fee fields", func() {
+		fields, err := opstack.Formatters.TransactionReceipt(json.RawMessage(`{
+			"l1GasPrice": "0x10",
+			"l1GasUsed": "0x20",
+			"l1Fee": "0x200",
+			"l1FeeScalar": "0.684"
+		}`))
+		Expect(err).NotTo(HaveOccurred())
+		receipt := fields.(*opstack.ReceiptFields)
+		Expect(receipt.L1GasPrice).To(Equal(big.NewInt(16)))
+		Expect(receipt.L1GasUsed).To(Equal(big.NewInt(32)))
+		Expect(receipt.L1Fee).To(Equal(big.NewInt(512)))
+		Expect(*receipt.L1FeeScalar).To(Equal(0.684))
+		Expect(receipt.DepositNonce).To(BeNil())
+	})
+
+	It("serializes deposits and delegates other transactions", func() {
+		deposit := &opstack.DepositTx{SourceHash: common.HexToHash("0x01"), From: sourceAddr, Gas: 21_000}
+		expected, err := opstack.SerializeDepositTransaction(deposit)
+		Expect(err).NotTo(HaveOccurred())
+		serialized, err := opstack.SerializeTransaction(&transaction.Transaction{ChainFields: deposit}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serialized).To(Equal(expected))
+
+		_, err = opstack.SerializeTransaction(&transaction.Transaction{ChainFields: deposit}, &transaction.Signature{})
+		Expect(errors.Is(err, opstack.ErrDepositNotSignable)).To(BeTrue())
+
+		tx := &transaction.Transaction{
+			Type:         transaction.TransactionTypeEIP1559,
+			ChainId:      10,
+			To:           targetAddr.Hex(),
+			Gas:          big.NewInt(21_000),
+			MaxFeePerGas: big.NewInt(1),
+		}
+		expected, err = transaction.SerializeTransaction(tx, nil)
+		Expect(err).NotTo(HaveOccurred())
+		serialized, err = opstack.SerializeTransaction(tx, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serialized).To(Equal(expected))
+	})
+
+	It("is set on OP Stack chain definitions", func() {
+		deposit := json.RawMessage(`{"type": "0x7e", "isSystemTx": true}`)
+		for _, ch := range []chain.Chain{definitions.Optimism, definitions.OptimismSepolia, definitions.Base, definitions.BaseSepolia} {
+			fields, err := ch.Formatters.Transaction(deposit)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fields).To(Equal(&opstack.TransactionFields{IsSystemTx: true}))
+			_, err = ch.Serializers.Transaction(&transaction.Transaction{ChainFields: &opstack.DepositTx{}}, &transaction.Signature{})
+			Expect(errors.Is(err, opstack.ErrDepositNotSignable)).To(BeTrue())
+		}
+	})
+})
+
+var _ = Describe("Fee estimation", func() {
+	var (
+		ctx   context.Context
+		node  *fakeNode
+		pc    *client.PublicClient
+		l1Arg []byte
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		node = newFakeNode(10)
+		node.addBlock(1, 1_000, common.HexToHash("0xb1"), common.Hash{})
+		node.Stub(opstack.GasPriceOracleAddress, gasPriceOracleABI, map[string]func([]any) []any{
+			"getL1Fee": func(args []any) []any {
+				l1Arg = args[0].([]byte)
+				return []any{big.NewInt(1_234)}
+			},
+		})
+		var err error
+		pc, err = client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(node.URL)})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		node.Close()
+	})
+
+	params := func() opstack.EstimateL1FeeParameters {
+		gas := uint64(50_000)
+		nonce := uint64(4)
+		return opstack.EstimateL1FeeParameters{
+			Account:              &sourceAddr,
+			To:                   &targetAddr,
+			Value:                big.NewInt(1),
+			Gas:                  &gas,
+			Nonce:                &nonce,
+			MaxFeePerGas:         big.NewInt(3),
+			MaxPriorityFeePerGas: big.NewInt(1),
+		}
+	}
+
+	It("prices the serialized transaction with the GasPriceOracle", func() {
+		fee, err := opstack.EstimateL1Fee(ctx, pc, params())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fee).To(Equal(big.NewInt(1_234)))
+
+		// The oracle prices the unsigned EIP-1559 payload.
+		Expect(l1Arg[0]).To(Equal(byte(gethtypes.DynamicFeeTxType)))
+		var fields []rlp.RawValue
+		Expect(rlp.DecodeBytes(l1Arg[1:], &fields)).To(Succeed())
+		var chainID, nonce, gas uint64
+		var to common.Address
+		Expect(rlp.DecodeBytes(fields[0], &chainID)).To(Succeed())
+		Expect(rlp.DecodeBytes(fields[1], &nonce)).To(Succeed())
+		Expect(rlp.DecodeBytes(fields[4], &gas)).To(Succeed())
+		Expect(rlp.DecodeBytes(fields[5], &to)).To(Succeed())
+		Expect([]uint64{chainID, nonce, gas}).To(Equal([]uint64{10, 4, 50_000}))
+		Expect(to).To(Equal(targetAddr))
+	})
+
+	It("adds the L2 execution fee for the total fee", func() {
+		fee, err := opstack.EstimateTotalFee(ctx, pc, params())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fee).To(Equal(big.NewInt(1_234 + 50_000*3)))
+	})
+
+	It("estimates missing gas and fees from the L2", func() {
+		fee, err := opstack.EstimateTotalFee(ctx, pc, opstack.EstimateTotalFeeParameters{
+			Account: &sourceAddr,
+			To:      &targetAddr,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fee.Cmp(big.NewInt(1_234 + 21_000))).To(BeNumerically(">", 0))
+	})
+})
+
+var _ = Describe("Bridge transactions", func() {
+	var (
+		ctx      context.Context
+		node     *fakeNode
+		wallet   *client.WalletClient
+		portal   common.Address
+		l1Chain  = &chain.Chain{ID: 1, Name: "L1"}
+		opClient *client.PublicClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		node = newFakeNode(1)
+		node.addBlock(1, 1_000, common.HexToHash("0xb1"), common.Hash{})
+		contracts, ok := opstack.GetL1Contracts(10)
+		Expect(ok).To(BeTrue())
+		portal = contracts.Portal
+
+		account, err := accounts.PrivateKeyToAccount(testPrivateKey)
+		Expect(err).NotTo(HaveOccurred())
+		wallet, err = client.CreateWalletClient(client.WalletClientConfig{
+			Account:   account,
+			Chain:     l1Chain,
+			Transport: transport.HTTP(node.URL),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		opClient, err = client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(node.URL)})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		node.Close()
+	})
+
+	decodeCall := func(a *abi.ABI, tx *gethtypes.Transaction) *abi.DecodedFunctionData {
+		decoded, err := a.DecodeFunctionData(tx.Data())
+		Expect(err).NotTo(HaveOccurred())
+		return decoded
+	}
+
+	It("builds deposits, estimating L2 gas", func() {
+		request, err := opstack.BuildDepositTransaction(ctx, opClient, opstack.BuildDepositTransactionParameters{
+			Account: &sourceAddr,
+			To:      targetAddr,
+			Mint:    big.NewInt(7),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(request).To(Equal(&opstack.DepositRequest{To: targetAddr, Mint: big.NewInt(7), Gas: 21_000}))
+
+		gas := uint64(99)
+		request, err = opstack.BuildDepositTransaction(ctx, opClient, opstack.BuildDepositTransactionParameters{
+			To:  targetAddr,
+			Gas: &gas,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(request.Gas).To(Equal(uint64(99)))
+	})
+
+	It("deposits through the OptimismPortal of the target chain", func() {
+		_, err := opstack.DepositTransaction(ctx, wallet, opstack.DepositTransactionParameters{
+			Request: opstack.DepositRequest{
+				To:    targetAddr,
+				Mint:  big.NewInt(1_000),
+				Value: big.NewInt(400),
+				Gas:   50_000,
+				Data:  []byte{0x01},
+			},
+			TargetChain: &definitions.Optimism,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		sent := node.SentTxs()
+		Expect(sent).To(HaveLen(1))
+		Expect(*sent[0].To()).To(Equal(portal))
+		Expect(sent[0].Value()).To(Equal(big.NewInt(1_000)))
+		call := decodeCall(portalABI, sent[0])
+		Expect(call.FunctionName).To(Equal("depositTransaction"))
+		Expect(call.Args).To(Equal([]any{targetAddr, big.NewInt(400), uint64(50_000), false, []byte{0x01}}))
+	})
+
+	It("requires known L1 contracts or an override", func() {
+		_, err := opstack.DepositTransaction(ctx, wallet, opstack.DepositTransactionParameters{
+			TargetChain: &chain.Chain{ID: 12345},
+		})
+		Expect(errors.Is(err, opstack.ErrContractNotFound)).To(BeTrue())
+
+		override := opstack.L1Contracts{Portal: targetAddr}
+		_, err = opstack.DepositTransaction(ctx, wallet, opstack.DepositTransactionParameters{
+			Request:   opstack.DepositRequest{To: targetAddr, Gas: 21_000},
+			Contracts: &override,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*node.SentTxs()[0].To()).To(Equal(targetAddr))
+	})
+
+	It("initiates withdrawals through the L2ToL1MessagePasser", func() {
+		_, err := opstack.InitiateWithdrawal(ctx, wallet, opstack.InitiateWithdrawalParameters{
+			Request: opstack.WithdrawalRequest{To: targetAddr, Value: big.NewInt(5), Gas: 100_000},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		sent := node.SentTxs()
+		Expect(sent).To(HaveLen(1))
+		Expect(*sent[0].To()).To(Equal(opstack.L2ToL1MessagePasserAddress))
+		Expect(sent[0].Value()).To(Equal(big.NewInt(5)))
+		call := decodeCall(messagePasserABI, sent[0])
+		Expect(call.Args).To(Equal([]any{targetAddr, big.NewInt(100_000), []byte{}}))
+	})
+
+	It("proves and finalizes withdrawals", func() {
+		w := testWithdrawal()
+		request := opstack.ProveWithdrawalRequest{
+			Withdrawal:      w,
+			OutputIndex:     big.NewInt(9),
+			OutputRootProof: opstack.OutputRootProof{StateRoot: common.HexToHash("0x5")},
+			WithdrawalProof: [][]byte{{0xaa}, {0xbb}},
+		}
+		_, err := opstack.ProveWithdrawal(ctx, wallet, opstack.ProveWithdrawalParameters{
+			Request:     request,
+			TargetChain: &definitions.Optimism,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		submitter := sourceAddr
+		_, err = opstack.FinalizeWithdrawal(ctx, wallet, opstack.FinalizeWithdrawalParameters{
+			Withdrawal:  w,
+			TargetChain: &definitions.Optimism,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = opstack.FinalizeWithdrawal(ctx, wallet, opstack.FinalizeWithdrawalParameters{
+			Withdrawal:     w,
+			ProofSubmitter: &submitter,
+			TargetChain:    &definitions.Optimism,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		sent := node.SentTxs()
+		Expect(sent).To(HaveLen(3))
+		prove := decodeCall(portalABI, sent[0])
+		Expect(prove.FunctionName).To(Equal("proveWithdrawalTransaction"))
+		Expect(prove.Args[1]).To(Equal(big.NewInt(9)))
+		Expect(prove.Args[3]).To(Equal([][]byte{{0xaa}, {0xbb}}))
+		Expect(decodeCall(portalABI, sent[1]).FunctionName).To(Equal("finalizeWithdrawalTransaction"))
+		external := decodeCall(portalABI, sent[2])
+		Expect(external.FunctionName).To(Equal("finalizeWithdrawalTransactionExternalProof"))
+		Expect(external.Args[1]).To(Equal(submitter))
+	})
+})
+
+var _ = Describe("Withdrawals", func() {
+	It("parses MessagePassed logs", func() {
+		w := testWithdrawal()
+		receipt := withdrawalReceipt(w, 150)
+		foreign := messagePassedLog(w)
+		foreign.Address = targetAddr
+		receipt.Logs = append(receipt.Logs, foreign)
+
+		withdrawals, err := opstack.GetWithdrawals(receipt)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(withdrawals).To(Equal([]opstack.Withdrawal{w}))
+	})
+
+	It("hashes every withdrawal field", func() {
+		w := testWithdrawal()
+		changed := w
+		changed.Data = []byte{0xbe, 0xef}
+		hash, err := opstack.HashWithdrawal(&changed)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hash).NotTo(Equal(w.WithdrawalHash))
+	})
+})
+
+var _ = Describe("Outputs and withdrawal status", func() {
+	var (
+		ctx       context.Context
+		node      *fakeNode
+		pc        *client.PublicClient
+		contracts opstack.L1Contracts
+		w         opstack.Withdrawal
+		receipt   *types.Receipt
+
+		version     string
+		finalized   bool
+		provenAt    uint64
+		gameStatus  uint8
+		gameType    uint32
+		blacklisted bool
+		submitters  []common.Address
+		checkedFrom common.Address
+	)
+
+	const (
+		now           = 10_000
+		maturityDelay = 1_000
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		node = newFakeNode(1)
+		node.addBlock(500, now, common.HexToHash("0xb1"), common.Hash{})
+		contracts, _ = opstack.GetL1Contracts(10)
+		w = testWithdrawal()
+		receipt = withdrawalReceipt(w, 150)
+
+		version, finalized, provenAt, gameStatus, submitters = "2.8.0", false, 0, 0, nil
+		gameType, blacklisted = 0, false
+
+		node.Stub(contracts.Portal, portalABI, map[string]func([]any) []any{
+			"version":                   func([]any) []any { return []any{version} },
+			"finalizedWithdrawals":      func([]any) []any { return []any{finalized} },
+			"respectedGameType":         rpctest.Returns(uint32(0)),
+			"disputeGameBlacklist":      func([]any) []any { return []any{blacklisted} },
+			"proofMaturityDelaySeconds": rpctest.Returns(big.NewInt(maturityDelay)),
+			"numProofSubmitters":        func([]any) []any { return []any{big.NewInt(int64(len(submitters)))} },
+			"proofSubmitters": func(args []any) []any {
+				return []any{submitters[args[1].(*big.Int).Int64()]}
+			},
+		})
+		node.Stub(contracts.Portal, legacyPortalABI, map[string]func([]any) []any{
+			"provenWithdrawals": func([]any) []any {
+				return []any{[32]byte{}, new(big.Int).SetUint64(provenAt), big.NewInt(7)}
+			},
+		})
+		node.Stub(contracts.Portal, faultProofPortalABI, map[string]func([]any) []any{
+			"provenWithdrawals": func(args []any) []any {
+				checkedFrom = args[1].(common.Address)
+				return []any{gameAddr, provenAt}
+			},
+		})
+		node.Stub(contracts.L2OutputOracle, outputOracleABI, map[string]func([]any) []any{
+			"latestBlockNumber":     rpctest.Returns(big.NewInt(200)),
+			"getL2OutputIndexAfter": rpctest.Returns(big.NewInt(7)),
+			"getL2Output": rpctest.Returns(struct {
+				OutputRoot    [32]byte
+				Timestamp     *big.Int
+				L2BlockNumber *big.Int
+			}{common.HexToHash("0x0707"), big.NewInt(900), big.NewInt(180)}),
+			"FINALIZATION_PERIOD_SECONDS": rpctest.Returns(big.NewInt(maturityDelay)),
+		})
+		type game struct {
+			Index     *big.Int
+			Metadata  [32]byte
+			Timestamp uint64
+			RootClaim [32]byte
+			ExtraData []byte
+		}
+		newGame := func(index int64, l2BlockNumber int64) game {
+			return game{
+				Index:     big.NewInt(index),
+				Timestamp: uint64(index * 100),
+				RootClaim: common.BigToHash(big.NewInt(index)),
+				ExtraData: common.BigToHash(big.NewInt(l2BlockNumber)).Bytes(),
+			}
+		}
+		node.Stub(contracts.DisputeGameFactory, gameFactoryABI, map[string]func([]any) []any{
+			"gameCount": rpctest.Returns(big.NewInt(5)),
+			"findLatestGames": rpctest.Returns([]game{
+				newGame(4, 300), newGame(3, 200), newGame(2, 100),
+			}),
+		})
+		node.Stub(gameAddr, disputeGameABI, map[string]func([]any) []any{
+			"status":   func([]any) []any { return []any{gameStatus} },
+			"gameType": func([]any) []any { return []any{gameType} },
+		})
+
+		var err error
+		pc, err = client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(node.URL)})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		node.Close()
+	})
+
+	status := func() opstack.WithdrawalStatus {
+		s, err := opstack.GetWithdrawalStatus(ctx, pc, opstack.GetWithdrawalStatusParameters{
+			Receipt:     receipt,
+			TargetChain: &definitions.Optimism,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return s
+	}
+
+	Context("with the L2OutputOracle", func() {
+		It("returns the output covering an L2 block", func() {
+			output, err := opstack.GetOutput(ctx, pc, opstack.GetOutputParameters{
+				L2BlockNumber: 150,
+				TargetChain:   &definitions.Optimism,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal(&opstack.Output{
+				Index:         big.NewInt(7),
+				L2BlockNumber: 180,
+				OutputRoot:    common.HexToHash("0x0707"),
+				Timestamp:     900,
+			}))
+
+			_, err = opstack.GetOutput(ctx, pc, opstack.GetOutputParameters{
+				L2BlockNumber: 250,
+				TargetChain:   &definitions.Optimism,
+			})
+			Expect(errors.Is(err, opstack.ErrOutputNotFound)).To(BeTrue())
+		})
+
+		It("tracks the withdrawal through proving and finalization", func() {
+			Expect(status()).To(Equal(opstack.WithdrawalStatusReadyToProve))
+
+			provenAt = now - 10
+			Expect(status()).To(Equal(opstack.WithdrawalStatusWaitingToFinalize))
+
+			provenAt = now - maturityDelay
+			Expect(status()).To(Equal(opstack.WithdrawalStatusReadyToFinalize))
+
+			finalized = true
+			Expect(status()).To(Equal(opstack.WithdrawalStatusFinalized))
+		})
+
+		It("waits to prove until an output is proposed", func() {
+			receipt = withdrawalReceipt(w, 250)
+			Expect(status()).To(Equal(opstack.WithdrawalStatusWaitingToProve))
+		})
+	})
+
+	Context("with fault proofs", func() {
+		BeforeEach(func() {
+			version = "3.10.0"
+		})
+
+		It("returns the oldest dispute game covering an L2 block", func() {
+			output, err := opstack.GetOutput(ctx, pc, opstack.GetOutputParameters{
+				L2BlockNumber: 150,
+				TargetChain:   &definitions.Optimism,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output).To(Equal(&opstack.Output{
+				Index:         big.NewInt(3),
+				L2BlockNumber: 200,
+				OutputRoot:    common.BigToHash(big.NewInt(3)),
+				Timestamp:     300,
+			}))
+
+			_, err = opstack.GetOutput(ctx, pc, opstack.GetOutputParameters{
+				L2BlockNumber: 301,
+				TargetChain:   &definitions.Optimism,
+			})
+			Expect(errors.Is(err, opstack.ErrOutputNotFound)).To(BeTrue())
+		})
+
+		It("requires the dispute game to resolve before finalizing", func() {
+			Expect(status()).To(Equal(opstack.WithdrawalStatusReadyToProve))
+			Expect(checkedFrom).To(Equal(w.Sender))
+
+			submitters = []common.Address{targetAddr}
+			provenAt = now - maturityDelay
+			Expect(status()).To(Equal(opstack.WithdrawalStatusWaitingToFinalize))
+			Expect(checkedFrom).To(Equal(targetAddr))
+
+			gameStatus = 2
+			Expect(status()).To(Equal(opstack.WithdrawalStatusReadyToFinalize))
+		})
+
+		Context("once proven", func() {
+			BeforeEach(func() {
+				provenAt, gameStatus = now-maturityDelay, 2
+				Expect(status()).To(Equal(opstack.WithdrawalStatusReadyToFinalize))
+			})
+
+			It("proves again after the challenger wins the game", func() {
+				gameStatus = 1
+				Expect(status()).To(Equal(opstack.WithdrawalStatusReadyToProve))
+			})
+
+			It("proves again when the game is blacklisted", func() {
+				blacklisted = true
+				Expect(status()).To(Equal(opstack.WithdrawalStatusReadyToProve))
+			})
+
+			It("proves again when the game type is no longer respected", func() {
+				gameType = 1
+				Expect(status()).To(Equal(opstack.WithdrawalStatusReadyToProve))
+			})
+		})
+	})
+
+	Context("building proofs", func() {
+		var (
+			stateRoot   = common.HexToHash("0x5a")
+			storageRoot = common.HexToHash("0x5b")
+			blockHash   = common.HexToHash("0x5c")
+		)
+
+		BeforeEach(func() {
+			node.addBlock(180, 900, blockHash, stateRoot)
+			node.proof = map[string]any{
+				"address":      opstack.L2ToL1MessagePasserAddress.Hex(),
+				"accountProof": []string{},
+				"balance":      "0x0",
+				"codeHash":     common.Hash{}.Hex(),
+				"nonce":        "0x0",
+				"storageHash":  storageRoot.Hex(),
+				"storageProof": []map[string]any{{
+					"key":   "0x01",
+					"proof": []string{"0xaa", "0xbb"},
+					"value": "0x1",
+				}},
+			}
+		})
+
+		It("proves the withdrawal against the output root", func() {
+			rootProof := opstack.OutputRootProof{
+				StateRoot:                stateRoot,
+				MessagePasserStorageRoot: storageRoot,
+				LatestBlockhash:          blockHash,
+			}
+			output := opstack.Output{Index: big.NewInt(7), L2BlockNumber: 180, OutputRoot: rootProof.Hash()}
+
+			request, err := opstack.BuildProveWithdrawal(ctx, pc, opstack.BuildProveWithdrawalParameters{
+				Withdrawal: w,
+				Output:     output,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(request).To(Equal(&opstack.ProveWithdrawalRequest{
+				Withdrawal:      w,
+				OutputIndex:     big.NewInt(7),
+				OutputRootProof: rootProof,
+				WithdrawalProof: [][]byte{{0xaa}, {0xbb}},
+			}))
+			slot := crypto.Keccak256Hash(w.WithdrawalHash.Bytes(), make([]byte, 32))
+			Expect(node.proofKeys).To(Equal([]string{slot.Hex()}))
+
+			output.OutputRoot = common.HexToHash("0xbad")
+			_, err = opstack.BuildProveWithdrawal(ctx, pc, opstack.BuildProveWithdrawalParameters{
+				Withdrawal: w,
+				Output:     output,
+			})
+			Expect(errors.Is(err, opstack.ErrOutputRootMismatch)).To(BeTrue())
+		})
+	})
+})
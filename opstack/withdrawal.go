@@ -0,0 +1,187 @@
+package opstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// ErrWithdrawalNotFound is returned when a receipt contains no withdrawal
+// at the requested index.
+var ErrWithdrawalNotFound = errors.New("opstack: withdrawal not found")
+
+// Withdrawal is an L2 -> L1 withdrawal, as recorded by the
+// L2ToL1MessagePasser MessagePassed event.
+type Withdrawal struct {
+	Nonce    *big.Int
+	Sender   common.Address
+	Target   common.Address
+	Value    *big.Int
+	GasLimit *big.Int
+	Data     []byte
+	// WithdrawalHash is the hash of the other fields, which identifies the
+	// withdrawal on L1.
+	WithdrawalHash common.Hash
+}
+
+var withdrawalHashParams, _ = abi.ParseAbiParameters("uint256, address, address, uint256, uint256, bytes")
+
+// HashWithdrawal returns the withdrawal hash:
+// keccak256(abi.encode(nonce, sender, target, value, gasLimit, data)).
+func HashWithdrawal(w *Withdrawal) (common.Hash, error) {
+	data := w.Data
+	if data == nil {
+		data = []byte{}
+	}
+	enc, err := abi.EncodeAbiParameters(withdrawalHashParams, []any{
+		bigOrZero(w.Nonce), w.Sender, w.Target, bigOrZero(w.Value), bigOrZero(w.GasLimit), data,
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode withdrawal: %w", err)
+	}
+	return crypto.Keccak256Hash(enc), nil
+}
+
+// GetWithdrawals returns the withdrawals initiated in an L2 transaction
+// receipt, e.g. of an InitiateWithdrawal call.
+func GetWithdrawals(receipt *types.Receipt) ([]Withdrawal, error) {
+	event, err := l2ToL1MessagePasserABI.GetEvent("MessagePassed")
+	if err != nil {
+		return nil, err
+	}
+	var withdrawals []Withdrawal
+	for _, log := range receipt.Logs {
+		if len(log.Topics) != 4 || log.Topics[0] != event.Topic || log.Address != L2ToL1MessagePasserAddress {
+			continue
+		}
+		decoded, err := l2ToL1MessagePasserABI.DecodeEventLogByName("MessagePassed", log.Topics, log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode MessagePassed event: %w", err)
+		}
+		w := Withdrawal{
+			Nonce:  new(big.Int).SetBytes(log.Topics[1].Bytes()),
+			Sender: common.BytesToAddress(log.Topics[2].Bytes()),
+			Target: common.BytesToAddress(log.Topics[3].Bytes()),
+		}
+		w.Value, _ = decoded.Args["value"].(*big.Int)
+		w.GasLimit, _ = decoded.Args["gasLimit"].(*big.Int)
+		w.Data, _ = decoded.Args["data"].([]byte)
+		if hash, ok := decoded.Args["withdrawalHash"].([32]byte); ok {
+			w.WithdrawalHash = hash
+		}
+		withdrawals = append(withdrawals, w)
+	}
+	return withdrawals, nil
+}
+
+// getWithdrawal returns the withdrawal at index in a receipt.
+func getWithdrawal(receipt *types.Receipt, index int) (*Withdrawal, error) {
+	withdrawals, err := GetWithdrawals(receipt)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(withdrawals) {
+		return nil, fmt.Errorf("%w: index %d in transaction %s", ErrWithdrawalNotFound, index, receipt.TransactionHash.Hex())
+	}
+	return &withdrawals[index], nil
+}
+
+// WithdrawalRequest is an L2 -> L1 withdrawal to initiate.
+type WithdrawalRequest struct {
+	// To is the L1 target.
+	To common.Address
+	// Value is the ETH to withdraw to To.
+	Value *big.Int
+	// Gas is the L1 gas limit of the call to To when the withdrawal is
+	// finalized.
+	Gas uint64
+	// Data is the L1 calldata.
+	Data []byte
+}
+
+// InitiateWithdrawalParameters contains the parameters for the
+// InitiateWithdrawal action.
+type InitiateWithdrawalParameters struct {
+	// Account is the L2 account to send from. If nil, uses the client's account.
+	Account wallet.Account
+
+	// Request is the withdrawal to initiate.
+	Request WithdrawalRequest
+
+	// Chain optionally overrides the client's (L2) chain for chain ID validation.
+	Chain *chain.Chain
+
+	// L2 transaction fields
+	Gas                  *big.Int
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Nonce                *int
+}
+
+// InitiateWithdrawal initiates a withdrawal from L2 to L1 through the
+// L2ToL1MessagePasser predeploy, returning the L2 transaction hash. The
+// withdrawal must then be proven and finalized on L1 once its L2 block is
+// proposed (see GetWithdrawalStatus).
+//
+// This is equivalent to viem's op-stack `initiateWithdrawal` action.
+//
+// Example:
+//
+//	hash, err := opstack.InitiateWithdrawal(ctx, opWallet, opstack.InitiateWithdrawalParameters{
+//	    Request: opstack.WithdrawalRequest{To: account, Value: big.NewInt(1e18), Gas: 21_000},
+//	})
+func InitiateWithdrawal(ctx context.Context, client wallet.Client, params InitiateWithdrawalParameters) (string, error) {
+	data := params.Request.Data
+	if data == nil {
+		data = []byte{}
+	}
+	return wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:              params.Account,
+		Address:              L2ToL1MessagePasserAddress.Hex(),
+		ABI:                  l2ToL1MessagePasserABI,
+		FunctionName:         "initiateWithdrawal",
+		Args:                 []any{params.Request.To, new(big.Int).SetUint64(params.Request.Gas), data},
+		Value:                params.Request.Value,
+		Chain:                params.Chain,
+		Gas:                  params.Gas,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		Nonce:                params.Nonce,
+	})
+}
+
+// withdrawalTuple is the WithdrawalTransaction struct of the OptimismPortal.
+type withdrawalTuple struct {
+	Nonce    *big.Int
+	Sender   common.Address
+	Target   common.Address
+	Value    *big.Int
+	GasLimit *big.Int
+	Data     []byte
+}
+
+func (w *Withdrawal) tuple() withdrawalTuple {
+	data := w.Data
+	if data == nil {
+		data = []byte{}
+	}
+	return withdrawalTuple{
+		Nonce:    bigOrZero(w.Nonce),
+		Sender:   w.Sender,
+		Target:   w.Target,
+		Value:    bigOrZero(w.Value),
+		GasLimit: bigOrZero(w.GasLimit),
+		Data:     data,
+	}
+}
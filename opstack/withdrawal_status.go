@@ -0,0 +1,290 @@
+package opstack
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/internal/contractread"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// WithdrawalStatus is the stage of an L2 -> L1 withdrawal.
+type WithdrawalStatus string
+
+const (
+	// WithdrawalStatusWaitingToProve means no output covering the withdrawal
+	// has been proposed on L1 yet.
+	WithdrawalStatusWaitingToProve WithdrawalStatus = "waiting-to-prove"
+	// WithdrawalStatusReadyToProve means the withdrawal can be proven, or
+	// must be proven again because its dispute game became invalid.
+	WithdrawalStatusReadyToProve WithdrawalStatus = "ready-to-prove"
+	// WithdrawalStatusWaitingToFinalize means the withdrawal is proven but its
+	// proof is not yet mature (or its dispute game not yet resolved).
+	WithdrawalStatusWaitingToFinalize WithdrawalStatus = "waiting-to-finalize"
+	// WithdrawalStatusReadyToFinalize means the withdrawal can be finalized.
+	WithdrawalStatusReadyToFinalize WithdrawalStatus = "ready-to-finalize"
+	// WithdrawalStatusFinalized means the withdrawal has been finalized.
+	WithdrawalStatusFinalized WithdrawalStatus = "finalized"
+)
+
+// GameStatus values of a dispute game resolved against or in favour of its
+// root claim.
+const (
+	gameStatusChallengerWins = 1
+	gameStatusDefenderWins   = 2
+)
+
+// GetWithdrawalStatusParameters contains the parameters for the
+// GetWithdrawalStatus action.
+type GetWithdrawalStatusParameters struct {
+	// Receipt is the L2 receipt of the transaction initiating the withdrawal.
+	Receipt *types.Receipt
+
+	// WithdrawalIndex selects the withdrawal when the transaction initiated
+	// several. Defaults to 0.
+	WithdrawalIndex int
+
+	// ProofSubmitter is the address whose proof is checked (fault proof
+	// portals only). When nil, the first submitter is used, falling back to
+	// the sender of Receipt.
+	ProofSubmitter *common.Address
+
+	// TargetChain is the L2 chain, whose L1 contracts are used.
+	TargetChain *chain.Chain
+
+	// Contracts overrides the L1 contracts of TargetChain.
+	Contracts *L1Contracts
+}
+
+// GetWithdrawalStatus returns the stage of a withdrawal initiated in an L2
+// transaction. It is run against the L1.
+//
+// This is equivalent to viem's op-stack `getWithdrawalStatus` action.
+//
+// Example:
+//
+//	status, err := opstack.GetWithdrawalStatus(ctx, mainnetClient, opstack.GetWithdrawalStatusParameters{
+//	    Receipt:     receipt,
+//	    TargetChain: definitions.Optimism,
+//	})
+//	if status == opstack.WithdrawalStatusReadyToProve { ... }
+func GetWithdrawalStatus(ctx context.Context, client public.Client, params GetWithdrawalStatusParameters) (WithdrawalStatus, error) {
+	contracts, err := resolveL1Contracts(params.Contracts, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+	withdrawal, err := getWithdrawal(params.Receipt, params.WithdrawalIndex)
+	if err != nil {
+		return "", err
+	}
+
+	var finalized bool
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.Portal,
+		ABI:          portalABI,
+		FunctionName: "finalizedWithdrawals",
+		Args:         []any{withdrawal.WithdrawalHash},
+	}, &finalized)
+	if err != nil {
+		return "", err
+	}
+	if finalized {
+		return WithdrawalStatusFinalized, nil
+	}
+
+	_, err = GetOutput(ctx, client, GetOutputParameters{
+		L2BlockNumber: params.Receipt.BlockNumber,
+		Contracts:     &contracts,
+	})
+	if errors.Is(err, ErrOutputNotFound) {
+		return WithdrawalStatusWaitingToProve, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	faultProofs, err := usesFaultProofs(ctx, client, contracts)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		provenAt      uint64
+		gameStatus    uint8
+		maturityDelay *big.Int
+	)
+	if faultProofs {
+		submitter, err := proofSubmitter(ctx, client, contracts, withdrawal.WithdrawalHash, params.ProofSubmitter, params.Receipt.From)
+		if err != nil {
+			return "", err
+		}
+		var proven struct {
+			DisputeGameProxy common.Address
+			Timestamp        uint64
+		}
+		err = contractread.Read(ctx, client, contractread.Parameters{
+			Address:      contracts.Portal,
+			ABI:          faultProofPortalABI,
+			FunctionName: "provenWithdrawals",
+			Args:         []any{withdrawal.WithdrawalHash, submitter},
+		}, &proven)
+		if err != nil {
+			return "", err
+		}
+		provenAt = proven.Timestamp
+		if provenAt == 0 {
+			return WithdrawalStatusReadyToProve, nil
+		}
+		// A proof against a game that lost, was blacklisted or is not of
+		// the respected game type can never be finalized: the withdrawal
+		// has to be proven again.
+		var valid bool
+		gameStatus, valid, err = provenGameStatus(ctx, client, contracts, proven.DisputeGameProxy)
+		if err != nil {
+			return "", err
+		}
+		if !valid {
+			return WithdrawalStatusReadyToProve, nil
+		}
+		err = contractread.Read(ctx, client, contractread.Parameters{
+			Address:      contracts.Portal,
+			ABI:          portalABI,
+			FunctionName: "proofMaturityDelaySeconds",
+		}, &maturityDelay)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		var proven struct {
+			OutputRoot    [32]byte
+			Timestamp     *big.Int
+			L2OutputIndex *big.Int
+		}
+		err = contractread.Read(ctx, client, contractread.Parameters{
+			Address:      contracts.Portal,
+			ABI:          legacyPortalABI,
+			FunctionName: "provenWithdrawals",
+			Args:         []any{withdrawal.WithdrawalHash},
+		}, &proven)
+		if err != nil {
+			return "", err
+		}
+		provenAt = proven.Timestamp.Uint64()
+		if provenAt == 0 {
+			return WithdrawalStatusReadyToProve, nil
+		}
+		err = contractread.Read(ctx, client, contractread.Parameters{
+			Address:      contracts.L2OutputOracle,
+			ABI:          l2OutputOracleABI,
+			FunctionName: "FINALIZATION_PERIOD_SECONDS",
+		}, &maturityDelay)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	latest, err := public.GetBlock(ctx, client, public.GetBlockParameters{})
+	if err != nil {
+		return "", err
+	}
+	maturesAt := new(big.Int).Add(new(big.Int).SetUint64(provenAt), maturityDelay)
+	if new(big.Int).SetUint64(latest.Timestamp).Cmp(maturesAt) < 0 {
+		return WithdrawalStatusWaitingToFinalize, nil
+	}
+
+	if faultProofs && gameStatus != gameStatusDefenderWins {
+		return WithdrawalStatusWaitingToFinalize, nil
+	}
+
+	return WithdrawalStatusReadyToFinalize, nil
+}
+
+// provenGameStatus returns the status of the dispute game a withdrawal was
+// proven against, and whether the proof can still be finalized: the game
+// must not be blacklisted, must be of the respected game type and must not
+// have resolved in favour of the challenger.
+func provenGameStatus(ctx context.Context, client public.Client, contracts L1Contracts, game common.Address) (uint8, bool, error) {
+	var blacklisted bool
+	err := contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.Portal,
+		ABI:          portalABI,
+		FunctionName: "disputeGameBlacklist",
+		Args:         []any{game},
+	}, &blacklisted)
+	if err != nil {
+		return 0, false, err
+	}
+	if blacklisted {
+		return 0, false, nil
+	}
+
+	var respectedType, gameType uint32
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.Portal,
+		ABI:          portalABI,
+		FunctionName: "respectedGameType",
+	}, &respectedType)
+	if err != nil {
+		return 0, false, err
+	}
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      game,
+		ABI:          disputeGameABI,
+		FunctionName: "gameType",
+	}, &gameType)
+	if err != nil {
+		return 0, false, err
+	}
+	if gameType != respectedType {
+		return 0, false, nil
+	}
+
+	var status uint8
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      game,
+		ABI:          disputeGameABI,
+		FunctionName: "status",
+	}, &status)
+	if err != nil {
+		return 0, false, err
+	}
+	return status, status != gameStatusChallengerWins, nil
+}
+
+// proofSubmitter returns the address whose withdrawal proof is checked on a
+// fault proof portal: the given submitter, else the first recorded one, else
+// the withdrawal sender.
+func proofSubmitter(ctx context.Context, client public.Client, contracts L1Contracts, hash common.Hash, submitter *common.Address, sender common.Address) (common.Address, error) {
+	if submitter != nil {
+		return *submitter, nil
+	}
+	var count *big.Int
+	err := contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.Portal,
+		ABI:          portalABI,
+		FunctionName: "numProofSubmitters",
+		Args:         []any{hash},
+	}, &count)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if count.Sign() == 0 {
+		return sender, nil
+	}
+	var first common.Address
+	err = contractread.Read(ctx, client, contractread.Parameters{
+		Address:      contracts.Portal,
+		ABI:          portalABI,
+		FunctionName: "proofSubmitters",
+		Args:         []any{hash, big.NewInt(0)},
+	}, &first)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return first, nil
+}
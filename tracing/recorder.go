@@ -0,0 +1,121 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+)
+
+// RecordedSpan is a finished span captured by a Recorder.
+type RecordedSpan struct {
+	Name       string
+	Context    SpanContext
+	Parent     SpanContext
+	Attributes map[string]any
+	Err        error
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// Duration returns how long the span took.
+func (s RecordedSpan) Duration() time.Duration {
+	return s.EndTime.Sub(s.StartTime)
+}
+
+// Recorder is a Tracer that keeps finished spans in memory. It is useful in
+// tests and for ad-hoc debugging; production systems typically adapt their
+// own tracing library instead.
+type Recorder struct {
+	mu    sync.Mutex
+	spans []RecordedSpan
+}
+
+// NewRecorder creates an empty recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Start implements Tracer.
+func (r *Recorder) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	parent := SpanFromContext(ctx).SpanContext()
+
+	sc := SpanContext{TraceID: parent.TraceID, Sampled: true}
+	if !parent.IsValid() {
+		_, _ = rand.Read(sc.TraceID[:])
+	}
+	_, _ = rand.Read(sc.SpanID[:])
+
+	span := &recordedSpan{
+		recorder: r,
+		data: RecordedSpan{
+			Name:       name,
+			Context:    sc,
+			Parent:     parent,
+			Attributes: make(map[string]any, len(attrs)),
+			StartTime:  time.Now(),
+		},
+	}
+	span.SetAttributes(attrs...)
+	return ctx, span
+}
+
+// Spans returns the finished spans in the order they ended.
+func (r *Recorder) Spans() []RecordedSpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RecordedSpan(nil), r.spans...)
+}
+
+// Reset discards every recorded span.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = nil
+}
+
+// recordedSpan is the Span returned by Recorder.
+type recordedSpan struct {
+	recorder *Recorder
+	mu       sync.Mutex
+	data     RecordedSpan
+	ended    bool
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...Attribute) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, attr := range attrs {
+		s.data.Attributes[attr.Key] = attr.Value
+	}
+}
+
+func (s *recordedSpan) RecordError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data.Err = err
+}
+
+func (s *recordedSpan) End() {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.EndTime = time.Now()
+	data := s.data
+	data.Attributes = make(map[string]any, len(s.data.Attributes))
+	for k, v := range s.data.Attributes {
+		data.Attributes[k] = v
+	}
+	s.mu.Unlock()
+
+	s.recorder.mu.Lock()
+	s.recorder.spans = append(s.recorder.spans, data)
+	s.recorder.mu.Unlock()
+}
+
+func (s *recordedSpan) SpanContext() SpanContext {
+	return s.data.Context
+}
@@ -0,0 +1,241 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/tracing"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
+)
+
+// rpcServer answers every request with "0x1" and records the traceparent
+// header of each HTTP request.
+func rpcServer() (*httptest.Server, func() []string) {
+	var (
+		mu      sync.Mutex
+		headers []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Get(tracing.TraceParentHeader))
+		mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if len(body) > 0 && body[0] == '[' {
+			var reqs []rpc.RPCRequest
+			Expect(json.Unmarshal(body, &reqs)).To(Succeed())
+			resps := make([]map[string]any, len(reqs))
+			for i, req := range reqs {
+				resps[i] = map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"}
+			}
+			_ = json.NewEncoder(w).Encode(resps)
+			return
+		}
+		var req rpc.RPCRequest
+		Expect(json.Unmarshal(body, &req)).To(Succeed())
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"})
+	}))
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), headers...)
+	}
+}
+
+func spanNamed(spans []tracing.RecordedSpan, name string) tracing.RecordedSpan {
+	for _, span := range spans {
+		if span.Name == name {
+			return span
+		}
+	}
+	Fail("no span named " + name)
+	return tracing.RecordedSpan{}
+}
+
+var _ = Describe("traceparent", func() {
+	It("formats and parses span contexts", func() {
+		sc, err := tracing.ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sc.IsValid()).To(BeTrue())
+		Expect(sc.Sampled).To(BeTrue())
+		Expect(sc.TraceParent()).To(Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+	})
+
+	It("rejects malformed values", func() {
+		for _, value := range []string{
+			"",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+			"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			"00-4bf92f3577b34da6a3ce929d0e0e4736-zzf067aa0ba902b7-01",
+		} {
+			_, err := tracing.ParseTraceParent(value)
+			Expect(err).To(MatchError(tracing.ErrInvalidTraceParent), value)
+		}
+	})
+
+	It("joins a remote trace extracted from headers", func() {
+		header := http.Header{}
+		header.Set(tracing.TraceParentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+		rec := tracing.NewRecorder()
+		ctx := tracing.Extract(tracing.WithTracer(context.Background(), rec), header)
+		_, span := tracing.Start(ctx, "child")
+		span.End()
+
+		child := rec.Spans()[0]
+		Expect(child.Parent.TraceParent()).To(Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+		Expect(child.Context.TraceID).To(Equal(child.Parent.TraceID))
+	})
+})
+
+var _ = Describe("Recorder", func() {
+	It("is a no-op without a tracer", func() {
+		ctx, span := tracing.Start(context.Background(), "untraced")
+		Expect(ctx).To(Equal(context.Background()))
+		Expect(span.SpanContext().IsValid()).To(BeFalse())
+		tracing.End(span, errors.New("ignored"))
+	})
+
+	It("records nested spans in one trace", func() {
+		rec := tracing.NewRecorder()
+		ctx := tracing.WithTracer(context.Background(), rec)
+
+		ctx, parent := tracing.Start(ctx, "parent", tracing.String("k", "v"))
+		_, child := tracing.Start(ctx, "child", tracing.Int("n", 2))
+		tracing.End(child, errors.New("boom"))
+		tracing.End(parent, nil)
+
+		spans := rec.Spans()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name).To(Equal("child"))
+		Expect(spans[0].Err).To(MatchError("boom"))
+		Expect(spans[0].Attributes).To(HaveKeyWithValue("n", int64(2)))
+		Expect(spans[0].Parent).To(Equal(spans[1].Context))
+		Expect(spans[0].Context.TraceID).To(Equal(spans[1].Context.TraceID))
+		Expect(spans[1].Parent.IsValid()).To(BeFalse())
+		Expect(spans[1].Attributes).To(HaveKeyWithValue("k", "v"))
+		Expect(spans[1].Duration()).To(BeNumerically(">=", 0))
+
+		rec.Reset()
+		Expect(rec.Spans()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Transport spans", func() {
+	It("creates a span per request and propagates it over HTTP", func() {
+		server, headers := rpcServer()
+		defer server.Close()
+
+		tr, err := transport.HTTP(server.URL, transport.HTTPTransportConfig{Key: "primary", Timeout: time.Second})(transport.TransportParams{})
+		Expect(err).NotTo(HaveOccurred())
+		defer tr.Close()
+
+		rec := tracing.NewRecorder()
+		_, err = tr.Request(tracing.WithTracer(context.Background(), rec), transport.RPCRequest{Method: "eth_chainId"})
+		Expect(err).NotTo(HaveOccurred())
+
+		span := spanNamed(rec.Spans(), "eth_chainId")
+		Expect(span.Attributes).To(HaveKeyWithValue(tracing.AttrRPCSystem, "jsonrpc"))
+		Expect(span.Attributes).To(HaveKeyWithValue(tracing.AttrRPCMethod, "eth_chainId"))
+		Expect(span.Attributes).To(HaveKeyWithValue(tracing.AttrTransport, "primary"))
+		Expect(headers()).To(ConsistOf(span.Context.TraceParent()))
+	})
+
+	It("sends no traceparent when tracing is disabled", func() {
+		server, headers := rpcServer()
+		defer server.Close()
+
+		tr, err := transport.HTTP(server.URL)(transport.TransportParams{})
+		Expect(err).NotTo(HaveOccurred())
+		defer tr.Close()
+
+		_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(headers()).To(ConsistOf(""))
+	})
+
+	It("annotates batched requests with the batch size", func() {
+		server, headers := rpcServer()
+		defer server.Close()
+
+		tr, err := transport.HTTP(server.URL, transport.HTTPTransportConfig{
+			Timeout: time.Second,
+			Batch:   &transport.BatchConfig{Enabled: true, BatchSize: 4, Wait: 20 * time.Millisecond},
+		})(transport.TransportParams{})
+		Expect(err).NotTo(HaveOccurred())
+		defer tr.Close()
+
+		rec := tracing.NewRecorder()
+		ctx := tracing.WithTracer(context.Background(), rec)
+		done := make(chan error, 3)
+		for i := 0; i < 3; i++ {
+			go func() {
+				_, err := tr.Request(ctx, transport.RPCRequest{Method: "eth_blockNumber"})
+				done <- err
+			}()
+		}
+		for i := 0; i < 3; i++ {
+			Expect(<-done).NotTo(HaveOccurred())
+		}
+
+		spans := rec.Spans()
+		Expect(spans).To(HaveLen(3))
+		traceParents := make([]string, len(spans))
+		for i, span := range spans {
+			Expect(span.Attributes).To(HaveKeyWithValue(tracing.AttrRPCBatchSize, int64(3)))
+			traceParents[i] = span.Context.TraceParent()
+		}
+
+		// The single batch request is sent in the trace of one of its requests.
+		Expect(headers()).To(HaveLen(1))
+		Expect(traceParents).To(ContainElement(headers()[0]))
+	})
+})
+
+var _ = Describe("Action spans", func() {
+	It("wraps the RPC request of an action in the same trace", func() {
+		server, headers := rpcServer()
+		defer server.Close()
+
+		c, err := client.CreatePublicClient(client.PublicClientConfig{
+			Chain:     &definitions.Mainnet,
+			Transport: transport.HTTP(server.URL),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		defer c.Close()
+
+		rec := tracing.NewRecorder()
+		to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		_, err = public.Call(tracing.WithTracer(context.Background(), rec), c, public.CallParameters{
+			To:       &to,
+			Data:     []byte{0x01},
+			BlockTag: public.BlockTagSafe,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		spans := rec.Spans()
+		action := spanNamed(spans, "viem.call")
+		request := spanNamed(spans, "eth_call")
+		Expect(action.Attributes).To(HaveKeyWithValue(tracing.AttrChainID, int64(1)))
+		Expect(action.Attributes).To(HaveKeyWithValue(tracing.AttrBlockTag, "safe"))
+		Expect(request.Parent).To(Equal(action.Context))
+		Expect(request.Context.TraceID).To(Equal(action.Context.TraceID))
+		Expect(headers()).To(ConsistOf(request.Context.TraceParent()))
+	})
+})
@@ -0,0 +1,151 @@
+// Package tracing provides a small tracer interface, carried in a context,
+// that actions and transports use to create spans.
+//
+// Tracing is disabled unless a Tracer is attached to the context with
+// WithTracer; every span is then a no-op. Actions create spans named
+// "viem.<action>" annotated with the chain ID and block tag; transports create
+// one span per JSON-RPC request, named after the RPC method. HTTP requests carry
+// the current span in a W3C traceparent header; a batch carries the span of
+// its first traced request.
+//
+// Applications using OpenTelemetry can implement Tracer with a thin adapter
+// around an OpenTelemetry tracer; Recorder is a minimal in-memory Tracer.
+//
+// Example:
+//
+//	rec := tracing.NewRecorder()
+//	ctx := tracing.WithTracer(context.Background(), rec)
+//	result, err := public.Call(ctx, client, params)
+//	for _, span := range rec.Spans() {
+//		fmt.Println(span.Name, span.Duration(), span.Err)
+//	}
+package tracing
+
+import (
+	"context"
+)
+
+// Attribute is a key/value annotation on a span.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// String returns a string attribute.
+func String(key, value string) Attribute { return Attribute{Key: key, Value: value} }
+
+// Int returns an integer attribute.
+func Int(key string, value int) Attribute { return Attribute{Key: key, Value: int64(value)} }
+
+// Int64 returns an integer attribute.
+func Int64(key string, value int64) Attribute { return Attribute{Key: key, Value: value} }
+
+// Bool returns a boolean attribute.
+func Bool(key string, value bool) Attribute { return Attribute{Key: key, Value: value} }
+
+// Attribute keys used by actions and transports.
+const (
+	AttrRPCSystem     = "rpc.system"
+	AttrRPCMethod     = "rpc.method"
+	AttrRPCBatchSize  = "rpc.jsonrpc.batch_size"
+	AttrTransport     = "viem.transport"
+	AttrChainID       = "viem.chain_id"
+	AttrBlockTag      = "viem.block_tag"
+	AttrTxHash        = "viem.transaction_hash"
+	AttrCalls         = "viem.multicall.calls"
+	AttrChunks        = "viem.multicall.chunks"
+	AttrFunctionName  = "viem.function_name"
+	AttrConfirmations = "viem.confirmations"
+)
+
+// Tracer creates spans.
+type Tracer interface {
+	// Start creates a span that is a child of the span in ctx, if any, and
+	// returns a context carrying it. Implementations can find the parent
+	// with SpanFromContext.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a unit of traced work.
+type Span interface {
+	// SetAttributes adds or replaces attributes.
+	SetAttributes(attrs ...Attribute)
+	// RecordError marks the span as failed with err.
+	RecordError(err error)
+	// End finishes the span.
+	End()
+	// SpanContext returns the span's identifiers, used for propagation.
+	// A zero SpanContext disables propagation.
+	SpanContext() SpanContext
+}
+
+type tracerKey struct{}
+type spanKey struct{}
+
+// WithTracer returns a context whose actions and RPC requests are traced by
+// tracer.
+func WithTracer(ctx context.Context, tracer Tracer) context.Context {
+	return context.WithValue(ctx, tracerKey{}, tracer)
+}
+
+// TracerFromContext returns the tracer attached to ctx, or nil.
+func TracerFromContext(ctx context.Context) Tracer {
+	tracer, _ := ctx.Value(tracerKey{}).(Tracer)
+	return tracer
+}
+
+// ContextWithSpan returns a context carrying span as the current span.
+func ContextWithSpan(ctx context.Context, span Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// SpanFromContext returns the current span, or a no-op span.
+func SpanFromContext(ctx context.Context) Span {
+	if span, ok := ctx.Value(spanKey{}).(Span); ok {
+		return span
+	}
+	return noopSpan{}
+}
+
+// Start starts a span with the tracer in ctx. Without a tracer it returns ctx
+// unchanged and a no-op span.
+func Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	tracer := TracerFromContext(ctx)
+	if tracer == nil {
+		return ctx, noopSpan{}
+	}
+	ctx, span := tracer.Start(ctx, name, attrs...)
+	return ContextWithSpan(ctx, span), span
+}
+
+// StartAction starts the span for an action, annotated with chainID when it
+// is non-zero. Like Start, it returns a no-op span unless ctx carries a tracer.
+func StartAction(ctx context.Context, name string, chainID int64, attrs ...Attribute) (context.Context, Span) {
+	if TracerFromContext(ctx) == nil {
+		return ctx, noopSpan{}
+	}
+	if chainID != 0 {
+		attrs = append(attrs, Int64(AttrChainID, chainID))
+	}
+	return Start(ctx, name, attrs...)
+}
+
+// End records err on span, if non-nil, and ends it. It is meant to be
+// deferred with a named error result:
+//
+//	ctx, span := tracing.Start(ctx, "viem.call")
+//	defer func() { tracing.End(span, err) }()
+func End(span Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+// noopSpan is returned when tracing is disabled.
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...Attribute) {}
+func (noopSpan) RecordError(error)          {}
+func (noopSpan) End()                       {}
+func (noopSpan) SpanContext() SpanContext   { return SpanContext{} }
@@ -0,0 +1,44 @@
+package txmanager
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// EventType identifies a transaction lifecycle event.
+type EventType string
+
+const (
+	// EventSent is emitted when a new transaction is first broadcast.
+	EventSent EventType = "sent"
+	// EventReplaced is emitted when a transaction is re-broadcast with higher
+	// fees (automatically, via SpeedUp, or via Cancel).
+	EventReplaced EventType = "replaced"
+	// EventMined is emitted when one of the transaction's hashes is included in a block.
+	EventMined EventType = "mined"
+	// EventConfirmed is emitted when the mined transaction reaches the
+	// configured confirmation depth. The transaction is then no longer tracked.
+	EventConfirmed EventType = "confirmed"
+	// EventDropped is emitted when the transaction's nonce was consumed by a
+	// transaction the manager did not send. The transaction is then no longer tracked.
+	EventDropped EventType = "dropped"
+	// EventReorged is emitted when a mined transaction disappears from the
+	// chain before reaching the confirmation depth and is tracked as pending again.
+	EventReorged EventType = "reorged"
+)
+
+// Event describes a change in a tracked transaction's lifecycle.
+type Event struct {
+	// Type is the kind of event.
+	Type EventType
+	// Transaction is a snapshot of the transaction after the change.
+	Transaction Transaction
+	// Hash is the hash the event refers to (the new hash for EventReplaced,
+	// the included hash for EventMined and EventConfirmed).
+	Hash common.Hash
+	// PreviousHash is the replaced hash for EventReplaced.
+	PreviousHash common.Hash
+	// Receipt is the receipt for EventMined and EventConfirmed.
+	Receipt *types.Receipt
+}
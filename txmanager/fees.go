@@ -0,0 +1,99 @@
+package txmanager
+
+import (
+	"math/big"
+)
+
+const (
+	// PriceBump is the minimum percentage by which a replacement transaction
+	// must raise its fees to be accepted by the node's transaction pool
+	// (go-ethereum's default txpool.pricebump).
+	PriceBump = 10
+
+	// BlobPriceBump is the minimum percentage by which a replacement blob
+	// transaction must raise every fee, including the blob fee cap
+	// (go-ethereum's blobpool requires the fees to be doubled).
+	BlobPriceBump = 100
+)
+
+// Fees holds the fee fields of a transaction. Legacy transactions use
+// GasPrice, EIP-1559 transactions use MaxFeePerGas and MaxPriorityFeePerGas,
+// and blob transactions additionally use MaxFeePerBlobGas.
+type Fees struct {
+	GasPrice             *big.Int `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *big.Int `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *big.Int `json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerBlobGas     *big.Int `json:"maxFeePerBlobGas,omitempty"`
+}
+
+// IsLegacy reports whether the fees describe a legacy (gasPrice) transaction.
+func (f Fees) IsLegacy() bool {
+	return f.GasPrice != nil && f.MaxFeePerGas == nil
+}
+
+// BumpFees returns the fees for a replacement of a transaction paying prev.
+//
+// Every set fee field is raised by at least percent (clamped to PriceBump,
+// or BlobPriceBump for blob transactions, which must bump all fields), and
+// then raised further to the current market fees if those are higher. The
+// fee cap is never left below the priority fee.
+//
+// Example:
+//
+//	next := txmanager.BumpFees(tx.Fees, false, txmanager.PriceBump, nil)
+func BumpFees(prev Fees, blob bool, percent int64, market *Fees) Fees {
+	minimum := int64(PriceBump)
+	if blob {
+		minimum = BlobPriceBump
+	}
+	if percent < minimum {
+		percent = minimum
+	}
+
+	var m Fees
+	if market != nil {
+		m = *market
+	}
+
+	next := Fees{
+		GasPrice:             bumpValue(prev.GasPrice, percent, m.GasPrice),
+		MaxFeePerGas:         bumpValue(prev.MaxFeePerGas, percent, m.MaxFeePerGas),
+		MaxPriorityFeePerGas: bumpValue(prev.MaxPriorityFeePerGas, percent, m.MaxPriorityFeePerGas),
+		MaxFeePerBlobGas:     bumpValue(prev.MaxFeePerBlobGas, percent, m.MaxFeePerBlobGas),
+	}
+	if next.MaxFeePerGas != nil && next.MaxPriorityFeePerGas != nil && next.MaxFeePerGas.Cmp(next.MaxPriorityFeePerGas) < 0 {
+		next.MaxFeePerGas = new(big.Int).Set(next.MaxPriorityFeePerGas)
+	}
+	return next
+}
+
+// exceeds reports whether any fee cap in f is above limit.
+func (f Fees) exceeds(limit *big.Int) bool {
+	if limit == nil {
+		return false
+	}
+	for _, v := range []*big.Int{f.GasPrice, f.MaxFeePerGas} {
+		if v != nil && v.Cmp(limit) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// bumpValue raises v by percent (rounding up, and by at least 1 wei), then
+// to market if that is higher. A nil v stays nil.
+func bumpValue(v *big.Int, percent int64, market *big.Int) *big.Int {
+	if v == nil {
+		return nil
+	}
+	bumped := new(big.Int).Mul(v, big.NewInt(100+percent))
+	bumped.Add(bumped, big.NewInt(99))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(v) <= 0 {
+		bumped.Add(v, big.NewInt(1))
+	}
+	if market != nil && market.Cmp(bumped) > 0 {
+		bumped.Set(market)
+	}
+	return bumped
+}
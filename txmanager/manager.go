@@ -0,0 +1,773 @@
+// Package txmanager tracks transactions sent from a wallet client until they
+// are confirmed, re-broadcasting them with bumped fees when they get stuck.
+//
+// A Manager assigns nonces per account, persists every pending transaction to
+// a Store so tracking survives restarts, and reports lifecycle changes (sent,
+// replaced, mined, confirmed, dropped) through Config.OnEvent.
+//
+// Example:
+//
+//	store, _ := txmanager.NewFileStore("pending-txs.json")
+//	mgr, err := txmanager.New(walletClient, txmanager.Config{
+//		Store:         store,
+//		Confirmations: 3,
+//		OnEvent: func(e txmanager.Event) {
+//			log.Printf("%s %s nonce=%d hash=%s", e.Type, e.Transaction.From, e.Transaction.Nonce, e.Hash)
+//		},
+//	})
+//	tx, err := mgr.Send(ctx, txmanager.SendParameters{To: "0x...", Value: big.NewInt(1)})
+//	go mgr.Run(ctx, nil)
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/formatters"
+)
+
+var (
+	// ErrNotFound is returned when a transaction ID is not tracked by the manager.
+	ErrNotFound = errors.New("transaction not found")
+	// ErrNotPending is returned when replacing a transaction that is no longer pending.
+	ErrNotPending = errors.New("transaction is not pending")
+	// ErrNoAccount is returned when no account is given and the client has none.
+	ErrNoAccount = errors.New("no account to send from")
+	// ErrFeeCapExceeded is returned when a replacement would exceed Config.MaxFeeCap.
+	ErrFeeCapExceeded = errors.New("replacement fees exceed the configured fee cap")
+)
+
+const cancelGas = 21000
+
+// Status is the lifecycle state of a tracked transaction.
+type Status string
+
+const (
+	// StatusPending means none of the transaction's hashes has been mined yet.
+	StatusPending Status = "pending"
+	// StatusMined means one of the transaction's hashes was included in a
+	// block that has not yet reached the confirmation depth.
+	StatusMined Status = "mined"
+	// StatusConfirmed means the transaction reached the confirmation depth.
+	StatusConfirmed Status = "confirmed"
+	// StatusDropped means the nonce was consumed by a transaction the manager did not send.
+	StatusDropped Status = "dropped"
+)
+
+// Request holds the fee-independent fields of a transaction, so it can be
+// re-signed with new fees.
+type Request struct {
+	To                  string                      `json:"to,omitempty"`
+	Data                string                      `json:"data,omitempty"`
+	Value               *big.Int                    `json:"value,omitempty"`
+	Gas                 *big.Int                    `json:"gas,omitempty"`
+	AccessList          []formatters.AccessListItem `json:"accessList,omitempty"`
+	Blobs               []string                    `json:"blobs,omitempty"`
+	BlobVersionedHashes []string                    `json:"blobVersionedHashes,omitempty"`
+	Type                formatters.TransactionType  `json:"type,omitempty"`
+}
+
+// IsBlob reports whether the request is an EIP-4844 blob transaction.
+func (r Request) IsBlob() bool {
+	return len(r.Blobs) > 0 || len(r.BlobVersionedHashes) > 0 || r.Type == formatters.TransactionTypeEIP4844
+}
+
+// Transaction is a transaction tracked by a Manager. All broadcast versions
+// share the same sender and nonce; Hashes lists them oldest first.
+type Transaction struct {
+	// ID uniquely identifies the transaction as "<from>/<nonce>".
+	ID     string         `json:"id"`
+	From   common.Address `json:"from"`
+	Nonce  uint64         `json:"nonce"`
+	Status Status         `json:"status"`
+
+	// Request is the currently broadcast request (the self-transfer after Cancel).
+	Request Request `json:"request"`
+	// Fees are the fees of the most recent broadcast.
+	Fees Fees `json:"fees"`
+	// Hashes are the hashes of every broadcast version, oldest first.
+	Hashes []common.Hash `json:"hashes"`
+	// Cancelled is true once Cancel replaced the request with a no-op.
+	Cancelled bool `json:"cancelled,omitempty"`
+	// Attempts is the number of broadcasts with distinct fees.
+	Attempts int `json:"attempts"`
+
+	SentAt        time.Time `json:"sentAt"`
+	LastBroadcast time.Time `json:"lastBroadcast"`
+
+	// MinedHash, MinedBlockHash and MinedBlockNumber are set once mined.
+	MinedHash        *common.Hash `json:"minedHash,omitempty"`
+	MinedBlockHash   *common.Hash `json:"minedBlockHash,omitempty"`
+	MinedBlockNumber uint64       `json:"minedBlockNumber,omitempty"`
+}
+
+// Hash returns the hash of the most recent broadcast.
+func (t *Transaction) Hash() common.Hash {
+	if len(t.Hashes) == 0 {
+		return common.Hash{}
+	}
+	return t.Hashes[len(t.Hashes)-1]
+}
+
+func (t *Transaction) clone() *Transaction {
+	c := *t
+	c.Hashes = append([]common.Hash(nil), t.Hashes...)
+	c.Request.AccessList = append([]formatters.AccessListItem(nil), t.Request.AccessList...)
+	c.Request.Blobs = append([]string(nil), t.Request.Blobs...)
+	c.Request.BlobVersionedHashes = append([]string(nil), t.Request.BlobVersionedHashes...)
+	return &c
+}
+
+// transactionID returns the ID for a transaction from from with nonce.
+func transactionID(from common.Address, nonce uint64) string {
+	return fmt.Sprintf("%s/%d", from.Hex(), nonce)
+}
+
+// Config configures a Manager.
+type Config struct {
+	// Store persists tracked transactions. Defaults to a MemoryStore.
+	Store Store
+
+	// Accounts are the accounts the manager may send from, in addition to the
+	// client's account. Transactions loaded from the store whose sender is not
+	// listed are re-broadcast via eth_sendTransaction (node-side signing).
+	Accounts []wallet.Account
+
+	// Confirmations is the depth at which a mined transaction is reported as
+	// confirmed and no longer tracked. Defaults to 1.
+	Confirmations uint64
+
+	// ResubmitAfter is how long a transaction may stay pending before it is
+	// re-broadcast with bumped fees. Defaults to 1 minute.
+	ResubmitAfter time.Duration
+
+	// PriceBump is the percentage by which fees are raised for each
+	// replacement. Values below PriceBump (BlobPriceBump for blob
+	// transactions) are raised to the minimum the txpool accepts.
+	PriceBump int64
+
+	// MaxFeeCap bounds the gas price / max fee per gas of automatic
+	// replacements. Once reached, stuck transactions are only re-broadcast
+	// with their current fees. Nil means no cap.
+	MaxFeeCap *big.Int
+
+	// MaxAttempts bounds the number of fee bumps per transaction.
+	// Defaults to 10; negative means unlimited.
+	MaxAttempts int
+
+	// PollInterval is how often Run checks pending transactions.
+	// Defaults to the client's polling interval.
+	PollInterval time.Duration
+
+	// OnEvent is called synchronously for every lifecycle event.
+	OnEvent func(Event)
+}
+
+// SendParameters contains the parameters for Manager.Send. Unset fees are
+// estimated from the network; the nonce is always assigned by the manager.
+type SendParameters struct {
+	// Account is the account to send from. Defaults to the client's account.
+	Account wallet.Account
+
+	To                  string
+	Data                string
+	Value               *big.Int
+	Gas                 *big.Int
+	AccessList          []formatters.AccessListItem
+	Blobs               []string
+	BlobVersionedHashes []string
+	Type                formatters.TransactionType
+
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	MaxFeePerBlobGas     *big.Int
+}
+
+// Manager sends transactions and tracks them until confirmation.
+// It is safe for concurrent use.
+type Manager struct {
+	client wallet.Client
+	cfg    Config
+
+	// opMu serializes operations that talk to the network, so nonces and
+	// replacements never race.
+	opMu sync.Mutex
+
+	mu       sync.Mutex
+	txs      map[string]*Transaction
+	accounts map[common.Address]wallet.Account
+}
+
+// New creates a Manager for client and resumes tracking every transaction in
+// cfg.Store.
+func New(client wallet.Client, cfg Config) (*Manager, error) {
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryStore()
+	}
+	if cfg.Confirmations == 0 {
+		cfg.Confirmations = 1
+	}
+	if cfg.ResubmitAfter <= 0 {
+		cfg.ResubmitAfter = time.Minute
+	}
+	if cfg.PriceBump <= 0 {
+		cfg.PriceBump = PriceBump
+	}
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = 10
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = client.PollingInterval()
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 4 * time.Second
+	}
+
+	m := &Manager{
+		client:   client,
+		cfg:      cfg,
+		txs:      make(map[string]*Transaction),
+		accounts: make(map[common.Address]wallet.Account),
+	}
+	if acc := client.Account(); acc != nil {
+		m.accounts[acc.Address()] = acc
+	}
+	for _, acc := range cfg.Accounts {
+		m.accounts[acc.Address()] = acc
+	}
+
+	stored, err := cfg.Store.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tracked transactions: %w", err)
+	}
+	for _, tx := range stored {
+		m.txs[tx.ID] = tx
+	}
+	return m, nil
+}
+
+// Get returns a snapshot of the tracked transaction with the given ID.
+func (m *Manager) Get(id string) (*Transaction, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tx, ok := m.txs[id]
+	if !ok {
+		return nil, false
+	}
+	return tx.clone(), true
+}
+
+// Pending returns snapshots of all tracked (pending or mined but not yet
+// confirmed) transactions from account, ordered by nonce.
+func (m *Manager) Pending(account common.Address) []*Transaction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Transaction
+	for _, tx := range sortedTransactions(m.txs) {
+		if tx.From == account {
+			out = append(out, tx)
+		}
+	}
+	return out
+}
+
+// Send assigns the next nonce for the account, broadcasts the transaction and
+// starts tracking it.
+func (m *Manager) Send(ctx context.Context, params SendParameters) (*Transaction, error) {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	account := params.Account
+	if account == nil {
+		account = m.client.Account()
+	}
+	if account == nil {
+		return nil, ErrNoAccount
+	}
+	from := account.Address()
+
+	m.mu.Lock()
+	m.accounts[from] = account
+	m.mu.Unlock()
+
+	nonce, err := m.nextNonce(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	req := Request{
+		To:                  params.To,
+		Data:                params.Data,
+		Value:               params.Value,
+		Gas:                 params.Gas,
+		AccessList:          params.AccessList,
+		Blobs:               params.Blobs,
+		BlobVersionedHashes: params.BlobVersionedHashes,
+		Type:                params.Type,
+	}
+	fees := Fees{
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
+	}
+	if fees.GasPrice == nil && fees.MaxFeePerGas == nil {
+		market, err := m.marketFees(ctx, req, req.Type == formatters.TransactionTypeLegacy)
+		if err != nil {
+			return nil, err
+		}
+		fees.GasPrice, fees.MaxFeePerGas, fees.MaxPriorityFeePerGas = market.GasPrice, market.MaxFeePerGas, market.MaxPriorityFeePerGas
+		if fees.MaxFeePerBlobGas == nil {
+			fees.MaxFeePerBlobGas = market.MaxFeePerBlobGas
+		}
+	} else if req.IsBlob() && fees.MaxFeePerBlobGas == nil {
+		market, err := m.marketFees(ctx, req, fees.IsLegacy())
+		if err != nil {
+			return nil, err
+		}
+		fees.MaxFeePerBlobGas = market.MaxFeePerBlobGas
+	}
+
+	tx := &Transaction{
+		ID:      transactionID(from, nonce),
+		From:    from,
+		Nonce:   nonce,
+		Status:  StatusPending,
+		Request: req,
+		Fees:    fees,
+	}
+	hash, err := m.broadcast(ctx, tx, req, fees)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	tx.Hashes = []common.Hash{hash}
+	tx.Attempts = 1
+	tx.SentAt = now
+	tx.LastBroadcast = now
+
+	if err := m.save(tx); err != nil {
+		return tx.clone(), err
+	}
+	m.emit(Event{Type: EventSent, Transaction: *tx, Hash: hash})
+	return tx.clone(), nil
+}
+
+// SpeedUp re-broadcasts a pending transaction with bumped fees and returns the
+// new hash.
+func (m *Manager) SpeedUp(ctx context.Context, id string) (common.Hash, error) {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	tx, err := m.pendingTx(id)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return m.replace(ctx, tx, tx.Request, false, true)
+}
+
+// Cancel replaces a pending transaction with a zero-value transfer to its own
+// sender at the same nonce and bumped fees, and returns the new hash. Blob
+// transactions keep their blobs, since the blobpool only accepts a blob
+// transaction as a replacement for another.
+func (m *Manager) Cancel(ctx context.Context, id string) (common.Hash, error) {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	tx, err := m.pendingTx(id)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	req := Request{
+		To:    tx.From.Hex(),
+		Value: big.NewInt(0),
+		Type:  tx.Request.Type,
+	}
+	if tx.Request.IsBlob() {
+		req.Blobs = tx.Request.Blobs
+		req.BlobVersionedHashes = tx.Request.BlobVersionedHashes
+	} else {
+		req.Gas = big.NewInt(cancelGas)
+	}
+	return m.replace(ctx, tx, req, true, true)
+}
+
+// Run calls Check every Config.PollInterval until ctx is done. Errors from
+// individual passes are passed to onError, which may be nil.
+func (m *Manager) Run(ctx context.Context, onError func(error)) error {
+	ticker := time.NewTicker(m.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := m.Check(ctx); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check runs a single tracking pass over every tracked transaction: it
+// detects inclusion, reorgs, confirmations and dropped nonces, and
+// re-broadcasts transactions pending longer than Config.ResubmitAfter.
+// Errors for individual transactions are joined; the pass continues past them.
+func (m *Manager) Check(ctx context.Context) error {
+	m.opMu.Lock()
+	defer m.opMu.Unlock()
+
+	m.mu.Lock()
+	txs := sortedTransactions(m.txs)
+	m.mu.Unlock()
+	if len(txs) == 0 {
+		return nil
+	}
+
+	zero := time.Duration(0)
+	head, err := public.GetBlockNumber(ctx, m.client, public.GetBlockNumberParameters{CacheTime: &zero})
+	if err != nil {
+		return fmt.Errorf("failed to get block number: %w", err)
+	}
+
+	var errs []error
+	nonces := make(map[common.Address]uint64)
+	for _, tx := range txs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var err error
+		switch tx.Status {
+		case StatusPending:
+			err = m.checkPending(ctx, tx, head, nonces)
+		case StatusMined:
+			err = m.checkMined(ctx, tx, head)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", tx.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkPending looks for a receipt of any broadcast hash, then decides whether
+// the transaction was dropped or needs a replacement.
+func (m *Manager) checkPending(ctx context.Context, tx *Transaction, head uint64, nonces map[common.Address]uint64) error {
+	// Read the confirmed nonce before the receipts: if the transaction is
+	// mined in between, the receipt lookup still finds it instead of the
+	// manager reporting it as dropped.
+	latest, ok := nonces[tx.From]
+	if !ok {
+		n, err := public.GetTransactionCount(ctx, m.client, public.GetTransactionCountParameters{
+			Address:  tx.From,
+			BlockTag: public.BlockTagLatest,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get nonce: %w", err)
+		}
+		latest = n
+		nonces[tx.From] = n
+	}
+
+	receipt, err := m.findReceipt(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if receipt != nil {
+		hash := receipt.TransactionHash
+		blockHash := receipt.BlockHash
+		tx.Status = StatusMined
+		tx.MinedHash = &hash
+		tx.MinedBlockHash = &blockHash
+		tx.MinedBlockNumber = receipt.BlockNumber
+		if err := m.save(tx); err != nil {
+			return err
+		}
+		m.emit(Event{Type: EventMined, Transaction: *tx, Hash: hash, Receipt: receipt})
+		return m.confirm(tx, receipt, head)
+	}
+
+	if latest > tx.Nonce {
+		tx.Status = StatusDropped
+		if err := m.forget(tx); err != nil {
+			return err
+		}
+		m.emit(Event{Type: EventDropped, Transaction: *tx, Hash: tx.Hash()})
+		return nil
+	}
+
+	if time.Since(tx.LastBroadcast) < m.cfg.ResubmitAfter {
+		return nil
+	}
+	if m.cfg.MaxAttempts > 0 && tx.Attempts >= m.cfg.MaxAttempts {
+		return m.rebroadcast(ctx, tx)
+	}
+	_, err = m.replace(ctx, tx, tx.Request, tx.Cancelled, false)
+	if errors.Is(err, ErrFeeCapExceeded) {
+		return m.rebroadcast(ctx, tx)
+	}
+	return err
+}
+
+// checkMined re-reads the receipt of a mined transaction to detect reorgs and
+// reports it as confirmed once deep enough.
+func (m *Manager) checkMined(ctx context.Context, tx *Transaction, head uint64) error {
+	var receipt *types.Receipt
+	if tx.MinedHash != nil {
+		r, err := public.GetTransactionReceipt(ctx, m.client, public.GetTransactionReceiptParameters{Hash: *tx.MinedHash})
+		var notFound *public.TransactionReceiptNotFoundError
+		if err != nil && !errors.As(err, &notFound) {
+			return fmt.Errorf("failed to get receipt: %w", err)
+		}
+		receipt = r
+	}
+
+	if receipt == nil {
+		// The block containing the transaction was reorged out; track it as
+		// pending again so it is re-broadcast if it does not reappear.
+		tx.Status = StatusPending
+		tx.MinedHash = nil
+		tx.MinedBlockHash = nil
+		tx.MinedBlockNumber = 0
+		tx.LastBroadcast = time.Now()
+		if err := m.save(tx); err != nil {
+			return err
+		}
+		m.emit(Event{Type: EventReorged, Transaction: *tx, Hash: tx.Hash()})
+		return nil
+	}
+
+	if tx.MinedBlockHash == nil || *tx.MinedBlockHash != receipt.BlockHash {
+		blockHash := receipt.BlockHash
+		tx.MinedBlockHash = &blockHash
+		tx.MinedBlockNumber = receipt.BlockNumber
+		if err := m.save(tx); err != nil {
+			return err
+		}
+	}
+	return m.confirm(tx, receipt, head)
+}
+
+// confirm reports tx as confirmed and stops tracking it if it is at least
+// Config.Confirmations blocks deep.
+func (m *Manager) confirm(tx *Transaction, receipt *types.Receipt, head uint64) error {
+	if head < receipt.BlockNumber || head-receipt.BlockNumber+1 < m.cfg.Confirmations {
+		return nil
+	}
+	tx.Status = StatusConfirmed
+	if err := m.forget(tx); err != nil {
+		return err
+	}
+	m.emit(Event{Type: EventConfirmed, Transaction: *tx, Hash: receipt.TransactionHash, Receipt: receipt})
+	return nil
+}
+
+// findReceipt returns the receipt of whichever broadcast version of tx was
+// mined, or nil if none was.
+func (m *Manager) findReceipt(ctx context.Context, tx *Transaction) (*types.Receipt, error) {
+	for i := len(tx.Hashes) - 1; i >= 0; i-- {
+		receipt, err := public.GetTransactionReceipt(ctx, m.client, public.GetTransactionReceiptParameters{Hash: tx.Hashes[i]})
+		if err == nil && receipt != nil {
+			return receipt, nil
+		}
+		var notFound *public.TransactionReceiptNotFoundError
+		if err != nil && !errors.As(err, &notFound) {
+			return nil, fmt.Errorf("failed to get receipt: %w", err)
+		}
+	}
+	return nil, nil
+}
+
+// replace broadcasts req at tx's nonce with bumped fees and records the new
+// hash. When manual is false the configured fee cap applies.
+func (m *Manager) replace(ctx context.Context, tx *Transaction, req Request, cancelled bool, manual bool) (common.Hash, error) {
+	market, err := m.marketFees(ctx, req, tx.Fees.IsLegacy())
+	if err != nil {
+		// Replacing is still possible without market data: the minimum bump
+		// is what the txpool requires.
+		market = nil
+	}
+	fees := BumpFees(tx.Fees, req.IsBlob(), m.cfg.PriceBump, market)
+	if !manual && fees.exceeds(m.cfg.MaxFeeCap) {
+		return common.Hash{}, ErrFeeCapExceeded
+	}
+
+	hash, err := m.broadcast(ctx, tx, req, fees)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	previous := tx.Hash()
+	tx.Request = req
+	tx.Fees = fees
+	tx.Cancelled = cancelled
+	tx.Hashes = append(tx.Hashes, hash)
+	tx.Attempts++
+	tx.LastBroadcast = time.Now()
+	if err := m.save(tx); err != nil {
+		return hash, err
+	}
+	m.emit(Event{Type: EventReplaced, Transaction: *tx, Hash: hash, PreviousHash: previous})
+	return hash, nil
+}
+
+// rebroadcast re-sends tx with its current fees, in case the node dropped it
+// from its pool. A node that still has it rejects it as already known.
+func (m *Manager) rebroadcast(ctx context.Context, tx *Transaction) error {
+	if _, err := m.broadcast(ctx, tx, tx.Request, tx.Fees); err != nil && !isKnownTransactionError(err) {
+		return err
+	}
+	tx.LastBroadcast = time.Now()
+	return m.save(tx)
+}
+
+// broadcast signs and sends req with fees at tx's nonce.
+func (m *Manager) broadcast(ctx context.Context, tx *Transaction, req Request, fees Fees) (common.Hash, error) {
+	nonce := int(tx.Nonce)
+	hash, err := wallet.SendTransaction(ctx, m.client, wallet.SendTransactionParameters{
+		Account:              m.account(tx.From),
+		To:                   req.To,
+		Data:                 req.Data,
+		Value:                req.Value,
+		Gas:                  req.Gas,
+		AccessList:           req.AccessList,
+		Blobs:                req.Blobs,
+		BlobVersionedHashes:  req.BlobVersionedHashes,
+		Type:                 req.Type,
+		Nonce:                &nonce,
+		GasPrice:             fees.GasPrice,
+		MaxFeePerGas:         fees.MaxFeePerGas,
+		MaxPriorityFeePerGas: fees.MaxPriorityFeePerGas,
+		MaxFeePerBlobGas:     fees.MaxFeePerBlobGas,
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send transaction %s: %w", tx.ID, err)
+	}
+	return common.HexToHash(hash), nil
+}
+
+// marketFees returns the current network fees for req. For blob requests the
+// blob fee cap is twice the current blob base fee.
+func (m *Manager) marketFees(ctx context.Context, req Request, legacy bool) (*Fees, error) {
+	var fees Fees
+	if !legacy {
+		est, err := public.EstimateFeesPerGas(ctx, m.client, public.EstimateFeesPerGasParameters{Type: public.FeeValuesTypeEIP1559})
+		if err == nil {
+			fees.MaxFeePerGas, fees.MaxPriorityFeePerGas = est.MaxFeePerGas, est.MaxPriorityFeePerGas
+		} else if req.Type == formatters.TransactionTypeEIP1559 || req.IsBlob() {
+			return nil, fmt.Errorf("failed to estimate fees: %w", err)
+		} else {
+			legacy = true
+		}
+	}
+	if legacy {
+		est, err := public.EstimateFeesPerGas(ctx, m.client, public.EstimateFeesPerGasParameters{Type: public.FeeValuesTypeLegacy})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate fees: %w", err)
+		}
+		fees.GasPrice = est.GasPrice
+	}
+	if req.IsBlob() {
+		blobBaseFee, err := public.GetBlobBaseFee(ctx, m.client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get blob base fee: %w", err)
+		}
+		fees.MaxFeePerBlobGas = new(big.Int).Mul(blobBaseFee, big.NewInt(2))
+	}
+	return &fees, nil
+}
+
+// nextNonce returns the next unused nonce for from, accounting for both the
+// node's pending pool and transactions the manager is still tracking.
+func (m *Manager) nextNonce(ctx context.Context, from common.Address) (uint64, error) {
+	nonce, err := public.GetTransactionCount(ctx, m.client, public.GetTransactionCountParameters{
+		Address:  from,
+		BlockTag: public.BlockTagPending,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, tx := range m.txs {
+		if tx.From == from && tx.Status == StatusPending && tx.Nonce >= nonce {
+			nonce = tx.Nonce + 1
+		}
+	}
+	return nonce, nil
+}
+
+func (m *Manager) pendingTx(id string) (*Transaction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tx, ok := m.txs[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if tx.Status != StatusPending {
+		return nil, fmt.Errorf("%w: %s is %s", ErrNotPending, id, tx.Status)
+	}
+	return tx.clone(), nil
+}
+
+// account returns the account to sign with for from. Unknown senders fall
+// back to node-side signing via eth_sendTransaction.
+func (m *Manager) account(from common.Address) wallet.Account {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if acc, ok := m.accounts[from]; ok {
+		return acc
+	}
+	return jsonRPCAccount(from)
+}
+
+func (m *Manager) save(tx *Transaction) error {
+	m.mu.Lock()
+	m.txs[tx.ID] = tx.clone()
+	m.mu.Unlock()
+	if err := m.cfg.Store.Put(tx); err != nil {
+		return fmt.Errorf("failed to persist transaction: %w", err)
+	}
+	return nil
+}
+
+func (m *Manager) forget(tx *Transaction) error {
+	m.mu.Lock()
+	delete(m.txs, tx.ID)
+	m.mu.Unlock()
+	if err := m.cfg.Store.Delete(tx.ID); err != nil {
+		return fmt.Errorf("failed to persist transaction: %w", err)
+	}
+	return nil
+}
+
+func (m *Manager) emit(e Event) {
+	if m.cfg.OnEvent == nil {
+		return
+	}
+	e.Transaction = *e.Transaction.clone()
+	m.cfg.OnEvent(e)
+}
+
+// jsonRPCAccount is an address-only account; the node signs its transactions.
+type jsonRPCAccount common.Address
+
+func (a jsonRPCAccount) Address() common.Address { return common.Address(a) }
+
+// isKnownTransactionError reports whether err is a node rejecting a
+// transaction it already has in its pool.
+func isKnownTransactionError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "known transaction")
+}
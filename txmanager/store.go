@@ -0,0 +1,162 @@
+package txmanager
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	json "github.com/goccy/go-json"
+)
+
+// Store persists tracked transactions so a Manager can resume after a restart.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// List returns every stored transaction.
+	List() ([]*Transaction, error)
+	// Put inserts or replaces a transaction, keyed by its ID.
+	Put(tx *Transaction) error
+	// Delete removes a transaction. Deleting an unknown ID is not an error.
+	Delete(id string) error
+}
+
+// MemoryStore is an in-memory Store. Its contents are lost on restart.
+type MemoryStore struct {
+	mu  sync.Mutex
+	txs map[string]*Transaction
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{txs: make(map[string]*Transaction)}
+}
+
+// List implements Store.
+func (s *MemoryStore) List() ([]*Transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedTransactions(s.txs), nil
+}
+
+// Put implements Store.
+func (s *MemoryStore) Put(tx *Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.txs[tx.ID] = tx.clone()
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.txs, id)
+	return nil
+}
+
+// FileStore is a Store backed by a single JSON file. Every change rewrites the
+// file atomically (write to a temporary file, then rename).
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+	txs  map[string]*Transaction
+}
+
+// NewFileStore opens (or creates) a file-backed store at path.
+//
+// Example:
+//
+//	store, err := txmanager.NewFileStore(filepath.Join(dataDir, "pending-txs.json"))
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, txs: make(map[string]*Transaction)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transaction store: %w", err)
+	}
+	if len(data) == 0 {
+		return s, nil
+	}
+
+	var txs []*Transaction
+	if err := json.Unmarshal(data, &txs); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction store %s: %w", path, err)
+	}
+	for _, tx := range txs {
+		s.txs[tx.ID] = tx
+	}
+	return s, nil
+}
+
+// Path returns the file the store writes to.
+func (s *FileStore) Path() string {
+	return s.path
+}
+
+// List implements Store.
+func (s *FileStore) List() ([]*Transaction, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedTransactions(s.txs), nil
+}
+
+// Put implements Store.
+func (s *FileStore) Put(tx *Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.txs[tx.ID] = tx.clone()
+	return s.flush()
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.txs[id]; !ok {
+		return nil
+	}
+	delete(s.txs, id)
+	return s.flush()
+}
+
+// flush writes the current contents to disk. The caller must hold s.mu.
+func (s *FileStore) flush() error {
+	data, err := json.MarshalIndent(sortedTransactions(s.txs), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode transaction store: %w", err)
+	}
+
+	if dir := filepath.Dir(s.path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create store directory: %w", err)
+		}
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write transaction store: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write transaction store: %w", err)
+	}
+	return nil
+}
+
+// sortedTransactions returns copies of txs ordered by sender and nonce.
+func sortedTransactions(txs map[string]*Transaction) []*Transaction {
+	out := make([]*Transaction, 0, len(txs))
+	for _, tx := range txs {
+		out = append(out, tx.clone())
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].From != out[j].From {
+			return out[i].From.Hex() < out[j].From.Hex()
+		}
+		return out[i].Nonce < out[j].Nonce
+	})
+	return out
+}
@@ -0,0 +1,13 @@
+package txmanager_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestTxManager(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TxManager Suite")
+}
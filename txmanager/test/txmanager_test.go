@@ -0,0 +1,400 @@
+package txmanager_test
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/accounts"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/internal/rpctest"
+	"github.com/ChefBingbong/viem-go/txmanager"
+)
+
+const testPrivateKey = "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcab78f4c6f2c5ff80"
+
+var targetAddr = common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+
+// fakeNode is a minimal JSON-RPC node that accepts raw transactions and
+// mines them on demand.
+type fakeNode struct {
+	*rpctest.Node
+	mu       sync.Mutex
+	head     uint64
+	nonce    uint64                 // confirmed nonce of the sender
+	receipts map[common.Hash]uint64 // tx hash -> block number
+}
+
+func newFakeNode() *fakeNode {
+	n := &fakeNode{
+		Node:     rpctest.NewNode(1),
+		head:     100,
+		receipts: make(map[common.Hash]uint64),
+	}
+	n.Result("eth_maxPriorityFeePerGas", "0x3b9aca00")
+	n.Result("eth_gasPrice", "0x77359400")
+	n.Handle("eth_blockNumber", n.blockNumber)
+	n.Handle("eth_getTransactionCount", n.getTransactionCount)
+	n.Handle("eth_getBlockByNumber", n.getBlockByNumber)
+	n.Handle("eth_getTransactionReceipt", n.getTransactionReceipt)
+	return n
+}
+
+// mine includes tx in a new block and advances the sender's nonce.
+func (n *fakeNode) mine(hash common.Hash) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.head++
+	n.receipts[hash] = n.head
+	n.nonce++
+}
+
+// reorg removes hash from the chain.
+func (n *fakeNode) reorg(hash common.Hash) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.receipts, hash)
+	n.nonce--
+}
+
+func (n *fakeNode) advance(blocks uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.head += blocks
+}
+
+func (n *fakeNode) blockNumber([]json.RawMessage) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return hexutil.EncodeUint64(n.head), nil
+}
+
+func (n *fakeNode) getTransactionCount(params []json.RawMessage) (any, error) {
+	sent := n.SentTxs()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var tag string
+	if len(params) > 1 {
+		_ = json.Unmarshal(params[1], &tag)
+	}
+	if tag != "pending" {
+		return hexutil.EncodeUint64(n.nonce), nil
+	}
+	pending := n.nonce
+	for _, tx := range sent {
+		if tx.Nonce() >= pending {
+			pending = tx.Nonce() + 1
+		}
+	}
+	return hexutil.EncodeUint64(pending), nil
+}
+
+func (n *fakeNode) getBlockByNumber([]json.RawMessage) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return map[string]any{
+		"number":        hexutil.EncodeUint64(n.head),
+		"baseFeePerGas": "0x3b9aca00",
+		"gasLimit":      "0x1c9c380",
+		"gasUsed":       "0x0",
+		"timestamp":     "0x60000000",
+		"hash":          common.BigToHash(new(big.Int).SetUint64(n.head)).Hex(),
+		"parentHash":    common.Hash{}.Hex(),
+		"transactions":  []string{},
+	}, nil
+}
+
+func (n *fakeNode) getTransactionReceipt(params []json.RawMessage) (any, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var hash common.Hash
+	_ = json.Unmarshal(params[0], &hash)
+	block, ok := n.receipts[hash]
+	if !ok {
+		return nil, nil
+	}
+	return map[string]any{
+		"transactionHash":   hash.Hex(),
+		"transactionIndex":  "0x0",
+		"blockHash":         common.BigToHash(new(big.Int).SetUint64(block)).Hex(),
+		"blockNumber":       hexutil.EncodeUint64(block),
+		"cumulativeGasUsed": "0x5208",
+		"gasUsed":           "0x5208",
+		"status":            "0x1",
+		"logs":              []any{},
+		"effectiveGasPrice": "0x3b9aca00",
+		"type":              "0x2",
+	}, nil
+}
+
+func tempDir() string {
+	dir, err := os.MkdirTemp("", "txmanager-test")
+	Expect(err).NotTo(HaveOccurred())
+	return dir
+}
+
+var _ = Describe("BumpFees", func() {
+	It("raises every fee by at least the price bump", func() {
+		next := txmanager.BumpFees(txmanager.Fees{
+			MaxFeePerGas:         big.NewInt(1000),
+			MaxPriorityFeePerGas: big.NewInt(15),
+		}, false, 5, nil)
+		Expect(next.MaxFeePerGas.Int64()).To(Equal(int64(1100)))
+		Expect(next.MaxPriorityFeePerGas.Int64()).To(Equal(int64(17)))
+		Expect(next.GasPrice).To(BeNil())
+	})
+
+	It("doubles every fee of blob transactions", func() {
+		next := txmanager.BumpFees(txmanager.Fees{
+			MaxFeePerGas:         big.NewInt(1000),
+			MaxPriorityFeePerGas: big.NewInt(10),
+			MaxFeePerBlobGas:     big.NewInt(7),
+		}, true, txmanager.PriceBump, nil)
+		Expect(next.MaxFeePerGas.Int64()).To(Equal(int64(2000)))
+		Expect(next.MaxPriorityFeePerGas.Int64()).To(Equal(int64(20)))
+		Expect(next.MaxFeePerBlobGas.Int64()).To(Equal(int64(14)))
+	})
+
+	It("follows the market when it is higher", func() {
+		next := txmanager.BumpFees(txmanager.Fees{GasPrice: big.NewInt(100)}, false, txmanager.PriceBump,
+			&txmanager.Fees{GasPrice: big.NewInt(500)})
+		Expect(next.GasPrice.Int64()).To(Equal(int64(500)))
+	})
+
+	It("bumps tiny values by at least one wei", func() {
+		next := txmanager.BumpFees(txmanager.Fees{GasPrice: big.NewInt(1)}, false, txmanager.PriceBump, nil)
+		Expect(next.GasPrice.Int64()).To(Equal(int64(2)))
+	})
+})
+
+var _ = Describe("Store", func() {
+	It("persists transactions across FileStore instances", func() {
+		dir := tempDir()
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "txs.json")
+		store, err := txmanager.NewFileStore(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		tx := &txmanager.Transaction{
+			ID:     "0xabc/1",
+			From:   common.HexToAddress("0xabc"),
+			Nonce:  1,
+			Status: txmanager.StatusPending,
+			Fees:   txmanager.Fees{MaxFeePerGas: big.NewInt(42)},
+			Hashes: []common.Hash{common.HexToHash("0x01")},
+		}
+		Expect(store.Put(tx)).To(Succeed())
+
+		reopened, err := txmanager.NewFileStore(path)
+		Expect(err).NotTo(HaveOccurred())
+		txs, err := reopened.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(txs).To(HaveLen(1))
+		Expect(txs[0].Fees.MaxFeePerGas.Int64()).To(Equal(int64(42)))
+		Expect(txs[0].Hashes).To(Equal(tx.Hashes))
+
+		Expect(reopened.Delete(tx.ID)).To(Succeed())
+		txs, err = reopened.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(txs).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Manager", func() {
+	var (
+		ctx     context.Context
+		node    *fakeNode
+		account *accounts.PrivateKeyAccount
+		wallet  *client.WalletClient
+		events  []txmanager.Event
+		onEvent func(txmanager.Event)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		node = newFakeNode()
+
+		var err error
+		account, err = accounts.PrivateKeyToAccount(testPrivateKey)
+		Expect(err).NotTo(HaveOccurred())
+		wallet, err = client.CreateWalletClient(client.WalletClientConfig{
+			Account:   account,
+			Chain:     &chain.Chain{ID: 1, Name: "Test Chain"},
+			Transport: transport.HTTP(node.URL),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		events = nil
+		onEvent = func(e txmanager.Event) { events = append(events, e) }
+	})
+
+	AfterEach(func() {
+		node.Close()
+	})
+
+	eventTypes := func() []txmanager.EventType {
+		var out []txmanager.EventType
+		for _, e := range events {
+			out = append(out, e.Type)
+		}
+		return out
+	}
+
+	It("assigns consecutive nonces and tracks until confirmed", func() {
+		mgr, err := txmanager.New(wallet, txmanager.Config{Confirmations: 3, OnEvent: onEvent})
+		Expect(err).NotTo(HaveOccurred())
+
+		first, err := mgr.Send(ctx, txmanager.SendParameters{To: targetAddr.Hex(), Value: big.NewInt(1)})
+		Expect(err).NotTo(HaveOccurred())
+		second, err := mgr.Send(ctx, txmanager.SendParameters{To: targetAddr.Hex(), Value: big.NewInt(2)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first.Nonce).To(Equal(uint64(0)))
+		Expect(second.Nonce).To(Equal(uint64(1)))
+		Expect(mgr.Pending(account.Address())).To(HaveLen(2))
+
+		node.mine(first.Hash())
+		Expect(mgr.Check(ctx)).To(Succeed())
+		tx, ok := mgr.Get(first.ID)
+		Expect(ok).To(BeTrue())
+		Expect(tx.Status).To(Equal(txmanager.StatusMined))
+
+		node.advance(2)
+		Expect(mgr.Check(ctx)).To(Succeed())
+		_, ok = mgr.Get(first.ID)
+		Expect(ok).To(BeFalse())
+		Expect(eventTypes()).To(Equal([]txmanager.EventType{
+			txmanager.EventSent, txmanager.EventSent, txmanager.EventMined, txmanager.EventConfirmed,
+		}))
+		Expect(events[3].Hash).To(Equal(first.Hash()))
+	})
+
+	It("re-broadcasts stuck transactions with bumped fees", func() {
+		mgr, err := txmanager.New(wallet, txmanager.Config{ResubmitAfter: time.Nanosecond, OnEvent: onEvent})
+		Expect(err).NotTo(HaveOccurred())
+
+		tx, err := mgr.Send(ctx, txmanager.SendParameters{To: targetAddr.Hex(), Value: big.NewInt(1)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mgr.Check(ctx)).To(Succeed())
+
+		sent := node.SentTxs()
+		Expect(sent).To(HaveLen(2))
+		Expect(sent[1].Nonce()).To(Equal(sent[0].Nonce()))
+		minFee := new(big.Int).Div(new(big.Int).Mul(sent[0].GasFeeCap(), big.NewInt(110)), big.NewInt(100))
+		Expect(sent[1].GasFeeCap().Cmp(minFee)).To(BeNumerically(">=", 0))
+		Expect(sent[1].GasTipCap().Cmp(sent[0].GasTipCap())).To(Equal(1))
+
+		Expect(events[1].Type).To(Equal(txmanager.EventReplaced))
+		Expect(events[1].PreviousHash).To(Equal(tx.Hash()))
+		Expect(events[1].Hash).To(Equal(sent[1].Hash()))
+
+		// The original version gets mined; the manager still recognises it.
+		node.mine(sent[0].Hash())
+		Expect(mgr.Check(ctx)).To(Succeed())
+		Expect(events[len(events)-1].Type).To(Equal(txmanager.EventConfirmed))
+		Expect(events[len(events)-1].Hash).To(Equal(sent[0].Hash()))
+	})
+
+	It("speeds up and cancels on request", func() {
+		mgr, err := txmanager.New(wallet, txmanager.Config{OnEvent: onEvent})
+		Expect(err).NotTo(HaveOccurred())
+
+		tx, err := mgr.Send(ctx, txmanager.SendParameters{To: targetAddr.Hex(), Value: big.NewInt(5), Data: "0x1234"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = mgr.SpeedUp(ctx, tx.ID)
+		Expect(err).NotTo(HaveOccurred())
+		hash, err := mgr.Cancel(ctx, tx.ID)
+		Expect(err).NotTo(HaveOccurred())
+
+		sent := node.SentTxs()
+		Expect(sent).To(HaveLen(3))
+		cancel := sent[2]
+		Expect(cancel.Hash()).To(Equal(hash))
+		Expect(cancel.Nonce()).To(Equal(tx.Nonce))
+		Expect(*cancel.To()).To(Equal(account.Address()))
+		Expect(cancel.Value().Sign()).To(Equal(0))
+		Expect(cancel.Data()).To(BeEmpty())
+		Expect(cancel.GasFeeCap().Cmp(sent[1].GasFeeCap())).To(Equal(1))
+
+		got, _ := mgr.Get(tx.ID)
+		Expect(got.Cancelled).To(BeTrue())
+		Expect(got.Hashes).To(HaveLen(3))
+
+		_, err = mgr.SpeedUp(ctx, "0x0/99")
+		Expect(err).To(MatchError(txmanager.ErrNotFound))
+	})
+
+	It("reports transactions whose nonce was consumed elsewhere as dropped", func() {
+		mgr, err := txmanager.New(wallet, txmanager.Config{OnEvent: onEvent})
+		Expect(err).NotTo(HaveOccurred())
+
+		tx, err := mgr.Send(ctx, txmanager.SendParameters{To: targetAddr.Hex(), Value: big.NewInt(1)})
+		Expect(err).NotTo(HaveOccurred())
+
+		node.mine(common.HexToHash("0xdead"))
+		Expect(mgr.Check(ctx)).To(Succeed())
+		Expect(eventTypes()).To(Equal([]txmanager.EventType{txmanager.EventSent, txmanager.EventDropped}))
+		_, ok := mgr.Get(tx.ID)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("tracks reorged transactions as pending again", func() {
+		mgr, err := txmanager.New(wallet, txmanager.Config{Confirmations: 5, OnEvent: onEvent})
+		Expect(err).NotTo(HaveOccurred())
+
+		tx, err := mgr.Send(ctx, txmanager.SendParameters{To: targetAddr.Hex(), Value: big.NewInt(1)})
+		Expect(err).NotTo(HaveOccurred())
+		node.mine(tx.Hash())
+		Expect(mgr.Check(ctx)).To(Succeed())
+
+		node.reorg(tx.Hash())
+		Expect(mgr.Check(ctx)).To(Succeed())
+		got, ok := mgr.Get(tx.ID)
+		Expect(ok).To(BeTrue())
+		Expect(got.Status).To(Equal(txmanager.StatusPending))
+		Expect(eventTypes()).To(Equal([]txmanager.EventType{
+			txmanager.EventSent, txmanager.EventMined, txmanager.EventReorged,
+		}))
+	})
+
+	It("resumes tracking from the store after a restart", func() {
+		dir := tempDir()
+		defer os.RemoveAll(dir)
+		store, err := txmanager.NewFileStore(filepath.Join(dir, "txs.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		mgr, err := txmanager.New(wallet, txmanager.Config{Store: store})
+		Expect(err).NotTo(HaveOccurred())
+		tx, err := mgr.Send(ctx, txmanager.SendParameters{To: targetAddr.Hex(), Value: big.NewInt(1)})
+		Expect(err).NotTo(HaveOccurred())
+
+		reopened, err := txmanager.NewFileStore(store.Path())
+		Expect(err).NotTo(HaveOccurred())
+		restarted, err := txmanager.New(wallet, txmanager.Config{Store: reopened, OnEvent: onEvent})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restarted.Pending(account.Address())).To(HaveLen(1))
+
+		next, err := restarted.Send(ctx, txmanager.SendParameters{To: targetAddr.Hex(), Value: big.NewInt(1)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next.Nonce).To(Equal(tx.Nonce + 1))
+
+		node.mine(tx.Hash())
+		Expect(restarted.Check(ctx)).To(Succeed())
+		Expect(eventTypes()).To(ContainElement(txmanager.EventConfirmed))
+		txs, err := reopened.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(txs).To(HaveLen(1))
+		Expect(txs[0].ID).To(Equal(next.ID))
+	})
+})
@@ -88,6 +88,13 @@ type Block struct {
 	ExcessBlobGas *uint64 `json:"excessBlobGas,omitempty"`
 	// EIP-4788 fields
 	ParentBeaconBlockRoot *common.Hash `json:"parentBeaconBlockRoot,omitempty"`
+	// EIP-4895 fields
+	WithdrawalsRoot *common.Hash `json:"withdrawalsRoot,omitempty"`
+	// EIP-7685 fields
+	RequestsHash *common.Hash `json:"requestsHash,omitempty"`
+	// ChainFields holds the chain-specific fields returned by the chain's
+	// block formatter, if any.
+	ChainFields any `json:"-"`
 }
 
 // UnmarshalJSON implements json.Unmarshaler for Block.
@@ -119,6 +126,8 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 		BlobGasUsed      *hexutil.Uint64 `json:"blobGasUsed"`
 		ExcessBlobGas    *hexutil.Uint64 `json:"excessBlobGas"`
 		ParentBeaconRoot *common.Hash    `json:"parentBeaconBlockRoot"`
+		WithdrawalsRoot  *common.Hash    `json:"withdrawalsRoot"`
+		RequestsHash     *common.Hash    `json:"requestsHash"`
 	}
 
 	var dec blockJSON
@@ -197,6 +206,8 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 	if dec.ParentBeaconRoot != nil {
 		b.ParentBeaconBlockRoot = dec.ParentBeaconRoot
 	}
+	b.WithdrawalsRoot = dec.WithdrawalsRoot
+	b.RequestsHash = dec.RequestsHash
 
 	return nil
 }
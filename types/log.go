@@ -102,6 +102,9 @@ type Receipt struct {
 	// EIP-4844 fields
 	BlobGasUsed  *uint64  `json:"blobGasUsed,omitempty"`
 	BlobGasPrice *big.Int `json:"blobGasPrice,omitempty"`
+	// ChainFields holds the chain-specific fields returned by the chain's
+	// receipt formatter, if any.
+	ChainFields any `json:"-"`
 }
 
 // UnmarshalJSON implements json.Unmarshaler for Receipt.
@@ -0,0 +1,26 @@
+package blob
+
+import (
+	"github.com/ChefBingbong/viem-go/utils/kzg"
+)
+
+// BlobsToCellProofs computes the EIP-7594 (PeerDAS) cell proofs of a list of
+// blobs: kzg.CellProofsPerBlob proofs per blob.
+//
+// Example:
+//
+//	blobs, _ := ToBlobs(data)
+//	cellProofs, err := BlobsToCellProofs(blobs, kzg.Default())
+func BlobsToCellProofs(blobs [][]byte, kzgImpl kzg.CellProofKzg) ([][][]byte, error) {
+	cellProofs := make([][][]byte, len(blobs))
+
+	for i, blob := range blobs {
+		proofs, err := kzgImpl.ComputeCellProofs(blob)
+		if err != nil {
+			return nil, err
+		}
+		cellProofs[i] = proofs
+	}
+
+	return cellProofs, nil
+}
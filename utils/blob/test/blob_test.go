@@ -198,6 +198,53 @@ var _ = Describe("Blob", func() {
 		})
 	})
 
+	Describe("ToBlobSidecars with the default KZG", func() {
+		It("should create verifiable version-0 sidecars", func() {
+			sidecars, err := blob.ToBlobSidecars(blob.ToBlobSidecarsParams{
+				Data: []byte("hello world"),
+				Kzg:  kzg.Default(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sidecars).To(HaveLen(1))
+			Expect(sidecars[0].CellProofs).To(BeNil())
+			Expect(kzg.VerifyBlobKzgProof(sidecars[0].Blob, sidecars[0].Commitment, sidecars[0].Proof)).To(Succeed())
+		})
+
+		It("should create verifiable version-1 sidecars", func() {
+			sidecars, err := blob.ToBlobSidecars(blob.ToBlobSidecarsParams{
+				Data:    []byte("hello world"),
+				Kzg:     kzg.Default(),
+				Version: blob.SidecarVersion1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sidecars).To(HaveLen(1))
+			Expect(sidecars[0].Proof).To(BeEmpty())
+			Expect(sidecars[0].CellProofs).To(HaveLen(kzg.CellProofsPerBlob))
+			Expect(kzg.VerifyCellKzgProofBatch(
+				[][]byte{sidecars[0].Blob}, [][]byte{sidecars[0].Commitment}, sidecars[0].CellProofs,
+			)).To(Succeed())
+
+			hexSidecars, err := blob.ToBlobSidecarsHex(blob.ToBlobSidecarsParams{
+				Blobs:       [][]byte{sidecars[0].Blob},
+				Commitments: [][]byte{sidecars[0].Commitment},
+				CellProofs:  [][][]byte{sidecars[0].CellProofs},
+				Version:     blob.SidecarVersion1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hexSidecars[0].Proof).To(BeEmpty())
+			Expect(hexSidecars[0].CellProofs).To(HaveLen(kzg.CellProofsPerBlob))
+		})
+
+		It("should require cell proof support for version-1 sidecars", func() {
+			_, err := blob.ToBlobSidecars(blob.ToBlobSidecarsParams{
+				Data:    []byte("hello world"),
+				Kzg:     &mockKzg{},
+				Version: blob.SidecarVersion1,
+			})
+			Expect(err).To(MatchError(kzg.ErrCellProofsNotSupported))
+		})
+	})
+
 	Describe("SidecarsToVersionedHashes", func() {
 		It("should extract versioned hashes from sidecars", func() {
 			sidecars := []blob.BlobSidecar{
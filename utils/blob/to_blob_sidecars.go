@@ -14,8 +14,14 @@ type ToBlobSidecarsParams struct {
 	Commitments [][]byte
 	// Pre-computed proofs (required if Blobs is set without Kzg)
 	Proofs [][]byte
-	// KZG implementation (required if Data is set or if Commitments/Proofs need to be computed)
+	// Pre-computed cell proofs, kzg.CellProofsPerBlob per blob (version 1 only)
+	CellProofs [][][]byte
+	// KZG implementation (required if Data is set or if Commitments/Proofs need to be computed).
+	// Version-1 sidecars need a kzg.CellProofKzg, such as kzg.Default().
 	Kzg kzg.Kzg
+	// Sidecar version: SidecarVersion0 (default, EIP-4844 blob proofs) or
+	// SidecarVersion1 (EIP-7594 cell proofs)
+	Version int
 }
 
 // ToBlobSidecars creates blob sidecars from data or pre-computed components.
@@ -34,6 +40,14 @@ type ToBlobSidecarsParams struct {
 //		Commitments: commitments,
 //		Proofs:      proofs,
 //	})
+//
+// Example with version-1 (PeerDAS) sidecars:
+//
+//	sidecars, err := ToBlobSidecars(ToBlobSidecarsParams{
+//		Data:    data,
+//		Kzg:     kzg.Default(),
+//		Version: SidecarVersion1,
+//	})
 func ToBlobSidecars(params ToBlobSidecarsParams) ([]BlobSidecar, error) {
 	var blobs [][]byte
 	var commitments [][]byte
@@ -64,6 +78,10 @@ func ToBlobSidecars(params ToBlobSidecarsParams) ([]BlobSidecar, error) {
 		return nil, kzg.ErrKzgNotInitialized
 	}
 
+	if params.Version == SidecarVersion1 {
+		return toBlobSidecarsV1(params, blobs, commitments)
+	}
+
 	// Get or compute proofs
 	if params.Proofs != nil {
 		proofs = params.Proofs
@@ -89,6 +107,42 @@ func ToBlobSidecars(params ToBlobSidecarsParams) ([]BlobSidecar, error) {
 	return sidecars, nil
 }
 
+// toBlobSidecarsV1 creates version-1 sidecars carrying cell proofs.
+func toBlobSidecarsV1(params ToBlobSidecarsParams, blobs, commitments [][]byte) ([]BlobSidecar, error) {
+	cellProofs := params.CellProofs
+	if cellProofs == nil {
+		cellKzg, ok := params.Kzg.(kzg.CellProofKzg)
+		if !ok {
+			if params.Kzg == nil {
+				return nil, kzg.ErrKzgNotInitialized
+			}
+			return nil, kzg.ErrCellProofsNotSupported
+		}
+		var err error
+		cellProofs, err = BlobsToCellProofs(blobs, cellKzg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(cellProofs) != len(blobs) || len(commitments) != len(blobs) {
+		return nil, kzg.ErrLengthMismatch
+	}
+
+	sidecars := make([]BlobSidecar, len(blobs))
+	for i := range blobs {
+		if len(cellProofs[i]) != kzg.CellProofsPerBlob {
+			return nil, kzg.ErrLengthMismatch
+		}
+		sidecars[i] = BlobSidecar{
+			Blob:       blobs[i],
+			Commitment: commitments[i],
+			CellProofs: cellProofs[i],
+		}
+	}
+
+	return sidecars, nil
+}
+
 // ToBlobSidecarsHex creates hex-encoded blob sidecars.
 func ToBlobSidecarsHex(params ToBlobSidecarsParams) ([]BlobSidecarHex, error) {
 	sidecars, err := ToBlobSidecars(params)
@@ -101,7 +155,14 @@ func ToBlobSidecarsHex(params ToBlobSidecarsParams) ([]BlobSidecarHex, error) {
 		hexSidecars[i] = BlobSidecarHex{
 			Blob:       bytesToHex(sidecar.Blob),
 			Commitment: bytesToHex(sidecar.Commitment),
-			Proof:      bytesToHex(sidecar.Proof),
+		}
+		if sidecar.CellProofs != nil {
+			hexSidecars[i].CellProofs = make([]string, len(sidecar.CellProofs))
+			for j, proof := range sidecar.CellProofs {
+				hexSidecars[i].CellProofs[j] = bytesToHex(proof)
+			}
+		} else {
+			hexSidecars[i].Proof = bytesToHex(sidecar.Proof)
 		}
 	}
 
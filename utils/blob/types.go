@@ -4,18 +4,30 @@ import (
 	"github.com/ChefBingbong/viem-go/utils/kzg"
 )
 
-// BlobSidecar represents a blob with its commitment and proof.
+// Sidecar versions.
+const (
+	// SidecarVersion0 sidecars carry one blob proof per blob (EIP-4844).
+	SidecarVersion0 = 0
+	// SidecarVersion1 sidecars carry kzg.CellProofsPerBlob cell proofs per
+	// blob instead of a blob proof (EIP-7594, PeerDAS).
+	SidecarVersion1 = 1
+)
+
+// BlobSidecar represents a blob with its commitment and proof. Version-1
+// sidecars set CellProofs and leave Proof empty.
 type BlobSidecar struct {
-	Blob       []byte `json:"blob"`
-	Commitment []byte `json:"commitment"`
-	Proof      []byte `json:"proof"`
+	Blob       []byte   `json:"blob"`
+	Commitment []byte   `json:"commitment"`
+	Proof      []byte   `json:"proof"`
+	CellProofs [][]byte `json:"cellProofs,omitempty"`
 }
 
 // BlobSidecarHex represents a blob sidecar with hex-encoded values.
 type BlobSidecarHex struct {
-	Blob       string `json:"blob"`
-	Commitment string `json:"commitment"`
-	Proof      string `json:"proof"`
+	Blob       string   `json:"blob"`
+	Commitment string   `json:"commitment"`
+	Proof      string   `json:"proof"`
+	CellProofs []string `json:"cellProofs,omitempty"`
 }
 
 // Re-export constants from kzg package for convenience
@@ -0,0 +1,140 @@
+// Package block recomputes the commitments of an execution block — the
+// block hash from the header fields, the transactions root and the receipts
+// root — so that blocks returned by an untrusted RPC provider can be
+// cross-checked.
+//
+// Example:
+//
+//	hash, err := block.HashHeader(b)
+//	if hash != b.Hash {
+//		// the provider returned inconsistent header fields
+//	}
+package block
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// BloomLength is the length of a logs bloom in bytes.
+const BloomLength = 256
+
+var (
+	// EmptyUncleHash is the keccak256 hash of an empty uncle list.
+	EmptyUncleHash = crypto.Keccak256Hash([]byte{0xc0})
+)
+
+var (
+	// ErrInvalidHeader is returned when the header fields of a block do not
+	// form a valid header shape.
+	ErrInvalidHeader = errors.New("block: invalid header")
+	// ErrInvalidTransaction is returned when a serialized transaction cannot
+	// be decoded.
+	ErrInvalidTransaction = errors.New("block: invalid serialized transaction")
+)
+
+// HeaderRLP returns the RLP encoding of the header of b.
+//
+// The header shape follows the fork fields that are set: BaseFeePerGas
+// (London), WithdrawalsRoot (Shanghai), BlobGasUsed, ExcessBlobGas and
+// ParentBeaconBlockRoot (Cancun) and RequestsHash (Prague). Fork fields are
+// appended in that order, so a field may only be set if every earlier one
+// is set.
+func HeaderRLP(b *types.Block) ([]byte, error) {
+	if b == nil {
+		return nil, fmt.Errorf("%w: nil block", ErrInvalidHeader)
+	}
+
+	bloom := b.LogsBloom
+	if len(bloom) == 0 {
+		bloom = make([]byte, BloomLength)
+	}
+	if len(bloom) != BloomLength {
+		return nil, fmt.Errorf("%w: logs bloom is %d bytes, want %d", ErrInvalidHeader, len(bloom), BloomLength)
+	}
+	difficulty := b.Difficulty
+	if difficulty == nil {
+		difficulty = new(big.Int)
+	}
+
+	fields := []any{
+		b.ParentHash,
+		b.Sha3Uncles,
+		b.Miner,
+		b.StateRoot,
+		b.TransactionsRoot,
+		b.ReceiptsRoot,
+		bloom,
+		difficulty,
+		b.Number,
+		b.GasLimit,
+		b.GasUsed,
+		b.Timestamp,
+		b.ExtraData,
+		b.MixHash,
+		b.Nonce[:],
+	}
+
+	forkFields, err := headerForkFields(b)
+	if err != nil {
+		return nil, err
+	}
+	fields = append(fields, forkFields...)
+
+	enc, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+	return enc, nil
+}
+
+// HashHeader recomputes the block hash of b from its header fields (see
+// HeaderRLP).
+func HashHeader(b *types.Block) (common.Hash, error) {
+	enc, err := HeaderRLP(b)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(enc), nil
+}
+
+// headerForkFields returns the header fields added after the merge-era base
+// header, in encoding order, up to the last one that is set.
+func headerForkFields(b *types.Block) ([]any, error) {
+	type forkField struct {
+		name  string
+		set   bool
+		value any
+	}
+	all := []forkField{
+		{"baseFeePerGas", b.BaseFeePerGas != nil, b.BaseFeePerGas},
+		{"withdrawalsRoot", b.WithdrawalsRoot != nil, b.WithdrawalsRoot},
+		{"blobGasUsed", b.BlobGasUsed != nil, b.BlobGasUsed},
+		{"excessBlobGas", b.ExcessBlobGas != nil, b.ExcessBlobGas},
+		{"parentBeaconBlockRoot", b.ParentBeaconBlockRoot != nil, b.ParentBeaconBlockRoot},
+		{"requestsHash", b.RequestsHash != nil, b.RequestsHash},
+	}
+
+	last := -1
+	for i, f := range all {
+		if f.set {
+			last = i
+		}
+	}
+
+	fields := make([]any, 0, last+1)
+	for _, f := range all[:last+1] {
+		if !f.set {
+			return nil, fmt.Errorf("%w: %s is missing but later fork fields are set", ErrInvalidHeader, f.name)
+		}
+		fields = append(fields, f.value)
+	}
+	return fields, nil
+}
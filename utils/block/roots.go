@@ -0,0 +1,136 @@
+package block
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// blobTxType is the EIP-2718 type of EIP-4844 transactions.
+const blobTxType = 0x03
+
+// CanonicalTransaction decodes a serialized transaction (as returned by
+// transaction.SerializeTransaction or eth_getRawTransactionByHash) into the
+// bytes that are committed to by the transactions root. EIP-4844
+// transactions in network form have their blobs, commitments and proofs
+// stripped.
+func CanonicalTransaction(serialized string) ([]byte, error) {
+	raw, err := hexutil.Decode(serialized)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("%w: empty transaction", ErrInvalidTransaction)
+	}
+	if raw[0] != blobTxType {
+		return raw, nil
+	}
+
+	// The network form is 0x03 || rlp([tx_payload_body, ...sidecar]), the
+	// canonical form 0x03 || rlp(tx_payload_body). Only the former starts
+	// with a nested list.
+	content, _, err := rlp.SplitList(raw[1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+	}
+	kind, _, rest, err := rlp.Split(content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransaction, err)
+	}
+	if kind != rlp.List {
+		return raw, nil
+	}
+	first := content[:len(content)-len(rest)]
+	return append([]byte{blobTxType}, first...), nil
+}
+
+// TransactionHash returns the hash of a serialized transaction.
+func TransactionHash(serialized string) (common.Hash, error) {
+	raw, err := CanonicalTransaction(serialized)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(raw), nil
+}
+
+// TransactionsRoot recomputes the transactions root of a block from its
+// serialized transactions, in block order.
+func TransactionsRoot(serialized []string) (common.Hash, error) {
+	values := make([][]byte, len(serialized))
+	for i, tx := range serialized {
+		raw, err := CanonicalTransaction(tx)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		values[i] = raw
+	}
+	return deriveRoot(values), nil
+}
+
+// receiptRLP is the consensus encoding of a receipt.
+type receiptRLP struct {
+	Status            uint64
+	CumulativeGasUsed uint64
+	Bloom             []byte
+	Logs              []logRLP
+}
+
+// logRLP is the consensus encoding of a log.
+type logRLP struct {
+	Address common.Address
+	Topics  []common.Hash
+	Data    []byte
+}
+
+// EncodeReceipt returns the consensus encoding of a receipt, as committed to
+// by the receipts root: rlp([status, cumulativeGasUsed, logsBloom, logs]),
+// prefixed with the transaction type for typed transactions. Receipts of
+// pre-Byzantium blocks, which carry a state root instead of a status, are
+// not supported.
+func EncodeReceipt(receipt *types.Receipt) ([]byte, error) {
+	bloom := receipt.LogsBloom
+	if len(bloom) == 0 {
+		bloom = make([]byte, BloomLength)
+	}
+	if len(bloom) != BloomLength {
+		return nil, fmt.Errorf("block: receipt logs bloom is %d bytes, want %d", len(bloom), BloomLength)
+	}
+
+	logs := make([]logRLP, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = logRLP{Address: log.Address, Topics: log.Topics, Data: log.Data}
+	}
+
+	enc, err := rlp.EncodeToBytes(receiptRLP{
+		Status:            receipt.Status,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		Bloom:             bloom,
+		Logs:              logs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("block: failed to encode receipt: %w", err)
+	}
+	if receipt.Type == 0 {
+		return enc, nil
+	}
+	return append([]byte{byte(receipt.Type)}, enc...), nil
+}
+
+// ReceiptsRoot recomputes the receipts root of a block from its receipts, in
+// block order.
+func ReceiptsRoot(receipts []types.Receipt) (common.Hash, error) {
+	values := make([][]byte, len(receipts))
+	for i := range receipts {
+		enc, err := EncodeReceipt(&receipts[i])
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("receipt %d: %w", i, err)
+		}
+		values[i] = enc
+	}
+	return deriveRoot(values), nil
+}
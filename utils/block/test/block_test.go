@@ -0,0 +1,271 @@
+package test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/block"
+	"github.com/ChefBingbong/viem-go/utils/proof"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// Expected hashes were computed with go-ethereum's core/types for the same
+// headers, transactions and receipts.
+
+func mainnetGenesis() *types.Block {
+	return &types.Block{
+		Number:           0,
+		Hash:             common.HexToHash("0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"),
+		Nonce:            types.BlockNonce{0, 0, 0, 0, 0, 0, 0, 0x42},
+		Sha3Uncles:       block.EmptyUncleHash,
+		LogsBloom:        make([]byte, block.BloomLength),
+		TransactionsRoot: proof.EmptyRootHash,
+		StateRoot:        common.HexToHash("0xd7f8974fb5ac78d9ac099b9ad5018bedc2ce0a72dad1827a1709da30580f0544"),
+		ReceiptsRoot:     proof.EmptyRootHash,
+		Difficulty:       big.NewInt(17179869184),
+		ExtraData:        common.FromHex("0x11bbe8db4e347b4e8c937c1c8370e4b5ed33adb3db69cbdb7a38e1e50b1b82fa"),
+		GasLimit:         5000,
+	}
+}
+
+func postMergeHeader() *types.Block {
+	return &types.Block{
+		Number:           20000000,
+		ParentHash:       common.HexToHash("0x01"),
+		Sha3Uncles:       block.EmptyUncleHash,
+		Miner:            common.HexToAddress("0x95222290dd7278aa3ddd389cc1e1d165cc4bafe5"),
+		StateRoot:        common.HexToHash("0x02"),
+		TransactionsRoot: common.HexToHash("0x03"),
+		ReceiptsRoot:     common.HexToHash("0x04"),
+		Difficulty:       new(big.Int),
+		GasLimit:         30000000,
+		GasUsed:          12345678,
+		Timestamp:        1718000000,
+		ExtraData:        []byte("beaverbuild.org"),
+		MixHash:          common.HexToHash("0x05"),
+		BaseFeePerGas:    big.NewInt(7000000000),
+	}
+}
+
+func hashPtr(s string) *common.Hash {
+	h := common.HexToHash(s)
+	return &h
+}
+
+func uint64Ptr(v uint64) *uint64 { return &v }
+
+func serialize(tx *transaction.Transaction) string {
+	serialized, err := transaction.SerializeTransaction(tx, &transaction.Signature{
+		R:       "0x" + strings.Repeat("11", 32),
+		S:       "0x" + strings.Repeat("22", 32),
+		YParity: 1,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return serialized
+}
+
+func testTransactions() []*transaction.Transaction {
+	to := "0x1234567890123456789012345678901234567890"
+	return []*transaction.Transaction{
+		{
+			Type:     transaction.TransactionTypeLegacy,
+			ChainId:  1,
+			Nonce:    7,
+			GasPrice: big.NewInt(20000000000),
+			Gas:      big.NewInt(21000),
+			To:       to,
+			Value:    big.NewInt(1000000000000000000),
+		},
+		{
+			Type:                 transaction.TransactionTypeEIP1559,
+			ChainId:              1,
+			Nonce:                8,
+			MaxPriorityFeePerGas: big.NewInt(1000000000),
+			MaxFeePerGas:         big.NewInt(2000000000),
+			Gas:                  big.NewInt(100000),
+			To:                   to,
+			Data:                 "0xa9059cbb",
+		},
+		{
+			Type:                 transaction.TransactionTypeEIP4844,
+			ChainId:              1,
+			Nonce:                9,
+			MaxPriorityFeePerGas: big.NewInt(1000000000),
+			MaxFeePerGas:         big.NewInt(2000000000),
+			MaxFeePerBlobGas:     big.NewInt(1),
+			Gas:                  big.NewInt(21000),
+			To:                   to,
+			BlobVersionedHashes:  []string{"0x01" + strings.Repeat("ab", 31)},
+		},
+	}
+}
+
+var _ = Describe("Block", func() {
+	Describe("HashHeader", func() {
+		It("should hash the mainnet genesis header", func() {
+			genesis := mainnetGenesis()
+			hash, err := block.HashHeader(genesis)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash).To(Equal(genesis.Hash))
+		})
+
+		It("should hash London, Shanghai, Cancun and Prague header shapes", func() {
+			header := postMergeHeader()
+			hash, err := block.HashHeader(header)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash.Hex()).To(Equal("0x6bad07dc3d01684ec29a1d6259dc9e709d8e589880af2ca89668e007f4bb8fc5"))
+
+			header.WithdrawalsRoot = hashPtr("0x06")
+			hash, err = block.HashHeader(header)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash.Hex()).To(Equal("0xd093cad9dba2cde85285c100d7ecaddec3cb4d98b032612b600738fbacfce48c"))
+
+			header.BlobGasUsed = uint64Ptr(262144)
+			header.ExcessBlobGas = uint64Ptr(0)
+			header.ParentBeaconBlockRoot = hashPtr("0x07")
+			hash, err = block.HashHeader(header)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash.Hex()).To(Equal("0xe2479a6109910738843e95a306dd6099ec0ff9f8d32185ffa9d0731cc378b432"))
+
+			header.RequestsHash = hashPtr("0x08")
+			hash, err = block.HashHeader(header)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash.Hex()).To(Equal("0x4093d2f0ec16d6809a6770cdd0ef5aa2c0a36b12a8104ff1d8f79b2704049868"))
+		})
+
+		It("should detect a modified header field", func() {
+			genesis := mainnetGenesis()
+			genesis.GasLimit++
+			hash, err := block.HashHeader(genesis)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash).NotTo(Equal(genesis.Hash))
+		})
+
+		It("should reject fork fields set out of order", func() {
+			header := postMergeHeader()
+			header.BaseFeePerGas = nil
+			header.WithdrawalsRoot = hashPtr("0x06")
+			_, err := block.HashHeader(header)
+			Expect(err).To(MatchError(block.ErrInvalidHeader))
+		})
+
+		It("should reject a malformed logs bloom", func() {
+			header := postMergeHeader()
+			header.LogsBloom = make([]byte, 32)
+			_, err := block.HashHeader(header)
+			Expect(err).To(MatchError(block.ErrInvalidHeader))
+		})
+	})
+
+	Describe("TransactionsRoot", func() {
+		It("should return the empty root for no transactions", func() {
+			root, err := block.TransactionsRoot(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(root).To(Equal(proof.EmptyRootHash))
+		})
+
+		It("should compute the root of legacy and typed transactions", func() {
+			var serialized []string
+			for _, tx := range testTransactions() {
+				serialized = append(serialized, serialize(tx))
+			}
+			root, err := block.TransactionsRoot(serialized)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(root.Hex()).To(Equal("0x0d585320d69eae930e003df6ba35371aa544cf3a687b165d83b09f79b7eab311"))
+		})
+
+		It("should compute roots of larger blocks", func() {
+			serialized := make([]string, 300)
+			for i := range serialized {
+				serialized[i] = serialize(&transaction.Transaction{
+					Type:     transaction.TransactionTypeLegacy,
+					ChainId:  1,
+					Nonce:    i,
+					GasPrice: big.NewInt(1),
+					Gas:      big.NewInt(21000),
+				})
+			}
+			root, err := block.TransactionsRoot(serialized)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(root.Hex()).To(Equal("0x08902618d059c41be36afe004bd81f0ff4e30becdef5a287e8f89de72331e699"))
+		})
+
+		It("should strip the network wrapper of blob transactions", func() {
+			tx := testTransactions()[2]
+			canonical := serialize(tx)
+
+			tx.Sidecars = []transaction.BlobSidecar{{
+				Blob:       "0x" + strings.Repeat("00", 32),
+				Commitment: "0x" + strings.Repeat("aa", 48),
+				Proof:      "0x" + strings.Repeat("bb", 48),
+			}}
+			wrapped := serialize(tx)
+			Expect(wrapped).NotTo(Equal(canonical))
+
+			canonicalHash, err := block.TransactionHash(canonical)
+			Expect(err).NotTo(HaveOccurred())
+			wrappedHash, err := block.TransactionHash(wrapped)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wrappedHash).To(Equal(canonicalHash))
+			Expect(canonicalHash.Hex()).To(Equal("0x97d532b211fa136f48d0e4f6fe08b5741a9d498426a7866e93fc5d3eae6e5446"))
+
+			canonicalRoot, err := block.TransactionsRoot([]string{canonical})
+			Expect(err).NotTo(HaveOccurred())
+			wrappedRoot, err := block.TransactionsRoot([]string{wrapped})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wrappedRoot).To(Equal(canonicalRoot))
+		})
+
+		It("should reject invalid transactions", func() {
+			_, err := block.TransactionsRoot([]string{"0xzz"})
+			Expect(err).To(MatchError(block.ErrInvalidTransaction))
+
+			_, err = block.TransactionsRoot([]string{"0x"})
+			Expect(err).To(MatchError(block.ErrInvalidTransaction))
+		})
+	})
+
+	Describe("ReceiptsRoot", func() {
+		It("should return the empty root for no receipts", func() {
+			root, err := block.ReceiptsRoot(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(root).To(Equal(proof.EmptyRootHash))
+		})
+
+		It("should compute the root of legacy and typed receipts", func() {
+			bloom := make([]byte, block.BloomLength)
+			bloom[0], bloom[255] = 0x80, 0x01
+			receipts := []types.Receipt{
+				{Type: 0, Status: 1, CumulativeGasUsed: 21000},
+				{
+					Type:              2,
+					Status:            1,
+					CumulativeGasUsed: 80000,
+					LogsBloom:         bloom,
+					Logs: []types.Log{{
+						Address: common.HexToAddress("0x1234567890123456789012345678901234567890"),
+						Topics: []common.Hash{
+							common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+							common.HexToHash("0x01"),
+						},
+						Data: common.FromHex("0x2a"),
+					}},
+				},
+				{Type: 3, Status: 0, CumulativeGasUsed: 101000},
+			}
+			root, err := block.ReceiptsRoot(receipts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(root.Hex()).To(Equal("0x70efd9b3136a7dc8a0a02e09605b9028d83a5ebec4fe5236b45e6fd0af6a9b8e"))
+
+			receipts[1].Status = 0
+			tampered, err := block.ReceiptsRoot(receipts)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tampered).NotTo(Equal(root))
+		})
+	})
+})
@@ -0,0 +1,113 @@
+package block
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ChefBingbong/viem-go/utils/proof"
+)
+
+// trieEntry is a key-value pair of a trie, with the key split into nibbles.
+type trieEntry struct {
+	path  []byte
+	value []byte
+}
+
+// deriveRoot returns the root of the Merkle-Patricia trie that maps
+// rlp(index) to values[index], as used for the transactions and receipts
+// roots of a block.
+func deriveRoot(values [][]byte) common.Hash {
+	if len(values) == 0 {
+		return proof.EmptyRootHash
+	}
+
+	entries := make([]trieEntry, len(values))
+	for i, value := range values {
+		key, _ := rlp.EncodeToBytes(uint64(i))
+		entries[i] = trieEntry{path: proof.KeyToNibbles(key), value: value}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].path, entries[j].path) < 0
+	})
+
+	return crypto.Keccak256Hash(encodeNode(entries, 0))
+}
+
+// encodeNode returns the RLP encoding of the node holding entries, whose
+// paths share their first depth nibbles. entries must be sorted by path.
+func encodeNode(entries []trieEntry, depth int) []byte {
+	if len(entries) == 1 {
+		enc, _ := rlp.EncodeToBytes([]any{
+			compactKey(entries[0].path[depth:], true),
+			entries[0].value,
+		})
+		return enc
+	}
+
+	// Sorted entries share a prefix exactly when the first and last do.
+	first, last := entries[0].path, entries[len(entries)-1].path
+	shared := 0
+	for depth+shared < len(first) && depth+shared < len(last) && first[depth+shared] == last[depth+shared] {
+		shared++
+	}
+	if shared > 0 {
+		enc, _ := rlp.EncodeToBytes([]any{
+			compactKey(first[depth:depth+shared], false),
+			nodeRef(encodeNode(entries, depth+shared)),
+		})
+		return enc
+	}
+
+	branch := make([]any, 17)
+	for i := range branch {
+		branch[i] = []byte{}
+	}
+	for len(entries) > 0 {
+		if len(entries[0].path) == depth {
+			branch[16] = entries[0].value
+			entries = entries[1:]
+			continue
+		}
+		nibble := entries[0].path[depth]
+		n := 1
+		for n < len(entries) && entries[n].path[depth] == nibble {
+			n++
+		}
+		branch[nibble] = nodeRef(encodeNode(entries[:n], depth+1))
+		entries = entries[n:]
+	}
+	enc, _ := rlp.EncodeToBytes(branch)
+	return enc
+}
+
+// nodeRef returns how a child node is referenced by its parent: nodes
+// shorter than 32 bytes are embedded, others are referenced by hash.
+func nodeRef(enc []byte) any {
+	if len(enc) < common.HashLength {
+		return rlp.RawValue(enc)
+	}
+	return crypto.Keccak256(enc)
+}
+
+// compactKey returns the hex-prefix encoding of a nibble path.
+func compactKey(nibbles []byte, leaf bool) []byte {
+	var flag byte
+	if leaf {
+		flag = 2
+	}
+	out := make([]byte, len(nibbles)/2+1)
+	if len(nibbles)%2 == 1 {
+		out[0] = (flag+1)<<4 | nibbles[0]
+		nibbles = nibbles[1:]
+	} else {
+		out[0] = flag << 4
+	}
+	for i := 0; i < len(nibbles); i += 2 {
+		out[i/2+1] = nibbles[i]<<4 | nibbles[i+1]
+	}
+	return out
+}
@@ -10,7 +10,8 @@ type DefineKzgParams struct {
 
 // DefineKzg creates a Kzg implementation from individual functions.
 // This is useful when working with external KZG libraries that expose
-// separate functions rather than a unified interface.
+// separate functions rather than a unified interface. Default needs no
+// setup and is usually enough.
 //
 // Example:
 //
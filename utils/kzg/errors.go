@@ -15,6 +15,19 @@ var (
 	// ErrInvalidCommitmentSize is returned when a commitment has an invalid size.
 	ErrInvalidCommitmentSize = errors.New("invalid commitment size")
 
+	// ErrInvalidProofSize is returned when a proof has an invalid size.
+	ErrInvalidProofSize = errors.New("invalid proof size")
+
+	// ErrInvalidKzgProof is returned when a KZG proof fails verification.
+	ErrInvalidKzgProof = errors.New("invalid kzg proof")
+
+	// ErrLengthMismatch is returned when blobs, commitments and proofs don't line up.
+	ErrLengthMismatch = errors.New("blobs, commitments and proofs length mismatch")
+
+	// ErrCellProofsNotSupported is returned when version-1 sidecars are
+	// requested from a Kzg that does not implement CellProofKzg.
+	ErrCellProofsNotSupported = errors.New("kzg implementation does not support cell proofs")
+
 	// ErrEmptyBlob is returned when trying to process empty data.
 	ErrEmptyBlob = errors.New("blob data is empty")
 
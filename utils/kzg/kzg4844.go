@@ -0,0 +1,190 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+)
+
+// CellProofsPerBlob is the number of cell proofs per blob in a version-1
+// (EIP-7594, PeerDAS) sidecar.
+const CellProofsPerBlob = kzg4844.CellProofsPerBlob
+
+// CellProofKzg is a Kzg implementation that can also compute the cell proofs
+// of EIP-7594 (PeerDAS) sidecars.
+type CellProofKzg interface {
+	Kzg
+
+	// ComputeCellProofs computes the CellProofsPerBlob cell proofs of a blob.
+	// The blob should be exactly 131072 bytes. Returns 48-byte proofs.
+	ComputeCellProofs(blob []byte) ([][]byte, error)
+}
+
+// defaultKzg implements CellProofKzg with go-ethereum's crypto/kzg4844.
+type defaultKzg struct{}
+
+var defaultInstance CellProofKzg = defaultKzg{}
+
+// Default returns the built-in KZG implementation, backed by go-ethereum's
+// crypto/kzg4844 in pure Go with the mainnet trusted setup embedded. It needs
+// no cgo and no trusted setup file, and is safe for concurrent use.
+//
+// Example:
+//
+//	sidecars, err := blob.ToBlobSidecars(blob.ToBlobSidecarsParams{
+//		Data: data,
+//		Kzg:  kzg.Default(),
+//	})
+func Default() CellProofKzg {
+	return defaultInstance
+}
+
+// BlobToKzgCommitment implements Kzg.
+func (defaultKzg) BlobToKzgCommitment(blob []byte) ([]byte, error) {
+	b, err := toBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	commitment, err := kzg4844.BlobToCommitment(b)
+	if err != nil {
+		return nil, err
+	}
+	return commitment[:], nil
+}
+
+// ComputeBlobKzgProof implements Kzg.
+func (defaultKzg) ComputeBlobKzgProof(blob []byte, commitment []byte) ([]byte, error) {
+	b, err := toBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	c, err := toCommitment(commitment)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := kzg4844.ComputeBlobProof(b, c)
+	if err != nil {
+		return nil, err
+	}
+	return proof[:], nil
+}
+
+// ComputeCellProofs implements CellProofKzg.
+func (defaultKzg) ComputeCellProofs(blob []byte) ([][]byte, error) {
+	b, err := toBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+	proofs, err := kzg4844.ComputeCellProofs(b)
+	if err != nil {
+		return nil, err
+	}
+	out := make([][]byte, len(proofs))
+	for i := range proofs {
+		out[i] = proofs[i][:]
+	}
+	return out, nil
+}
+
+// VerifyBlobKzgProof verifies the KZG proof of a blob against its
+// commitment. It returns nil if the proof is valid and an error wrapping
+// ErrInvalidKzgProof otherwise.
+//
+// Example:
+//
+//	err := kzg.VerifyBlobKzgProof(sidecar.Blob, sidecar.Commitment, sidecar.Proof)
+func VerifyBlobKzgProof(blob, commitment, proof []byte) error {
+	b, err := toBlob(blob)
+	if err != nil {
+		return err
+	}
+	c, err := toCommitment(commitment)
+	if err != nil {
+		return err
+	}
+	p, err := toProof(proof)
+	if err != nil {
+		return err
+	}
+	if err := kzg4844.VerifyBlobProof(b, c, p); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidKzgProof, err)
+	}
+	return nil
+}
+
+// VerifyBlobKzgProofBatch verifies the KZG proofs of several blobs. The
+// slices must have the same length. The error identifies the first invalid
+// proof.
+func VerifyBlobKzgProofBatch(blobs, commitments, proofs [][]byte) error {
+	if len(blobs) != len(commitments) || len(blobs) != len(proofs) {
+		return fmt.Errorf("%w: %d blobs, %d commitments, %d proofs",
+			ErrLengthMismatch, len(blobs), len(commitments), len(proofs))
+	}
+	for i := range blobs {
+		if err := VerifyBlobKzgProof(blobs[i], commitments[i], proofs[i]); err != nil {
+			return fmt.Errorf("blob %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// VerifyCellKzgProofBatch verifies the cell proofs of version-1 sidecars.
+// cellProofs holds CellProofsPerBlob proofs per blob, flattened in blob
+// order as in the EIP-7594 network wrapper.
+func VerifyCellKzgProofBatch(blobs, commitments, cellProofs [][]byte) error {
+	if len(blobs) != len(commitments) || len(cellProofs) != len(blobs)*CellProofsPerBlob {
+		return fmt.Errorf("%w: %d blobs, %d commitments, %d cell proofs",
+			ErrLengthMismatch, len(blobs), len(commitments), len(cellProofs))
+	}
+	bs := make([]kzg4844.Blob, len(blobs))
+	cs := make([]kzg4844.Commitment, len(commitments))
+	ps := make([]kzg4844.Proof, len(cellProofs))
+	for i := range blobs {
+		b, err := toBlob(blobs[i])
+		if err != nil {
+			return err
+		}
+		bs[i] = *b
+		if cs[i], err = toCommitment(commitments[i]); err != nil {
+			return err
+		}
+	}
+	for i := range cellProofs {
+		p, err := toProof(cellProofs[i])
+		if err != nil {
+			return err
+		}
+		ps[i] = p
+	}
+	if err := kzg4844.VerifyCellProofs(bs, cs, ps); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidKzgProof, err)
+	}
+	return nil
+}
+
+func toBlob(blob []byte) (*kzg4844.Blob, error) {
+	if len(blob) != BytesPerBlob {
+		return nil, fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidBlobSize, len(blob), BytesPerBlob)
+	}
+	var b kzg4844.Blob
+	copy(b[:], blob)
+	return &b, nil
+}
+
+func toCommitment(commitment []byte) (kzg4844.Commitment, error) {
+	var c kzg4844.Commitment
+	if len(commitment) != BytesPerCommitment {
+		return c, fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidCommitmentSize, len(commitment), BytesPerCommitment)
+	}
+	copy(c[:], commitment)
+	return c, nil
+}
+
+func toProof(proof []byte) (kzg4844.Proof, error) {
+	var p kzg4844.Proof
+	if len(proof) != BytesPerProof {
+		return p, fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidProofSize, len(proof), BytesPerProof)
+	}
+	copy(p[:], proof)
+	return p, nil
+}
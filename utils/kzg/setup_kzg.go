@@ -19,6 +19,8 @@ type SetupKzgParams struct {
 //
 // If the trusted setup is already loaded, this function will still return
 // a valid KZG interface (the error is ignored for this specific case).
+// Default embeds the mainnet trusted setup, so this is only needed for
+// external libraries such as c-kzg-4844.
 //
 // Example:
 //
@@ -98,4 +98,76 @@ var _ = Describe("Kzg", func() {
 			Expect(kzgImpl).NotTo(BeNil())
 		})
 	})
+
+	Describe("Default", func() {
+		var (
+			blob       []byte
+			commitment []byte
+			proof      []byte
+		)
+
+		BeforeEach(func() {
+			blob = make([]byte, kzg.BytesPerBlob)
+			for i := 0; i < kzg.FieldElementsPerBlob; i++ {
+				blob[i*kzg.BytesPerFieldElement+31] = byte(i)
+			}
+
+			var err error
+			commitment, err = kzg.Default().BlobToKzgCommitment(blob)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(commitment).To(HaveLen(kzg.BytesPerCommitment))
+
+			proof, err = kzg.Default().ComputeBlobKzgProof(blob, commitment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proof).To(HaveLen(kzg.BytesPerProof))
+		})
+
+		It("should verify computed blob proofs", func() {
+			Expect(kzg.VerifyBlobKzgProof(blob, commitment, proof)).To(Succeed())
+			Expect(kzg.VerifyBlobKzgProofBatch(
+				[][]byte{blob, blob}, [][]byte{commitment, commitment}, [][]byte{proof, proof},
+			)).To(Succeed())
+		})
+
+		It("should reject a proof for another blob", func() {
+			other := make([]byte, kzg.BytesPerBlob)
+			otherCommitment, err := kzg.Default().BlobToKzgCommitment(other)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = kzg.VerifyBlobKzgProof(other, otherCommitment, proof)
+			Expect(err).To(MatchError(kzg.ErrInvalidKzgProof))
+
+			err = kzg.VerifyBlobKzgProofBatch(
+				[][]byte{blob, other}, [][]byte{commitment, otherCommitment}, [][]byte{proof, proof},
+			)
+			Expect(err).To(MatchError(kzg.ErrInvalidKzgProof))
+			Expect(err.Error()).To(ContainSubstring("blob 1"))
+		})
+
+		It("should validate input sizes", func() {
+			_, err := kzg.Default().BlobToKzgCommitment(make([]byte, 10))
+			Expect(err).To(MatchError(kzg.ErrInvalidBlobSize))
+
+			_, err = kzg.Default().ComputeBlobKzgProof(blob, make([]byte, 10))
+			Expect(err).To(MatchError(kzg.ErrInvalidCommitmentSize))
+
+			Expect(kzg.VerifyBlobKzgProof(blob, commitment, make([]byte, 10))).To(MatchError(kzg.ErrInvalidProofSize))
+			Expect(kzg.VerifyBlobKzgProofBatch([][]byte{blob}, nil, nil)).To(MatchError(kzg.ErrLengthMismatch))
+		})
+
+		It("should compute and verify cell proofs", func() {
+			cellProofs, err := kzg.Default().ComputeCellProofs(blob)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cellProofs).To(HaveLen(kzg.CellProofsPerBlob))
+			Expect(kzg.VerifyCellKzgProofBatch([][]byte{blob}, [][]byte{commitment}, cellProofs)).To(Succeed())
+
+			tampered := append([][]byte{}, cellProofs...)
+			tampered[0], tampered[1] = cellProofs[1], cellProofs[0]
+			err = kzg.VerifyCellKzgProofBatch([][]byte{blob}, [][]byte{commitment}, tampered)
+			Expect(err).To(MatchError(kzg.ErrInvalidKzgProof))
+
+			err = kzg.VerifyCellKzgProofBatch([][]byte{blob}, [][]byte{commitment}, cellProofs[:1])
+			Expect(err).To(MatchError(kzg.ErrLengthMismatch))
+		})
+	})
 })
@@ -3,8 +3,9 @@ package kzg
 // Kzg defines the interface for KZG cryptographic operations.
 // This is used for EIP-4844 blob transactions.
 //
-// Implementations of this interface can be provided by external KZG libraries
-// such as c-kzg-4844 or go-kzg-4844.
+// Default returns a ready-to-use pure-Go implementation. Other
+// implementations can be provided by external KZG libraries such as
+// c-kzg-4844 (see DefineKzg and SetupKzg).
 type Kzg interface {
 	// BlobToKzgCommitment converts a blob to a KZG commitment.
 	// The blob should be exactly 131072 bytes (4096 field elements * 32 bytes).
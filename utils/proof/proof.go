@@ -0,0 +1,218 @@
+// Package proof verifies EIP-1186 (eth_getProof) Merkle-Patricia proofs
+// against a block's state root, so that account and storage values read from
+// an untrusted RPC provider can be checked.
+//
+// Example:
+//
+//	p, _ := public.GetProof(ctx, client, public.GetProofParameters{
+//		Address:     addr,
+//		StorageKeys: []common.Hash{slot},
+//		BlockNumber: &block.Number,
+//	})
+//	account, err := proof.VerifyProof(block.StateRoot, p)
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/ChefBingbong/viem-go/utils/formatters"
+)
+
+var (
+	// EmptyRootHash is the root of an empty trie: the storage root of
+	// accounts without storage, and the transactions and receipts root of
+	// blocks without transactions.
+	EmptyRootHash = common.HexToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+	// EmptyCodeHash is the keccak256 hash of empty code.
+	EmptyCodeHash = crypto.Keccak256Hash(nil)
+)
+
+var (
+	// ErrInvalidAccountProof is returned when an account proof does not
+	// prove the claimed account against the state root.
+	ErrInvalidAccountProof = errors.New("proof: invalid account proof")
+	// ErrInvalidStorageProof is returned when a storage proof does not prove
+	// the claimed value against the account's storage root.
+	ErrInvalidStorageProof = errors.New("proof: invalid storage proof")
+	// ErrCodeMismatch is returned when code does not hash to the proven code
+	// hash.
+	ErrCodeMismatch = errors.New("proof: code does not match code hash")
+)
+
+// Account is an account proven against a state root.
+type Account struct {
+	// Address is the account address.
+	Address common.Address
+	// Exists is false when the proof proves the account's absence, in which
+	// case the other fields describe an empty account.
+	Exists bool
+	// Nonce is the account nonce.
+	Nonce uint64
+	// Balance is the account balance in wei.
+	Balance *big.Int
+	// StorageRoot is the root of the account's storage trie.
+	StorageRoot common.Hash
+	// CodeHash is the keccak256 hash of the account's code.
+	CodeHash common.Hash
+	// Storage holds the proven storage values by slot.
+	Storage map[common.Hash]common.Hash
+}
+
+// stateAccount is the RLP encoding of an account in the state trie.
+type stateAccount struct {
+	Nonce    uint64
+	Balance  *big.Int
+	Root     common.Hash
+	CodeHash []byte
+}
+
+// VerifyAccountProof verifies the account proof of p against stateRoot and
+// checks that the balance, nonce, code hash and storage hash claimed by p
+// match the proven account. Storage proofs are not checked; see VerifyProof.
+func VerifyAccountProof(stateRoot common.Hash, p formatters.Proof) (*Account, error) {
+	if !common.IsHexAddress(p.Address) {
+		return nil, fmt.Errorf("%w: invalid address %q", ErrInvalidAccountProof, p.Address)
+	}
+	address := common.HexToAddress(p.Address)
+
+	value, err := verify(stateRoot, crypto.Keccak256(address.Bytes()), p.AccountProof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidAccountProof, address.Hex(), err)
+	}
+
+	account := &Account{
+		Address:     address,
+		Balance:     new(big.Int),
+		StorageRoot: EmptyRootHash,
+		CodeHash:    EmptyCodeHash,
+	}
+	if value != nil {
+		var decoded stateAccount
+		if err := rlp.DecodeBytes(value, &decoded); err != nil {
+			return nil, fmt.Errorf("%w: %s: invalid account encoding: %v", ErrInvalidAccountProof, address.Hex(), err)
+		}
+		account.Exists = true
+		account.Nonce = decoded.Nonce
+		account.Balance = decoded.Balance
+		account.StorageRoot = decoded.Root
+		account.CodeHash = common.BytesToHash(decoded.CodeHash)
+	}
+
+	if err := checkClaims(account, p); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidAccountProof, address.Hex(), err)
+	}
+	return account, nil
+}
+
+// VerifyStorageProof verifies a storage proof against storageRoot and
+// returns the proven value. Values absent from the trie are proven as zero.
+func VerifyStorageProof(storageRoot common.Hash, sp formatters.StorageProof) (common.Hash, error) {
+	slotBytes, err := hexutil.Decode(normalizeHex(sp.Key))
+	if err != nil || len(slotBytes) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("%w: invalid key %q", ErrInvalidStorageProof, sp.Key)
+	}
+	slot := common.BytesToHash(slotBytes)
+
+	var proven common.Hash
+	// An empty storage trie proves every slot to be zero without nodes.
+	if storageRoot != EmptyRootHash || len(sp.Proof) > 0 {
+		value, err := verify(storageRoot, crypto.Keccak256(slot.Bytes()), sp.Proof)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("%w: slot %s: %v", ErrInvalidStorageProof, slot.Hex(), err)
+		}
+		if value != nil {
+			var content []byte
+			if err := rlp.DecodeBytes(value, &content); err != nil {
+				return common.Hash{}, fmt.Errorf("%w: slot %s: invalid value encoding: %v", ErrInvalidStorageProof, slot.Hex(), err)
+			}
+			proven = common.BytesToHash(content)
+		}
+	}
+
+	claimed := new(big.Int)
+	if sp.Value != nil {
+		claimed = sp.Value
+	}
+	if proven.Big().Cmp(claimed) != 0 {
+		return common.Hash{}, fmt.Errorf("%w: slot %s: claimed value %s, proven %s",
+			ErrInvalidStorageProof, slot.Hex(), claimed, proven.Big())
+	}
+	return proven, nil
+}
+
+// VerifyProof verifies the account proof and every storage proof of p
+// against stateRoot and returns the proven account with its storage values.
+func VerifyProof(stateRoot common.Hash, p formatters.Proof) (*Account, error) {
+	account, err := VerifyAccountProof(stateRoot, p)
+	if err != nil {
+		return nil, err
+	}
+	account.Storage = make(map[common.Hash]common.Hash, len(p.StorageProof))
+	for _, sp := range p.StorageProof {
+		value, err := VerifyStorageProof(account.StorageRoot, sp)
+		if err != nil {
+			return nil, err
+		}
+		slotBytes, _ := hexutil.Decode(normalizeHex(sp.Key))
+		account.Storage[common.BytesToHash(slotBytes)] = value
+	}
+	return account, nil
+}
+
+// VerifyCode checks that code hashes to codeHash.
+func VerifyCode(codeHash common.Hash, code []byte) error {
+	if got := crypto.Keccak256Hash(code); got != codeHash {
+		return fmt.Errorf("%w: got %s, want %s", ErrCodeMismatch, got.Hex(), codeHash.Hex())
+	}
+	return nil
+}
+
+// checkClaims compares the account fields claimed by the RPC response with
+// the proven account. Providers report absent accounts either with empty
+// trie hashes or with zero hashes, so both are accepted.
+func checkClaims(account *Account, p formatters.Proof) error {
+	balance := new(big.Int)
+	if p.Balance != nil {
+		balance = p.Balance
+	}
+	if balance.Cmp(account.Balance) != 0 {
+		return fmt.Errorf("claimed balance %s, proven %s", balance, account.Balance)
+	}
+	if p.Nonce != nil && uint64(*p.Nonce) != account.Nonce {
+		return fmt.Errorf("claimed nonce %d, proven %d", *p.Nonce, account.Nonce)
+	}
+	if err := checkHash("code hash", p.CodeHash, account.CodeHash, account.Exists); err != nil {
+		return err
+	}
+	return checkHash("storage hash", p.StorageHash, account.StorageRoot, account.Exists)
+}
+
+func checkHash(name, claimed string, proven common.Hash, exists bool) error {
+	if claimed == "" {
+		return nil
+	}
+	b, err := hexutil.Decode(normalizeHex(claimed))
+	if err != nil {
+		return fmt.Errorf("invalid %s %q", name, claimed)
+	}
+	if bytes.Equal(b, proven.Bytes()) || (!exists && common.BytesToHash(b) == (common.Hash{})) {
+		return nil
+	}
+	return fmt.Errorf("claimed %s %s, proven %s", name, claimed, proven.Hex())
+}
+
+// normalizeHex pads odd-length hex strings (such as "0x0" storage keys).
+func normalizeHex(s string) string {
+	if len(s) >= 2 && (s[:2] == "0x" || s[:2] == "0X") && len(s)%2 == 1 {
+		return "0x0" + s[2:]
+	}
+	return s
+}
@@ -0,0 +1,212 @@
+package test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/utils/formatters"
+	"github.com/ChefBingbong/viem-go/utils/proof"
+)
+
+// testState is a state trie of accounts with storage tries.
+type testState struct {
+	trie     *testTrie
+	accounts map[common.Address]testAccount
+}
+
+type testAccount struct {
+	nonce   uint64
+	balance *big.Int
+	code    []byte
+	storage map[common.Hash]*big.Int
+	trie    *testTrie
+}
+
+func newTestState(accounts map[common.Address]testAccount) *testState {
+	entries := map[string][]byte{}
+	for addr, acc := range accounts {
+		slots := map[string][]byte{}
+		for slot, value := range acc.storage {
+			slots[string(crypto.Keccak256(slot.Bytes()))] = mustEncode(value.Bytes())
+		}
+		if len(slots) > 0 {
+			acc.trie = newTestTrie(slots)
+		}
+		accounts[addr] = acc
+		entries[string(crypto.Keccak256(addr.Bytes()))] = mustEncode([]any{
+			acc.nonce, acc.balance, acc.storageRoot(), crypto.Keccak256(acc.code),
+		})
+	}
+	return &testState{trie: newTestTrie(entries), accounts: accounts}
+}
+
+func (a testAccount) storageRoot() common.Hash {
+	if len(a.storage) == 0 {
+		return proof.EmptyRootHash
+	}
+	return a.trie.root
+}
+
+// getProof builds the eth_getProof response of an account.
+func (s *testState) getProof(addr common.Address, slots ...common.Hash) formatters.Proof {
+	p := formatters.Proof{
+		Address:      addr.Hex(),
+		AccountProof: s.trie.proof(crypto.Keccak256(addr.Bytes())),
+		Balance:      new(big.Int),
+		CodeHash:     common.Hash{}.Hex(),
+		StorageHash:  common.Hash{}.Hex(),
+		Nonce:        new(int),
+	}
+	acc, ok := s.accounts[addr]
+	if !ok {
+		return p
+	}
+	nonce := int(acc.nonce)
+	p.Nonce = &nonce
+	p.Balance = acc.balance
+	p.CodeHash = crypto.Keccak256Hash(acc.code).Hex()
+	p.StorageHash = acc.storageRoot().Hex()
+	for _, slot := range slots {
+		sp := formatters.StorageProof{Key: slot.Hex(), Value: new(big.Int)}
+		if value, ok := acc.storage[slot]; ok {
+			sp.Value = value
+		}
+		if len(acc.storage) > 0 {
+			sp.Proof = acc.trie.proof(crypto.Keccak256(slot.Bytes()))
+		}
+		p.StorageProof = append(p.StorageProof, sp)
+	}
+	return p
+}
+
+var _ = Describe("Proof", func() {
+	var (
+		alice   = common.HexToAddress("0x000000000000000000000000000000000000a11c")
+		bob     = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+		token   = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		nobody  = common.HexToAddress("0x00000000000000000000000000000000000000ff")
+		code    = []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+		slot0   = common.HexToHash("0x0")
+		slot1   = common.HexToHash("0x1")
+		missing = common.HexToHash("0x2")
+		state   *testState
+	)
+
+	BeforeEach(func() {
+		state = newTestState(map[common.Address]testAccount{
+			alice: {nonce: 7, balance: big.NewInt(1e18)},
+			bob:   {nonce: 0, balance: big.NewInt(42)},
+			token: {nonce: 1, balance: big.NewInt(0), code: code, storage: map[common.Hash]*big.Int{
+				slot0: big.NewInt(0x2a),
+				slot1: new(big.Int).Lsh(big.NewInt(1), 200),
+			}},
+		})
+	})
+
+	Describe("VerifyAccountProof", func() {
+		It("proves existing accounts", func() {
+			account, err := proof.VerifyAccountProof(state.trie.root, state.getProof(alice))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(account.Exists).To(BeTrue())
+			Expect(account.Nonce).To(Equal(uint64(7)))
+			Expect(account.Balance.String()).To(Equal("1000000000000000000"))
+			Expect(account.CodeHash).To(Equal(proof.EmptyCodeHash))
+			Expect(account.StorageRoot).To(Equal(proof.EmptyRootHash))
+		})
+
+		It("proves the absence of accounts", func() {
+			account, err := proof.VerifyAccountProof(state.trie.root, state.getProof(nobody))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(account.Exists).To(BeFalse())
+			Expect(account.Balance.Sign()).To(Equal(0))
+		})
+
+		It("rejects claims that differ from the proven account", func() {
+			p := state.getProof(alice)
+			p.Balance = big.NewInt(2e18)
+			_, err := proof.VerifyAccountProof(state.trie.root, p)
+			Expect(err).To(MatchError(proof.ErrInvalidAccountProof))
+
+			p = state.getProof(alice)
+			nonce := 8
+			p.Nonce = &nonce
+			_, err = proof.VerifyAccountProof(state.trie.root, p)
+			Expect(err).To(MatchError(proof.ErrInvalidAccountProof))
+
+			p = state.getProof(nobody)
+			p.Balance = big.NewInt(1)
+			_, err = proof.VerifyAccountProof(state.trie.root, p)
+			Expect(err).To(MatchError(proof.ErrInvalidAccountProof))
+		})
+
+		It("rejects proofs for another root or with missing nodes", func() {
+			_, err := proof.VerifyAccountProof(common.HexToHash("0x1234"), state.getProof(alice))
+			Expect(err).To(MatchError(proof.ErrInvalidAccountProof))
+
+			p := state.getProof(alice)
+			p.AccountProof = p.AccountProof[:len(p.AccountProof)-1]
+			_, err = proof.VerifyAccountProof(state.trie.root, p)
+			Expect(err).To(MatchError(proof.ErrInvalidAccountProof))
+		})
+
+		It("proves accounts in a large trie", func() {
+			accounts := map[common.Address]testAccount{}
+			for i := int64(1); i <= 500; i++ {
+				accounts[common.BigToAddress(big.NewInt(i))] = testAccount{nonce: uint64(i), balance: big.NewInt(i * 1000)}
+			}
+			large := newTestState(accounts)
+			for i := int64(1); i <= 510; i++ {
+				addr := common.BigToAddress(big.NewInt(i))
+				account, err := proof.VerifyAccountProof(large.trie.root, large.getProof(addr))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(account.Exists).To(Equal(i <= 500))
+			}
+		})
+
+		It("rejects a proof of another account", func() {
+			p := state.getProof(bob)
+			p.Address = alice.Hex()
+			_, err := proof.VerifyAccountProof(state.trie.root, p)
+			Expect(err).To(MatchError(proof.ErrInvalidAccountProof))
+		})
+	})
+
+	Describe("VerifyProof", func() {
+		It("proves storage values, including absent slots", func() {
+			account, err := proof.VerifyProof(state.trie.root, state.getProof(token, slot0, slot1, missing))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(account.Storage[slot0]).To(Equal(common.BigToHash(big.NewInt(0x2a))))
+			Expect(account.Storage[slot1].Big()).To(Equal(new(big.Int).Lsh(big.NewInt(1), 200)))
+			Expect(account.Storage[missing]).To(Equal(common.Hash{}))
+			Expect(proof.VerifyCode(account.CodeHash, code)).To(Succeed())
+			Expect(proof.VerifyCode(account.CodeHash, []byte{0x00})).To(MatchError(proof.ErrCodeMismatch))
+		})
+
+		It("proves storage of accounts without storage as zero", func() {
+			account, err := proof.VerifyProof(state.trie.root, state.getProof(alice, slot0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(account.Storage[slot0]).To(Equal(common.Hash{}))
+		})
+
+		It("rejects wrong storage values", func() {
+			p := state.getProof(token, slot0)
+			p.StorageProof[0].Value = big.NewInt(0x2b)
+			_, err := proof.VerifyProof(state.trie.root, p)
+			Expect(err).To(MatchError(proof.ErrInvalidStorageProof))
+
+			p = state.getProof(token, missing)
+			p.StorageProof[0].Value = big.NewInt(1)
+			_, err = proof.VerifyProof(state.trie.root, p)
+			Expect(err).To(MatchError(proof.ErrInvalidStorageProof))
+
+			p = state.getProof(token, slot0)
+			p.StorageProof[0].Proof = nil
+			_, err = proof.VerifyProof(state.trie.root, p)
+			Expect(err).To(MatchError(proof.ErrInvalidStorageProof))
+		})
+	})
+})
@@ -0,0 +1,154 @@
+package test
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testTrie is a minimal Merkle-Patricia trie builder used to generate
+// eth_getProof-style proofs.
+type testTrie struct {
+	root  common.Hash
+	nodes map[string][]byte // hashed nodes by nibble path
+}
+
+type trieEntry struct {
+	path  []byte
+	value []byte
+}
+
+func newTestTrie(entries map[string][]byte) *testTrie {
+	t := &testTrie{nodes: map[string][]byte{}}
+	var all []trieEntry
+	for k, v := range entries {
+		all = append(all, trieEntry{path: nibbles([]byte(k)), value: v})
+	}
+	root := t.encode(all, nil)
+	t.nodes[""] = root
+	t.root = crypto.Keccak256Hash(root)
+	return t
+}
+
+// proof returns the hashed nodes on the path of key, from the root.
+func (t *testTrie) proof(key []byte) []string {
+	path := string(nibbles(key))
+	var prefixes []string
+	for prefix := range t.nodes {
+		if strings.HasPrefix(path, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	sort.Slice(prefixes, func(i, j int) bool { return len(prefixes[i]) < len(prefixes[j]) })
+	out := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		out[i] = hexutil.Encode(t.nodes[prefix])
+	}
+	return out
+}
+
+func (t *testTrie) encode(entries []trieEntry, prefix []byte) []byte {
+	if len(entries) == 1 {
+		return mustEncode([]any{compact(entries[0].path, true), entries[0].value})
+	}
+
+	shared := commonPrefix(entries)
+	if shared > 0 {
+		rest := make([]trieEntry, len(entries))
+		for i, e := range entries {
+			rest[i] = trieEntry{path: e.path[shared:], value: e.value}
+		}
+		childPrefix := append(append([]byte{}, prefix...), entries[0].path[:shared]...)
+		return mustEncode([]any{compact(entries[0].path[:shared], false), t.ref(t.encode(rest, childPrefix), childPrefix)})
+	}
+
+	branch := make([]any, 17)
+	for i := range branch {
+		branch[i] = []byte{}
+	}
+	groups := map[byte][]trieEntry{}
+	for _, e := range entries {
+		if len(e.path) == 0 {
+			branch[16] = e.value
+			continue
+		}
+		groups[e.path[0]] = append(groups[e.path[0]], trieEntry{path: e.path[1:], value: e.value})
+	}
+	for n, group := range groups {
+		childPrefix := append(append([]byte{}, prefix...), n)
+		branch[n] = t.ref(t.encode(group, childPrefix), childPrefix)
+	}
+	return mustEncode(branch)
+}
+
+// ref embeds nodes shorter than 32 bytes and hashes the others.
+func (t *testTrie) ref(node, prefix []byte) any {
+	if len(node) < 32 {
+		return rlp.RawValue(node)
+	}
+	t.nodes[string(prefix)] = node
+	return crypto.Keccak256(node)
+}
+
+func commonPrefix(entries []trieEntry) int {
+	n := len(entries[0].path)
+	for _, e := range entries[1:] {
+		i := 0
+		for i < n && i < len(e.path) && e.path[i] == entries[0].path[i] {
+			i++
+		}
+		n = i
+	}
+	return n
+}
+
+func compact(path []byte, leaf bool) []byte {
+	flag := byte(0)
+	if leaf {
+		flag = 2
+	}
+	var out []byte
+	if len(path)%2 == 1 {
+		out = append(out, (flag+1)<<4|path[0])
+		path = path[1:]
+	} else {
+		out = append(out, flag<<4)
+	}
+	for i := 0; i < len(path); i += 2 {
+		out = append(out, path[i]<<4|path[i+1])
+	}
+	return out
+}
+
+func nibbles(key []byte) []byte {
+	out := make([]byte, len(key)*2)
+	for i, b := range key {
+		out[i*2] = b >> 4
+		out[i*2+1] = b & 0x0f
+	}
+	return out
+}
+
+func mustEncode(v any) []byte {
+	b, err := rlp.EncodeToBytes(v)
+	Expect(err).NotTo(HaveOccurred())
+	return b
+}
+
+var _ = Describe("testTrie", func() {
+	It("matches the reference trie root", func() {
+		t := newTestTrie(map[string][]byte{
+			"do":    []byte("verb"),
+			"dog":   []byte("puppy"),
+			"doge":  []byte("coin"),
+			"horse": []byte("stallion"),
+		})
+		Expect(t.root).To(Equal(common.HexToHash("0x5991bb8c6514148a29db676a14ac506cd2cd5775ace63c30a4fe457715e9ac84")))
+	})
+})
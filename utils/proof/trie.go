@@ -0,0 +1,165 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// verify checks a Merkle-Patricia proof for key against root and returns
+// the value, or nil if the proof proves the key's absence. nodes are the
+// RLP-encoded trie nodes on the path from the root, as returned by
+// eth_getProof.
+func verify(root common.Hash, key []byte, nodes []string) ([]byte, error) {
+	db := make(map[common.Hash][]byte, len(nodes))
+	for _, node := range nodes {
+		data, err := hexutil.Decode(node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof node: %w", err)
+		}
+		db[crypto.Keccak256Hash(data)] = data
+	}
+	if root == EmptyRootHash && len(db) == 0 {
+		return nil, nil
+	}
+
+	path := KeyToNibbles(key)
+	ref := root.Bytes()
+	for {
+		// References of 32 bytes are node hashes; shorter nodes are embedded
+		// in their parent.
+		node := ref
+		if len(ref) == common.HashLength {
+			var ok bool
+			if node, ok = db[common.BytesToHash(ref)]; !ok {
+				return nil, fmt.Errorf("missing trie node %s", hexutil.Encode(ref))
+			}
+		}
+
+		elems, err := splitNode(node)
+		if err != nil {
+			return nil, err
+		}
+		switch len(elems) {
+		case 17:
+			if len(path) == 0 {
+				return stringValue(elems[16])
+			}
+			child, err := reference(elems[path[0]])
+			if err != nil || child == nil {
+				return nil, err
+			}
+			ref, path = child, path[1:]
+		case 2:
+			nibbles, leaf, err := decodeCompact(elems[0])
+			if err != nil {
+				return nil, err
+			}
+			if leaf {
+				if !bytes.Equal(nibbles, path) {
+					return nil, nil
+				}
+				return stringValue(elems[1])
+			}
+			if len(path) < len(nibbles) || !bytes.Equal(nibbles, path[:len(nibbles)]) {
+				return nil, nil
+			}
+			child, err := reference(elems[1])
+			if err != nil {
+				return nil, err
+			}
+			if child == nil {
+				return nil, errors.New("extension node without child")
+			}
+			ref, path = child, path[len(nibbles):]
+		default:
+			return nil, fmt.Errorf("invalid trie node with %d elements", len(elems))
+		}
+	}
+}
+
+// splitNode splits an RLP-encoded trie node into its raw elements.
+func splitNode(node []byte) ([][]byte, error) {
+	content, rest, err := rlp.SplitList(node)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trie node: %w", err)
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("invalid trie node: trailing data")
+	}
+	var elems [][]byte
+	for len(content) > 0 {
+		_, _, tail, err := rlp.Split(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trie node: %w", err)
+		}
+		elems = append(elems, content[:len(content)-len(tail)])
+		content = tail
+	}
+	return elems, nil
+}
+
+// reference returns the child reference of a raw node element: a 32-byte
+// hash, an embedded node, or nil for an empty slot.
+func reference(elem []byte) ([]byte, error) {
+	kind, content, _, err := rlp.Split(elem)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trie node: %w", err)
+	}
+	switch {
+	case kind == rlp.List:
+		return elem, nil
+	case len(content) == 0:
+		return nil, nil
+	case len(content) == common.HashLength:
+		return content, nil
+	}
+	return nil, fmt.Errorf("invalid child reference of %d bytes", len(content))
+}
+
+// stringValue returns the content of a raw RLP string element, or nil if it
+// is empty.
+func stringValue(elem []byte) ([]byte, error) {
+	content, _, err := rlp.SplitString(elem)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trie value: %w", err)
+	}
+	if len(content) == 0 {
+		return nil, nil
+	}
+	return content, nil
+}
+
+// decodeCompact decodes a hex-prefix encoded path into nibbles and reports
+// whether it belongs to a leaf node.
+func decodeCompact(elem []byte) ([]byte, bool, error) {
+	compact, _, err := rlp.SplitString(elem)
+	if err != nil || len(compact) == 0 {
+		return nil, false, errors.New("invalid trie node path")
+	}
+	flag := compact[0] >> 4
+	if flag > 3 {
+		return nil, false, fmt.Errorf("invalid trie node path flag %d", flag)
+	}
+	nibbles := KeyToNibbles(compact[1:])
+	if flag&1 == 1 {
+		nibbles = append([]byte{compact[0] & 0x0f}, nibbles...)
+	}
+	return nibbles, flag >= 2, nil
+}
+
+// KeyToNibbles splits a trie key into its 4-bit nibbles, the path of the
+// key in the trie.
+func KeyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, len(key)*2)
+	for i, b := range key {
+		nibbles[i*2] = b >> 4
+		nibbles[i*2+1] = b & 0x0f
+	}
+	return nibbles
+}
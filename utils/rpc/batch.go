@@ -2,8 +2,13 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/tracing"
 )
 
 // BatchScheduler batches multiple RPC requests together.
@@ -14,6 +19,7 @@ type BatchScheduler struct {
 	mu        sync.Mutex
 	pending   []pendingRequest
 	timer     *time.Timer
+	onFlush   func(size int)
 	ctx       context.Context
 	cancel    context.CancelFunc
 }
@@ -22,6 +28,7 @@ type BatchScheduler struct {
 type pendingRequest struct {
 	body   RPCRequest
 	respCh chan batchResult
+	span   tracing.Span
 }
 
 // batchResult contains the result of a batched request.
@@ -36,6 +43,8 @@ type BatchSchedulerOptions struct {
 	BatchSize int
 	// Wait is the maximum time to wait before sending a batch.
 	Wait time.Duration
+	// OnFlush is called with the number of requests in each batch sent.
+	OnFlush func(size int)
 }
 
 // DefaultBatchSchedulerOptions returns default options.
@@ -59,6 +68,7 @@ func NewBatchScheduler(client *HTTPClient, opts ...BatchSchedulerOptions) *Batch
 		client:    client,
 		batchSize: opt.BatchSize,
 		wait:      opt.Wait,
+		onFlush:   opt.OnFlush,
 		ctx:       ctx,
 		cancel:    cancel,
 	}
@@ -74,6 +84,7 @@ func (s *BatchScheduler) Schedule(ctx context.Context, body RPCRequest) (*RPCRes
 	s.pending = append(s.pending, pendingRequest{
 		body:   body,
 		respCh: respCh,
+		span:   tracing.SpanFromContext(ctx),
 	})
 
 	// Check if we should flush immediately
@@ -124,20 +135,37 @@ func (s *BatchScheduler) flush() {
 		return
 	}
 
+	if s.onFlush != nil {
+		s.onFlush(len(pending))
+	}
+	for _, p := range pending {
+		p.span.SetAttributes(tracing.Int(tracing.AttrRPCBatchSize, len(pending)))
+	}
+
 	// Build batch request
 	bodies := make([]RPCRequest, len(pending))
 	for i, p := range pending {
 		bodies[i] = p.body
 	}
 
+	// An HTTP request carries a single traceparent header, so the batch is
+	// sent in the trace of its first traced request.
+	ctx := s.ctx
+	for _, p := range pending {
+		if p.span.SpanContext().IsValid() {
+			ctx = tracing.ContextWithSpan(ctx, p.span)
+			break
+		}
+	}
+
 	// Send batch request
-	responses, err := s.client.BatchRequest(s.ctx, bodies)
+	responses, err := s.client.BatchRequest(ctx, bodies)
 
 	// Map responses back to requests
-	responseMap := make(map[any]RPCResponse)
+	responseMap := make(map[string]RPCResponse)
 	if err == nil {
 		for _, resp := range responses {
-			responseMap[resp.ID] = resp
+			responseMap[idKey(resp.ID)] = resp
 		}
 	}
 
@@ -146,7 +174,7 @@ func (s *BatchScheduler) flush() {
 		result := batchResult{}
 		if err != nil {
 			result.err = err
-		} else if resp, ok := responseMap[p.body.ID]; ok {
+		} else if resp, ok := responseMap[idKey(p.body.ID)]; ok {
 			result.resp = &resp
 		} else {
 			result.err = NewHTTPRequestError(s.client.URL(), 0, "", p.body, nil)
@@ -159,6 +187,20 @@ func (s *BatchScheduler) flush() {
 	}
 }
 
+// idKey returns the JSON text of a request or response ID. Response IDs
+// are decoded as json.Number, so numeric IDs are matched exactly rather
+// than through float64, which cannot represent integers above 2^53.
+func idKey(id any) string {
+	if n, ok := id.(json.Number); ok {
+		return n.String()
+	}
+	text, err := json.Marshal(id)
+	if err != nil {
+		return fmt.Sprint(id)
+	}
+	return string(text)
+}
+
 // Close stops the batch scheduler.
 func (s *BatchScheduler) Close() {
 	s.cancel()
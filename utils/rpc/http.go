@@ -12,6 +12,8 @@ import (
 	"time"
 
 	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/tracing"
 )
 
 // HTTPClientOptions contains options for the HTTP RPC client.
@@ -146,6 +148,7 @@ func (c *HTTPClient) doRequest(ctx context.Context, bodies []RPCRequest) ([]RPCR
 	for k, v := range c.headers {
 		req.Header.Set(k, v)
 	}
+	tracing.Inject(ctx, req.Header)
 
 	// Call onRequest hook
 	if c.onRequest != nil {
@@ -188,10 +191,10 @@ func (c *HTTPClient) doRequest(ctx context.Context, bodies []RPCRequest) ([]RPCR
 	var responses []RPCResponse
 
 	// Try parsing as array first (batch response)
-	if err := json.Unmarshal(respBody, &responses); err != nil {
+	if err := unmarshalResponse(respBody, &responses); err != nil {
 		// Try parsing as single response
 		var singleResp RPCResponse
-		if err := json.Unmarshal(respBody, &singleResp); err != nil {
+		if err := unmarshalResponse(respBody, &singleResp); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 		}
 		responses = []RPCResponse{singleResp}
@@ -200,6 +203,14 @@ func (c *HTTPClient) doRequest(ctx context.Context, bodies []RPCRequest) ([]RPCR
 	return responses, nil
 }
 
+// unmarshalResponse decodes a response body, keeping numbers such as
+// response IDs as json.Number so that they are not rounded to float64.
+func unmarshalResponse(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
 // Close closes the HTTP client.
 func (c *HTTPClient) Close() error {
 	c.httpClient.CloseIdleConnections()
@@ -328,12 +339,28 @@ func SanitizeURL(rawURL string) string {
 		return rawURL
 	}
 
-	// Remove password
+	// Remove password, and usernames that are really API keys
 	if parsed.User != nil {
+		username := parsed.User.Username()
+		if looksLikeAPIKey(username) {
+			username = "***"
+		}
 		if _, hasPassword := parsed.User.Password(); hasPassword {
-			parsed.User = url.UserPassword(parsed.User.Username(), "***")
+			parsed.User = url.UserPassword(username, "***")
+		} else {
+			parsed.User = url.User(username)
+		}
+	}
+
+	// Remove API keys embedded in the path (e.g. Alchemy's /v2/<key>)
+	segments := strings.Split(parsed.Path, "/")
+	for i, segment := range segments {
+		if looksLikeAPIKey(segment) {
+			segments[i] = "***"
 		}
 	}
+	parsed.Path = strings.Join(segments, "/")
+	parsed.RawPath = ""
 
 	// Remove API keys from query params
 	q := parsed.Query()
@@ -351,5 +378,27 @@ func SanitizeURL(rawURL string) string {
 	}
 	parsed.RawQuery = q.Encode()
 
-	return parsed.String()
+	// Keep the redaction marker readable rather than percent-encoded
+	return strings.ReplaceAll(parsed.String(), "%2A%2A%2A", "***")
+}
+
+// looksLikeAPIKey reports whether s looks like an opaque credential: a long
+// token of letters and digits (optionally with '-' or '_') containing both.
+func looksLikeAPIKey(s string) bool {
+	if len(s) < 20 {
+		return false
+	}
+	var letters, digits bool
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			digits = true
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+			letters = true
+		case c == '-' || c == '_':
+		default:
+			return false
+		}
+	}
+	return letters && digits
 }
@@ -3,6 +3,8 @@ package rpc
 import (
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"sync/atomic"
 
 	json "github.com/goccy/go-json"
@@ -69,12 +71,12 @@ type HTTPRequestError struct {
 
 func (e *HTTPRequestError) Error() string {
 	if e.Status > 0 {
-		return fmt.Sprintf("HTTP request failed: %d %s (url: %s)", e.Status, e.StatusText, e.URL)
+		return fmt.Sprintf("HTTP request failed: %d %s (url: %s)", e.Status, e.StatusText, SanitizeURL(e.URL))
 	}
 	if e.Cause != nil {
-		return fmt.Sprintf("HTTP request failed: %v (url: %s)", e.Cause, e.URL)
+		return fmt.Sprintf("HTTP request failed: %s (url: %s)", redactURLIn(e.Cause.Error(), e.URL), SanitizeURL(e.URL))
 	}
-	return fmt.Sprintf("HTTP request failed (url: %s)", e.URL)
+	return fmt.Sprintf("HTTP request failed (url: %s)", SanitizeURL(e.URL))
 }
 
 func (e *HTTPRequestError) Unwrap() error {
@@ -101,9 +103,9 @@ type WebSocketRequestError struct {
 
 func (e *WebSocketRequestError) Error() string {
 	if e.Cause != nil {
-		return fmt.Sprintf("WebSocket request failed: %v (url: %s)", e.Cause, e.URL)
+		return fmt.Sprintf("WebSocket request failed: %s (url: %s)", redactURLIn(e.Cause.Error(), e.URL), SanitizeURL(e.URL))
 	}
-	return fmt.Sprintf("WebSocket request failed (url: %s)", e.URL)
+	return fmt.Sprintf("WebSocket request failed (url: %s)", SanitizeURL(e.URL))
 }
 
 func (e *WebSocketRequestError) Unwrap() error {
@@ -126,7 +128,16 @@ type TimeoutError struct {
 }
 
 func (e *TimeoutError) Error() string {
-	return fmt.Sprintf("request timed out (url: %s)", e.URL)
+	return fmt.Sprintf("request timed out (url: %s)", SanitizeURL(e.URL))
+}
+
+// redactURLIn replaces rawURL in msg (e.g. a *url.Error message) with its
+// sanitized form.
+func redactURLIn(msg, rawURL string) string {
+	if rawURL == "" {
+		return msg
+	}
+	return strings.ReplaceAll(msg, rawURL, SanitizeURL(rawURL))
 }
 
 // NewTimeoutError creates a new TimeoutError.
@@ -217,3 +228,15 @@ type Subscription struct {
 	// Unsubscribe cancels the subscription.
 	Unsubscribe func() error
 }
+
+// discardLogger drops every record.
+var discardLogger = slog.New(slog.DiscardHandler)
+
+// LoggerOrDiscard returns logger, or a logger that discards everything if
+// logger is nil.
+func LoggerOrDiscard(logger *slog.Logger) *slog.Logger {
+	if logger == nil {
+		return discardLogger
+	}
+	return logger
+}
@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
@@ -17,6 +18,8 @@ type WebSocketClientOptions struct {
 	KeepAlive *KeepAliveConfig
 	// Reconnect enables automatic reconnection.
 	Reconnect *ReconnectConfig
+	// Logger receives connection, reconnect and resubscribe events (optional).
+	Logger *slog.Logger
 }
 
 // KeepAliveConfig contains keep-alive configuration.
@@ -85,6 +88,7 @@ type WebSocketClient struct {
 	keepAliveTick *time.Ticker
 	reconnecting  bool
 	reconnectMu   sync.Mutex
+	logger        *slog.Logger
 }
 
 // NewWebSocketClient creates a new WebSocket RPC client.
@@ -103,6 +107,7 @@ func NewWebSocketClient(url string, opts ...WebSocketClientOptions) (*WebSocketC
 		requests:      make(map[any]*callbackFn),
 		subscriptions: make(map[string]*callbackFn),
 		closeCh:       make(chan struct{}),
+		logger:        LoggerOrDiscard(opt.Logger).With("url", SanitizeURL(url)),
 	}
 
 	// Connect
@@ -197,6 +202,9 @@ func (c *WebSocketClient) handleResponse(resp RPCResponse) {
 // handleError processes connection errors.
 func (c *WebSocketClient) handleError(err error) {
 	c.mu.Lock()
+	if !c.closed {
+		c.logger.Warn("websocket connection lost", "error", err, "pending", len(c.requests), "subscriptions", len(c.subscriptions))
+	}
 
 	// Notify all pending requests
 	for id, callback := range c.requests {
@@ -255,15 +263,21 @@ func (c *WebSocketClient) attemptReconnect() {
 		default:
 		}
 
+		c.logger.Info("reconnecting websocket", "attempt", attempt+1, "maxAttempts", c.reconnect.MaxAttempts, "delay", c.reconnect.Delay)
 		time.Sleep(c.reconnect.Delay)
 
-		if err := c.connect(); err == nil {
+		err := c.connect()
+		if err == nil {
 			// Reconnected successfully
+			c.logger.Info("websocket reconnected", "attempt", attempt+1)
 			// Re-establish subscriptions
 			c.resubscribe()
 			return
 		}
+		c.logger.Warn("websocket reconnect failed", "attempt", attempt+1, "error", err)
 	}
+
+	c.logger.Error("giving up reconnecting websocket", "attempts", c.reconnect.MaxAttempts)
 }
 
 // resubscribe re-establishes subscriptions after reconnect.
@@ -284,8 +298,10 @@ func (c *WebSocketClient) resubscribe() {
 
 		// Re-send subscription request
 		if err := c.Request(*callback.body, callback.onResponse, callback.onError); err != nil {
-			fmt.Println("Error resubscrbing request", err)
+			c.logger.Warn("failed to resubscribe", "params", callback.body.Params, "error", err)
+			continue
 		}
+		c.logger.Debug("resubscribed", "params", callback.body.Params)
 	}
 }
 
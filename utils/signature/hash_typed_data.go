@@ -3,6 +3,7 @@ package signature
 import (
 	"fmt"
 	"math/big"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -264,7 +265,42 @@ func encodeAbiValue(fieldType string, value any) ([]byte, error) {
 		return nil, err
 	}
 
-	return args.Pack(converted)
+	return args.Pack(toAbiGoType(abiType, converted))
+}
+
+// toAbiGoType adapts a converted value to the exact Go type go-ethereum packs
+// for abiType: fixed-size byte arrays for bytesN and native integers for
+// (u)int8..(u)int64. Map messages rely on it as much as struct-derived ones:
+// without it a hex bytes32 salt or a uint8 field fails to pack.
+func toAbiGoType(abiType abi.Type, value any) any {
+	target := abiType.GetType()
+	switch v := value.(type) {
+	case []byte:
+		if abiType.T != abi.FixedBytesTy || len(v) > target.Len() {
+			return value
+		}
+		out := reflect.New(target).Elem()
+		reflect.Copy(out, reflect.ValueOf(v))
+		return out.Interface()
+	case *big.Int:
+		if abiType.T != abi.IntTy && abiType.T != abi.UintTy || target.Kind() == reflect.Ptr {
+			return value
+		}
+		out := reflect.New(target).Elem()
+		if abiType.T == abi.IntTy {
+			if !v.IsInt64() || out.OverflowInt(v.Int64()) {
+				return value
+			}
+			out.SetInt(v.Int64())
+		} else {
+			if v.Sign() < 0 || !v.IsUint64() || out.OverflowUint(v.Uint64()) {
+				return value
+			}
+			out.SetUint(v.Uint64())
+		}
+		return out.Interface()
+	}
+	return value
 }
 
 // convertTypedDataValue converts a value to the expected Go type for ABI encoding.
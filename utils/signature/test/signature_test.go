@@ -158,6 +158,42 @@ var _ = Describe("Signature", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(hash).To(HavePrefix("0x"))
 			Expect(len(hash)).To(Equal(66)) // 0x + 64 hex chars
+			Expect(hash).To(Equal("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"))
+		})
+
+		It("should hash map messages with small integers and fixed bytes", func() {
+			typedData := signature.TypedDataDefinition{
+				Domain: signature.TypedDataDomain{
+					Name:    "Orders",
+					ChainId: big.NewInt(1),
+					Salt:    "0x1100000000000000000000000000000000000000000000000000000000000022",
+				},
+				Types: map[string][]signature.TypedDataField{
+					"Order": {
+						{Name: "kind", Type: "uint8"},
+						{Name: "delta", Type: "int8"},
+						{Name: "tag", Type: "bytes4"},
+						{Name: "id", Type: "bytes32"},
+						{Name: "amount", Type: "uint256"},
+						{Name: "owner", Type: "address"},
+						{Name: "live", Type: "bool"},
+					},
+				},
+				PrimaryType: "Order",
+				Message: map[string]any{
+					"kind":   7,
+					"delta":  int64(-3),
+					"tag":    "0xdeadbeef",
+					"id":     "0x00000000000000000000000000000000000000000000000000000000000000ff",
+					"amount": big.NewInt(1000),
+					"owner":  "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+					"live":   true,
+				},
+			}
+
+			hash, err := signature.HashTypedData(typedData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash).To(Equal("0x232d04cd88533628136d7926dfa6abb20f3a0cc71614924b2f085ee6a8f5c2e5"))
 		})
 	})
 
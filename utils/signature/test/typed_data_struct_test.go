@@ -0,0 +1,170 @@
+package test
+
+import (
+	"math/big"
+	"reflect"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/utils/signature"
+)
+
+type mailPerson struct {
+	Name   string         `eip712:"name"`
+	Wallet common.Address `eip712:"wallet"`
+}
+
+func (mailPerson) TypedDataName() string { return "Person" }
+
+type mail struct {
+	From     mailPerson `eip712:"from"`
+	To       mailPerson `eip712:"to"`
+	Contents string     `eip712:"contents"`
+}
+
+func (mail) TypedDataName() string { return "Mail" }
+
+type permitBatch struct {
+	Owner    common.Address
+	Amounts  []*big.Int   `eip712:"amounts,uint160[]"`
+	Nonce    uint48Value  `eip712:",uint48"`
+	Deadline uint64       `eip712:"deadline"`
+	Salt     common.Hash  `eip712:"salt"`
+	Data     []byte       `eip712:"data"`
+	Spenders []mailPerson `eip712:"spenders"`
+	Internal string       `eip712:"-"`
+}
+
+type uint48Value uint64
+
+var _ = Describe("Typed data from structs", func() {
+	domain := signature.TypedDataDomain{
+		Name:              "Ether Mail",
+		Version:           "1",
+		ChainId:           big.NewInt(1),
+		VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+	}
+	message := mail{
+		From:     mailPerson{Name: "Cow", Wallet: common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")},
+		To:       mailPerson{Name: "Bob", Wallet: common.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB")},
+		Contents: "Hello, Bob!",
+	}
+
+	Describe("TypedDataFromStruct", func() {
+		It("should derive the EIP-712 type graph and message", func() {
+			typedData, err := signature.TypedDataFromStruct(domain, message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(typedData.PrimaryType).To(Equal("Mail"))
+			Expect(typedData.Types).To(Equal(map[string][]signature.TypedDataField{
+				"Person": {{Name: "name", Type: "string"}, {Name: "wallet", Type: "address"}},
+				"Mail":   {{Name: "from", Type: "Person"}, {Name: "to", Type: "Person"}, {Name: "contents", Type: "string"}},
+			}))
+			Expect(typedData.Message["from"]).To(Equal(map[string]any{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			}))
+		})
+
+		It("should hash to the EIP-712 reference value", func() {
+			hash, err := signature.HashTypedDataStruct(domain, message)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hash).To(Equal("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"))
+		})
+
+		It("should infer and override types for nested arrays, bytes and integers", func() {
+			_, types, err := signature.TypedDataTypes(reflect.TypeOf(permitBatch{}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(types["permitBatch"]).To(Equal([]signature.TypedDataField{
+				{Name: "owner", Type: "address"},
+				{Name: "amounts", Type: "uint160[]"},
+				{Name: "nonce", Type: "uint48"},
+				{Name: "deadline", Type: "uint64"},
+				{Name: "salt", Type: "bytes32"},
+				{Name: "data", Type: "bytes"},
+				{Name: "spenders", Type: "Person[]"},
+			}))
+			Expect(types).To(HaveKey("Person"))
+		})
+
+		It("should reject unsupported field types", func() {
+			type bad struct {
+				Value float64
+			}
+			_, err := signature.TypedDataFromStruct(domain, bad{Value: 1})
+			Expect(err).To(MatchError(ContainSubstring("cannot infer EIP-712 type")))
+		})
+	})
+
+	Describe("DecodeTypedData", func() {
+		It("should round-trip a struct through the JSON payload", func() {
+			value := permitBatch{
+				Owner:    common.HexToAddress("0x01"),
+				Amounts:  []*big.Int{big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 150)},
+				Nonce:    7,
+				Deadline: 1700000000,
+				Salt:     common.HexToHash("0xabcd"),
+				Data:     []byte{0xde, 0xad},
+				Spenders: []mailPerson{message.To},
+			}
+			typedData, err := signature.TypedDataFromStruct(domain, value)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload, err := json.Marshal(typedData)
+			Expect(err).NotTo(HaveOccurred())
+
+			var decoded permitBatch
+			parsed, err := signature.DecodeTypedData(payload, &decoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Domain.ChainId.Int64()).To(Equal(int64(1)))
+			Expect(decoded.Owner).To(Equal(value.Owner))
+			Expect(decoded.Amounts[1].Cmp(value.Amounts[1])).To(Equal(0))
+			Expect(decoded.Nonce).To(Equal(value.Nonce))
+			Expect(decoded.Deadline).To(Equal(value.Deadline))
+			Expect(decoded.Salt).To(Equal(value.Salt))
+			Expect(decoded.Data).To(Equal(value.Data))
+			Expect(decoded.Spenders).To(Equal(value.Spenders))
+
+			original, err := signature.HashTypedData(typedData)
+			Expect(err).NotTo(HaveOccurred())
+			roundTripped, err := signature.HashTypedDataStruct(parsed.Domain, decoded)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roundTripped).To(Equal(original))
+		})
+
+		It("should accept string chain IDs and numeric strings", func() {
+			payload := []byte(`{
+				"domain": {"name": "Ether Mail", "chainId": "0x1"},
+				"types": {
+					"EIP712Domain": [{"name": "name", "type": "string"}, {"name": "chainId", "type": "uint256"}],
+					"Person": [{"name": "name", "type": "string"}, {"name": "wallet", "type": "address"}]
+				},
+				"primaryType": "Person",
+				"message": {"name": "Cow", "wallet": "0xcd2a3d9f938e13cd947ec05abc7fe734df8dd826"}
+			}`)
+
+			var person mailPerson
+			parsed, err := signature.DecodeTypedData(payload, &person)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Domain.ChainId.Int64()).To(Equal(int64(1)))
+			Expect(person.Wallet).To(Equal(message.From.Wallet))
+		})
+
+		It("should reject payloads whose types do not match the struct", func() {
+			payload := []byte(`{
+				"domain": {"name": "Ether Mail"},
+				"types": {"Person": [{"name": "name", "type": "string"}, {"name": "wallet", "type": "bytes20"}]},
+				"primaryType": "Person",
+				"message": {"name": "Cow", "wallet": "0xcd2a3d9f938e13cd947ec05abc7fe734df8dd826"}
+			}`)
+
+			var person mailPerson
+			_, err := signature.DecodeTypedData(payload, &person)
+			Expect(err).To(MatchError(ContainSubstring(`type "Person" does not match`)))
+		})
+	})
+})
@@ -0,0 +1,676 @@
+package signature
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TypedDataNamer can be implemented by message structs whose EIP-712 type
+// name differs from their Go type name.
+type TypedDataNamer interface {
+	TypedDataName() string
+}
+
+var (
+	bigIntType    = reflect.TypeOf((*big.Int)(nil))
+	addressGoType = reflect.TypeOf(common.Address{})
+	namerType     = reflect.TypeOf((*TypedDataNamer)(nil)).Elem()
+)
+
+// TypedDataFromStruct derives an EIP-712 typed data definition from a Go struct.
+//
+// The primary type is named after the struct's Go type (or TypedDataName() if
+// implemented). Fields are described with `eip712:"name,type"` tags, both
+// parts optional: the name defaults to the lower-camel-cased field name and
+// the type is inferred from the Go type (string, bool, common.Address,
+// *big.Int as uint256, sized Go integers, []byte, byte arrays as bytesN,
+// slices, arrays and nested structs). Fields tagged `eip712:"-"` and
+// unexported fields are skipped. For nested structs the tag type names the
+// EIP-712 struct, e.g. `eip712:"from,Person"`.
+//
+// Example:
+//
+//	type Person struct {
+//	    Name   string         `eip712:"name"`
+//	    Wallet common.Address `eip712:"wallet"`
+//	}
+//	type Mail struct {
+//	    From     Person `eip712:"from"`
+//	    To       Person `eip712:"to"`
+//	    Contents string `eip712:"contents"`
+//	}
+//	typedData, err := signature.TypedDataFromStruct(domain, Mail{...})
+func TypedDataFromStruct(domain TypedDataDomain, message any) (TypedDataDefinition, error) {
+	rv := reflect.ValueOf(message)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return TypedDataDefinition{}, fmt.Errorf("typed data message is nil")
+		}
+		rv = rv.Elem()
+	}
+
+	primaryType, types, err := TypedDataTypes(rv.Type())
+	if err != nil {
+		return TypedDataDefinition{}, err
+	}
+
+	msg, err := structToMessage(rv, primaryType, types)
+	if err != nil {
+		return TypedDataDefinition{}, err
+	}
+
+	return TypedDataDefinition{
+		Domain:      domain,
+		Types:       types,
+		PrimaryType: primaryType,
+		Message:     msg,
+	}, nil
+}
+
+// TypedDataTypes derives the EIP-712 primary type name and type graph of a Go
+// struct type, following the tag rules of TypedDataFromStruct.
+func TypedDataTypes(t reflect.Type) (string, map[string][]TypedDataField, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return "", nil, fmt.Errorf("typed data message must be a struct, got %s", t)
+	}
+
+	b := &typesBuilder{
+		types: make(map[string][]TypedDataField),
+		names: make(map[string]reflect.Type),
+	}
+	name := structTypeName(t, "")
+	if err := b.addStruct(t, name); err != nil {
+		return "", nil, err
+	}
+	return name, b.types, nil
+}
+
+// HashTypedDataStruct computes the EIP-712 hash of a Go struct message.
+// See TypedDataFromStruct for how the struct is mapped.
+func HashTypedDataStruct(domain TypedDataDomain, message any) (string, error) {
+	typedData, err := TypedDataFromStruct(domain, message)
+	if err != nil {
+		return "", err
+	}
+	return HashTypedData(typedData)
+}
+
+// ParseTypedData parses an EIP-712 typed data JSON payload, as sent to
+// eth_signTypedData_v4. The chainId may be a number or a decimal/hex string,
+// and large integers in the message are kept as json.Number.
+func ParseTypedData(data []byte) (TypedDataDefinition, error) {
+	var raw struct {
+		Domain struct {
+			Name              string          `json:"name"`
+			Version           string          `json:"version"`
+			ChainId           json.RawMessage `json:"chainId"`
+			VerifyingContract string          `json:"verifyingContract"`
+			Salt              string          `json:"salt"`
+		} `json:"domain"`
+		Types       map[string][]TypedDataField `json:"types"`
+		PrimaryType string                      `json:"primaryType"`
+		Message     json.RawMessage             `json:"message"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return TypedDataDefinition{}, fmt.Errorf("invalid typed data JSON: %w", err)
+	}
+
+	def := TypedDataDefinition{
+		Domain: TypedDataDomain{
+			Name:              raw.Domain.Name,
+			Version:           raw.Domain.Version,
+			VerifyingContract: raw.Domain.VerifyingContract,
+			Salt:              raw.Domain.Salt,
+		},
+		Types:       raw.Types,
+		PrimaryType: raw.PrimaryType,
+	}
+	delete(def.Types, "EIP712Domain")
+
+	if len(raw.Domain.ChainId) > 0 && string(raw.Domain.ChainId) != "null" {
+		chainID, err := toBigInt(json.Number(strings.Trim(string(raw.Domain.ChainId), `"`)))
+		if err != nil {
+			return TypedDataDefinition{}, fmt.Errorf("invalid domain chainId: %w", err)
+		}
+		def.Domain.ChainId = chainID
+	}
+
+	if len(raw.Message) > 0 {
+		dec := json.NewDecoder(strings.NewReader(string(raw.Message)))
+		dec.UseNumber()
+		if err := dec.Decode(&def.Message); err != nil {
+			return TypedDataDefinition{}, fmt.Errorf("invalid typed data message: %w", err)
+		}
+	}
+	return def, nil
+}
+
+// DecodeTypedData parses an EIP-712 typed data JSON payload and fills out,
+// a pointer to a Go struct, from its message. The payload's type graph must
+// match the one derived from out (see TypedDataFromStruct), so a payload for
+// a different struct is rejected rather than partially decoded.
+//
+// Example:
+//
+//	var mail Mail
+//	typedData, err := signature.DecodeTypedData(payload, &mail)
+func DecodeTypedData(data []byte, out any) (TypedDataDefinition, error) {
+	def, err := ParseTypedData(data)
+	if err != nil {
+		return def, err
+	}
+	if err := DecodeTypedDataMessage(def, out); err != nil {
+		return def, err
+	}
+	return def, nil
+}
+
+// DecodeTypedDataMessage fills out, a pointer to a Go struct, from the
+// message of a typed data definition after checking that the type graphs match.
+func DecodeTypedDataMessage(def TypedDataDefinition, out any) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("output must be a non-nil pointer, got %T", out)
+	}
+
+	primaryType, types, err := TypedDataTypes(rv.Type())
+	if err != nil {
+		return err
+	}
+	if primaryType != def.PrimaryType {
+		return fmt.Errorf("typed data primary type %q does not match %q", def.PrimaryType, primaryType)
+	}
+	for name, fields := range types {
+		got, ok := def.Types[name]
+		if !ok {
+			return fmt.Errorf("typed data is missing type %q", name)
+		}
+		if !reflect.DeepEqual(got, fields) {
+			return fmt.Errorf("typed data type %q does not match: expected %s, got %s",
+				name, encodeType(name, map[string][]TypedDataField{name: fields}), encodeType(name, map[string][]TypedDataField{name: got}))
+		}
+	}
+
+	return messageToStruct(rv.Elem(), def.Message, primaryType, types, "")
+}
+
+// =============================================================================
+// Type derivation
+// =============================================================================
+
+// typesBuilder accumulates EIP-712 struct types derived from Go types.
+type typesBuilder struct {
+	types map[string][]TypedDataField
+	names map[string]reflect.Type
+}
+
+// addStruct registers a Go struct type under an EIP-712 type name.
+func (b *typesBuilder) addStruct(t reflect.Type, name string) error {
+	if existing, ok := b.names[name]; ok {
+		if existing != t {
+			return fmt.Errorf("EIP-712 type %q is used by both %s and %s", name, existing, t)
+		}
+		return nil
+	}
+	b.names[name] = t
+
+	fields := typedFields(t)
+	if len(fields) == 0 {
+		return fmt.Errorf("EIP-712 type %q (%s) has no fields", name, t)
+	}
+
+	out := make([]TypedDataField, 0, len(fields))
+	for _, f := range fields {
+		typ, err := b.fieldType(f.goType, f.typ)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), f.goName, err)
+		}
+		out = append(out, TypedDataField{Name: f.name, Type: typ})
+	}
+	b.types[name] = out
+	return nil
+}
+
+// fieldType returns the EIP-712 type string of a Go type, registering any
+// struct types it references. override is the type from the field tag.
+func (b *typesBuilder) fieldType(t reflect.Type, override string) (string, error) {
+	base := t
+	for {
+		switch {
+		case base == bigIntType:
+		case base.Kind() == reflect.Ptr:
+			base = base.Elem()
+			continue
+		case (base.Kind() == reflect.Slice || base.Kind() == reflect.Array) && base.Elem().Kind() != reflect.Uint8:
+			base = base.Elem()
+			continue
+		}
+		break
+	}
+
+	if base.Kind() == reflect.Struct && base != addressGoType {
+		name := structTypeName(base, extractBaseType(override))
+		if err := b.addStruct(base, name); err != nil {
+			return "", err
+		}
+		if override != "" {
+			return override, nil
+		}
+		return inferType(t, name)
+	}
+
+	if override != "" {
+		return override, nil
+	}
+	return inferType(t, "")
+}
+
+// inferType maps a Go type to its default EIP-712 type.
+func inferType(t reflect.Type, structName string) (string, error) {
+	switch {
+	case t == bigIntType:
+		return "uint256", nil
+	case t == addressGoType:
+		return "address", nil
+	case t.Kind() == reflect.Ptr:
+		return inferType(t.Elem(), structName)
+	case t.Kind() == reflect.Struct:
+		return structName, nil
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return "bytes", nil
+	case t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8:
+		if t.Len() < 1 || t.Len() > 32 {
+			return "", fmt.Errorf("cannot map %s to a bytesN type", t)
+		}
+		return fmt.Sprintf("bytes%d", t.Len()), nil
+	case t.Kind() == reflect.Slice:
+		elem, err := inferType(t.Elem(), structName)
+		return elem + "[]", err
+	case t.Kind() == reflect.Array:
+		elem, err := inferType(t.Elem(), structName)
+		return fmt.Sprintf("%s[%d]", elem, t.Len()), err
+	case t.Kind() == reflect.String:
+		return "string", nil
+	case t.Kind() == reflect.Bool:
+		return "bool", nil
+	case t.Kind() == reflect.Int:
+		return "int256", nil
+	case t.Kind() == reflect.Uint:
+		return "uint256", nil
+	case t.Kind() >= reflect.Int8 && t.Kind() <= reflect.Int64:
+		return fmt.Sprintf("int%d", t.Bits()), nil
+	case t.Kind() >= reflect.Uint8 && t.Kind() <= reflect.Uint64:
+		return fmt.Sprintf("uint%d", t.Bits()), nil
+	}
+	return "", fmt.Errorf("cannot infer EIP-712 type for %s (add a type to the eip712 tag)", t)
+}
+
+// typedField describes a struct field included in an EIP-712 type.
+type typedField struct {
+	index  int
+	goName string
+	goType reflect.Type
+	name   string
+	typ    string
+}
+
+// typedFields returns the EIP-712 fields of a struct type in declaration order.
+func typedFields(t reflect.Type) []typedField {
+	var fields []typedField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("eip712")
+		if tag == "-" {
+			continue
+		}
+		name, typ, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = lowerCamel(f.Name)
+		}
+		fields = append(fields, typedField{
+			index:  i,
+			goName: f.Name,
+			goType: f.Type,
+			name:   name,
+			typ:    strings.TrimSpace(typ),
+		})
+	}
+	return fields
+}
+
+// structTypeName returns the EIP-712 name of a struct type.
+func structTypeName(t reflect.Type, override string) string {
+	if override != "" {
+		return override
+	}
+	if t.Implements(namerType) {
+		return reflect.Zero(t).Interface().(TypedDataNamer).TypedDataName()
+	}
+	if reflect.PointerTo(t).Implements(namerType) {
+		return reflect.New(t).Interface().(TypedDataNamer).TypedDataName()
+	}
+	return t.Name()
+}
+
+// lowerCamel lower-cases the leading word of a Go identifier ("ID" -> "id",
+// "URLPath" -> "urlPath", "Wallet" -> "wallet").
+func lowerCamel(s string) string {
+	r := []rune(s)
+	for i := 0; i < len(r); i++ {
+		if !unicode.IsUpper(r[i]) {
+			break
+		}
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
+
+// =============================================================================
+// Struct -> message
+// =============================================================================
+
+// structToMessage converts a struct value into an EIP-712 message map.
+func structToMessage(v reflect.Value, typeName string, types map[string][]TypedDataField) (map[string]any, error) {
+	fields := typedFields(v.Type())
+	defs := types[typeName]
+	msg := make(map[string]any, len(fields))
+	for i, f := range fields {
+		value, err := valueToMessage(v.Field(f.index), defs[i].Type, types)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", typeName, f.name, err)
+		}
+		msg[f.name] = value
+	}
+	return msg, nil
+}
+
+// valueToMessage converts a Go value into its EIP-712 message representation.
+func valueToMessage(v reflect.Value, typ string, types map[string][]TypedDataField) (any, error) {
+	for v.Kind() == reflect.Ptr && v.Type() != bigIntType || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, fmt.Errorf("nil value for %s", typ)
+		}
+		v = v.Elem()
+	}
+
+	if strings.HasSuffix(typ, "]") {
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return nil, fmt.Errorf("cannot use %s as %s", v.Type(), typ)
+		}
+		elemType := typ[:strings.LastIndex(typ, "[")]
+		out := make([]any, v.Len())
+		for i := range out {
+			elem, err := valueToMessage(v.Index(i), elemType, types)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			out[i] = elem
+		}
+		return out, nil
+	}
+
+	if _, ok := types[typ]; ok {
+		if v.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("cannot use %s as %s", v.Type(), typ)
+		}
+		return structToMessage(v, typ, types)
+	}
+
+	switch {
+	case typ == "address":
+		if v.Type() == addressGoType {
+			return v.Interface().(common.Address).Hex(), nil
+		}
+		if v.Kind() == reflect.String {
+			return v.String(), nil
+		}
+	case typ == "string":
+		if v.Kind() == reflect.String {
+			return v.String(), nil
+		}
+	case typ == "bool":
+		if v.Kind() == reflect.Bool {
+			return v.Bool(), nil
+		}
+	case strings.HasPrefix(typ, "uint") || strings.HasPrefix(typ, "int"):
+		switch {
+		case v.Type() == bigIntType:
+			if v.IsNil() {
+				return nil, fmt.Errorf("nil value for %s", typ)
+			}
+			return new(big.Int).Set(v.Interface().(*big.Int)), nil
+		case v.Kind() >= reflect.Int && v.Kind() <= reflect.Int64:
+			return big.NewInt(v.Int()), nil
+		case v.Kind() >= reflect.Uint && v.Kind() <= reflect.Uint64:
+			return new(big.Int).SetUint64(v.Uint()), nil
+		}
+	case strings.HasPrefix(typ, "bytes"):
+		switch {
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			return hexutil.Encode(v.Bytes()), nil
+		case v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8:
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b), nil
+		case v.Kind() == reflect.String:
+			return v.String(), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot use %s as %s", v.Type(), typ)
+}
+
+// =============================================================================
+// Message -> struct
+// =============================================================================
+
+// messageToStruct fills a struct value from an EIP-712 message.
+func messageToStruct(dst reflect.Value, value any, typeName string, types map[string][]TypedDataField, path string) error {
+	msg, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%s: expected object for %s, got %T", pathOr(path, typeName), typeName, value)
+	}
+
+	defs := types[typeName]
+	for i, f := range typedFields(dst.Type()) {
+		fieldPath := joinTypedPath(path, typeName, f.name)
+		raw, ok := msg[f.name]
+		if !ok {
+			return fmt.Errorf("%s: missing value", fieldPath)
+		}
+		if err := messageToValue(dst.Field(f.index), raw, defs[i].Type, types, fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// messageToValue assigns an EIP-712 message value to a Go value.
+func messageToValue(dst reflect.Value, value any, typ string, types map[string][]TypedDataField, path string) error {
+	for dst.Kind() == reflect.Ptr && dst.Type() != bigIntType {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		dst = dst.Elem()
+	}
+
+	if strings.HasSuffix(typ, "]") {
+		arr, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected array for %s, got %T", path, typ, value)
+		}
+		elemType := typ[:strings.LastIndex(typ, "[")]
+		switch dst.Kind() {
+		case reflect.Slice:
+			dst.Set(reflect.MakeSlice(dst.Type(), len(arr), len(arr)))
+		case reflect.Array:
+			if dst.Len() != len(arr) {
+				return fmt.Errorf("%s: expected %d elements, got %d", path, dst.Len(), len(arr))
+			}
+		default:
+			return fmt.Errorf("%s: cannot decode %s into %s", path, typ, dst.Type())
+		}
+		for i, elem := range arr {
+			if err := messageToValue(dst.Index(i), elem, elemType, types, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if _, ok := types[typ]; ok {
+		return messageToStruct(dst, value, typ, types, path)
+	}
+
+	switch {
+	case typ == "address":
+		s, ok := value.(string)
+		if !ok || !common.IsHexAddress(s) {
+			return fmt.Errorf("%s: invalid address %v", path, value)
+		}
+		switch {
+		case dst.Type() == addressGoType:
+			dst.Set(reflect.ValueOf(common.HexToAddress(s)))
+		case dst.Kind() == reflect.String:
+			dst.SetString(s)
+		default:
+			return fmt.Errorf("%s: cannot decode address into %s", path, dst.Type())
+		}
+		return nil
+
+	case typ == "string":
+		s, ok := value.(string)
+		if !ok || dst.Kind() != reflect.String {
+			return fmt.Errorf("%s: cannot decode %T into %s", path, value, dst.Type())
+		}
+		dst.SetString(s)
+		return nil
+
+	case typ == "bool":
+		b, ok := value.(bool)
+		if !ok || dst.Kind() != reflect.Bool {
+			return fmt.Errorf("%s: cannot decode %T into %s", path, value, dst.Type())
+		}
+		dst.SetBool(b)
+		return nil
+
+	case strings.HasPrefix(typ, "uint") || strings.HasPrefix(typ, "int"):
+		n, err := toBigInt(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		switch {
+		case dst.Type() == bigIntType:
+			dst.Set(reflect.ValueOf(n))
+		case dst.Kind() >= reflect.Int && dst.Kind() <= reflect.Int64:
+			if !n.IsInt64() || dst.OverflowInt(n.Int64()) {
+				return fmt.Errorf("%s: value %s overflows %s", path, n, dst.Type())
+			}
+			dst.SetInt(n.Int64())
+		case dst.Kind() >= reflect.Uint && dst.Kind() <= reflect.Uint64:
+			if n.Sign() < 0 || !n.IsUint64() || dst.OverflowUint(n.Uint64()) {
+				return fmt.Errorf("%s: value %s overflows %s", path, n, dst.Type())
+			}
+			dst.SetUint(n.Uint64())
+		default:
+			return fmt.Errorf("%s: cannot decode %s into %s", path, typ, dst.Type())
+		}
+		return nil
+
+	case strings.HasPrefix(typ, "bytes"):
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected hex string for %s, got %T", path, typ, value)
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return fmt.Errorf("%s: invalid hex for %s: %w", path, typ, err)
+		}
+		switch {
+		case dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8:
+			dst.SetBytes(b)
+		case dst.Kind() == reflect.Array && dst.Type().Elem().Kind() == reflect.Uint8:
+			if len(b) != dst.Len() {
+				return fmt.Errorf("%s: expected %d bytes, got %d", path, dst.Len(), len(b))
+			}
+			reflect.Copy(dst, reflect.ValueOf(b))
+		case dst.Kind() == reflect.String:
+			dst.SetString(s)
+		default:
+			return fmt.Errorf("%s: cannot decode %s into %s", path, typ, dst.Type())
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%s: unsupported type %s", path, typ)
+}
+
+// toBigInt converts a JSON number, decimal or hex string, or Go integer to *big.Int.
+func toBigInt(value any) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return new(big.Int).Set(v), nil
+	case json.Number:
+		return parseBigInt(string(v))
+	case string:
+		return parseBigInt(v)
+	case float64:
+		if v != float64(int64(v)) {
+			return nil, fmt.Errorf("invalid integer %v", v)
+		}
+		return big.NewInt(int64(v)), nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	}
+	return nil, fmt.Errorf("invalid integer %v (%T)", value, value)
+}
+
+// parseBigInt parses a decimal or 0x-prefixed hex integer.
+func parseBigInt(s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		// Allow exponent notation for integral JSON numbers, e.g. "1e18".
+		if f, err := strconv.ParseFloat(s, 64); err == nil && f == float64(int64(f)) {
+			return big.NewInt(int64(f)), nil
+		}
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	return n, nil
+}
+
+// joinTypedPath appends a field name to a message path.
+func joinTypedPath(path, typeName, field string) string {
+	if path == "" {
+		return typeName + "." + field
+	}
+	return path + "." + field
+}
+
+// pathOr returns path, or fallback for the top-level value.
+func pathOr(path, fallback string) string {
+	if path == "" {
+		return fallback
+	}
+	return path
+}
@@ -5,6 +5,25 @@ import (
 	"strings"
 )
 
+// AssertTransactionEIP712 validates a zkSync EIP-712 transaction.
+func AssertTransactionEIP712(tx *Transaction) error {
+	// Validate from address if present
+	if tx.From != "" && !isValidAddress(tx.From) {
+		return fmt.Errorf("%w: %s", ErrInvalidAddress, tx.From)
+	}
+
+	// Validate paymaster
+	if tx.Paymaster != "" && !isValidAddress(tx.Paymaster) {
+		return fmt.Errorf("%w: %s", ErrInvalidAddress, tx.Paymaster)
+	}
+	if (tx.Paymaster == "") != (tx.PaymasterInput == "") {
+		return ErrInvalidPaymasterParams
+	}
+
+	// Also validate as EIP-1559
+	return AssertTransactionEIP1559(tx)
+}
+
 // AssertTransactionEIP7702 validates an EIP-7702 transaction.
 func AssertTransactionEIP7702(tx *Transaction) error {
 	// Validate authorization list
@@ -140,6 +159,8 @@ func AssertTransaction(tx *Transaction) error {
 	}
 
 	switch txType {
+	case TransactionTypeEIP712:
+		return AssertTransactionEIP712(tx)
 	case TransactionTypeEIP7702:
 		return AssertTransactionEIP7702(tx)
 	case TransactionTypeEIP4844:
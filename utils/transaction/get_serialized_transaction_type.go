@@ -20,6 +20,8 @@ func GetSerializedTransactionType(serializedTx string) (TransactionType, error)
 	serializedType := strings.ToLower(serializedTx[0:4])
 
 	switch serializedType {
+	case "0x71":
+		return TransactionTypeEIP712, nil
 	case "0x04":
 		return TransactionTypeEIP7702, nil
 	case "0x03":
@@ -18,6 +18,12 @@ func GetTransactionType(tx *Transaction) (TransactionType, error) {
 		return tx.Type, nil
 	}
 
+	// zkSync EIP-712: has any zkSync-specific field
+	if tx.GasPerPubdata != nil || len(tx.FactoryDeps) > 0 ||
+		tx.CustomSignature != "" || tx.Paymaster != "" || tx.PaymasterInput != "" {
+		return TransactionTypeEIP712, nil
+	}
+
 	// EIP-7702: has authorizationList
 	if len(tx.AuthorizationList) > 0 {
 		return TransactionTypeEIP7702, nil
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/ChefBingbong/viem-go/utils/encoding"
+	"github.com/ChefBingbong/viem-go/utils/kzg"
 )
 
 // ParseTransaction parses a serialized transaction.
@@ -20,6 +21,8 @@ func ParseTransaction(serializedTx string) (*Transaction, error) {
 	}
 
 	switch txType {
+	case TransactionTypeEIP712:
+		return parseTransactionEIP712(serializedTx)
 	case TransactionTypeEIP7702:
 		return parseTransactionEIP7702(serializedTx)
 	case TransactionTypeEIP4844:
@@ -35,6 +38,56 @@ func ParseTransaction(serializedTx string) (*Transaction, error) {
 	}
 }
 
+func parseTransactionEIP712(serializedTx string) (*Transaction, error) {
+	// Remove type prefix (0x71) and decode RLP
+	data, err := decodeTransactionRlp(serializedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := data.([]any)
+	if !ok {
+		return nil, ErrInvalidSerializedTransaction
+	}
+
+	// EIP-712: [nonce, maxPriorityFeePerGas, maxFeePerGas, gas, to, value, data, chainId, '', '', chainId, from, gasPerPubdata, factoryDeps, customSignature, paymasterParams]
+	if len(items) != 16 {
+		return nil, fmt.Errorf("%w: expected 16 fields, got %d", ErrInvalidSerializedTransaction, len(items))
+	}
+
+	tx := &Transaction{Type: TransactionTypeEIP712}
+
+	tx.Nonce = hexToNumber(getHexString(items[0]))
+	tx.MaxPriorityFeePerGas = hexToBigInt(getHexString(items[1]))
+	tx.MaxFeePerGas = hexToBigInt(getHexString(items[2]))
+	tx.Gas = hexToBigInt(getHexString(items[3]))
+	tx.To = getNonEmptyHex(getHexString(items[4]))
+	tx.Value = hexToBigInt(getHexString(items[5]))
+	tx.Data = getNonEmptyHex(getHexString(items[6]))
+	tx.ChainId = hexToNumber(getHexString(items[10]))
+	tx.From = getNonEmptyHex(getHexString(items[11]))
+	tx.GasPerPubdata = hexToBigInt(getHexString(items[12]))
+
+	if deps, ok := items[13].([]any); ok && len(deps) > 0 {
+		tx.FactoryDeps = make([]string, len(deps))
+		for i, dep := range deps {
+			tx.FactoryDeps[i] = getHexString(dep)
+		}
+	}
+
+	tx.CustomSignature = getNonEmptyHex(getHexString(items[14]))
+
+	if paymasterParams, ok := items[15].([]any); ok && len(paymasterParams) > 0 {
+		if len(paymasterParams) != 2 {
+			return nil, fmt.Errorf("%w: expected 2 paymaster params, got %d", ErrInvalidSerializedTransaction, len(paymasterParams))
+		}
+		tx.Paymaster = getNonEmptyHex(getHexString(paymasterParams[0]))
+		tx.PaymasterInput = getNonEmptyHex(getHexString(paymasterParams[1]))
+	}
+
+	return tx, nil
+}
+
 func parseTransactionEIP7702(serializedTx string) (*Transaction, error) {
 	// Remove type prefix (0x04) and decode RLP
 	data, err := decodeTransactionRlp(serializedTx)
@@ -91,8 +144,10 @@ func parseTransactionEIP4844(serializedTx string) (*Transaction, error) {
 		return nil, ErrInvalidSerializedTransaction
 	}
 
-	// Check if it's a wrapper format (4 items: transaction, blobs, commitments, proofs)
-	hasWrapper := len(items) == 4
+	// Check if it's a wrapper format: 4 items (transaction, blobs, commitments,
+	// proofs) or, for version-1 sidecars, 5 items (transaction, version, blobs,
+	// commitments, cell proofs)
+	hasWrapper := len(items) == 4 || len(items) == 5
 	var txItems []any
 
 	if hasWrapper {
@@ -140,9 +195,26 @@ func parseTransactionEIP4844(serializedTx string) (*Transaction, error) {
 
 	// Parse wrapper (sidecars)
 	if hasWrapper {
-		blobs, _ := items[1].([]any)
-		commitments, _ := items[2].([]any)
-		proofs, _ := items[3].([]any)
+		sidecarItems := items[1:]
+		cellProofs := len(items) == 5
+		if cellProofs {
+			if version := hexToNumber(getHexString(items[1])); version != 1 {
+				return nil, fmt.Errorf("%w: unsupported sidecar version %d", ErrInvalidSerializedTransaction, version)
+			}
+			sidecarItems = items[2:]
+		}
+		blobs, _ := sidecarItems[0].([]any)
+		commitments, _ := sidecarItems[1].([]any)
+		proofs, _ := sidecarItems[2].([]any)
+
+		// Version-1 wrappers carry every cell proof of each blob (EIP-7594).
+		proofsPerBlob := 1
+		if cellProofs {
+			proofsPerBlob = kzg.CellProofsPerBlob
+		}
+		if len(commitments) != len(blobs) || len(proofs) != len(blobs)*proofsPerBlob {
+			return nil, fmt.Errorf("%w: sidecar length mismatch", ErrInvalidSerializedTransaction)
+		}
 
 		if len(blobs) > 0 {
 			tx.Sidecars = make([]BlobSidecar, len(blobs))
@@ -150,7 +222,14 @@ func parseTransactionEIP4844(serializedTx string) (*Transaction, error) {
 				tx.Sidecars[i] = BlobSidecar{
 					Blob:       getHexString(blobs[i]),
 					Commitment: getHexString(commitments[i]),
-					Proof:      getHexString(proofs[i]),
+				}
+				if cellProofs {
+					tx.Sidecars[i].CellProofs = make([]string, proofsPerBlob)
+					for j := range proofsPerBlob {
+						tx.Sidecars[i].CellProofs[j] = getHexString(proofs[i*proofsPerBlob+j])
+					}
+				} else {
+					tx.Sidecars[i].Proof = getHexString(proofs[i])
 				}
 			}
 		}
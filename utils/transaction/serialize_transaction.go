@@ -29,6 +29,8 @@ func SerializeTransaction(tx *Transaction, signature *Signature) (string, error)
 	}
 
 	switch txType {
+	case TransactionTypeEIP712:
+		return serializeTransactionEIP712(tx, signature)
 	case TransactionTypeEIP7702:
 		return serializeTransactionEIP7702(tx, signature)
 	case TransactionTypeEIP4844:
@@ -44,6 +46,72 @@ func SerializeTransaction(tx *Transaction, signature *Signature) (string, error)
 	}
 }
 
+// serializeTransactionEIP712 serializes a zkSync EIP-712 transaction. The
+// signature, if any, goes into the customSignature field as r || s || v; the
+// v, r and s fields hold the chain ID and two empty values instead.
+func serializeTransactionEIP712(tx *Transaction, signature *Signature) (string, error) {
+	if err := AssertTransactionEIP712(tx); err != nil {
+		return "", err
+	}
+
+	customSignature := tx.CustomSignature
+	if customSignature == "" {
+		sig := signature
+		if sig == nil && tx.HasSignature() {
+			sig = tx.GetSignature()
+		}
+		if sig != nil {
+			customSignature = serializeCustomSignature(sig)
+		}
+	}
+
+	paymasterParams := []any{}
+	if tx.Paymaster != "" {
+		paymasterParams = []any{tx.Paymaster, tx.PaymasterInput}
+	}
+
+	fields := []any{
+		numberToHexRlp(tx.Nonce),
+		bigIntToHexRlp(tx.MaxPriorityFeePerGas),
+		bigIntToHexRlp(tx.MaxFeePerGas),
+		bigIntToHexRlp(tx.Gas),
+		addressOrEmpty(tx.To),
+		bigIntToHexRlp(tx.Value),
+		dataOrEmpty(tx.Data),
+		numberToHexRlp(tx.ChainId),
+		"0x",
+		"0x",
+		numberToHexRlp(tx.ChainId),
+		addressOrEmpty(tx.From),
+		bigIntToHexRlp(tx.GasPerPubdata),
+		stringSliceToAny(tx.FactoryDeps),
+		dataOrEmpty(customSignature),
+		paymasterParams,
+	}
+
+	rlpEncoded, err := encoding.RlpEncodeToHex(fields)
+	if err != nil {
+		return "", err
+	}
+
+	return "0x71" + strings.TrimPrefix(rlpEncoded, "0x"), nil
+}
+
+// serializeCustomSignature encodes a signature as the 65-byte r || s || v
+// (v = 27 + yParity) used by zkSync EIP-712 transactions.
+func serializeCustomSignature(sig *Signature) string {
+	yParity := sig.YParity
+	if sig.V != nil {
+		switch v := sig.V.Int64(); v {
+		case 0, 1:
+			yParity = int(v)
+		case 27, 28:
+			yParity = int(v - 27)
+		}
+	}
+	return padHex(sig.R, 32) + strings.TrimPrefix(padHex(sig.S, 32), "0x") + fmt.Sprintf("%02x", 27+yParity)
+}
+
 func serializeTransactionEIP7702(tx *Transaction, signature *Signature) (string, error) {
 	if err := AssertTransactionEIP7702(tx); err != nil {
 		return "", err
@@ -111,18 +179,34 @@ func serializeTransactionEIP4844(tx *Transaction, signature *Signature) (string,
 	var rlpEncoded string
 	var err2 error
 
-	// If sidecars are present, use wrapper format
+	// If sidecars are present, use wrapper format:
+	// version 0: [tx, blobs, commitments, proofs]
+	// version 1 (EIP-7594): [tx, 0x01, blobs, commitments, cellProofs]
 	if len(tx.Sidecars) > 0 {
 		blobs := make([]any, len(tx.Sidecars))
 		commitments := make([]any, len(tx.Sidecars))
-		proofs := make([]any, len(tx.Sidecars))
+		proofs := make([]any, 0, len(tx.Sidecars))
+		cellProofs := len(tx.Sidecars[0].CellProofs) > 0
 		for i, sidecar := range tx.Sidecars {
+			// The wrapper version applies to every sidecar.
+			if (len(sidecar.CellProofs) > 0) != cellProofs {
+				return "", fmt.Errorf("%w: sidecar %d differs from sidecar 0", ErrMixedSidecarProofs, i)
+			}
 			blobs[i] = sidecar.Blob
 			commitments[i] = sidecar.Commitment
-			proofs[i] = sidecar.Proof
+			if cellProofs {
+				proofs = append(proofs, stringSliceToAny(sidecar.CellProofs)...)
+			} else {
+				proofs = append(proofs, sidecar.Proof)
+			}
 		}
 
-		wrapper := []any{fields, blobs, commitments, proofs}
+		var wrapper []any
+		if cellProofs {
+			wrapper = []any{fields, "0x01", blobs, commitments, proofs}
+		} else {
+			wrapper = []any{fields, blobs, commitments, proofs}
+		}
 		rlpEncoded, err2 = encoding.RlpEncodeToHex(wrapper)
 	} else {
 		rlpEncoded, err2 = encoding.RlpEncodeToHex(fields)
@@ -1,11 +1,15 @@
 package test
 
 import (
+	"fmt"
 	"math/big"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/ChefBingbong/viem-go/utils/encoding"
+	"github.com/ChefBingbong/viem-go/utils/kzg"
 	"github.com/ChefBingbong/viem-go/utils/transaction"
 )
 
@@ -71,6 +75,16 @@ var _ = Describe("Transaction", func() {
 			Expect(txType).To(Equal(transaction.TransactionTypeEIP7702))
 		})
 
+		It("should detect zkSync EIP-712 transaction", func() {
+			tx := &transaction.Transaction{
+				MaxFeePerGas:  big.NewInt(1000000000),
+				GasPerPubdata: big.NewInt(50000),
+			}
+			txType, err := transaction.GetTransactionType(tx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(txType).To(Equal(transaction.TransactionTypeEIP712))
+		})
+
 		It("should use explicit type if set", func() {
 			tx := &transaction.Transaction{
 				Type:     transaction.TransactionTypeEIP1559,
@@ -107,6 +121,12 @@ var _ = Describe("Transaction", func() {
 			Expect(txType).To(Equal(transaction.TransactionTypeEIP7702))
 		})
 
+		It("should detect zkSync EIP-712 from serialized", func() {
+			txType, err := transaction.GetSerializedTransactionType("0x71f8...")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(txType).To(Equal(transaction.TransactionTypeEIP712))
+		})
+
 		It("should detect legacy from serialized", func() {
 			// Legacy starts with 0xc0-0xff (RLP list prefix)
 			txType, err := transaction.GetSerializedTransactionType("0xf8...")
@@ -219,6 +239,120 @@ var _ = Describe("Transaction", func() {
 			Expect(parsed.To).To(Equal("0x1234567890123456789012345678901234567890"))
 		})
 
+		It("should serialize and parse EIP-4844 transactions with version-1 sidecars", func() {
+			cellProofs := make([]string, kzg.CellProofsPerBlob)
+			for i := range cellProofs {
+				cellProofs[i] = fmt.Sprintf("0x%02x", i) + strings.Repeat("11", 47)
+			}
+			tx := &transaction.Transaction{
+				Type:                 transaction.TransactionTypeEIP4844,
+				ChainId:              1,
+				MaxPriorityFeePerGas: big.NewInt(1000000000),
+				MaxFeePerGas:         big.NewInt(2000000000),
+				MaxFeePerBlobGas:     big.NewInt(1),
+				Gas:                  big.NewInt(21000),
+				To:                   "0x1234567890123456789012345678901234567890",
+				BlobVersionedHashes:  []string{"0x01" + strings.Repeat("00", 31)},
+				Sidecars: []transaction.BlobSidecar{{
+					Blob:       "0x" + strings.Repeat("00", 32),
+					Commitment: "0x" + strings.Repeat("aa", 48),
+					CellProofs: cellProofs,
+				}},
+			}
+
+			serialized, err := transaction.SerializeTransaction(tx, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err := transaction.ParseTransaction(serialized)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Sidecars).To(HaveLen(1))
+			Expect(parsed.Sidecars[0].Proof).To(BeEmpty())
+			Expect(parsed.Sidecars[0].CellProofs).To(Equal(cellProofs))
+
+			tx.Sidecars[0].CellProofs = nil
+			tx.Sidecars[0].Proof = cellProofs[0]
+			serialized, err = transaction.SerializeTransaction(tx, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err = transaction.ParseTransaction(serialized)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Sidecars[0].Proof).To(Equal(cellProofs[0]))
+			Expect(parsed.Sidecars[0].CellProofs).To(BeNil())
+		})
+
+		It("should reject sidecars that mix KZG proofs and cell proofs", func() {
+			cellProofs := make([]string, kzg.CellProofsPerBlob)
+			for i := range cellProofs {
+				cellProofs[i] = "0x" + strings.Repeat("11", 48)
+			}
+			sidecar := transaction.BlobSidecar{
+				Blob:       "0x" + strings.Repeat("00", 32),
+				Commitment: "0x" + strings.Repeat("aa", 48),
+				CellProofs: cellProofs,
+			}
+			legacy := sidecar
+			legacy.CellProofs = nil
+			legacy.Proof = cellProofs[0]
+
+			for _, sidecars := range [][]transaction.BlobSidecar{{sidecar, legacy}, {legacy, sidecar}} {
+				_, err := transaction.SerializeTransaction(&transaction.Transaction{
+					Type:                 transaction.TransactionTypeEIP4844,
+					ChainId:              1,
+					MaxPriorityFeePerGas: big.NewInt(1000000000),
+					MaxFeePerGas:         big.NewInt(2000000000),
+					MaxFeePerBlobGas:     big.NewInt(1),
+					Gas:                  big.NewInt(21000),
+					To:                   "0x1234567890123456789012345678901234567890",
+					BlobVersionedHashes:  []string{"0x01" + strings.Repeat("00", 31), "0x01" + strings.Repeat("00", 31)},
+					Sidecars:             sidecars,
+				}, nil)
+				Expect(err).To(MatchError(transaction.ErrMixedSidecarProofs))
+			}
+		})
+
+		It("should reject version-1 sidecars without exactly one cell proof per cell", func() {
+			blob := "0x" + strings.Repeat("00", 32)
+			commitment := "0x" + strings.Repeat("aa", 48)
+			proof := "0x" + strings.Repeat("11", 48)
+			unsigned, err := transaction.SerializeTransaction(&transaction.Transaction{
+				Type:                 transaction.TransactionTypeEIP4844,
+				ChainId:              1,
+				MaxPriorityFeePerGas: big.NewInt(1000000000),
+				MaxFeePerGas:         big.NewInt(2000000000),
+				MaxFeePerBlobGas:     big.NewInt(1),
+				Gas:                  big.NewInt(21000),
+				To:                   "0x1234567890123456789012345678901234567890",
+				BlobVersionedHashes:  []string{"0x01" + strings.Repeat("00", 31)},
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			txFields, err := encoding.RlpDecodeHex("0x" + unsigned[4:])
+			Expect(err).NotTo(HaveOccurred())
+
+			wrap := func(blobs []any, proofCount int) string {
+				proofs := make([]any, proofCount)
+				for i := range proofs {
+					proofs[i] = proof
+				}
+				commitments := make([]any, len(blobs))
+				for i := range commitments {
+					commitments[i] = commitment
+				}
+				encoded, err := encoding.RlpEncodeToHex([]any{txFields, "0x01", blobs, commitments, proofs})
+				Expect(err).NotTo(HaveOccurred())
+				return "0x03" + strings.TrimPrefix(encoded, "0x")
+			}
+
+			_, err = transaction.ParseTransaction(wrap([]any{blob}, kzg.CellProofsPerBlob))
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, count := range []int{1, 64, 2 * kzg.CellProofsPerBlob} {
+				_, err = transaction.ParseTransaction(wrap([]any{blob}, count))
+				Expect(err).To(MatchError(transaction.ErrInvalidSerializedTransaction), "%d proofs", count)
+			}
+			_, err = transaction.ParseTransaction(wrap([]any{}, 1))
+			Expect(err).To(MatchError(transaction.ErrInvalidSerializedTransaction))
+		})
+
 		It("should serialize and parse legacy transaction", func() {
 			tx := &transaction.Transaction{
 				Type:     transaction.TransactionTypeLegacy,
@@ -240,5 +374,65 @@ var _ = Describe("Transaction", func() {
 			Expect(parsed.Nonce).To(Equal(0))
 			Expect(parsed.To).To(Equal("0x1234567890123456789012345678901234567890"))
 		})
+
+		It("should serialize and parse zkSync EIP-712 transaction", func() {
+			tx := &transaction.Transaction{
+				Type:                 transaction.TransactionTypeEIP712,
+				ChainId:              324,
+				Nonce:                5,
+				MaxPriorityFeePerGas: big.NewInt(0),
+				MaxFeePerGas:         big.NewInt(250000000),
+				Gas:                  big.NewInt(158774),
+				To:                   "0x1234567890123456789012345678901234567890",
+				Value:                big.NewInt(1000),
+				Data:                 "0x01",
+				From:                 "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+				GasPerPubdata:        big.NewInt(50000),
+				FactoryDeps:          []string{"0x" + strings.Repeat("ab", 32)},
+				Paymaster:            "0x4b5df730c2e6b28e17013a1485e5d9bc41efe021",
+				PaymasterInput:       "0x8c5a344500000000000000000000000000000000000000000000000000000000000000200000000000000000000000000000000000000000000000000000000000000000",
+			}
+			signature := &transaction.Signature{
+				R:       "0x" + strings.Repeat("11", 32),
+				S:       "0x" + strings.Repeat("22", 32),
+				YParity: 1,
+			}
+
+			serialized, err := transaction.SerializeTransaction(tx, signature)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serialized).To(HavePrefix("0x71"))
+
+			parsed, err := transaction.ParseTransaction(serialized)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Type).To(Equal(transaction.TransactionTypeEIP712))
+			Expect(parsed.ChainId).To(Equal(324))
+			Expect(parsed.Nonce).To(Equal(5))
+			Expect(parsed.MaxFeePerGas).To(Equal(big.NewInt(250000000)))
+			Expect(parsed.Gas).To(Equal(big.NewInt(158774)))
+			Expect(parsed.To).To(Equal(tx.To))
+			Expect(parsed.Value).To(Equal(big.NewInt(1000)))
+			Expect(parsed.Data).To(Equal("0x01"))
+			Expect(parsed.From).To(Equal(tx.From))
+			Expect(parsed.GasPerPubdata).To(Equal(big.NewInt(50000)))
+			Expect(parsed.FactoryDeps).To(Equal(tx.FactoryDeps))
+			Expect(parsed.Paymaster).To(Equal(tx.Paymaster))
+			Expect(parsed.PaymasterInput).To(Equal(tx.PaymasterInput))
+			Expect(parsed.CustomSignature).To(Equal(signature.R + strings.Repeat("22", 32) + "1c"))
+
+			reserialized, err := transaction.SerializeTransaction(parsed, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reserialized).To(Equal(serialized))
+		})
+
+		It("should require paymaster and paymasterInput together", func() {
+			tx := &transaction.Transaction{
+				Type:          transaction.TransactionTypeEIP712,
+				ChainId:       324,
+				GasPerPubdata: big.NewInt(50000),
+				Paymaster:     "0x4b5df730c2e6b28e17013a1485e5d9bc41efe021",
+			}
+			_, err := transaction.SerializeTransaction(tx, nil)
+			Expect(err).To(MatchError(transaction.ErrInvalidPaymasterParams))
+		})
 	})
 })
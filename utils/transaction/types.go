@@ -14,6 +14,8 @@ const (
 	TransactionTypeEIP1559 TransactionType = "eip1559"
 	TransactionTypeEIP4844 TransactionType = "eip4844"
 	TransactionTypeEIP7702 TransactionType = "eip7702"
+	// TransactionTypeEIP712 is the zkSync Era EIP-712 transaction (type 0x71).
+	TransactionTypeEIP712 TransactionType = "eip712"
 )
 
 // Common errors
@@ -31,6 +33,8 @@ var (
 	ErrInvalidVersionedHashSize         = errors.New("invalid versioned hash size")
 	ErrInvalidVersionedHashVersion      = errors.New("invalid versioned hash version")
 	ErrMaxFeePerGasNotAllowed           = errors.New("maxFeePerGas/maxPriorityFeePerGas is not allowed for this transaction type")
+	ErrInvalidPaymasterParams           = errors.New("paymaster and paymasterInput must be set together")
+	ErrMixedSidecarProofs               = errors.New("blob sidecars mix KZG proofs and cell proofs")
 )
 
 // MaxUint256 is 2^256 - 1
@@ -157,18 +161,34 @@ type Transaction struct {
 	// EIP-7702 fields
 	AuthorizationList []SignedAuthorization `json:"authorizationList,omitempty"`
 
+	// zkSync EIP-712 fields. The transaction is signed over EIP-712 typed
+	// data rather than its serialization; the signature is carried in
+	// CustomSignature.
+	From            string   `json:"from,omitempty"`
+	GasPerPubdata   *big.Int `json:"gasPerPubdata,omitempty"`
+	FactoryDeps     []string `json:"factoryDeps,omitempty"`
+	CustomSignature string   `json:"customSignature,omitempty"`
+	Paymaster       string   `json:"paymaster,omitempty"`
+	PaymasterInput  string   `json:"paymasterInput,omitempty"`
+
 	// Signature fields
 	R       string   `json:"r,omitempty"`
 	S       string   `json:"s,omitempty"`
 	V       *big.Int `json:"v,omitempty"`
 	YParity int      `json:"yParity,omitempty"`
+
+	// ChainFields holds chain-specific fields (e.g. of L2 transaction types)
+	// for chain serializers. It is ignored by SerializeTransaction.
+	ChainFields any `json:"-"`
 }
 
-// BlobSidecar represents a blob sidecar (EIP-4844).
+// BlobSidecar represents a blob sidecar (EIP-4844). Version-1 sidecars
+// (EIP-7594) set CellProofs instead of Proof.
 type BlobSidecar struct {
-	Blob       string `json:"blob"`
-	Commitment string `json:"commitment"`
-	Proof      string `json:"proof"`
+	Blob       string   `json:"blob"`
+	Commitment string   `json:"commitment"`
+	Proof      string   `json:"proof"`
+	CellProofs []string `json:"cellProofs,omitempty"`
 }
 
 // HasSignature returns true if the transaction has signature fields.
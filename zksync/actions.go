@@ -0,0 +1,213 @@
+package zksync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+)
+
+// ErrLogProofNotFound is returned by GetL2ToL1LogProof when the transaction
+// has no such L2 -> L1 log, or its batch is not sealed yet.
+var ErrLogProofNotFound = errors.New("zksync: L2 to L1 log proof not found")
+
+// EstimateFeeParameters contains the parameters for the EstimateFee action.
+type EstimateFeeParameters struct {
+	// Account is the sender of the transaction.
+	Account *common.Address
+
+	// To is the recipient address. Nil for contract deployment.
+	To *common.Address
+
+	// Data is the calldata.
+	Data []byte
+
+	// Value is the amount of wei to send.
+	Value *big.Int
+
+	// GasPerPubdata is the gas per pubdata byte limit. Defaults to
+	// DefaultGasPerPubdata.
+	GasPerPubdata *big.Int
+
+	// FactoryDeps are the bytecodes of the contracts deployed by the
+	// transaction.
+	FactoryDeps [][]byte
+
+	// Paymaster and PaymasterInput are the paymaster paying the fees.
+	Paymaster      *common.Address
+	PaymasterInput []byte
+}
+
+// Fee is a fee estimate returned by zks_estimateFee.
+type Fee struct {
+	GasLimit             *big.Int
+	GasPerPubdataLimit   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+}
+
+// EstimateFee estimates the gas limit and fees of an EIP-712 transaction.
+//
+// JSON-RPC Method: zks_estimateFee
+//
+// Example:
+//
+//	fee, err := zksync.EstimateFee(ctx, zkClient, zksync.EstimateFeeParameters{
+//	    Account: &from,
+//	    To:      &to,
+//	    Value:   big.NewInt(1e18),
+//	})
+func EstimateFee(ctx context.Context, client public.Client, params EstimateFeeParameters) (*Fee, error) {
+	resp, err := client.Request(ctx, "zks_estimateFee", formatCallRequest(params))
+	if err != nil {
+		return nil, fmt.Errorf("zks_estimateFee failed: %w", err)
+	}
+
+	var dec struct {
+		GasLimit             *hexutil.Big `json:"gas_limit"`
+		GasPerPubdataLimit   *hexutil.Big `json:"gas_per_pubdata_limit"`
+		MaxFeePerGas         *hexutil.Big `json:"max_fee_per_gas"`
+		MaxPriorityFeePerGas *hexutil.Big `json:"max_priority_fee_per_gas"`
+	}
+	if err := json.Unmarshal(resp.Result, &dec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal fee: %w", err)
+	}
+	return &Fee{
+		GasLimit:             (*big.Int)(dec.GasLimit),
+		GasPerPubdataLimit:   (*big.Int)(dec.GasPerPubdataLimit),
+		MaxFeePerGas:         (*big.Int)(dec.MaxFeePerGas),
+		MaxPriorityFeePerGas: (*big.Int)(dec.MaxPriorityFeePerGas),
+	}, nil
+}
+
+// formatCallRequest formats an EIP-712 call request, with the ZKsync fields
+// under eip712Meta. Byte fields of eip712Meta are sent as byte arrays.
+func formatCallRequest(params EstimateFeeParameters) map[string]any {
+	gasPerPubdata := params.GasPerPubdata
+	if gasPerPubdata == nil {
+		gasPerPubdata = big.NewInt(DefaultGasPerPubdata)
+	}
+	meta := map[string]any{
+		"gasPerPubdata": (*hexutil.Big)(gasPerPubdata),
+	}
+	if len(params.FactoryDeps) > 0 {
+		deps := make([]string, len(params.FactoryDeps))
+		for i, dep := range params.FactoryDeps {
+			deps[i] = hexutil.Encode(dep)
+		}
+		meta["factoryDeps"] = deps
+	}
+	if params.Paymaster != nil {
+		meta["paymasterParams"] = map[string]any{
+			"paymaster":      params.Paymaster.Hex(),
+			"paymasterInput": byteArray(params.PaymasterInput),
+		}
+	}
+
+	request := map[string]any{
+		"type":       hexutil.EncodeUint64(EIP712TxType),
+		"data":       hexutil.Encode(params.Data),
+		"eip712Meta": meta,
+	}
+	if params.Account != nil {
+		request["from"] = params.Account.Hex()
+	}
+	if params.To != nil {
+		request["to"] = params.To.Hex()
+	}
+	if params.Value != nil {
+		request["value"] = (*hexutil.Big)(params.Value)
+	}
+	return request
+}
+
+// byteArray converts bytes to the number array the ZKsync API expects for
+// byte fields of eip712Meta.
+func byteArray(b []byte) []int {
+	out := make([]int, len(b))
+	for i, v := range b {
+		out[i] = int(v)
+	}
+	return out
+}
+
+// GetL2ToL1LogProofParameters contains the parameters for the
+// GetL2ToL1LogProof action.
+type GetL2ToL1LogProofParameters struct {
+	// TxHash is the L2 transaction that sent the log.
+	TxHash common.Hash
+
+	// Index is the index of the L2 -> L1 log in the transaction. Defaults to
+	// the first log.
+	Index *int
+}
+
+// L2ToL1LogProof is the Merkle proof of an L2 -> L1 log, used to finalize
+// withdrawals on L1.
+type L2ToL1LogProof struct {
+	// ID is the position of the log in the batch's log Merkle tree.
+	ID uint64
+	// Proof is the list of sibling hashes from the log to the root.
+	Proof []common.Hash
+	// Root is the root of the batch's log Merkle tree.
+	Root common.Hash
+}
+
+// GetL2ToL1LogProof returns the proof of an L2 -> L1 log.
+//
+// JSON-RPC Method: zks_getL2ToL1LogProof
+func GetL2ToL1LogProof(ctx context.Context, client public.Client, params GetL2ToL1LogProofParameters) (*L2ToL1LogProof, error) {
+	args := []any{params.TxHash.Hex()}
+	if params.Index != nil {
+		args = append(args, *params.Index)
+	}
+	resp, err := client.Request(ctx, "zks_getL2ToL1LogProof", args...)
+	if err != nil {
+		return nil, fmt.Errorf("zks_getL2ToL1LogProof failed: %w", err)
+	}
+	if resp.Result == nil || string(resp.Result) == "null" {
+		return nil, fmt.Errorf("%w: transaction %s", ErrLogProofNotFound, params.TxHash.Hex())
+	}
+
+	var dec struct {
+		ID    uint64        `json:"id"`
+		Proof []common.Hash `json:"proof"`
+		Root  common.Hash   `json:"root"`
+	}
+	if err := json.Unmarshal(resp.Result, &dec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal log proof: %w", err)
+	}
+	return &L2ToL1LogProof{ID: dec.ID, Proof: dec.Proof, Root: dec.Root}, nil
+}
+
+// BridgeContracts are the default bridges between ZKsync and L1.
+type BridgeContracts struct {
+	L1Erc20DefaultBridge  common.Address `json:"l1Erc20DefaultBridge"`
+	L2Erc20DefaultBridge  common.Address `json:"l2Erc20DefaultBridge"`
+	L1WethBridge          common.Address `json:"l1WethBridge"`
+	L2WethBridge          common.Address `json:"l2WethBridge"`
+	L1SharedDefaultBridge common.Address `json:"l1SharedDefaultBridge"`
+	L2SharedDefaultBridge common.Address `json:"l2SharedDefaultBridge"`
+}
+
+// GetBridgeContracts returns the addresses of the default bridges.
+//
+// JSON-RPC Method: zks_getBridgeContracts
+func GetBridgeContracts(ctx context.Context, client public.Client) (*BridgeContracts, error) {
+	resp, err := client.Request(ctx, "zks_getBridgeContracts")
+	if err != nil {
+		return nil, fmt.Errorf("zks_getBridgeContracts failed: %w", err)
+	}
+	var contracts BridgeContracts
+	if err := json.Unmarshal(resp.Result, &contracts); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal bridge contracts: %w", err)
+	}
+	return &contracts, nil
+}
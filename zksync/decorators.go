@@ -0,0 +1,51 @@
+package zksync
+
+import (
+	"context"
+
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// PublicActions returns the ZKsync public actions, bound to c, as a map for
+// client.Extend.
+//
+// Example:
+//
+//	zkClient := client.CreatePublicClient(config)
+//	zkClient.Extend("zksync", zksync.PublicActions(zkClient))
+func PublicActions(c *client.PublicClient) map[string]any {
+	return map[string]any{
+		"estimateFee": func(ctx context.Context, params EstimateFeeParameters) (*Fee, error) {
+			return EstimateFee(ctx, c, params)
+		},
+		"getL2ToL1LogProof": func(ctx context.Context, params GetL2ToL1LogProofParameters) (*L2ToL1LogProof, error) {
+			return GetL2ToL1LogProof(ctx, c, params)
+		},
+		"getBridgeContracts": func(ctx context.Context) (*BridgeContracts, error) {
+			return GetBridgeContracts(ctx, c)
+		},
+	}
+}
+
+// WalletActions returns the ZKsync wallet actions, bound to c, as a map for
+// client.Extend. signTransaction signs with the client's account.
+//
+// Example:
+//
+//	zkWallet := client.CreateWalletClient(config)
+//	zkWallet.Extend("zksync", zksync.WalletActions(zkWallet))
+func WalletActions(c *client.WalletClient) map[string]any {
+	return map[string]any{
+		"sendTransaction": func(ctx context.Context, params SendTransactionParameters) (string, error) {
+			return SendTransaction(ctx, c, params)
+		},
+		"signTransaction": func(tx *transaction.Transaction) (string, error) {
+			signer, err := typedDataSigner(c.Account())
+			if err != nil {
+				return "", err
+			}
+			return SignTransaction(signer, tx)
+		},
+	}
+}
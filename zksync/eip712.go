@@ -0,0 +1,226 @@
+// Package zksync adds support for ZKsync Era (ZKsync and ZKsync Sepolia):
+//
+//   - EIP-712 transactions (type 0x71), signed over typed data with the
+//     account's typed-data signer (SignTransaction, SendTransaction); they
+//     are serialized and parsed by utils/transaction
+//   - general and approval-based paymaster inputs
+//     (GetGeneralPaymasterInput, GetApprovalBasedPaymasterInput)
+//   - zks_* RPC actions (EstimateFee, GetL2ToL1LogProof, GetBridgeContracts)
+//
+// EIP-712 transactions are not signed over their serialization, so they are
+// sent with SendTransaction rather than wallet.SendTransaction.
+// PublicActions and WalletActions bind the actions to a client for use with
+// client.Extend, like the decorators package.
+//
+// Example:
+//
+//	input, err := zksync.GetGeneralPaymasterInput(nil)
+//	hash, err := zksync.SendTransaction(ctx, zkWallet, zksync.SendTransactionParameters{
+//		To:             &to,
+//		Value:          big.NewInt(1e18),
+//		Paymaster:      &paymaster,
+//		PaymasterInput: input,
+//	})
+package zksync
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/utils/signature"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// EIP712TxType is the transaction type of ZKsync EIP-712 transactions.
+const EIP712TxType = 0x71
+
+// DefaultGasPerPubdata is the default limit of gas per byte of pubdata
+// (L1 data) of a transaction.
+const DefaultGasPerPubdata = 50_000
+
+var (
+	// ErrInvalidBytecode is returned when a bytecode cannot be hashed: its
+	// length must be an odd number of 32-byte words, below 2^16.
+	ErrInvalidBytecode = errors.New("zksync: invalid bytecode")
+	// ErrNotEIP712Transaction is returned when signing a transaction that is
+	// not an EIP-712 transaction.
+	ErrNotEIP712Transaction = errors.New("zksync: not an EIP-712 transaction")
+	// ErrSenderMismatch is returned when signing a transaction whose From is
+	// not the signing account.
+	ErrSenderMismatch = errors.New("zksync: transaction sender is not the signing account")
+)
+
+// HashBytecode returns the ZKsync hash of a contract bytecode, which
+// identifies it as a factory dependency: a version byte (1), a zero byte,
+// the length in 32-byte words and the last 28 bytes of sha256(bytecode).
+func HashBytecode(bytecode []byte) (common.Hash, error) {
+	if len(bytecode)%32 != 0 {
+		return common.Hash{}, fmt.Errorf("%w: length %d is not a multiple of 32 bytes", ErrInvalidBytecode, len(bytecode))
+	}
+	words := len(bytecode) / 32
+	if words >= 1<<16 {
+		return common.Hash{}, fmt.Errorf("%w: length of %d words is too long", ErrInvalidBytecode, words)
+	}
+	if words%2 == 0 {
+		return common.Hash{}, fmt.Errorf("%w: length of %d words is even", ErrInvalidBytecode, words)
+	}
+
+	hash := common.Hash(sha256.Sum256(bytecode))
+	hash[0] = 1
+	hash[1] = 0
+	binary.BigEndian.PutUint16(hash[2:4], uint16(words))
+	return hash, nil
+}
+
+// GetEip712Domain returns the EIP-712 domain of ZKsync transactions on a
+// chain.
+func GetEip712Domain(chainID int) signature.TypedDataDomain {
+	return signature.TypedDataDomain{
+		Name:    "zkSync",
+		Version: "2",
+		ChainId: big.NewInt(int64(chainID)),
+	}
+}
+
+// eip712Types are the EIP-712 types of ZKsync transactions.
+var eip712Types = map[string][]signature.TypedDataField{
+	"Transaction": {
+		{Name: "txType", Type: "uint256"},
+		{Name: "from", Type: "uint256"},
+		{Name: "to", Type: "uint256"},
+		{Name: "gasLimit", Type: "uint256"},
+		{Name: "gasPerPubdataByteLimit", Type: "uint256"},
+		{Name: "maxFeePerGas", Type: "uint256"},
+		{Name: "maxPriorityFeePerGas", Type: "uint256"},
+		{Name: "paymaster", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "value", Type: "uint256"},
+		{Name: "data", Type: "bytes"},
+		{Name: "factoryDeps", Type: "bytes32[]"},
+		{Name: "paymasterInput", Type: "bytes"},
+	},
+}
+
+// GetEip712TypedData returns the EIP-712 typed data an EIP-712 transaction
+// is signed over. Factory dependencies are included by bytecode hash.
+func GetEip712TypedData(tx *transaction.Transaction) (signature.TypedDataDefinition, error) {
+	if tx.From == "" {
+		return signature.TypedDataDefinition{}, fmt.Errorf("%w: from is required", transaction.ErrInvalidAddress)
+	}
+
+	data, err := transaction.HexToBytes(tx.Data)
+	if err != nil {
+		return signature.TypedDataDefinition{}, fmt.Errorf("invalid data: %w", err)
+	}
+	paymasterInput, err := transaction.HexToBytes(tx.PaymasterInput)
+	if err != nil {
+		return signature.TypedDataDefinition{}, fmt.Errorf("invalid paymaster input: %w", err)
+	}
+	factoryDeps := make([]any, len(tx.FactoryDeps))
+	for i, dep := range tx.FactoryDeps {
+		bytecode, err := transaction.HexToBytes(dep)
+		if err != nil {
+			return signature.TypedDataDefinition{}, fmt.Errorf("invalid factory dep %d: %w", i, err)
+		}
+		hash, err := HashBytecode(bytecode)
+		if err != nil {
+			return signature.TypedDataDefinition{}, err
+		}
+		factoryDeps[i] = hash.Bytes()
+	}
+
+	gasPerPubdata := tx.GasPerPubdata
+	if gasPerPubdata == nil {
+		gasPerPubdata = big.NewInt(DefaultGasPerPubdata)
+	}
+
+	return signature.TypedDataDefinition{
+		Domain:      GetEip712Domain(tx.ChainId),
+		Types:       eip712Types,
+		PrimaryType: "Transaction",
+		Message: map[string]any{
+			"txType":                 big.NewInt(EIP712TxType),
+			"from":                   addressToBig(tx.From),
+			"to":                     addressToBig(tx.To),
+			"gasLimit":               bigOrZero(tx.Gas),
+			"gasPerPubdataByteLimit": gasPerPubdata,
+			"maxFeePerGas":           bigOrZero(tx.MaxFeePerGas),
+			"maxPriorityFeePerGas":   bigOrZero(tx.MaxPriorityFeePerGas),
+			"paymaster":              addressToBig(tx.Paymaster),
+			"nonce":                  big.NewInt(int64(tx.Nonce)),
+			"value":                  bigOrZero(tx.Value),
+			"data":                   data,
+			"factoryDeps":            factoryDeps,
+			"paymasterInput":         paymasterInput,
+		},
+	}, nil
+}
+
+// TypedDataSigner is an account that signs EIP-712 typed data, such as
+// accounts.LocalAccount.
+type TypedDataSigner interface {
+	Address() common.Address
+	SignTypedData(data signature.TypedDataDefinition) (string, error)
+}
+
+// SignTransaction signs an EIP-712 transaction with the account's
+// typed-data signer and returns it serialized, with the signature as its
+// customSignature. From defaults to the account address, and must be it when
+// set. GasPerPubdata defaults to DefaultGasPerPubdata.
+//
+// Example:
+//
+//	account, _ := accounts.PrivateKeyToAccount(privateKey)
+//	signed, err := zksync.SignTransaction(account, &transaction.Transaction{
+//		Type:          transaction.TransactionTypeEIP712,
+//		ChainId:       324,
+//		To:            "0x...",
+//		Gas:           big.NewInt(200_000),
+//		MaxFeePerGas:  big.NewInt(25_000_000),
+//		GasPerPubdata: big.NewInt(zksync.DefaultGasPerPubdata),
+//	})
+func SignTransaction(account TypedDataSigner, tx *transaction.Transaction) (string, error) {
+	if txType, _ := transaction.GetTransactionType(tx); txType != transaction.TransactionTypeEIP712 {
+		return "", fmt.Errorf("%w: type %q", ErrNotEIP712Transaction, txType)
+	}
+	signable := *tx
+	signable.Type = transaction.TransactionTypeEIP712
+	if signable.From == "" {
+		signable.From = account.Address().Hex()
+	} else if common.HexToAddress(signable.From) != account.Address() {
+		return "", fmt.Errorf("%w: from %s, account %s", ErrSenderMismatch, signable.From, account.Address().Hex())
+	}
+	if signable.GasPerPubdata == nil {
+		signable.GasPerPubdata = big.NewInt(DefaultGasPerPubdata)
+	}
+
+	typedData, err := GetEip712TypedData(&signable)
+	if err != nil {
+		return "", err
+	}
+	customSignature, err := account.SignTypedData(typedData)
+	if err != nil {
+		return "", err
+	}
+	signable.CustomSignature = customSignature
+	return transaction.SerializeTransaction(&signable, nil)
+}
+
+func addressToBig(address string) *big.Int {
+	if address == "" {
+		return new(big.Int)
+	}
+	return new(big.Int).SetBytes(common.HexToAddress(address).Bytes())
+}
+
+func bigOrZero(n *big.Int) *big.Int {
+	if n == nil {
+		return new(big.Int)
+	}
+	return n
+}
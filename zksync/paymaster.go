@@ -0,0 +1,39 @@
+package zksync
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+)
+
+// paymasterFlowABI is the IPaymasterFlow interface paymaster inputs are
+// encoded with.
+var paymasterFlowABI = abi.MustParseAbi([]string{
+	"function general(bytes input)",
+	"function approvalBased(address _token, uint256 _minAllowance, bytes _innerInput)",
+})
+
+// GetGeneralPaymasterInput returns the paymaster input of the general
+// paymaster flow, for paymasters that need no token allowance (e.g. ones
+// sponsoring transactions). innerInput is passed on to the paymaster and may
+// be nil.
+func GetGeneralPaymasterInput(innerInput []byte) ([]byte, error) {
+	if innerInput == nil {
+		innerInput = []byte{}
+	}
+	return paymasterFlowABI.EncodeFunctionData("general", innerInput)
+}
+
+// GetApprovalBasedPaymasterInput returns the paymaster input of the
+// approval-based paymaster flow, in which fees are paid in token: the
+// bootloader approves minAllowance of token to the paymaster before the
+// transaction is validated. innerInput is passed on to the paymaster and may
+// be nil.
+func GetApprovalBasedPaymasterInput(token common.Address, minAllowance *big.Int, innerInput []byte) ([]byte, error) {
+	if innerInput == nil {
+		innerInput = []byte{}
+	}
+	return paymasterFlowABI.EncodeFunctionData("approvalBased", token, bigOrZero(minAllowance), innerInput)
+}
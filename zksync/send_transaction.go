@@ -0,0 +1,176 @@
+package zksync
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// SendTransactionParameters contains the parameters for the SendTransaction
+// action.
+type SendTransactionParameters struct {
+	// Account is the account to send from. If nil, uses the client's account.
+	// It must sign typed data locally (see TypedDataSigner).
+	Account wallet.Account
+
+	// To is the recipient address. Nil for contract deployment.
+	To *common.Address
+
+	// Value is the amount of wei to send.
+	Value *big.Int
+
+	// Data is the calldata.
+	Data []byte
+
+	// GasPerPubdata is the gas per pubdata byte limit. Defaults to
+	// DefaultGasPerPubdata.
+	GasPerPubdata *big.Int
+
+	// FactoryDeps are the bytecodes of the contracts deployed by the
+	// transaction.
+	FactoryDeps [][]byte
+
+	// Paymaster and PaymasterInput are the paymaster paying the fees, see
+	// GetGeneralPaymasterInput and GetApprovalBasedPaymasterInput.
+	Paymaster      *common.Address
+	PaymasterInput []byte
+
+	// Chain optionally overrides the client's chain for chain ID validation.
+	Chain *chain.Chain
+
+	// Gas and fee fields. Missing ones are estimated with EstimateFee.
+	Gas                  *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Nonce                *int
+}
+
+// SendTransaction prepares an EIP-712 transaction, signs it with the
+// account's typed-data signer and sends it, returning the transaction hash.
+//
+// Example:
+//
+//	input, err := zksync.GetApprovalBasedPaymasterInput(token, big.NewInt(1), nil)
+//	hash, err := zksync.SendTransaction(ctx, zkWallet, zksync.SendTransactionParameters{
+//	    To:             &to,
+//	    Data:           calldata,
+//	    Paymaster:      &paymaster,
+//	    PaymasterInput: input,
+//	})
+func SendTransaction(ctx context.Context, client wallet.Client, params SendTransactionParameters) (string, error) {
+	account := params.Account
+	if account == nil {
+		account = client.Account()
+	}
+	signer, err := typedDataSigner(account)
+	if err != nil {
+		return "", err
+	}
+
+	chainID, err := public.GetChainID(ctx, client)
+	if err != nil {
+		return "", fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	ch := params.Chain
+	if ch == nil {
+		ch = client.Chain()
+	}
+	if ch != nil {
+		if err := chain.AssertCurrentChain(ch, int64(chainID)); err != nil {
+			return "", err
+		}
+	}
+
+	tx := &transaction.Transaction{
+		Type:                 transaction.TransactionTypeEIP712,
+		ChainId:              int(chainID),
+		From:                 account.Address().Hex(),
+		Value:                params.Value,
+		Data:                 hexutil.Encode(params.Data),
+		Gas:                  params.Gas,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		GasPerPubdata:        params.GasPerPubdata,
+	}
+	if params.To != nil {
+		tx.To = params.To.Hex()
+	}
+	for _, dep := range params.FactoryDeps {
+		tx.FactoryDeps = append(tx.FactoryDeps, hexutil.Encode(dep))
+	}
+	if params.Paymaster != nil {
+		tx.Paymaster = params.Paymaster.Hex()
+		tx.PaymasterInput = hexutil.Encode(params.PaymasterInput)
+	}
+
+	if params.Nonce != nil {
+		tx.Nonce = *params.Nonce
+	} else {
+		nonce, err := public.GetTransactionCount(ctx, client, public.GetTransactionCountParameters{
+			Address:  account.Address(),
+			BlockTag: public.BlockTagPending,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to get nonce: %w", err)
+		}
+		tx.Nonce = int(nonce)
+	}
+
+	if tx.Gas == nil || tx.MaxFeePerGas == nil || tx.MaxPriorityFeePerGas == nil {
+		from := account.Address()
+		fee, err := EstimateFee(ctx, client, EstimateFeeParameters{
+			Account:        &from,
+			To:             params.To,
+			Data:           params.Data,
+			Value:          params.Value,
+			GasPerPubdata:  params.GasPerPubdata,
+			FactoryDeps:    params.FactoryDeps,
+			Paymaster:      params.Paymaster,
+			PaymasterInput: params.PaymasterInput,
+		})
+		if err != nil {
+			return "", err
+		}
+		if tx.Gas == nil {
+			tx.Gas = fee.GasLimit
+		}
+		if tx.MaxFeePerGas == nil {
+			tx.MaxFeePerGas = fee.MaxFeePerGas
+		}
+		if tx.MaxPriorityFeePerGas == nil {
+			tx.MaxPriorityFeePerGas = fee.MaxPriorityFeePerGas
+		}
+		if tx.GasPerPubdata == nil {
+			tx.GasPerPubdata = fee.GasPerPubdataLimit
+		}
+	}
+
+	signed, err := SignTransaction(signer, tx)
+	if err != nil {
+		return "", err
+	}
+	return wallet.SendRawTransaction(ctx, client, wallet.SendRawTransactionParameters{SerializedTransaction: signed})
+}
+
+// typedDataSigner returns account as a TypedDataSigner.
+func typedDataSigner(account wallet.Account) (TypedDataSigner, error) {
+	if account == nil {
+		return nil, &wallet.AccountNotFoundError{DocsPath: "/zksync/actions/sendTransaction"}
+	}
+	signer, ok := account.(TypedDataSigner)
+	if !ok {
+		return nil, &wallet.AccountTypeNotSupportedError{
+			DocsPath:     "/zksync/actions/sendTransaction",
+			MetaMessages: []string{"EIP-712 transactions are signed with the account's typed-data signer, which this account does not have."},
+		}
+	}
+	return signer, nil
+}
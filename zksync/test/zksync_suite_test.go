@@ -0,0 +1,13 @@
+package zksync_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestZksync(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ZKsync Suite")
+}
@@ -0,0 +1,401 @@
+package zksync_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	json "github.com/goccy/go-json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/accounts"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/internal/rpctest"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+	"github.com/ChefBingbong/viem-go/zksync"
+)
+
+const testPrivateKey = "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcab78f4c6f2c5ff80"
+
+var (
+	targetAddr    = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	paymasterAddr = common.HexToAddress("0x4B5DF730c2e6b28E17013A1485E5d9BC41Efe021")
+	tokenAddr     = common.HexToAddress("0x3333333333333333333333333333333333333333")
+)
+
+// newFakeNode starts a JSON-RPC node answering the zks_* methods.
+func newFakeNode(chainID uint64) *rpctest.Node {
+	n := rpctest.NewNode(chainID)
+	n.Result("eth_getTransactionCount", "0x7")
+	n.Result("zks_estimateFee", map[string]any{
+		"gas_limit":                "0x26c36",
+		"gas_per_pubdata_limit":    "0xc350",
+		"max_fee_per_gas":          "0xee6b280",
+		"max_priority_fee_per_gas": "0x0",
+	})
+	n.Result("zks_getBridgeContracts", map[string]any{
+		"l1Erc20DefaultBridge":  "0x57891966931eb4bb6fb81430e6ce0a03aabde063",
+		"l2Erc20DefaultBridge":  "0x11f943b2c77b743ab90f4a0ae7d5a4e7fca3e102",
+		"l1WethBridge":          "0x0000000000000000000000000000000000000000",
+		"l2WethBridge":          "0x0000000000000000000000000000000000000000",
+		"l1SharedDefaultBridge": "0xd7f9f54194c633f36ccd5f3da84ad4a1c38cb2cb",
+		"l2SharedDefaultBridge": "0x11f943b2c77b743ab90f4a0ae7d5a4e7fca3e102",
+	})
+	n.Result("zks_getL2ToL1LogProof", map[string]any{
+		"id":    3,
+		"proof": []string{common.HexToHash("0x01").Hex(), common.HexToHash("0x02").Hex()},
+		"root":  common.HexToHash("0xabc").Hex(),
+	})
+	return n
+}
+
+// recoverSigner recovers the signer of an EIP-712 transaction, hashing its
+// typed data independently with go-ethereum.
+func recoverSigner(tx *transaction.Transaction) common.Address {
+	factoryDeps := make([]any, len(tx.FactoryDeps))
+	for i, dep := range tx.FactoryDeps {
+		hash, err := zksync.HashBytecode(common.FromHex(dep))
+		Expect(err).NotTo(HaveOccurred())
+		factoryDeps[i] = hash.Hex()
+	}
+	addressNumber := func(address string) *math.HexOrDecimal256 {
+		return (*math.HexOrDecimal256)(new(big.Int).SetBytes(common.FromHex(address)))
+	}
+	number := func(n *big.Int) *math.HexOrDecimal256 {
+		if n == nil {
+			n = new(big.Int)
+		}
+		return (*math.HexOrDecimal256)(n)
+	}
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"Transaction": {
+				{Name: "txType", Type: "uint256"},
+				{Name: "from", Type: "uint256"},
+				{Name: "to", Type: "uint256"},
+				{Name: "gasLimit", Type: "uint256"},
+				{Name: "gasPerPubdataByteLimit", Type: "uint256"},
+				{Name: "maxFeePerGas", Type: "uint256"},
+				{Name: "maxPriorityFeePerGas", Type: "uint256"},
+				{Name: "paymaster", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "factoryDeps", Type: "bytes32[]"},
+				{Name: "paymasterInput", Type: "bytes"},
+			},
+		},
+		PrimaryType: "Transaction",
+		Domain: apitypes.TypedDataDomain{
+			Name:    "zkSync",
+			Version: "2",
+			ChainId: (*math.HexOrDecimal256)(big.NewInt(int64(tx.ChainId))),
+		},
+		Message: apitypes.TypedDataMessage{
+			"txType":                 number(big.NewInt(0x71)),
+			"from":                   addressNumber(tx.From),
+			"to":                     addressNumber(tx.To),
+			"gasLimit":               number(tx.Gas),
+			"gasPerPubdataByteLimit": number(tx.GasPerPubdata),
+			"maxFeePerGas":           number(tx.MaxFeePerGas),
+			"maxPriorityFeePerGas":   number(tx.MaxPriorityFeePerGas),
+			"paymaster":              addressNumber(tx.Paymaster),
+			"nonce":                  number(big.NewInt(int64(tx.Nonce))),
+			"value":                  number(tx.Value),
+			"data":                   hexutil.Bytes(common.FromHex(tx.Data)),
+			"factoryDeps":            factoryDeps,
+			"paymasterInput":         hexutil.Bytes(common.FromHex(tx.PaymasterInput)),
+		},
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	Expect(err).NotTo(HaveOccurred())
+
+	sig := common.FromHex(tx.CustomSignature)
+	Expect(sig).To(HaveLen(65))
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(hash, sig)
+	Expect(err).NotTo(HaveOccurred())
+	return crypto.PubkeyToAddress(*pub)
+}
+
+var _ = Describe("ZKsync", func() {
+	var (
+		ctx     context.Context
+		account *accounts.PrivateKeyAccount
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		account, err = accounts.PrivateKeyToAccount(testPrivateKey)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("HashBytecode", func() {
+		It("hashes bytecode with its version and length", func() {
+			bytecode := make([]byte, 3*32)
+			bytecode[0] = 0x42
+			hash, err := zksync.HashBytecode(bytecode)
+			Expect(err).NotTo(HaveOccurred())
+
+			sum := sha256.Sum256(bytecode)
+			Expect(hash[:4]).To(Equal([]byte{0x01, 0x00, 0x00, 0x03}))
+			Expect(hash[4:]).To(Equal(sum[4:]))
+		})
+
+		It("rejects bytecode of invalid length", func() {
+			_, err := zksync.HashBytecode(make([]byte, 31))
+			Expect(errors.Is(err, zksync.ErrInvalidBytecode)).To(BeTrue())
+			_, err = zksync.HashBytecode(make([]byte, 64))
+			Expect(errors.Is(err, zksync.ErrInvalidBytecode)).To(BeTrue())
+		})
+	})
+
+	Describe("paymaster inputs", func() {
+		It("encodes the general flow", func() {
+			input, err := zksync.GetGeneralPaymasterInput(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hexutil.Encode(input)).To(Equal("0x8c5a3445" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000000"))
+		})
+
+		It("encodes the approval-based flow", func() {
+			input, err := zksync.GetApprovalBasedPaymasterInput(tokenAddr, big.NewInt(1), []byte{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hexutil.Encode(input[:4])).To(Equal("0x949431dc"))
+			Expect(input[4:36]).To(Equal(common.LeftPadBytes(tokenAddr.Bytes(), 32)))
+			Expect(new(big.Int).SetBytes(input[36:68])).To(Equal(big.NewInt(1)))
+		})
+	})
+
+	Describe("SignTransaction", func() {
+		It("signs the transaction typed data into its custom signature", func() {
+			input, err := zksync.GetGeneralPaymasterInput(nil)
+			Expect(err).NotTo(HaveOccurred())
+			tx := &transaction.Transaction{
+				Type:                 transaction.TransactionTypeEIP712,
+				ChainId:              324,
+				Nonce:                3,
+				To:                   targetAddr.Hex(),
+				Value:                big.NewInt(1_000),
+				Data:                 "0xdeadbeef",
+				Gas:                  big.NewInt(158_774),
+				MaxFeePerGas:         big.NewInt(250_000_000),
+				MaxPriorityFeePerGas: big.NewInt(0),
+				FactoryDeps:          []string{hexutil.Encode(make([]byte, 32))},
+				Paymaster:            paymasterAddr.Hex(),
+				PaymasterInput:       hexutil.Encode(input),
+			}
+
+			signed, err := zksync.SignTransaction(account, tx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signed).To(HavePrefix("0x71"))
+
+			parsed, err := transaction.ParseTransaction(signed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(common.HexToAddress(parsed.From)).To(Equal(account.Address()))
+			Expect(parsed.GasPerPubdata).To(Equal(big.NewInt(zksync.DefaultGasPerPubdata)))
+			Expect(recoverSigner(parsed)).To(Equal(account.Address()))
+
+			// The input transaction is left untouched.
+			Expect(tx.CustomSignature).To(BeEmpty())
+			Expect(tx.From).To(BeEmpty())
+		})
+
+		It("rejects transactions from another sender", func() {
+			_, err := zksync.SignTransaction(account, &transaction.Transaction{
+				Type:         transaction.TransactionTypeEIP712,
+				ChainId:      324,
+				From:         targetAddr.Hex(),
+				To:           targetAddr.Hex(),
+				Gas:          big.NewInt(21_000),
+				MaxFeePerGas: big.NewInt(1),
+			})
+			Expect(errors.Is(err, zksync.ErrSenderMismatch)).To(BeTrue())
+
+			// A matching sender in another case is accepted.
+			_, err = zksync.SignTransaction(account, &transaction.Transaction{
+				Type:         transaction.TransactionTypeEIP712,
+				ChainId:      324,
+				From:         strings.ToLower(account.Address().Hex()),
+				To:           targetAddr.Hex(),
+				Gas:          big.NewInt(21_000),
+				MaxFeePerGas: big.NewInt(1),
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects other transaction types", func() {
+			_, err := zksync.SignTransaction(account, &transaction.Transaction{
+				ChainId:      324,
+				MaxFeePerGas: big.NewInt(1),
+			})
+			Expect(errors.Is(err, zksync.ErrNotEIP712Transaction)).To(BeTrue())
+		})
+	})
+
+	Describe("actions", func() {
+		var (
+			node *rpctest.Node
+			pc   *client.PublicClient
+		)
+
+		BeforeEach(func() {
+			node = newFakeNode(324)
+			var err error
+			pc, err = client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(node.URL)})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			node.Close()
+		})
+
+		It("estimates fees with the EIP-712 fields in eip712Meta", func() {
+			from := account.Address()
+			fee, err := zksync.EstimateFee(ctx, pc, zksync.EstimateFeeParameters{
+				Account:        &from,
+				To:             &targetAddr,
+				Data:           []byte{0x01},
+				Value:          big.NewInt(5),
+				Paymaster:      &paymasterAddr,
+				PaymasterInput: []byte{0x8c, 0x5a},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fee.GasLimit).To(Equal(big.NewInt(0x26c36)))
+			Expect(fee.GasPerPubdataLimit).To(Equal(big.NewInt(50_000)))
+			Expect(fee.MaxFeePerGas).To(Equal(big.NewInt(250_000_000)))
+			Expect(fee.MaxPriorityFeePerGas.Sign()).To(BeZero())
+
+			var request struct {
+				From       common.Address `json:"from"`
+				To         common.Address `json:"to"`
+				Type       string         `json:"type"`
+				EIP712Meta struct {
+					GasPerPubdata   string `json:"gasPerPubdata"`
+					PaymasterParams struct {
+						Paymaster      common.Address `json:"paymaster"`
+						PaymasterInput []int          `json:"paymasterInput"`
+					} `json:"paymasterParams"`
+				} `json:"eip712Meta"`
+			}
+			Expect(json.Unmarshal(node.Params("zks_estimateFee")[0], &request)).To(Succeed())
+			Expect(request.From).To(Equal(from))
+			Expect(request.To).To(Equal(targetAddr))
+			Expect(request.Type).To(Equal("0x71"))
+			Expect(request.EIP712Meta.GasPerPubdata).To(Equal("0xc350"))
+			Expect(request.EIP712Meta.PaymasterParams.Paymaster).To(Equal(paymasterAddr))
+			Expect(request.EIP712Meta.PaymasterParams.PaymasterInput).To(Equal([]int{0x8c, 0x5a}))
+		})
+
+		It("returns L2 to L1 log proofs", func() {
+			index := 1
+			proof, err := zksync.GetL2ToL1LogProof(ctx, pc, zksync.GetL2ToL1LogProofParameters{
+				TxHash: common.HexToHash("0xaa"),
+				Index:  &index,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proof.ID).To(Equal(uint64(3)))
+			Expect(proof.Proof).To(Equal([]common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")}))
+			Expect(proof.Root).To(Equal(common.HexToHash("0xabc")))
+			Expect(node.Params("zks_getL2ToL1LogProof")).To(HaveLen(2))
+
+			node.Result("zks_getL2ToL1LogProof", nil)
+			_, err = zksync.GetL2ToL1LogProof(ctx, pc, zksync.GetL2ToL1LogProofParameters{TxHash: common.HexToHash("0xaa")})
+			Expect(errors.Is(err, zksync.ErrLogProofNotFound)).To(BeTrue())
+		})
+
+		It("returns the bridge contracts", func() {
+			contracts, err := zksync.GetBridgeContracts(ctx, pc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(contracts.L1SharedDefaultBridge).To(Equal(common.HexToAddress("0xd7f9f54194c633f36ccd5f3da84ad4a1c38cb2cb")))
+			Expect(contracts.L2Erc20DefaultBridge).To(Equal(common.HexToAddress("0x11f943b2c77b743ab90f4a0ae7d5a4e7fca3e102")))
+		})
+
+		Describe("SendTransaction", func() {
+			var wc *client.WalletClient
+
+			BeforeEach(func() {
+				var err error
+				wc, err = client.CreateWalletClient(client.WalletClientConfig{
+					Account:   account,
+					Chain:     &definitions.ZkSync,
+					Transport: transport.HTTP(node.URL),
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("prepares, signs and sends an EIP-712 transaction", func() {
+				input, err := zksync.GetGeneralPaymasterInput(nil)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = zksync.SendTransaction(ctx, wc, zksync.SendTransactionParameters{
+					To:             &targetAddr,
+					Value:          big.NewInt(1_000),
+					Paymaster:      &paymasterAddr,
+					PaymasterInput: input,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(node.RawTxs()).To(HaveLen(1))
+				sent, err := transaction.ParseTransaction(node.RawTxs()[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sent.Type).To(Equal(transaction.TransactionTypeEIP712))
+				Expect(sent.ChainId).To(Equal(324))
+				Expect(sent.Nonce).To(Equal(7))
+				Expect(sent.Gas).To(Equal(big.NewInt(0x26c36)))
+				Expect(sent.MaxFeePerGas).To(Equal(big.NewInt(250_000_000)))
+				Expect(sent.GasPerPubdata).To(Equal(big.NewInt(50_000)))
+				Expect(strings.EqualFold(sent.Paymaster, paymasterAddr.Hex())).To(BeTrue())
+				Expect(recoverSigner(sent)).To(Equal(account.Address()))
+			})
+
+			It("asserts the chain", func() {
+				wrong, err := client.CreateWalletClient(client.WalletClientConfig{
+					Account:   account,
+					Chain:     &definitions.ZkSyncSepolia,
+					Transport: transport.HTTP(node.URL),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = zksync.SendTransaction(ctx, wrong, zksync.SendTransactionParameters{To: &targetAddr})
+				Expect(err).To(HaveOccurred())
+				Expect(node.RawTxs()).To(BeEmpty())
+			})
+
+			It("binds the actions for client extensions", func() {
+				wc.Extend("zksync", zksync.WalletActions(wc))
+				ext, ok := wc.GetExtension("zksync")
+				Expect(ok).To(BeTrue())
+				sign, ok := ext.(map[string]any)["signTransaction"].(func(*transaction.Transaction) (string, error))
+				Expect(ok).To(BeTrue())
+				signed, err := sign(&transaction.Transaction{
+					Type:         transaction.TransactionTypeEIP712,
+					ChainId:      324,
+					To:           targetAddr.Hex(),
+					MaxFeePerGas: big.NewInt(1),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(signed).To(HavePrefix("0x71"))
+
+				Expect(zksync.PublicActions(pc)).To(HaveKey("estimateFee"))
+				Expect(zksync.PublicActions(pc)).To(HaveKey("getL2ToL1LogProof"))
+				Expect(zksync.PublicActions(pc)).To(HaveKey("getBridgeContracts"))
+			})
+		})
+	})
+})